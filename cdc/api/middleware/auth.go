@@ -0,0 +1,363 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package middleware
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha1" //nolint:gosec
+	"crypto/subtle"
+	"crypto/tls"
+	"encoding/base64"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/soheilhy/cmux"
+	"go.uber.org/zap"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	// authUserKey is the key of the authenticated user in the gin context.
+	authUserKey = "cdc-auth-user"
+	// authRoleKey is the key of the role of the authenticated user in the gin context.
+	authRoleKey = "cdc-auth-role"
+)
+
+// role is the role of a user, a role can access all the endpoints
+// which are accessible to the roles lower than it.
+type role int
+
+const (
+	roleNone role = iota
+	roleReadOnly
+	roleOperator
+	roleAdmin
+)
+
+func parseRole(s string) role {
+	switch s {
+	case config.HTTPRoleReadOnly:
+		return roleReadOnly
+	case config.HTTPRoleOperator:
+		return roleOperator
+	case config.HTTPRoleAdmin:
+		return roleAdmin
+	}
+	return roleNone
+}
+
+func (r role) String() string {
+	switch r {
+	case roleReadOnly:
+		return config.HTTPRoleReadOnly
+	case roleOperator:
+		return config.HTTPRoleOperator
+	case roleAdmin:
+		return config.HTTPRoleAdmin
+	}
+	return "none"
+}
+
+// publicPaths can be accessed without authentication, they are used by
+// health checks and monitoring systems.
+var publicPaths = map[string]struct{}{
+	"/status":        {},
+	"/metrics":       {},
+	"/api/v1/health": {},
+	"/api/v2/health": {},
+}
+
+// readOnlyPosts are the POST endpoints which don't change anything.
+var readOnlyPosts = map[string]struct{}{
+	"/api/v2/tso":                     {},
+	"/api/v2/verify_table":            {},
+	"/capture/owner/changefeed/query": {},
+}
+
+// adminPathPrefixes are the prefixes of the unsafe and debug endpoints.
+var adminPathPrefixes = []string{
+	"/api/v2/unsafe",
+	"/debug/",
+}
+
+// requiredRole returns the lowest role which can access the endpoint,
+// roleNone means the endpoint can be accessed without authentication.
+func requiredRole(method, path string) role {
+	if _, ok := publicPaths[path]; ok {
+		return roleNone
+	}
+	for _, prefix := range adminPathPrefixes {
+		if strings.HasPrefix(path, prefix) {
+			return roleAdmin
+		}
+	}
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return roleReadOnly
+	case http.MethodPost:
+		if _, ok := readOnlyPosts[path]; ok {
+			return roleReadOnly
+		}
+	}
+	return roleOperator
+}
+
+// Authenticator authenticates the HTTP requests by static bearer tokens,
+// basic auth against a htpasswd file or the identity of the client certificate.
+type Authenticator struct {
+	// tokens maps tokens to users.
+	tokens map[string]string
+	// passwords maps users to the password hashes.
+	passwords   map[string]string
+	tlsIdentity bool
+	roles       map[string]role
+}
+
+// NewAuthenticator creates an Authenticator, it returns nil if the
+// authentication is disabled.
+func NewAuthenticator(cfg *config.HTTPAuthConfig) (*Authenticator, error) {
+	if cfg == nil || !cfg.Enable {
+		return nil, nil
+	}
+	a := &Authenticator{
+		tokens:      make(map[string]string),
+		passwords:   make(map[string]string),
+		tlsIdentity: cfg.EnableTLSIdentity,
+		roles:       make(map[string]role, len(cfg.UserRoles)),
+	}
+	for user, r := range cfg.UserRoles {
+		a.roles[user] = parseRole(r)
+	}
+	if cfg.TokenFile != "" {
+		err := readAuthFile(cfg.TokenFile, " ", func(token, user string) {
+			a.tokens[token] = user
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	if cfg.HtpasswdFile != "" {
+		err := readAuthFile(cfg.HtpasswdFile, ":", func(user, hash string) {
+			a.passwords[user] = hash
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	log.Info("http api authentication enabled",
+		zap.Int("tokens", len(a.tokens)),
+		zap.Int("htpasswdUsers", len(a.passwords)),
+		zap.Bool("tlsIdentity", a.tlsIdentity),
+		zap.Int("roles", len(a.roles)))
+	return a, nil
+}
+
+// readAuthFile reads the non-empty and non-comment lines of the file,
+// each line is split into two fields by the separator.
+func readAuthFile(path, sep string, fn func(string, string)) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return cerror.WrapError(cerror.ErrHTTPAuthConfig, err)
+	}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		first, second, ok := strings.Cut(line, sep)
+		first, second = strings.TrimSpace(first), strings.TrimSpace(second)
+		if !ok || first == "" || second == "" {
+			return cerror.ErrHTTPAuthConfig.GenWithStackByArgs(
+				fmt.Sprintf("malformed line %d of %s", lineNo, path))
+		}
+		fn(first, second)
+	}
+	return cerror.WrapError(cerror.ErrHTTPAuthConfig, scanner.Err())
+}
+
+// Authenticate returns the user of the request. The credentials in the
+// Authorization header are preferred to the identity of the client certificate.
+func (a *Authenticator) Authenticate(req *http.Request) (string, error) {
+	if auth := req.Header.Get("Authorization"); auth != "" {
+		if token, ok := cutPrefixFold(auth, "Bearer "); ok {
+			return a.authenticateToken(token)
+		}
+		if user, password, ok := req.BasicAuth(); ok {
+			return a.authenticatePassword(user, password)
+		}
+		return "", cerror.ErrHTTPUnauthorized.GenWithStackByArgs(
+			"unsupported authorization scheme")
+	}
+	if a.tlsIdentity {
+		if cn := peerCommonName(req); cn != "" {
+			return cn, nil
+		}
+	}
+	return "", cerror.ErrHTTPUnauthorized.GenWithStackByArgs("no credentials")
+}
+
+func (a *Authenticator) authenticateToken(token string) (string, error) {
+	token = strings.TrimSpace(token)
+	for t, user := range a.tokens {
+		if subtle.ConstantTimeCompare([]byte(t), []byte(token)) == 1 {
+			return user, nil
+		}
+	}
+	return "", cerror.ErrHTTPUnauthorized.GenWithStackByArgs("invalid token")
+}
+
+func (a *Authenticator) authenticatePassword(user, password string) (string, error) {
+	hash, ok := a.passwords[user]
+	if ok && verifyPassword(hash, password) {
+		return user, nil
+	}
+	return "", cerror.ErrHTTPUnauthorized.GenWithStackByArgs(
+		"invalid user name or password")
+}
+
+// verifyPassword verifies the password against a htpasswd hash,
+// only bcrypt and SHA1 hashes are supported.
+func verifyPassword(hash, password string) bool {
+	if sha, ok := cutPrefixFold(hash, "{SHA}"); ok {
+		sum := sha1.Sum([]byte(password)) //nolint:gosec
+		expected := base64.StdEncoding.EncodeToString(sum[:])
+		return subtle.ConstantTimeCompare([]byte(sha), []byte(expected)) == 1
+	}
+	if strings.HasPrefix(hash, "$2") {
+		return bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)) == nil
+	}
+	return false
+}
+
+// role returns the role of the user.
+func (a *Authenticator) role(user string) role {
+	return a.roles[user]
+}
+
+func cutPrefixFold(s, prefix string) (string, bool) {
+	if len(s) >= len(prefix) && strings.EqualFold(s[:len(prefix)], prefix) {
+		return s[len(prefix):], true
+	}
+	return s, false
+}
+
+type tlsConnKey struct{}
+
+// ConnContext saves the TLS connection into the context of the requests.
+// It should be used as http.Server.ConnContext, because the connections are
+// wrapped by cmux and http.Request.TLS is not set.
+func ConnContext(ctx context.Context, c net.Conn) context.Context {
+	for {
+		switch conn := c.(type) {
+		case *tls.Conn:
+			return context.WithValue(ctx, tlsConnKey{}, conn)
+		case *cmux.MuxConn:
+			c = conn.Conn
+		default:
+			return ctx
+		}
+	}
+}
+
+// peerCommonName returns the common name of the client certificate.
+func peerCommonName(req *http.Request) string {
+	state := req.TLS
+	if state == nil {
+		conn, ok := req.Context().Value(tlsConnKey{}).(*tls.Conn)
+		if !ok {
+			return ""
+		}
+		s := conn.ConnectionState()
+		state = &s
+	}
+	if len(state.PeerCertificates) == 0 {
+		return ""
+	}
+	return state.PeerCertificates[0].Subject.CommonName
+}
+
+// AuthMiddleware authenticates the requests and checks whether the user
+// has the role required by the endpoint. It does nothing if the
+// authenticator is nil.
+func AuthMiddleware(a *Authenticator) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if a == nil {
+			c.Next()
+			return
+		}
+		required := requiredRole(c.Request.Method, c.Request.URL.Path)
+		if required == roleNone {
+			c.Next()
+			return
+		}
+		user, err := a.Authenticate(c.Request)
+		if err != nil {
+			log.Warn("http request is not authenticated",
+				zap.String("method", c.Request.Method),
+				zap.String("path", c.Request.URL.Path),
+				zap.String("ip", c.ClientIP()),
+				zap.Error(err))
+			if len(a.passwords) != 0 {
+				c.Header("WWW-Authenticate", `Basic realm="TiCDC"`)
+			}
+			c.IndentedJSON(http.StatusUnauthorized, model.NewHTTPError(err))
+			c.Abort()
+			return
+		}
+		r := a.role(user)
+		c.Set(authUserKey, user)
+		c.Set(authRoleKey, r.String())
+		if r < required {
+			err := cerror.ErrHTTPPermissionDenied.GenWithStackByArgs(
+				user, r, c.Request.Method, c.Request.URL.Path)
+			log.Warn("http request is denied",
+				zap.String("user", user),
+				zap.String("ip", c.ClientIP()),
+				zap.Error(err))
+			c.IndentedJSON(http.StatusForbidden, model.NewHTTPError(err))
+			c.Abort()
+			return
+		}
+		c.Next()
+	}
+}
+
+// AuditLogMiddleware logs the mutating requests with the authenticated user.
+func AuditLogMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Next()
+		if requiredRole(c.Request.Method, c.Request.URL.Path) <= roleReadOnly {
+			return
+		}
+		log.Info("audit http request",
+			zap.String("user", c.GetString(authUserKey)),
+			zap.String("role", c.GetString(authRoleKey)),
+			zap.String("method", c.Request.Method),
+			zap.String("path", c.Request.URL.Path),
+			zap.String("query", c.Request.URL.RawQuery),
+			zap.String("ip", c.ClientIP()),
+			zap.Int("status", c.Writer.Status()))
+	}
+}
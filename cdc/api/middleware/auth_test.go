@@ -0,0 +1,185 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package middleware
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestRequiredRole(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		method string
+		path   string
+		role   role
+	}{
+		{http.MethodGet, "/status", roleNone},
+		{http.MethodGet, "/api/v1/health", roleNone},
+		{http.MethodGet, "/api/v1/changefeeds", roleReadOnly},
+		{http.MethodGet, "/api/v2/changefeeds/test/meta_info", roleReadOnly},
+		{http.MethodPost, "/api/v2/tso", roleReadOnly},
+		{http.MethodPost, "/api/v1/changefeeds", roleOperator},
+		{http.MethodPut, "/api/v2/changefeeds/test", roleOperator},
+		{http.MethodDelete, "/api/v1/changefeeds/test", roleOperator},
+		{http.MethodGet, "/api/v2/unsafe/metadata", roleAdmin},
+		{http.MethodPost, "/api/v2/unsafe/resolve_lock", roleAdmin},
+		{http.MethodGet, "/debug/pprof/", roleAdmin},
+	}
+	for _, c := range cases {
+		require.Equal(t, c.role, requiredRole(c.method, c.path), "%s %s", c.method, c.path)
+	}
+}
+
+func newAuthTestRouter(t *testing.T) *gin.Engine {
+	dir := t.TempDir()
+	tokenFile := filepath.Join(dir, "tokens")
+	require.NoError(t, os.WriteFile(tokenFile, []byte(
+		"# token user\nreader-token reader\n\noperator-token operator\n"), 0o600))
+	hash, err := bcrypt.GenerateFromPassword([]byte("admin-password"), bcrypt.MinCost)
+	require.NoError(t, err)
+	htpasswdFile := filepath.Join(dir, "htpasswd")
+	require.NoError(t, os.WriteFile(htpasswdFile, []byte(
+		"admin:"+string(hash)+"\n"+
+			// The SHA1 hash of "password".
+			"sha:{SHA}W6ph5Mm5Pz8GgiULbPgzG37mj9g=\n"), 0o600))
+
+	a, err := NewAuthenticator(&config.HTTPAuthConfig{
+		Enable:            true,
+		TokenFile:         tokenFile,
+		HtpasswdFile:      htpasswdFile,
+		EnableTLSIdentity: true,
+		UserRoles: map[string]string{
+			"reader":   config.HTTPRoleReadOnly,
+			"operator": config.HTTPRoleOperator,
+			"admin":    config.HTTPRoleAdmin,
+			"sha":      config.HTTPRoleReadOnly,
+			"cdc":      config.HTTPRoleAdmin,
+		},
+	})
+	require.NoError(t, err)
+
+	router := gin.New()
+	router.Use(AuthMiddleware(a))
+	router.Use(AuditLogMiddleware())
+	handler := func(c *gin.Context) {
+		c.String(http.StatusOK, c.GetString(authUserKey))
+	}
+	router.GET("/status", handler)
+	router.GET("/api/v2/changefeeds/:changefeed_id/meta_info", handler)
+	router.POST("/api/v2/changefeeds", handler)
+	router.GET("/api/v2/unsafe/metadata", handler)
+	return router
+}
+
+func TestAuthMiddleware(t *testing.T) {
+	t.Parallel()
+
+	router := newAuthTestRouter(t)
+	cases := []struct {
+		method string
+		path   string
+		setup  func(req *http.Request)
+		code   int
+		user   string
+	}{
+		{http.MethodGet, "/status", nil, http.StatusOK, ""},
+		{http.MethodGet, "/api/v2/changefeeds/test/meta_info", nil, http.StatusUnauthorized, ""},
+		{http.MethodGet, "/api/v2/changefeeds/test/meta_info", func(req *http.Request) {
+			req.Header.Set("Authorization", "Bearer reader-token")
+		}, http.StatusOK, "reader"},
+		{http.MethodGet, "/api/v2/changefeeds/test/meta_info", func(req *http.Request) {
+			req.Header.Set("Authorization", "Bearer invalid-token")
+		}, http.StatusUnauthorized, ""},
+		{http.MethodPost, "/api/v2/changefeeds", func(req *http.Request) {
+			req.Header.Set("Authorization", "Bearer reader-token")
+		}, http.StatusForbidden, ""},
+		{http.MethodPost, "/api/v2/changefeeds", func(req *http.Request) {
+			req.Header.Set("Authorization", "bearer operator-token")
+		}, http.StatusOK, "operator"},
+		{http.MethodGet, "/api/v2/unsafe/metadata", func(req *http.Request) {
+			req.Header.Set("Authorization", "Bearer operator-token")
+		}, http.StatusForbidden, ""},
+		{http.MethodGet, "/api/v2/unsafe/metadata", func(req *http.Request) {
+			req.SetBasicAuth("admin", "admin-password")
+		}, http.StatusOK, "admin"},
+		{http.MethodGet, "/api/v2/unsafe/metadata", func(req *http.Request) {
+			req.SetBasicAuth("admin", "wrong-password")
+		}, http.StatusUnauthorized, ""},
+		{http.MethodGet, "/api/v2/changefeeds/test/meta_info", func(req *http.Request) {
+			req.SetBasicAuth("sha", "password")
+		}, http.StatusOK, "sha"},
+		// The identity of the client certificate.
+		{http.MethodGet, "/api/v2/unsafe/metadata", func(req *http.Request) {
+			req.TLS = &tls.ConnectionState{PeerCertificates: []*x509.Certificate{
+				{Subject: pkix.Name{CommonName: "cdc"}},
+			}}
+		}, http.StatusOK, "cdc"},
+		// Users without roles are denied.
+		{http.MethodGet, "/api/v2/changefeeds/test/meta_info", func(req *http.Request) {
+			req.TLS = &tls.ConnectionState{PeerCertificates: []*x509.Certificate{
+				{Subject: pkix.Name{CommonName: "unknown"}},
+			}}
+		}, http.StatusForbidden, ""},
+	}
+	for i, c := range cases {
+		req, err := http.NewRequestWithContext(context.Background(), c.method, c.path, nil)
+		require.NoError(t, err)
+		if c.setup != nil {
+			c.setup(req)
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		require.Equal(t, c.code, w.Code, "case %d", i)
+		if c.code == http.StatusOK {
+			require.Equal(t, c.user, w.Body.String(), "case %d", i)
+		}
+	}
+}
+
+func TestNewAuthenticator(t *testing.T) {
+	t.Parallel()
+
+	a, err := NewAuthenticator(&config.HTTPAuthConfig{})
+	require.NoError(t, err)
+	require.Nil(t, a)
+
+	_, err = NewAuthenticator(&config.HTTPAuthConfig{
+		Enable:    true,
+		TokenFile: filepath.Join(t.TempDir(), "not-exist"),
+	})
+	require.ErrorIs(t, err, cerror.ErrHTTPAuthConfig)
+
+	tokenFile := filepath.Join(t.TempDir(), "tokens")
+	require.NoError(t, os.WriteFile(tokenFile, []byte("token-without-user\n"), 0o600))
+	_, err = NewAuthenticator(&config.HTTPAuthConfig{
+		Enable:    true,
+		TokenFile: tokenFile,
+	})
+	require.ErrorIs(t, err, cerror.ErrHTTPAuthConfig)
+}
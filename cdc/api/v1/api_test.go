@@ -99,6 +99,11 @@ func (p *mockStatusProvider) IsHealthy(ctx context.Context) (bool, error) {
 	return args.Get(0).(bool), args.Error(1)
 }
 
+func (p *mockStatusProvider) GetChangefeedSLOStatus(ctx context.Context, changefeedID model.ChangeFeedID) (*model.ChangefeedSLOStatus, error) {
+	args := p.Called(ctx, changefeedID)
+	return args.Get(0).(*model.ChangefeedSLOStatus), args.Error(1)
+}
+
 func newRouter(c capture.Capture, p owner.StatusProvider) *gin.Engine {
 	router := gin.New()
 	RegisterOpenAPIRoutes(router, NewOpenAPI4Test(c, p))
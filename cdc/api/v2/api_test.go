@@ -64,6 +64,7 @@ type mockStatusProvider struct {
 	owner.StatusProvider
 	changefeedStatus *model.ChangeFeedStatus
 	changefeedInfo   *model.ChangeFeedInfo
+	sloStatus        *model.ChangefeedSLOStatus
 	err              error
 }
 
@@ -80,3 +81,10 @@ func (m *mockStatusProvider) GetChangeFeedInfo(ctx context.Context,
 ) (*model.ChangeFeedInfo, error) {
 	return m.changefeedInfo, m.err
 }
+
+// GetChangefeedSLOStatus returns a mock changefeeds' slo status.
+func (m *mockStatusProvider) GetChangefeedSLOStatus(ctx context.Context,
+	changefeedID model.ChangeFeedID,
+) (*model.ChangefeedSLOStatus, error) {
+	return m.sloStatus, m.err
+}
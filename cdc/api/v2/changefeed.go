@@ -292,6 +292,42 @@ func (h *OpenAPIV2) getChangeFeedMetaInfo(c *gin.Context) {
 	c.JSON(http.StatusOK, toAPIModel(info, false))
 }
 
+// getChangefeedStatus returns the runtime status of a changefeed,
+// including the lag SLO status
+func (h *OpenAPIV2) getChangefeedStatus(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	changefeedID := model.DefaultChangeFeedID(c.Param(apiOpVarChangefeedID))
+	if err := model.ValidateChangefeedID(changefeedID.ID); err != nil {
+		_ = c.Error(cerror.ErrAPIInvalidParam.GenWithStack("invalid changefeed_id: %s",
+			changefeedID.ID))
+		return
+	}
+	provider := h.capture.StatusProvider()
+	info, err := provider.GetChangeFeedInfo(ctx, changefeedID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	status, err := provider.GetChangeFeedStatus(ctx, changefeedID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	slo, err := provider.GetChangefeedSLOStatus(ctx, changefeedID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, &ChangefeedStatus{
+		State:            info.State,
+		ResolvedTs:       status.ResolvedTs,
+		CheckpointTs:     status.CheckpointTs,
+		SLO:              slo,
+		PendingAsyncDDLs: status.PendingAsyncDDLs,
+	})
+}
+
 // resumeChangefeed handles update changefeed request.
 func (h *OpenAPIV2) resumeChangefeed(c *gin.Context) {
 	ctx := c.Request.Context()
@@ -657,3 +657,64 @@ func TestResumeChangefeed(t *testing.T) {
 	router.ServeHTTP(w, req)
 	require.Equal(t, http.StatusOK, w.Code)
 }
+
+func TestGetChangefeedStatus(t *testing.T) {
+	t.Parallel()
+
+	status := testCase{url: "/api/v2/changefeeds/%s/status", method: "GET"}
+	statusProvider := &mockStatusProvider{}
+	cp := mock_capture.NewMockCapture(gomock.NewController(t))
+	cp.EXPECT().IsReady().Return(true).AnyTimes()
+	cp.EXPECT().IsOwner().Return(true).AnyTimes()
+	cp.EXPECT().StatusProvider().Return(statusProvider).AnyTimes()
+
+	apiV2 := NewOpenAPIV2ForTest(cp, APIV2HelpersImpl{})
+	router := newRouter(apiV2)
+
+	// invalid id
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequestWithContext(context.Background(),
+		status.method, fmt.Sprintf(status.url, "@^Invalid"), nil)
+	router.ServeHTTP(w, req)
+	respErr := model.HTTPError{}
+	require.Nil(t, json.NewDecoder(w.Body).Decode(&respErr))
+	require.Contains(t, respErr.Code, "ErrAPIInvalidParam")
+
+	// changefeed not exists
+	validID := "changefeed-valid-id"
+	statusProvider.err = cerrors.ErrChangeFeedNotExists.GenWithStackByArgs(validID)
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequestWithContext(context.Background(),
+		status.method, fmt.Sprintf(status.url, validID), nil)
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	respErr = model.HTTPError{}
+	require.Nil(t, json.NewDecoder(w.Body).Decode(&respErr))
+	require.Contains(t, respErr.Code, "ErrChangeFeedNotExists")
+
+	// success
+	statusProvider.err = nil
+	statusProvider.changefeedInfo = &model.ChangeFeedInfo{
+		ID: validID, State: model.StateNormal,
+	}
+	statusProvider.changefeedStatus = &model.ChangeFeedStatus{
+		ResolvedTs: 2, CheckpointTs: 1,
+	}
+	statusProvider.sloStatus = &model.ChangefeedSLOStatus{
+		CheckpointLag: &model.LagSLOStatus{
+			MaxLagInSec: 60, LagInSec: 120, Breached: true, BreachDurationInSec: 60,
+		},
+	}
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequestWithContext(context.Background(),
+		status.method, fmt.Sprintf(status.url, validID), nil)
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+	resp := ChangefeedStatus{}
+	require.Nil(t, json.NewDecoder(w.Body).Decode(&resp))
+	require.Equal(t, model.StateNormal, resp.State)
+	require.Equal(t, uint64(2), resp.ResolvedTs)
+	require.Equal(t, uint64(1), resp.CheckpointTs)
+	require.True(t, resp.SLO.IsBreached())
+	require.Nil(t, resp.SLO.ResolvedLag)
+}
@@ -91,14 +91,16 @@ type ChangefeedConfig struct {
 
 // ReplicaConfig is a duplicate of  config.ReplicaConfig
 type ReplicaConfig struct {
-	CaseSensitive         bool              `json:"case_sensitive"`
-	EnableOldValue        bool              `json:"enable_old_value"`
-	ForceReplicate        bool              `json:"force_replicate"`
-	IgnoreIneligibleTable bool              `json:"ignore_ineligible_table"`
-	CheckGCSafePoint      bool              `json:"check_gc_safe_point"`
-	Filter                *FilterConfig     `json:"filter"`
-	Sink                  *SinkConfig       `json:"sink"`
-	Consistent            *ConsistentConfig `json:"consistent"`
+	CaseSensitive         bool                 `json:"case_sensitive"`
+	EnableOldValue        bool                 `json:"enable_old_value"`
+	ForceReplicate        bool                 `json:"force_replicate"`
+	IgnoreIneligibleTable bool                 `json:"ignore_ineligible_table"`
+	CheckGCSafePoint      bool                 `json:"check_gc_safe_point"`
+	Filter                *FilterConfig        `json:"filter"`
+	Sink                  *SinkConfig          `json:"sink"`
+	Consistent            *ConsistentConfig    `json:"consistent"`
+	SLO                   *SLOConfig           `json:"slo,omitempty"`
+	Bidirectional         *BidirectionalConfig `json:"bidirectional,omitempty"`
 }
 
 // ToInternalReplicaConfig coverts *v2.ReplicaConfig into *config.ReplicaConfig
@@ -156,6 +158,21 @@ func (c *ReplicaConfig) ToInternalReplicaConfig() *config.ReplicaConfig {
 			Storage:           c.Consistent.Storage,
 		}
 	}
+	if c.SLO != nil {
+		res.SLO = &config.SLOConfig{
+			MaxCheckpointLagInSec: c.SLO.MaxCheckpointLagInSec,
+			MaxResolvedLagInSec:   c.SLO.MaxResolvedLagInSec,
+			WebhookURL:            c.SLO.WebhookURL,
+		}
+	}
+	if c.Bidirectional != nil {
+		res.Bidirectional = &config.BidirectionalConfig{
+			Enable:           c.Bidirectional.Enable,
+			ReplicaID:        c.Bidirectional.ReplicaID,
+			FilterReplicaIDs: c.Bidirectional.FilterReplicaIDs,
+			SyncDDL:          c.Bidirectional.SyncDDL,
+		}
+	}
 	if c.Sink != nil {
 		var dispatchRules []*config.DispatchRule
 		for _, rule := range c.Sink.DispatchRules {
@@ -173,12 +190,23 @@ func (c *ReplicaConfig) ToInternalReplicaConfig() *config.ReplicaConfig {
 				Columns: selector.Columns,
 			})
 		}
+		var conflictRules []*config.ConflictRule
+		for _, rule := range c.Sink.ConflictRules {
+			conflictRules = append(conflictRules, &config.ConflictRule{
+				Matcher:         rule.Matcher,
+				Policy:          rule.Policy,
+				TimestampColumn: rule.TimestampColumn,
+				ConflictTable:   rule.ConflictTable,
+			})
+		}
 		res.Sink = &config.SinkConfig{
 			DispatchRules:   dispatchRules,
 			Protocol:        c.Sink.Protocol,
 			TxnAtomicity:    config.AtomicityLevel(c.Sink.TxnAtomicity),
 			ColumnSelectors: columnSelectors,
 			SchemaRegistry:  c.Sink.SchemaRegistry,
+			ConflictRules:   conflictRules,
+			AsyncIndexDDL:   c.Sink.AsyncIndexDDL,
 		}
 	}
 	return res
@@ -252,12 +280,23 @@ func ToAPIReplicaConfig(c *config.ReplicaConfig) *ReplicaConfig {
 				Columns: selector.Columns,
 			})
 		}
+		var conflictRules []*ConflictRule
+		for _, rule := range cloned.Sink.ConflictRules {
+			conflictRules = append(conflictRules, &ConflictRule{
+				Matcher:         rule.Matcher,
+				Policy:          rule.Policy,
+				TimestampColumn: rule.TimestampColumn,
+				ConflictTable:   rule.ConflictTable,
+			})
+		}
 		res.Sink = &SinkConfig{
 			Protocol:        cloned.Sink.Protocol,
 			SchemaRegistry:  cloned.Sink.SchemaRegistry,
 			DispatchRules:   dispatchRules,
 			ColumnSelectors: columnSelectors,
 			TxnAtomicity:    string(cloned.Sink.TxnAtomicity),
+			ConflictRules:   conflictRules,
+			AsyncIndexDDL:   cloned.Sink.AsyncIndexDDL,
 		}
 	}
 	if cloned.Consistent != nil {
@@ -268,6 +307,21 @@ func ToAPIReplicaConfig(c *config.ReplicaConfig) *ReplicaConfig {
 			Storage:           cloned.Consistent.Storage,
 		}
 	}
+	if cloned.SLO != nil {
+		res.SLO = &SLOConfig{
+			MaxCheckpointLagInSec: cloned.SLO.MaxCheckpointLagInSec,
+			MaxResolvedLagInSec:   cloned.SLO.MaxResolvedLagInSec,
+			WebhookURL:            cloned.SLO.WebhookURL,
+		}
+	}
+	if cloned.Bidirectional != nil {
+		res.Bidirectional = &BidirectionalConfig{
+			Enable:           cloned.Bidirectional.Enable,
+			ReplicaID:        cloned.Bidirectional.ReplicaID,
+			FilterReplicaIDs: cloned.Bidirectional.FilterReplicaIDs,
+			SyncDDL:          cloned.Bidirectional.SyncDDL,
+		}
+	}
 	return res
 }
 
@@ -385,6 +439,8 @@ type SinkConfig struct {
 	DispatchRules   []*DispatchRule   `json:"dispatchers,omitempty"`
 	ColumnSelectors []*ColumnSelector `json:"column_selectors"`
 	TxnAtomicity    string            `json:"transaction_atomicity"`
+	ConflictRules   []*ConflictRule   `json:"conflict_rules,omitempty"`
+	AsyncIndexDDL   bool              `json:"async_index_ddl"`
 }
 
 // DispatchRule represents partition rule for a table
@@ -402,6 +458,15 @@ type ColumnSelector struct {
 	Columns []string `json:"columns,omitempty"`
 }
 
+// ConflictRule represents the conflict policy of tables for the MySQL sink.
+// This is a duplicate of config.ConflictRule
+type ConflictRule struct {
+	Matcher         []string `json:"matcher,omitempty"`
+	Policy          string   `json:"policy"`
+	TimestampColumn string   `json:"timestamp_column,omitempty"`
+	ConflictTable   string   `json:"conflict_table,omitempty"`
+}
+
 // ConsistentConfig represents replication consistency config for a changefeed
 // This is a duplicate of config.ConsistentConfig
 type ConsistentConfig struct {
@@ -411,6 +476,23 @@ type ConsistentConfig struct {
 	Storage           string `json:"storage"`
 }
 
+// SLOConfig represents the replication lag SLO of a changefeed
+// This is a duplicate of config.SLOConfig
+type SLOConfig struct {
+	MaxCheckpointLagInSec int64  `json:"max_checkpoint_lag_in_sec"`
+	MaxResolvedLagInSec   int64  `json:"max_resolved_lag_in_sec"`
+	WebhookURL            string `json:"webhook_url"`
+}
+
+// BidirectionalConfig represents the bidirectional replication config of a changefeed
+// This is a duplicate of config.BidirectionalConfig
+type BidirectionalConfig struct {
+	Enable           bool     `json:"enable"`
+	ReplicaID        uint64   `json:"replica_id"`
+	FilterReplicaIDs []uint64 `json:"filter_replica_ids"`
+	SyncDDL          bool     `json:"sync_ddl"`
+}
+
 // EtcdData contains key/value pair of etcd data
 type EtcdData struct {
 	Key   string `json:"key,omitempty"`
@@ -446,6 +528,18 @@ type ChangeFeedInfo struct {
 	CreatorVersion    string             `json:"creator_version,omitempty"`
 }
 
+// ChangefeedStatus is the runtime status of a changefeed
+type ChangefeedStatus struct {
+	State        model.FeedState `json:"state,omitempty"`
+	ResolvedTs   uint64          `json:"resolved_ts"`
+	CheckpointTs uint64          `json:"checkpoint_ts"`
+	// SLO is the lag SLO status, it's nil if no lag SLO is set
+	// or the changefeed is not running.
+	SLO *model.ChangefeedSLOStatus `json:"slo,omitempty"`
+	// PendingAsyncDDLs are the DDLs executing asynchronously downstream.
+	PendingAsyncDDLs []*model.AsyncDDL `json:"pending_async_ddls,omitempty"`
+}
+
 // RunningError represents some running error from cdc components, such as processor.
 type RunningError struct {
 	Addr    string `json:"addr"`
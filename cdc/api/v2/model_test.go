@@ -53,6 +53,12 @@ func TestToAPIReplicaConfig(t *testing.T) {
 		FlushIntervalInMs: 10,
 		Storage:           "s3",
 	}
+	cfg.Bidirectional = &config.BidirectionalConfig{
+		Enable:           true,
+		ReplicaID:        1,
+		FilterReplicaIDs: []uint64{2},
+		SyncDDL:          true,
+	}
 	cfg.Filter = &config.FilterConfig{
 		Rules: []string{"a", "b", "c"},
 		MySQLReplicationRules: &filter.MySQLReplicationRules{
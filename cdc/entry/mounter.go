@@ -31,6 +31,7 @@ import (
 	"github.com/pingcap/tidb/tablecodec"
 	"github.com/pingcap/tidb/types"
 	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/bdr"
 	cerror "github.com/pingcap/tiflow/pkg/errors"
 	pfilter "github.com/pingcap/tiflow/pkg/filter"
 	"github.com/prometheus/client_golang/prometheus"
@@ -179,6 +180,12 @@ func (m *mounterImpl) unmarshalAndMountRowChanged(ctx context.Context, raw *mode
 			if err != nil {
 				return nil, err
 			}
+			// The mark rows of the bidirectional replication are only
+			// subscribed by the table pipelines for loop prevention, they
+			// are dropped by the sink node.
+			if bdr.IsMarkTable(row.Table.Schema, row.Table.Table) {
+				return row, nil
+			}
 			// We need to filter a row here because we need its tableInfo.
 			ignore, err := m.filter.ShouldIgnoreDMLEvent(row, rawRow, tableInfo)
 			if err != nil {
@@ -0,0 +1,578 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multiplexer
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/contextutil"
+	"github.com/pingcap/tiflow/cdc/kv"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/puller/frontier"
+	"github.com/pingcap/tiflow/pkg/regionspan"
+	"github.com/pingcap/tiflow/pkg/txnutil"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	defaultSubscriberChanSize = 128
+	// subscriberLagTimeout is the max duration to wait for a subscriber whose
+	// buffer is full, it's detached from the shared EventFeed after that.
+	subscriberLagTimeout = time.Second
+)
+
+// Multiplexer dedupes the region subscriptions of all changefeeds of an
+// upstream in a capture. The subscriptions of the same span share a single
+// EventFeed, and the events are fanned out to each subscriber according to
+// its own start ts. A subscriber whose start ts is older than the progress of
+// the shared EventFeed catches up through a separate EventFeed. A subscriber
+// which can't keep up with the shared EventFeed is detached from it, and falls
+// back to a dedicated EventFeed, so it doesn't block the other subscribers.
+//
+// Multiplexer implements kv.CDCKVClient, so it can be used by pullers directly.
+type Multiplexer struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	// newClient creates the kv client to pull the events of a span.
+	newClient func() kv.CDCKVClient
+	// changefeed is used to identify the shared EventFeeds in logs and metrics.
+	changefeed model.ChangeFeedID
+
+	mu     sync.Mutex
+	feeds  map[spanKey]*sharedFeed
+	nextID uint64
+}
+
+var _ kv.CDCKVClient = (*Multiplexer)(nil)
+
+type spanKey struct {
+	start string
+	end   string
+}
+
+func newSpanKey(span regionspan.ComparableSpan) spanKey {
+	return spanKey{start: string(span.Start), end: string(span.End)}
+}
+
+// New creates a Multiplexer.
+func New(
+	ctx context.Context, changefeed model.ChangeFeedID, newClient func() kv.CDCKVClient,
+) *Multiplexer {
+	ctx, cancel := context.WithCancel(ctx)
+	return &Multiplexer{
+		ctx:        ctx,
+		cancel:     cancel,
+		newClient:  newClient,
+		changefeed: changefeed,
+		feeds:      make(map[spanKey]*sharedFeed),
+	}
+}
+
+// EventFeed implements kv.CDCKVClient. It subscribes the shared EventFeed of
+// the span, and sends the events whose commit ts is greater than ts to eventCh.
+// It returns when ctx is done or the shared EventFeed fails.
+func (m *Multiplexer) EventFeed(
+	ctx context.Context, span regionspan.ComparableSpan, ts uint64,
+	lockResolver txnutil.LockResolver,
+	isPullerInit kv.PullerInitialization,
+	eventCh chan<- model.RegionFeedEvent,
+) error {
+	feed, sub, err := m.subscribe(ctx, span, ts, lockResolver)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	err = m.runSubscriber(ctx, feed, sub, ts, lockResolver, isPullerInit, eventCh)
+	m.unsubscribe(feed, sub)
+	if errors.Cause(err) != errLagged {
+		return errors.Trace(err)
+	}
+
+	// The events whose commit ts is not greater than the resolved ts have
+	// been sent, pull the rest by a dedicated EventFeed.
+	resolvedTs := sub.resolvedTs()
+	log.Warn("subscriber lags behind the shared event feed, "+
+		"fall back to a dedicated event feed",
+		zap.String("namespace", m.changefeed.Namespace),
+		zap.String("changefeed", m.changefeed.ID),
+		zap.Stringer("span", span),
+		zap.Uint64("startTs", ts),
+		zap.Uint64("resolvedTs", resolvedTs))
+	return m.newClient().EventFeed(ctx, span, resolvedTs, lockResolver, isPullerInit, eventCh)
+}
+
+// runSubscriber sends the events of the shared EventFeed to eventCh, the
+// events before the progress of the shared EventFeed are caught up first.
+func (m *Multiplexer) runSubscriber(
+	ctx context.Context, feed *sharedFeed, sub *subscriber, ts uint64,
+	lockResolver txnutil.LockResolver,
+	isPullerInit kv.PullerInitialization,
+	eventCh chan<- model.RegionFeedEvent,
+) error {
+	span := feed.span
+	g, ctx := errgroup.WithContext(ctx)
+	if sub.catchUpTs > ts {
+		log.Info("subscriber catches up the shared event feed",
+			zap.String("namespace", m.changefeed.Namespace),
+			zap.String("changefeed", m.changefeed.ID),
+			zap.Stringer("span", span),
+			zap.Uint64("startTs", ts),
+			zap.Uint64("catchUpTs", sub.catchUpTs))
+		g.Go(func() error {
+			return sub.catchUp(ctx, m.newClient(), lockResolver, isPullerInit, eventCh)
+		})
+	} else {
+		atomic.StoreInt32(&sub.caughtUp, 1)
+	}
+	g.Go(func() error {
+		return sub.forward(ctx, feed, eventCh)
+	})
+	return g.Wait()
+}
+
+// Close stops all shared EventFeeds.
+func (m *Multiplexer) Close() {
+	m.cancel()
+	m.mu.Lock()
+	feeds := m.feeds
+	m.feeds = make(map[spanKey]*sharedFeed)
+	m.mu.Unlock()
+	for _, feed := range feeds {
+		feed.stop()
+	}
+}
+
+func (m *Multiplexer) subscribe(
+	ctx context.Context, span regionspan.ComparableSpan, ts uint64,
+	lockResolver txnutil.LockResolver,
+) (*sharedFeed, *subscriber, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := m.ctx.Err(); err != nil {
+		return nil, nil, errors.Trace(err)
+	}
+
+	key := newSpanKey(span)
+	feed, ok := m.feeds[key]
+	if ok {
+		select {
+		case <-feed.done:
+			// The shared EventFeed has exited, create a new one.
+			ok = false
+		default:
+		}
+	}
+	if !ok {
+		feedCtx := contextutil.PutChangefeedIDInCtx(m.ctx, m.changefeed)
+		tableID, tableName := contextutil.TableIDFromCtx(ctx)
+		feedCtx = contextutil.PutTableInfoInCtx(feedCtx, tableID, tableName)
+		feed = newSharedFeed(feedCtx, span, ts)
+		m.feeds[key] = feed
+		go func() {
+			err := feed.run(m.newClient(), lockResolver)
+			m.mu.Lock()
+			if m.feeds[key] == feed {
+				delete(m.feeds, key)
+			}
+			m.mu.Unlock()
+			if err != nil && errors.Cause(err) != context.Canceled {
+				log.Warn("shared event feed failed",
+					zap.String("namespace", m.changefeed.Namespace),
+					zap.String("changefeed", m.changefeed.ID),
+					zap.Stringer("span", span),
+					zap.Error(err))
+			}
+		}()
+		log.Info("shared event feed created",
+			zap.String("namespace", m.changefeed.Namespace),
+			zap.String("changefeed", m.changefeed.ID),
+			zap.Stringer("span", span),
+			zap.Uint64("startTs", ts))
+	}
+
+	sub := feed.addSubscriber(m.nextID, ts)
+	m.nextID++
+	return feed, sub, nil
+}
+
+func (m *Multiplexer) unsubscribe(feed *sharedFeed, sub *subscriber) {
+	m.mu.Lock()
+	empty := feed.removeSubscriber(sub)
+	if empty {
+		key := newSpanKey(feed.span)
+		if m.feeds[key] == feed {
+			delete(m.feeds, key)
+		}
+	}
+	m.mu.Unlock()
+	if empty {
+		feed.stop()
+		log.Info("shared event feed stopped",
+			zap.String("namespace", m.changefeed.Namespace),
+			zap.String("changefeed", m.changefeed.ID),
+			zap.Stringer("span", feed.span))
+	}
+}
+
+// sharedFeed is an EventFeed of a span shared by subscribers.
+type sharedFeed struct {
+	ctx     context.Context
+	cancel  context.CancelFunc
+	span    regionspan.ComparableSpan
+	startTs uint64
+	// done is closed when the EventFeed exits, err is set before it's closed.
+	done chan struct{}
+	err  error
+
+	mu sync.Mutex
+	// tsTracker tracks the resolved ts of the whole span.
+	tsTracker frontier.Frontier
+	// pending holds the events whose commit ts is greater than the resolved ts
+	// of the whole span, they are replayed to late subscribers.
+	pending     []*model.RawKVEntry
+	subscribers map[uint64]*subscriber
+}
+
+func newSharedFeed(ctx context.Context, span regionspan.ComparableSpan, startTs uint64) *sharedFeed {
+	ctx, cancel := context.WithCancel(ctx)
+	return &sharedFeed{
+		ctx:     ctx,
+		cancel:  cancel,
+		span:    span,
+		startTs: startTs,
+		done:    make(chan struct{}),
+		// Use 0 as the initial ts to distinguish whether it's initialized.
+		tsTracker:   frontier.NewFrontier(0, span),
+		subscribers: make(map[uint64]*subscriber),
+	}
+}
+
+// run pulls the events of the span and fans them out to subscribers.
+func (f *sharedFeed) run(client kv.CDCKVClient, lockResolver txnutil.LockResolver) error {
+	defer close(f.done)
+
+	g, ctx := errgroup.WithContext(f.ctx)
+	eventCh := make(chan model.RegionFeedEvent, defaultSubscriberChanSize)
+	g.Go(func() error {
+		return client.EventFeed(ctx, f.span, f.startTs, lockResolver, f, eventCh)
+	})
+	g.Go(func() error {
+		for {
+			var e model.RegionFeedEvent
+			select {
+			case <-ctx.Done():
+				return errors.Trace(ctx.Err())
+			case e = <-eventCh:
+			}
+			for _, sub := range f.handleEvent(e) {
+				if err := f.send(ctx, sub, e); err != nil {
+					return errors.Trace(err)
+				}
+			}
+		}
+	})
+	f.err = g.Wait()
+	return f.err
+}
+
+// handleEvent records the event and returns the subscribers to receive it.
+func (f *sharedFeed) handleEvent(e model.RegionFeedEvent) []*subscriber {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if e.Val != nil {
+		if e.Val.CRTs > f.tsTracker.Frontier() {
+			f.pending = append(f.pending, e.Val)
+		}
+	} else if e.Resolved != nil {
+		lastResolvedTs := f.tsTracker.Frontier()
+		f.tsTracker.Forward(e.Resolved.Span, e.Resolved.ResolvedTs)
+		if resolvedTs := f.tsTracker.Frontier(); resolvedTs > lastResolvedTs {
+			pending := f.pending[:0]
+			for _, entry := range f.pending {
+				if entry.CRTs > resolvedTs {
+					pending = append(pending, entry)
+				}
+			}
+			for i := len(pending); i < len(f.pending); i++ {
+				f.pending[i] = nil
+			}
+			f.pending = pending
+		}
+	}
+	subscribers := make([]*subscriber, 0, len(f.subscribers))
+	for _, sub := range f.subscribers {
+		subscribers = append(subscribers, sub)
+	}
+	return subscribers
+}
+
+// send sends the event to the subscriber. The subscriber is detached if its
+// buffer is still full after subscriberLagTimeout, so a slow subscriber won't
+// block the others.
+func (f *sharedFeed) send(ctx context.Context, sub *subscriber, e model.RegionFeedEvent) error {
+	select {
+	case <-sub.done:
+		return nil
+	case sub.eventCh <- e:
+		return nil
+	default:
+	}
+	timer := time.NewTimer(subscriberLagTimeout)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return errors.Trace(ctx.Err())
+	case <-sub.done:
+	case sub.eventCh <- e:
+	case <-timer.C:
+		f.detachSubscriber(sub)
+	}
+	return nil
+}
+
+// IsInitialized implements kv.PullerInitialization.
+func (f *sharedFeed) IsInitialized() bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.tsTracker.Frontier() > 0
+}
+
+// addSubscriber adds a subscriber, the pending events are replayed to it
+// and the events before the resolved ts of the span need to be caught up.
+func (f *sharedFeed) addSubscriber(id uint64, startTs uint64) *subscriber {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	resolvedTs := f.tsTracker.Frontier()
+	if resolvedTs < f.startTs {
+		resolvedTs = f.startTs
+	}
+	sub := &subscriber{
+		id:        id,
+		span:      f.span,
+		startTs:   startTs,
+		catchUpTs: resolvedTs,
+		replay:    make([]*model.RawKVEntry, len(f.pending)),
+		eventCh:   make(chan model.RegionFeedEvent, defaultSubscriberChanSize),
+		done:      make(chan struct{}),
+		tsTracker: frontier.NewFrontier(startTs, f.span),
+	}
+	copy(sub.replay, f.pending)
+	f.subscribers[id] = sub
+	return sub
+}
+
+// removeSubscriber removes the subscriber and returns
+// true if the feed has no subscriber.
+func (f *sharedFeed) removeSubscriber(sub *subscriber) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if _, ok := f.subscribers[sub.id]; ok {
+		delete(f.subscribers, sub.id)
+		close(sub.done)
+	}
+	return len(f.subscribers) == 0
+}
+
+// detachSubscriber removes the subscriber which lags behind the feed, it
+// won't receive any event from the feed.
+func (f *sharedFeed) detachSubscriber(sub *subscriber) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if _, ok := f.subscribers[sub.id]; ok {
+		delete(f.subscribers, sub.id)
+		atomic.StoreInt32(&sub.lagged, 1)
+		close(sub.done)
+	}
+}
+
+func (f *sharedFeed) stop() {
+	f.cancel()
+	<-f.done
+}
+
+// subscriber is a subscription to a shared EventFeed.
+type subscriber struct {
+	id      uint64
+	span    regionspan.ComparableSpan
+	startTs uint64
+	// catchUpTs is the resolved ts of the shared EventFeed when subscribing,
+	// the events whose commit ts is not greater than it are caught up by
+	// a separate EventFeed if the startTs is smaller than it.
+	catchUpTs uint64
+	caughtUp  int32
+	// replay is the events received by the shared EventFeed before subscribing.
+	replay []*model.RawKVEntry
+	// eventCh receives the events from the shared EventFeed.
+	eventCh chan model.RegionFeedEvent
+	// done is closed when the subscriber is removed.
+	done chan struct{}
+	// lagged is set if the subscriber is detached for lagging behind.
+	lagged int32
+
+	mu sync.Mutex
+	// tsTracker tracks the resolved ts sent to the output channel.
+	tsTracker frontier.Frontier
+}
+
+func (s *subscriber) isCaughtUp() bool {
+	return atomic.LoadInt32(&s.caughtUp) == 1
+}
+
+func (s *subscriber) removedErr() error {
+	if atomic.LoadInt32(&s.lagged) == 1 {
+		return errLagged
+	}
+	return errors.New("subscriber removed unexpectedly")
+}
+
+func (s *subscriber) forwardResolvedTs(resolved *model.ResolvedSpan) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.tsTracker.Forward(resolved.Span, resolved.ResolvedTs)
+}
+
+// resolvedTs returns the ts that all the events not greater than it
+// have been sent to the output channel.
+func (s *subscriber) resolvedTs() uint64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.tsTracker.Frontier()
+}
+
+// forward sends the events of the shared EventFeed to the output channel.
+func (s *subscriber) forward(
+	ctx context.Context, feed *sharedFeed, output chan<- model.RegionFeedEvent,
+) error {
+	send := func(e model.RegionFeedEvent) error {
+		select {
+		case <-ctx.Done():
+			return errors.Trace(ctx.Err())
+		case <-s.done:
+			return s.removedErr()
+		case output <- e:
+		}
+		if e.Resolved != nil {
+			s.forwardResolvedTs(e.Resolved)
+		}
+		return nil
+	}
+	minCommitTs := s.startTs
+	if s.catchUpTs > minCommitTs {
+		minCommitTs = s.catchUpTs
+	}
+	for _, entry := range s.replay {
+		if entry.CRTs > minCommitTs {
+			if err := send(model.RegionFeedEvent{Val: entry, RegionID: entry.RegionID}); err != nil {
+				return errors.Trace(err)
+			}
+		}
+	}
+	s.replay = nil
+
+	for {
+		var e model.RegionFeedEvent
+		select {
+		case <-ctx.Done():
+			return errors.Trace(ctx.Err())
+		case <-feed.done:
+			if feed.err == nil {
+				return errors.New("shared event feed exited unexpectedly")
+			}
+			return errors.Trace(feed.err)
+		case <-s.done:
+			return s.removedErr()
+		case e = <-s.eventCh:
+		}
+		if e.Val != nil && e.Val.CRTs <= minCommitTs {
+			continue
+		}
+		// The resolved ts of the shared EventFeed can't be forwarded
+		// until the subscriber catches up.
+		if e.Resolved != nil && (!s.isCaughtUp() || e.Resolved.ResolvedTs < s.startTs) {
+			continue
+		}
+		if err := send(e); err != nil {
+			return errors.Trace(err)
+		}
+	}
+}
+
+// catchUp pulls the events in (startTs, catchUpTs] by a separate EventFeed.
+func (s *subscriber) catchUp(
+	ctx context.Context,
+	client kv.CDCKVClient,
+	lockResolver txnutil.LockResolver,
+	isPullerInit kv.PullerInitialization,
+	output chan<- model.RegionFeedEvent,
+) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	g, ctx := errgroup.WithContext(ctx)
+	eventCh := make(chan model.RegionFeedEvent, defaultSubscriberChanSize)
+	g.Go(func() error {
+		return client.EventFeed(ctx, s.span, s.startTs, lockResolver, isPullerInit, eventCh)
+	})
+	g.Go(func() error {
+		tsTracker := frontier.NewFrontier(s.startTs, s.span)
+		for {
+			var e model.RegionFeedEvent
+			select {
+			case <-ctx.Done():
+				return errors.Trace(ctx.Err())
+			case e = <-eventCh:
+			}
+			if e.Val != nil && e.Val.CRTs > s.catchUpTs {
+				continue
+			}
+			if e.Resolved != nil {
+				tsTracker.Forward(e.Resolved.Span, e.Resolved.ResolvedTs)
+				if e.Resolved.ResolvedTs > s.catchUpTs {
+					e.Resolved = &model.ResolvedSpan{Span: e.Resolved.Span, ResolvedTs: s.catchUpTs}
+				}
+			}
+			select {
+			case <-ctx.Done():
+				return errors.Trace(ctx.Err())
+			case output <- e:
+			}
+			if e.Resolved != nil {
+				s.forwardResolvedTs(e.Resolved)
+			}
+			if tsTracker.Frontier() >= s.catchUpTs {
+				atomic.StoreInt32(&s.caughtUp, 1)
+				log.Info("subscriber caught up the shared event feed",
+					zap.Stringer("span", s.span),
+					zap.Uint64("startTs", s.startTs),
+					zap.Uint64("catchUpTs", s.catchUpTs))
+				// Stop the separate EventFeed.
+				return errCaughtUp
+			}
+		}
+	})
+	err := g.Wait()
+	if err == errCaughtUp {
+		return nil
+	}
+	return errors.Trace(err)
+}
+
+var (
+	errCaughtUp = errors.New("caught up")
+	errLagged   = errors.New("subscriber lagged")
+)
@@ -0,0 +1,261 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multiplexer
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pingcap/tiflow/cdc/kv"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/regionspan"
+	"github.com/pingcap/tiflow/pkg/txnutil"
+	"github.com/stretchr/testify/require"
+)
+
+type mockEventFeed struct {
+	startTs uint64
+	eventCh chan<- model.RegionFeedEvent
+	done    chan struct{}
+}
+
+type mockKVClient struct {
+	mu    sync.Mutex
+	feeds []*mockEventFeed
+}
+
+func (c *mockKVClient) EventFeed(
+	ctx context.Context, span regionspan.ComparableSpan, ts uint64,
+	lockResolver txnutil.LockResolver,
+	isPullerInit kv.PullerInitialization,
+	eventCh chan<- model.RegionFeedEvent,
+) error {
+	feed := &mockEventFeed{startTs: ts, eventCh: eventCh, done: make(chan struct{})}
+	c.mu.Lock()
+	c.feeds = append(c.feeds, feed)
+	c.mu.Unlock()
+	defer close(feed.done)
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func (c *mockKVClient) waitFeed(t *testing.T, i int) *mockEventFeed {
+	var feed *mockEventFeed
+	require.Eventually(t, func() bool {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		if len(c.feeds) > i {
+			feed = c.feeds[i]
+			return true
+		}
+		return false
+	}, 5*time.Second, 10*time.Millisecond)
+	return feed
+}
+
+func (c *mockKVClient) feedCount() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.feeds)
+}
+
+func putEvent(commitTs uint64) model.RegionFeedEvent {
+	return model.RegionFeedEvent{Val: &model.RawKVEntry{
+		OpType: model.OpTypePut, Key: []byte("a"), CRTs: commitTs,
+	}}
+}
+
+func resolvedEvent(span regionspan.ComparableSpan, ts uint64) model.RegionFeedEvent {
+	return model.RegionFeedEvent{Resolved: &model.ResolvedSpan{Span: span, ResolvedTs: ts}}
+}
+
+func receive(t *testing.T, ch <-chan model.RegionFeedEvent) model.RegionFeedEvent {
+	select {
+	case e := <-ch:
+		return e
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "receive event timeout")
+	}
+	return model.RegionFeedEvent{}
+}
+
+func TestMultiplexerShareEventFeed(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	client := &mockKVClient{}
+	m := New(ctx, model.DefaultChangeFeedID("test"), func() kv.CDCKVClient { return client })
+	defer m.Close()
+
+	span := regionspan.ToComparableSpan(regionspan.GetTableSpan(1))
+	ctx1, cancel1 := context.WithCancel(ctx)
+	ch1 := make(chan model.RegionFeedEvent, 16)
+	errCh1 := make(chan error, 1)
+	go func() { errCh1 <- m.EventFeed(ctx1, span, 100, nil, nil, ch1) }()
+	shared := client.waitFeed(t, 0)
+	require.Equal(t, uint64(100), shared.startTs)
+
+	// The subscriber whose start ts is not older than the shared event feed
+	// joins directly.
+	ctx2, cancel2 := context.WithCancel(ctx)
+	ch2 := make(chan model.RegionFeedEvent, 16)
+	errCh2 := make(chan error, 1)
+	go func() { errCh2 <- m.EventFeed(ctx2, span, 105, nil, nil, ch2) }()
+	require.Eventually(t, func() bool {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		feed := m.feeds[newSpanKey(span)]
+		feed.mu.Lock()
+		defer feed.mu.Unlock()
+		return len(feed.subscribers) == 2
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Equal(t, 1, client.feedCount())
+
+	shared.eventCh <- putEvent(103)
+	shared.eventCh <- putEvent(108)
+	shared.eventCh <- resolvedEvent(span, 110)
+
+	require.Equal(t, uint64(103), receive(t, ch1).Val.CRTs)
+	require.Equal(t, uint64(108), receive(t, ch1).Val.CRTs)
+	require.Equal(t, uint64(110), receive(t, ch1).Resolved.ResolvedTs)
+	// The event before the start ts is filtered.
+	require.Equal(t, uint64(108), receive(t, ch2).Val.CRTs)
+	require.Equal(t, uint64(110), receive(t, ch2).Resolved.ResolvedTs)
+
+	// The shared event feed is stopped after all subscribers exit.
+	cancel1()
+	require.ErrorIs(t, <-errCh1, context.Canceled)
+	cancel2()
+	require.ErrorIs(t, <-errCh2, context.Canceled)
+	<-shared.done
+	m.mu.Lock()
+	require.Len(t, m.feeds, 0)
+	m.mu.Unlock()
+}
+
+func TestMultiplexerCatchUp(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	client := &mockKVClient{}
+	m := New(ctx, model.DefaultChangeFeedID("test"), func() kv.CDCKVClient { return client })
+	defer m.Close()
+
+	span := regionspan.ToComparableSpan(regionspan.GetTableSpan(1))
+	ch1 := make(chan model.RegionFeedEvent, 16)
+	go func() { _ = m.EventFeed(ctx, span, 100, nil, nil, ch1) }()
+	shared := client.waitFeed(t, 0)
+
+	shared.eventCh <- putEvent(105)
+	shared.eventCh <- resolvedEvent(span, 110)
+	// The event is not resolved, it will be replayed to the late subscriber.
+	shared.eventCh <- putEvent(115)
+	require.Equal(t, uint64(105), receive(t, ch1).Val.CRTs)
+	require.Equal(t, uint64(110), receive(t, ch1).Resolved.ResolvedTs)
+	require.Equal(t, uint64(115), receive(t, ch1).Val.CRTs)
+
+	// The late subscriber catches up (90, 110] by a separate event feed.
+	ch2 := make(chan model.RegionFeedEvent, 16)
+	go func() { _ = m.EventFeed(ctx, span, 90, nil, nil, ch2) }()
+	catchUp := client.waitFeed(t, 1)
+	require.Equal(t, uint64(90), catchUp.startTs)
+	require.Equal(t, uint64(115), receive(t, ch2).Val.CRTs)
+
+	// The resolved ts of the shared event feed is not forwarded before
+	// the subscriber catches up.
+	shared.eventCh <- resolvedEvent(span, 112)
+	require.Equal(t, uint64(112), receive(t, ch1).Resolved.ResolvedTs)
+
+	catchUp.eventCh <- putEvent(95)
+	catchUp.eventCh <- putEvent(105)
+	// The event after the catch-up ts is ignored.
+	catchUp.eventCh <- putEvent(113)
+	catchUp.eventCh <- resolvedEvent(span, 111)
+	require.Equal(t, uint64(95), receive(t, ch2).Val.CRTs)
+	require.Equal(t, uint64(105), receive(t, ch2).Val.CRTs)
+	require.Equal(t, uint64(110), receive(t, ch2).Resolved.ResolvedTs)
+	// The separate event feed is stopped after catching up.
+	<-catchUp.done
+
+	shared.eventCh <- resolvedEvent(span, 120)
+	require.Equal(t, uint64(120), receive(t, ch1).Resolved.ResolvedTs)
+	// The resolved ts 112 may be forwarded if it's received after catching up.
+	e := receive(t, ch2)
+	if e.Resolved.ResolvedTs == 112 {
+		e = receive(t, ch2)
+	}
+	require.Equal(t, uint64(120), e.Resolved.ResolvedTs)
+	require.Len(t, ch2, 0)
+}
+
+func TestMultiplexerDetachLaggedSubscriber(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	client := &mockKVClient{}
+	m := New(ctx, model.DefaultChangeFeedID("test"), func() kv.CDCKVClient { return client })
+	defer m.Close()
+
+	span := regionspan.ToComparableSpan(regionspan.GetTableSpan(1))
+	fastCh := make(chan model.RegionFeedEvent, 2*defaultSubscriberChanSize)
+	go func() { _ = m.EventFeed(ctx, span, 100, nil, nil, fastCh) }()
+	shared := client.waitFeed(t, 0)
+	// The slow subscriber never reads its output channel.
+	slowCh := make(chan model.RegionFeedEvent)
+	errCh := make(chan error, 1)
+	go func() { errCh <- m.EventFeed(ctx, span, 100, nil, nil, slowCh) }()
+	require.Eventually(t, func() bool {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		feed := m.feeds[newSpanKey(span)]
+		feed.mu.Lock()
+		defer feed.mu.Unlock()
+		return len(feed.subscribers) == 2
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// The slow subscriber doesn't block the fast one.
+	for i := 0; i < 2*defaultSubscriberChanSize; i++ {
+		shared.eventCh <- putEvent(uint64(101 + i))
+	}
+	for i := 0; i < 2*defaultSubscriberChanSize; i++ {
+		require.Equal(t, uint64(101+i), receive(t, fastCh).Val.CRTs)
+	}
+
+	// The slow subscriber is detached and falls back to a dedicated
+	// event feed from the resolved ts it has sent.
+	dedicated := client.waitFeed(t, 1)
+	require.Equal(t, uint64(100), dedicated.startTs)
+	m.mu.Lock()
+	feed := m.feeds[newSpanKey(span)]
+	m.mu.Unlock()
+	feed.mu.Lock()
+	require.Len(t, feed.subscribers, 1)
+	feed.mu.Unlock()
+	select {
+	case err := <-errCh:
+		require.FailNow(t, "unexpected exit", err)
+	default:
+	}
+	// The dedicated event feed sends events to the output channel directly.
+	go func() { dedicated.eventCh <- putEvent(101) }()
+	require.Equal(t, uint64(101), receive(t, slowCh).Val.CRTs)
+
+	shared.eventCh <- resolvedEvent(span, 500)
+	require.Equal(t, uint64(500), receive(t, fastCh).Resolved.ResolvedTs)
+}
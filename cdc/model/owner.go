@@ -289,6 +289,53 @@ type ChangeFeedStatus struct {
 	ResolvedTs   uint64       `json:"resolved-ts"`
 	CheckpointTs uint64       `json:"checkpoint-ts"`
 	AdminJobType AdminJobType `json:"admin-job-type"`
+	// PendingAsyncDDLs are the DDLs still executing asynchronously downstream,
+	// they are executed again if the changefeed is restarted.
+	PendingAsyncDDLs []*AsyncDDL `json:"pending-async-ddls,omitempty"`
+	// SLOBreachStartMs maps the kinds of the breached lag SLOs to the physical
+	// time in milliseconds when they start to be breached, so that a breach
+	// lasts over restarts of the changefeed and switches of the owner.
+	SLOBreachStartMs map[string]int64 `json:"slo-breach-start-ms,omitempty"`
+}
+
+// AsyncDDL is a DDL executed asynchronously downstream, the changefeed keeps
+// replicating while it's executing.
+type AsyncDDL struct {
+	StartTs  uint64             `json:"start-ts"`
+	CommitTs uint64             `json:"commit-ts"`
+	TableID  int64              `json:"table-id"`
+	Schema   string             `json:"schema"`
+	Table    string             `json:"table"`
+	Query    string             `json:"query"`
+	Type     timodel.ActionType `json:"type"`
+}
+
+// NewAsyncDDL creates an AsyncDDL from the DDL event.
+func NewAsyncDDL(ddl *DDLEvent) *AsyncDDL {
+	return &AsyncDDL{
+		StartTs:  ddl.StartTs,
+		CommitTs: ddl.CommitTs,
+		TableID:  ddl.TableInfo.TableID,
+		Schema:   ddl.TableInfo.Schema,
+		Table:    ddl.TableInfo.Table,
+		Query:    ddl.Query,
+		Type:     ddl.Type,
+	}
+}
+
+// ToDDLEvent converts the AsyncDDL to a DDL event.
+func (d *AsyncDDL) ToDDLEvent() *DDLEvent {
+	return &DDLEvent{
+		StartTs:  d.StartTs,
+		CommitTs: d.CommitTs,
+		TableInfo: &SimpleTableInfo{
+			Schema:  d.Schema,
+			Table:   d.Table,
+			TableID: d.TableID,
+		},
+		Query: d.Query,
+		Type:  d.Type,
+	}
 }
 
 // Marshal returns json encoded string of ChangeFeedStatus, only contains necessary fields stored in storage
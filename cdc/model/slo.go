@@ -0,0 +1,46 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import "time"
+
+// LagSLOStatus is the evaluation result of a lag SLO of a changefeed.
+type LagSLOStatus struct {
+	// MaxLagInSec is the threshold of the lag.
+	MaxLagInSec int64 `json:"max_lag_in_sec"`
+	// LagInSec is the lag when the SLO is evaluated last time.
+	LagInSec float64 `json:"lag_in_sec"`
+	Breached bool    `json:"breached"`
+	// BreachStartTime is the time when the SLO starts to be breached,
+	// it's nil if the SLO is not breached.
+	BreachStartTime *time.Time `json:"breach_start_time,omitempty"`
+	// BreachDurationInSec is how long the SLO has been breached.
+	BreachDurationInSec float64 `json:"breach_duration_in_sec"`
+}
+
+// ChangefeedSLOStatus is the evaluation result of the lag SLOs of a changefeed,
+// the SLO which is not set is nil.
+type ChangefeedSLOStatus struct {
+	CheckpointLag *LagSLOStatus `json:"checkpoint_lag,omitempty"`
+	ResolvedLag   *LagSLOStatus `json:"resolved_lag,omitempty"`
+}
+
+// IsBreached returns whether any SLO of the changefeed is breached.
+func (s *ChangefeedSLOStatus) IsBreached() bool {
+	if s == nil {
+		return false
+	}
+	return (s.CheckpointLag != nil && s.CheckpointLag.Breached) ||
+		(s.ResolvedLag != nil && s.ResolvedLag.Breached)
+}
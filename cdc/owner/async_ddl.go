@@ -0,0 +1,82 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package owner
+
+import (
+	"github.com/pingcap/tidb/parser"
+	"github.com/pingcap/tidb/parser/ast"
+	timodel "github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tiflow/cdc/model"
+)
+
+// isAsyncDDL returns whether the DDL can be executed asynchronously
+// downstream. Only the DDLs adding or dropping non-unique indexes qualify,
+// they don't change the row format, so the DMLs of the table can be
+// replicated while they are executing. Unique indexes are excluded because
+// the DMLs replicated in the meantime may violate them.
+func isAsyncDDL(ddl *model.DDLEvent) bool {
+	if ddl.TableInfo == nil {
+		return false
+	}
+	switch ddl.Type {
+	case timodel.ActionDropIndex:
+		return true
+	case timodel.ActionAddIndex:
+	default:
+		return false
+	}
+
+	stmt, err := parser.New().ParseOneStmt(ddl.Query, "", "")
+	if err != nil {
+		return false
+	}
+	switch s := stmt.(type) {
+	case *ast.CreateIndexStmt:
+		return s.KeyType == ast.IndexKeyTypeNone
+	case *ast.AlterTableStmt:
+		for _, spec := range s.Specs {
+			if spec.Tp != ast.AlterTableAddConstraint || spec.Constraint == nil {
+				return false
+			}
+			if spec.Constraint.Tp != ast.ConstraintKey &&
+				spec.Constraint.Tp != ast.ConstraintIndex {
+				return false
+			}
+		}
+		return len(s.Specs) > 0
+	default:
+		return false
+	}
+}
+
+// blockedByAsyncDDL returns whether the DDL must wait for the async DDL to
+// finish before it's executed downstream.
+func blockedByAsyncDDL(ddl *model.DDLEvent, async *model.AsyncDDL) bool {
+	switch ddl.Type {
+	case timodel.ActionCreateSchema:
+		return false
+	case timodel.ActionDropSchema, timodel.ActionModifySchemaCharsetAndCollate:
+		return ddl.TableInfo == nil || ddl.TableInfo.Schema == async.Schema
+	case timodel.ActionRenameTables, timodel.ActionExchangeTablePartition:
+		// These DDLs touch more than one table.
+		return true
+	}
+	if ddl.TableInfo == nil {
+		return true
+	}
+	if ddl.TableInfo.TableID == async.TableID {
+		return true
+	}
+	return ddl.PreTableInfo != nil && ddl.PreTableInfo.TableID == async.TableID
+}
@@ -0,0 +1,89 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package owner
+
+import (
+	"testing"
+
+	timodel "github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsAsyncDDL(t *testing.T) {
+	t.Parallel()
+
+	tableInfo := &model.SimpleTableInfo{Schema: "test", Table: "t", TableID: 1}
+	cases := []struct {
+		tp       timodel.ActionType
+		query    string
+		expected bool
+	}{
+		{timodel.ActionAddIndex, "ALTER TABLE `test`.`t` ADD INDEX `idx`(`a`)", true},
+		{timodel.ActionAddIndex, "CREATE INDEX `idx` ON `test`.`t`(`a`)", true},
+		{timodel.ActionAddIndex, "ALTER TABLE `test`.`t` ADD INDEX `i1`(`a`), ADD KEY `i2`(`b`)", true},
+		{timodel.ActionAddIndex, "ALTER TABLE `test`.`t` ADD UNIQUE INDEX `idx`(`a`)", false},
+		{timodel.ActionAddIndex, "CREATE UNIQUE INDEX `idx` ON `test`.`t`(`a`)", false},
+		{timodel.ActionDropIndex, "ALTER TABLE `test`.`t` DROP INDEX `idx`", true},
+		{timodel.ActionAddColumn, "ALTER TABLE `test`.`t` ADD COLUMN `c` INT", false},
+		{timodel.ActionAddPrimaryKey, "ALTER TABLE `test`.`t` ADD PRIMARY KEY (`a`)", false},
+	}
+	for _, c := range cases {
+		ddl := &model.DDLEvent{Type: c.tp, Query: c.query, TableInfo: tableInfo}
+		require.Equal(t, c.expected, isAsyncDDL(ddl), c.query)
+	}
+	require.False(t, isAsyncDDL(&model.DDLEvent{
+		Type: timodel.ActionDropIndex, Query: "ALTER TABLE `test`.`t` DROP INDEX `idx`",
+	}))
+}
+
+func TestBlockedByAsyncDDL(t *testing.T) {
+	t.Parallel()
+
+	async := &model.AsyncDDL{Schema: "test", Table: "t", TableID: 1}
+	cases := []struct {
+		ddl      *model.DDLEvent
+		expected bool
+	}{
+		{&model.DDLEvent{
+			Type:      timodel.ActionAddColumn,
+			TableInfo: &model.SimpleTableInfo{Schema: "test", Table: "t", TableID: 1},
+		}, true},
+		{&model.DDLEvent{
+			Type:      timodel.ActionAddColumn,
+			TableInfo: &model.SimpleTableInfo{Schema: "test", Table: "t2", TableID: 2},
+		}, false},
+		{&model.DDLEvent{
+			Type:         timodel.ActionRenameTable,
+			TableInfo:    &model.SimpleTableInfo{Schema: "test", Table: "t3", TableID: 3},
+			PreTableInfo: &model.SimpleTableInfo{Schema: "test", Table: "t", TableID: 1},
+		}, true},
+		{&model.DDLEvent{
+			Type:      timodel.ActionCreateSchema,
+			TableInfo: &model.SimpleTableInfo{Schema: "test2"},
+		}, false},
+		{&model.DDLEvent{
+			Type:      timodel.ActionDropSchema,
+			TableInfo: &model.SimpleTableInfo{Schema: "test"},
+		}, true},
+		{&model.DDLEvent{
+			Type:      timodel.ActionDropSchema,
+			TableInfo: &model.SimpleTableInfo{Schema: "test2"},
+		}, false},
+		{&model.DDLEvent{Type: timodel.ActionRenameTables}, true},
+	}
+	for i, c := range cases {
+		require.Equal(t, c.expected, blockedByAsyncDDL(c.ddl, async), i)
+	}
+}
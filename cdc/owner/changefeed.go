@@ -15,6 +15,7 @@ package owner
 
 import (
 	"context"
+	"reflect"
 	"strings"
 	"sync"
 	"time"
@@ -80,6 +81,8 @@ type changefeed struct {
 	barriers         *barriers
 	feedStateManager *feedStateManager
 	redoManager      redo.LogManager
+	// slo is nil if no lag SLO is set for the changefeed.
+	slo *sloChecker
 
 	schema      *schemaWrap4Owner
 	sink        DDLSink
@@ -371,10 +374,12 @@ func (c *changefeed) tick(ctx cdcContext.Context, captures map[model.CaptureID]*
 		}
 		c.updateStatus(newCheckpointTs, newResolvedTs)
 		c.updateMetrics(currentTs, newCheckpointTs, newResolvedTs)
+		c.checkSLO(currentTs, newCheckpointTs, newResolvedTs)
 	} else if c.state.Status != nil {
 		// We should keep the metrics updated even if the scheduler cannot
 		// advance the watermarks for now.
 		c.updateMetrics(currentTs, c.state.Status.CheckpointTs, c.state.Status.ResolvedTs)
+		c.checkSLO(currentTs, c.state.Status.CheckpointTs, c.state.Status.ResolvedTs)
 	}
 	return nil
 }
@@ -471,6 +476,9 @@ LOOP:
 	c.cancel = cancel
 
 	c.sink = c.newSink()
+	// The async DDLs unfinished when the changefeed stopped are executed
+	// again, re-adding or re-dropping an index is ignored downstream.
+	c.sink.resumeAsyncDDLs(c.state.Status.PendingAsyncDDLs)
 	c.sink.run(cancelCtx, c.id, c.state.Info)
 
 	// Refer to the previous comment on why we use (checkpointTs-1).
@@ -506,6 +514,7 @@ LOOP:
 	}
 
 	c.initMetrics()
+	c.slo = newSLOChecker(c.id, c.state.Info.Config.SLO, c.state.Status.SLOBreachStartMs)
 
 	c.initialized = true
 	log.Info("changefeed initialized",
@@ -570,6 +579,7 @@ func (c *changefeed) releaseResources(ctx cdcContext.Context) {
 	}
 
 	c.cleanupMetrics()
+	c.slo = nil
 	c.schema = nil
 	c.barriers = nil
 	c.initialized = false
@@ -821,6 +831,15 @@ func (c *changefeed) asyncExecDDLEvent(ctx cdcContext.Context,
 			zap.String("changefeed", c.id.ID), zap.Reflect("event", ddlEvent))
 		return true, nil
 	}
+	// Only one side of the bidirectional replication replicates DDLs,
+	// otherwise the DDLs would be executed back and forth.
+	if bdrCfg := c.state.Info.Config.Bidirectional; bdrCfg.IsEnabled() && !bdrCfg.SyncDDL {
+		log.Info("ignore the DDL event, DDLs are replicated by "+
+			"the other side of the bidirectional replication",
+			zap.String("namespace", c.id.Namespace),
+			zap.String("changefeed", c.id.ID), zap.String("query", ddlEvent.Query))
+		return true, nil
+	}
 	done, err = c.sink.emitDDLEvent(ctx, ddlEvent)
 	if err != nil {
 		return false, err
@@ -839,6 +858,25 @@ func (c *changefeed) updateMetrics(currentTs int64, checkpointTs, resolvedTs mod
 	c.metricsChangefeedResolvedTsLagGauge.Set(float64(currentTs-phyRTs) / 1e3)
 }
 
+// checkSLO evaluates the lag SLOs of the changefeed, currentTs is the
+// physical time of PD in milliseconds.
+func (c *changefeed) checkSLO(currentTs int64, checkpointTs, resolvedTs model.Ts) {
+	if c.slo == nil {
+		return
+	}
+	checkpointLag := time.Duration(currentTs-oracle.ExtractPhysical(checkpointTs)) * time.Millisecond
+	resolvedLag := time.Duration(currentTs-oracle.ExtractPhysical(resolvedTs)) * time.Millisecond
+	c.slo.check(time.UnixMilli(currentTs), checkpointLag, resolvedLag)
+}
+
+// sloStatus returns nil if no lag SLO is set or the changefeed is not running.
+func (c *changefeed) sloStatus() *model.ChangefeedSLOStatus {
+	if c.slo == nil {
+		return nil
+	}
+	return c.slo.status()
+}
+
 func (c *changefeed) updateStatus(checkpointTs, resolvedTs model.Ts) {
 	c.state.PatchStatus(func(status *model.ChangeFeedStatus) (*model.ChangeFeedStatus, bool, error) {
 		changed := false
@@ -853,6 +891,18 @@ func (c *changefeed) updateStatus(checkpointTs, resolvedTs model.Ts) {
 			status.CheckpointTs = checkpointTs
 			changed = true
 		}
+		if pending := c.sink.pendingAsyncDDLs(); !reflect.DeepEqual(status.PendingAsyncDDLs, pending) {
+			status.PendingAsyncDDLs = pending
+			changed = true
+		}
+		var breachStartMs map[string]int64
+		if c.slo != nil {
+			breachStartMs = c.slo.breachStartMs()
+		}
+		if !reflect.DeepEqual(status.SLOBreachStartMs, breachStartMs) {
+			status.SLOBreachStartMs = breachStartMs
+			changed = true
+		}
 		return status, changed, nil
 	})
 }
@@ -145,6 +145,12 @@ func (m *mockDDLSink) isInitialized() bool {
 	return true
 }
 
+func (m *mockDDLSink) pendingAsyncDDLs() []*model.AsyncDDL {
+	return nil
+}
+
+func (m *mockDDLSink) resumeAsyncDDLs(_ []*model.AsyncDDL) {}
+
 func (m *mockDDLSink) Barrier(ctx context.Context) error {
 	return nil
 }
@@ -362,6 +368,35 @@ func TestExecDDL(t *testing.T) {
 	require.Contains(t, cf.scheduler.(*mockScheduler).currentTables, job.TableID)
 }
 
+func TestExecDDLWithoutSyncDDL(t *testing.T) {
+	helper := entry.NewSchemaTestHelper(t)
+	defer helper.Close()
+	ctx := cdcContext.NewContext4Test(context.Background(), true)
+	ctx.ChangefeedVars().Info.Config.Bidirectional = &config.BidirectionalConfig{
+		Enable: true, ReplicaID: 1, FilterReplicaIDs: []uint64{2},
+	}
+	cf, captures, tester := createChangefeed4Test(ctx, t)
+	cf.upstream.KVStorage = helper.Storage()
+	defer cf.Close(ctx)
+	tickThreeTime := func() {
+		for i := 0; i < 3; i++ {
+			cf.Tick(ctx, captures)
+			tester.MustApplyPatches()
+		}
+	}
+	tickThreeTime()
+
+	// The DDLs are replicated by the other side.
+	job := helper.DDL2Job("create database test1")
+	mockDDLPuller := cf.ddlPuller.(*mockDDLPuller)
+	mockDDLPuller.resolvedTs = cf.state.Status.CheckpointTs + 1000
+	job.BinlogInfo.FinishedTS = mockDDLPuller.resolvedTs
+	mockDDLPuller.ddlQueue = append(mockDDLPuller.ddlQueue, job)
+	tickThreeTime()
+	require.Equal(t, mockDDLPuller.resolvedTs, cf.state.Status.CheckpointTs)
+	require.Nil(t, cf.sink.(*mockDDLSink).ddlExecuting)
+}
+
 func TestEmitCheckpointTs(t *testing.T) {
 	helper := entry.NewSchemaTestHelper(t)
 	defer helper.Close()
@@ -15,6 +15,7 @@ package owner
 
 import (
 	"context"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -57,6 +58,12 @@ type DDLSink interface {
 	// close the sink, cancel running goroutine.
 	close(ctx context.Context) error
 	isInitialized() bool
+	// pendingAsyncDDLs returns the DDLs executing asynchronously downstream,
+	// ordered by their CommitTs.
+	pendingAsyncDDLs() []*model.AsyncDDL
+	// resumeAsyncDDLs executes the async DDLs left by the previous owner again,
+	// it should be called before the DDLSink runs.
+	resumeAsyncDDLs(ddls []*model.AsyncDDL)
 }
 
 type ddlSinkImpl struct {
@@ -68,7 +75,15 @@ type ddlSinkImpl struct {
 		sync.Mutex
 		checkpointTs      model.Ts
 		currentTableNames []model.TableName
+		// asyncDDLs are the DDLs executing asynchronously downstream.
+		asyncDDLs map[*model.DDLEvent]*model.AsyncDDL
+		// asyncDDLQueue are the async DDLs waiting to be started.
+		asyncDDLQueue []*model.DDLEvent
 	}
+	// asyncIndexDDL indicates whether the index DDLs are executed
+	// asynchronously, see isAsyncDDL for the DDLs qualified.
+	asyncIndexDDL  bool
+	asyncDDLNotify chan struct{}
 	// ddlSentTsMap is used to check whether a ddl event in a ddl job has been
 	// sent to `ddlCh` successfully.
 	ddlSentTsMap map[*model.DDLEvent]model.Ts
@@ -95,9 +110,11 @@ func newDDLSink() DDLSink {
 		ddlSentTsMap:    make(map[*model.DDLEvent]uint64),
 		ddlCh:           make(chan *model.DDLEvent, 1),
 		errCh:           make(chan error, defaultErrChSize),
+		asyncDDLNotify:  make(chan struct{}, 1),
 		sinkInitHandler: ddlSinkInitializer,
 		cancel:          func() {},
 	}
+	res.mu.asyncDDLs = make(map[*model.DDLEvent]*model.AsyncDDL)
 	res.initialized.Store(false)
 	return res
 }
@@ -145,6 +162,8 @@ func ddlSinkInitializer(ctx cdcContext.Context, a *ddlSinkImpl, id model.ChangeF
 func (s *ddlSinkImpl) run(ctx cdcContext.Context, id model.ChangeFeedID, info *model.ChangeFeedInfo) {
 	ctx, cancel := cdcContext.WithCancel(ctx)
 	s.cancel = cancel
+	s.asyncIndexDDL = info.Config != nil && info.Config.Sink != nil &&
+		info.Config.Sink.AsyncIndexDDL
 
 	s.wg.Add(1)
 	go func() {
@@ -187,6 +206,15 @@ func (s *ddlSinkImpl) run(ctx cdcContext.Context, id model.ChangeFeedID, info *m
 			case err := <-s.errCh:
 				ctx.Throw(err)
 				return
+			case <-s.asyncDDLNotify:
+				s.mu.Lock()
+				queue := s.mu.asyncDDLQueue
+				s.mu.asyncDDLQueue = nil
+				s.mu.Unlock()
+				for _, ddl := range queue {
+					s.wg.Add(1)
+					go s.execAsyncDDL(ctx, ddl)
+				}
 			case <-ticker.C:
 				s.mu.Lock()
 				checkpointTs := s.mu.checkpointTs
@@ -295,6 +323,30 @@ func (s *ddlSinkImpl) emitDDLEvent(ctx cdcContext.Context, ddl *model.DDLEvent)
 		s.mu.Unlock()
 		return true, nil
 	}
+	for _, async := range s.mu.asyncDDLs {
+		if blockedByAsyncDDL(ddl, async) {
+			log.Debug("ddl is blocked by an async ddl",
+				zap.String("namespace", ctx.ChangefeedVars().ID.Namespace),
+				zap.String("changefeed", ctx.ChangefeedVars().ID.ID),
+				zap.Any("DDL", ddl), zap.Any("asyncDDL", async))
+			s.mu.Unlock()
+			return false, nil
+		}
+	}
+	if s.asyncIndexDDL && isAsyncDDL(ddl) {
+		// The DDL is regarded as done once it's started, so the barrier
+		// of the changefeed moves on without waiting for it.
+		ddl.Done = true
+		s.mu.asyncDDLs[ddl] = model.NewAsyncDDL(ddl)
+		s.mu.asyncDDLQueue = append(s.mu.asyncDDLQueue, ddl)
+		s.mu.Unlock()
+		s.notifyAsyncDDL()
+		log.Info("ddl is executed asynchronously",
+			zap.String("namespace", ctx.ChangefeedVars().ID.Namespace),
+			zap.String("changefeed", ctx.ChangefeedVars().ID.ID),
+			zap.Any("DDL", ddl))
+		return true, nil
+	}
 	s.mu.Unlock()
 
 	ddlSentTs := s.ddlSentTsMap[ddl]
@@ -327,6 +379,86 @@ func (s *ddlSinkImpl) emitDDLEvent(ctx cdcContext.Context, ddl *model.DDLEvent)
 	return false, nil
 }
 
+func (s *ddlSinkImpl) notifyAsyncDDL() {
+	select {
+	case s.asyncDDLNotify <- struct{}{}:
+	default:
+	}
+}
+
+// execAsyncDDL executes an async DDL downstream, the DDL is removed from the
+// pending ones after it's finished.
+func (s *ddlSinkImpl) execAsyncDDL(ctx cdcContext.Context, ddl *model.DDLEvent) {
+	defer s.wg.Done()
+	start := time.Now()
+	log.Info("begin emit async ddl event",
+		zap.String("namespace", ctx.ChangefeedVars().ID.Namespace),
+		zap.String("changefeed", ctx.ChangefeedVars().ID.ID),
+		zap.Any("DDL", ddl))
+	var err error
+	if s.sinkV1 != nil {
+		err = s.sinkV1.EmitDDLEvent(ctx, ddl)
+	} else {
+		err = s.sinkV2.WriteDDLEvent(ctx, ddl)
+	}
+	if err != nil {
+		if errors.Cause(err) == context.Canceled {
+			return
+		}
+		log.Error("Execute async DDL failed",
+			zap.String("namespace", ctx.ChangefeedVars().ID.Namespace),
+			zap.String("changefeed", ctx.ChangefeedVars().ID.ID),
+			zap.Error(err),
+			zap.Any("ddl", ddl))
+		select {
+		case <-ctx.Done():
+		case s.errCh <- errors.Trace(err):
+		}
+		return
+	}
+	s.mu.Lock()
+	delete(s.mu.asyncDDLs, ddl)
+	s.mu.Unlock()
+	log.Info("Execute async DDL succeeded",
+		zap.String("namespace", ctx.ChangefeedVars().ID.Namespace),
+		zap.String("changefeed", ctx.ChangefeedVars().ID.ID),
+		zap.Duration("duration", time.Since(start)),
+		zap.Any("ddl", ddl))
+}
+
+func (s *ddlSinkImpl) pendingAsyncDDLs() []*model.AsyncDDL {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.mu.asyncDDLs) == 0 {
+		return nil
+	}
+	res := make([]*model.AsyncDDL, 0, len(s.mu.asyncDDLs))
+	for _, async := range s.mu.asyncDDLs {
+		res = append(res, async)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].CommitTs != res[j].CommitTs {
+			return res[i].CommitTs < res[j].CommitTs
+		}
+		return res[i].TableID < res[j].TableID
+	})
+	return res
+}
+
+func (s *ddlSinkImpl) resumeAsyncDDLs(ddls []*model.AsyncDDL) {
+	if len(ddls) == 0 {
+		return
+	}
+	s.mu.Lock()
+	for _, async := range ddls {
+		ddl := async.ToDDLEvent()
+		s.mu.asyncDDLs[ddl] = async
+		s.mu.asyncDDLQueue = append(s.mu.asyncDDLQueue, ddl)
+	}
+	s.mu.Unlock()
+	s.notifyAsyncDDL()
+}
+
 func (s *ddlSinkImpl) emitSyncPoint(ctx cdcContext.Context, checkpointTs uint64) error {
 	if checkpointTs == s.lastSyncPoint {
 		return nil
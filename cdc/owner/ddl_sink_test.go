@@ -21,6 +21,7 @@ import (
 	"time"
 
 	"github.com/pingcap/errors"
+	timodel "github.com/pingcap/tidb/parser/model"
 	"github.com/pingcap/tiflow/cdc/model"
 	"github.com/pingcap/tiflow/cdc/sink"
 	cdcContext "github.com/pingcap/tiflow/pkg/context"
@@ -167,3 +168,63 @@ func TestExecDDLError(t *testing.T) {
 	}
 	require.True(t, cerror.ErrExecDDLFailed.Equal(readResultErr()))
 }
+
+func TestExecAsyncDDLEvents(t *testing.T) {
+	ddlSink, mSink := newDDLSink4Test()
+	ctx := cdcContext.NewBackendContext4Test(true)
+	ctx, cancel := cdcContext.WithCancel(ctx)
+	defer func() {
+		cancel()
+		ddlSink.close(ctx)
+	}()
+	info, err := ctx.ChangefeedVars().Info.Clone()
+	require.Nil(t, err)
+	info.Config.Sink.AsyncIndexDDL = true
+	ddlSink.run(ctx, ctx.ChangefeedVars().ID, info)
+
+	tableInfo := &model.SimpleTableInfo{Schema: "test", Table: "t", TableID: 1}
+	addIndex := &model.DDLEvent{
+		CommitTs:  1,
+		Type:      timodel.ActionAddIndex,
+		Query:     "ALTER TABLE `test`.`t` ADD INDEX `idx`(`a`)",
+		TableInfo: tableInfo,
+	}
+	// The async DDL is done once it's started.
+	done, err := ddlSink.emitDDLEvent(ctx, addIndex)
+	require.Nil(t, err)
+	require.True(t, done)
+	require.Equal(t, []*model.AsyncDDL{model.NewAsyncDDL(addIndex)}, ddlSink.pendingAsyncDDLs())
+
+	// The DDLs of other tables are not blocked.
+	createTable := &model.DDLEvent{
+		CommitTs:  2,
+		Type:      timodel.ActionCreateTable,
+		TableInfo: &model.SimpleTableInfo{Schema: "test", Table: "t2", TableID: 2},
+	}
+	for {
+		done, err := ddlSink.emitDDLEvent(ctx, createTable)
+		require.Nil(t, err)
+		if done {
+			break
+		}
+	}
+
+	// The DDLs of the same table wait for the async DDL.
+	addColumn := &model.DDLEvent{
+		CommitTs:  3,
+		Type:      timodel.ActionAddColumn,
+		TableInfo: tableInfo,
+	}
+	for {
+		done, err := ddlSink.emitDDLEvent(ctx, addColumn)
+		require.Nil(t, err)
+		if done {
+			break
+		}
+		if len(ddlSink.pendingAsyncDDLs()) != 0 {
+			require.NotEqual(t, addColumn, mSink.GetDDL())
+		}
+	}
+	require.Empty(t, ddlSink.pendingAsyncDDLs())
+	require.Equal(t, addColumn, mSink.GetDDL())
+}
@@ -125,6 +125,21 @@ func (mr *MockStatusProviderMockRecorder) GetChangeFeedStatus(ctx, changefeedID
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChangeFeedStatus", reflect.TypeOf((*MockStatusProvider)(nil).GetChangeFeedStatus), ctx, changefeedID)
 }
 
+// GetChangefeedSLOStatus mocks base method.
+func (m *MockStatusProvider) GetChangefeedSLOStatus(ctx context.Context, changefeedID model.ChangeFeedID) (*model.ChangefeedSLOStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChangefeedSLOStatus", ctx, changefeedID)
+	ret0, _ := ret[0].(*model.ChangefeedSLOStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChangefeedSLOStatus indicates an expected call of GetChangefeedSLOStatus.
+func (mr *MockStatusProviderMockRecorder) GetChangefeedSLOStatus(ctx, changefeedID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChangefeedSLOStatus", reflect.TypeOf((*MockStatusProvider)(nil).GetChangefeedSLOStatus), ctx, changefeedID)
+}
+
 // GetProcessors mocks base method.
 func (m *MockStatusProvider) GetProcessors(ctx context.Context) ([]*model.ProcInfoSnap, error) {
 	m.ctrl.T.Helper()
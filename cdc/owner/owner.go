@@ -615,6 +615,12 @@ func (o *ownerImpl) handleQueries(query *Query) error {
 			return errors.Trace(err)
 		}
 		query.Data = isHealthy
+	case QueryChangefeedSLO:
+		cfReactor, ok := o.changefeeds[query.ChangeFeedID]
+		if !ok {
+			return cerror.ErrChangeFeedNotExists.GenWithStackByArgs(query.ChangeFeedID)
+		}
+		query.Data = cfReactor.sloStatus()
 	}
 	return nil
 }
@@ -0,0 +1,236 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package owner
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+	"go.uber.org/zap"
+)
+
+const (
+	sloKindCheckpointLag = "checkpoint-lag"
+	sloKindResolvedLag   = "resolved-lag"
+
+	sloWebhookTimeout = 5 * time.Second
+)
+
+// sloEvent is sent to the webhook when a SLO is breached or recovered.
+type sloEvent struct {
+	Namespace           string    `json:"namespace"`
+	Changefeed          string    `json:"changefeed"`
+	Kind                string    `json:"kind"`
+	Breached            bool      `json:"breached"`
+	LagInSec            float64   `json:"lag_in_sec"`
+	MaxLagInSec         int64     `json:"max_lag_in_sec"`
+	BreachDurationInSec float64   `json:"breach_duration_in_sec"`
+	Time                time.Time `json:"time"`
+}
+
+// lagSLO tracks the breach state of a lag SLO.
+type lagSLO struct {
+	kind   string
+	maxLag time.Duration
+	lag    time.Duration
+	// breachStart is zero if the SLO is not breached.
+	breachStart time.Time
+}
+
+// check updates the breach state by the lag, it returns whether the state changes.
+func (s *lagSLO) check(now time.Time, lag time.Duration) bool {
+	s.lag = lag
+	breached := lag > s.maxLag
+	if breached == !s.breachStart.IsZero() {
+		return false
+	}
+	if breached {
+		s.breachStart = now
+	} else {
+		s.breachStart = time.Time{}
+	}
+	return true
+}
+
+func (s *lagSLO) status(now time.Time) *model.LagSLOStatus {
+	status := &model.LagSLOStatus{
+		MaxLagInSec: int64(s.maxLag / time.Second),
+		LagInSec:    s.lag.Seconds(),
+	}
+	if !s.breachStart.IsZero() {
+		breachStart := s.breachStart
+		status.Breached = true
+		status.BreachStartTime = &breachStart
+		status.BreachDurationInSec = now.Sub(s.breachStart).Seconds()
+	}
+	return status
+}
+
+// sloChecker evaluates the lag SLOs of a changefeed in every tick of the owner,
+// and notifies the webhook when the SLOs are breached or recovered.
+// It's only accessed by the owner goroutine, so no lock is needed.
+type sloChecker struct {
+	id         model.ChangeFeedID
+	checkpoint *lagSLO
+	resolved   *lagSLO
+	webhookURL string
+	// lastCheckTime is the time when the SLOs are evaluated last time.
+	lastCheckTime time.Time
+
+	notify func(event *sloEvent)
+}
+
+// newSLOChecker returns nil if no SLO is set. breachStartMs is the breach
+// state persisted by the last owner of the changefeed, the breaches of the
+// SLOs which are still set are restored from it.
+func newSLOChecker(
+	id model.ChangeFeedID, cfg *config.SLOConfig, breachStartMs map[string]int64,
+) *sloChecker {
+	if !cfg.IsEnabled() {
+		return nil
+	}
+	c := &sloChecker{id: id, webhookURL: cfg.WebhookURL}
+	if cfg.MaxCheckpointLagInSec > 0 {
+		c.checkpoint = &lagSLO{
+			kind:   sloKindCheckpointLag,
+			maxLag: time.Duration(cfg.MaxCheckpointLagInSec) * time.Second,
+		}
+	}
+	if cfg.MaxResolvedLagInSec > 0 {
+		c.resolved = &lagSLO{
+			kind:   sloKindResolvedLag,
+			maxLag: time.Duration(cfg.MaxResolvedLagInSec) * time.Second,
+		}
+	}
+	for _, slo := range []*lagSLO{c.checkpoint, c.resolved} {
+		if slo == nil {
+			continue
+		}
+		if ms, ok := breachStartMs[slo.kind]; ok {
+			slo.breachStart = time.UnixMilli(ms)
+		}
+	}
+	c.notify = c.sendWebhook
+	return c
+}
+
+// breachStartMs returns the breach state to be persisted, it's nil if no SLO
+// is breached.
+func (c *sloChecker) breachStartMs() map[string]int64 {
+	var res map[string]int64
+	for _, slo := range []*lagSLO{c.checkpoint, c.resolved} {
+		if slo == nil || slo.breachStart.IsZero() {
+			continue
+		}
+		if res == nil {
+			res = make(map[string]int64)
+		}
+		res[slo.kind] = slo.breachStart.UnixMilli()
+	}
+	return res
+}
+
+// check evaluates the SLOs, now is the current time of PD.
+func (c *sloChecker) check(now time.Time, checkpointLag, resolvedLag time.Duration) {
+	c.lastCheckTime = now
+	for _, item := range []struct {
+		slo *lagSLO
+		lag time.Duration
+	}{{c.checkpoint, checkpointLag}, {c.resolved, resolvedLag}} {
+		if item.slo == nil {
+			continue
+		}
+		prevBreachStart := item.slo.breachStart
+		if !item.slo.check(now, item.lag) {
+			continue
+		}
+		event := &sloEvent{
+			Namespace:   c.id.Namespace,
+			Changefeed:  c.id.ID,
+			Kind:        item.slo.kind,
+			Breached:    !item.slo.breachStart.IsZero(),
+			LagInSec:    item.lag.Seconds(),
+			MaxLagInSec: int64(item.slo.maxLag / time.Second),
+			Time:        now,
+		}
+		if !event.Breached {
+			event.BreachDurationInSec = now.Sub(prevBreachStart).Seconds()
+		}
+		log.Warn("changefeed lag slo state changed",
+			zap.String("namespace", c.id.Namespace),
+			zap.String("changefeed", c.id.ID),
+			zap.String("kind", event.Kind),
+			zap.Bool("breached", event.Breached),
+			zap.Duration("lag", item.lag),
+			zap.Duration("maxLag", item.slo.maxLag))
+		c.notify(event)
+	}
+}
+
+func (c *sloChecker) status() *model.ChangefeedSLOStatus {
+	status := &model.ChangefeedSLOStatus{}
+	if c.checkpoint != nil {
+		status.CheckpointLag = c.checkpoint.status(c.lastCheckTime)
+	}
+	if c.resolved != nil {
+		status.ResolvedLag = c.resolved.status(c.lastCheckTime)
+	}
+	return status
+}
+
+// sendWebhook posts the event to the webhook in background,
+// so the owner is not blocked by a slow webhook.
+func (c *sloChecker) sendWebhook(event *sloEvent) {
+	if c.webhookURL == "" {
+		return
+	}
+	body, err := json.Marshal(event)
+	if err != nil {
+		log.Warn("failed to marshal slo event", zap.Error(err))
+		return
+	}
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), sloWebhookTimeout)
+		defer cancel()
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.webhookURL, bytes.NewReader(body))
+		if err != nil {
+			log.Warn("failed to create slo webhook request", zap.Error(err))
+			return
+		}
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			log.Warn("failed to send slo event to webhook",
+				zap.String("namespace", event.Namespace),
+				zap.String("changefeed", event.Changefeed),
+				zap.String("kind", event.Kind),
+				zap.Error(err))
+			return
+		}
+		_ = resp.Body.Close()
+		if resp.StatusCode/100 != 2 {
+			log.Warn("slo webhook responds with unexpected status",
+				zap.String("namespace", event.Namespace),
+				zap.String("changefeed", event.Changefeed),
+				zap.String("kind", event.Kind),
+				zap.Int("status", resp.StatusCode))
+		}
+	}()
+}
@@ -0,0 +1,135 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package owner
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSLOChecker(t *testing.T) {
+	t.Parallel()
+
+	id := model.DefaultChangeFeedID("test")
+	require.Nil(t, newSLOChecker(id, nil, nil))
+	require.Nil(t, newSLOChecker(id, &config.SLOConfig{}, nil))
+
+	c := newSLOChecker(id, &config.SLOConfig{MaxCheckpointLagInSec: 60}, nil)
+	var events []*sloEvent
+	c.notify = func(event *sloEvent) { events = append(events, event) }
+
+	now := time.Unix(1000, 0)
+	c.check(now, 30*time.Second, time.Hour)
+	require.Empty(t, events)
+	status := c.status()
+	require.Nil(t, status.ResolvedLag)
+	require.False(t, status.IsBreached())
+	require.Equal(t, float64(30), status.CheckpointLag.LagInSec)
+
+	// The SLO is breached.
+	c.check(now.Add(time.Second), 90*time.Second, time.Hour)
+	require.Len(t, events, 1)
+	require.True(t, events[0].Breached)
+	require.Equal(t, sloKindCheckpointLag, events[0].Kind)
+	require.Equal(t, int64(60), events[0].MaxLagInSec)
+
+	// No more events if the SLO is still breached.
+	c.check(now.Add(11*time.Second), 100*time.Second, time.Hour)
+	require.Len(t, events, 1)
+	status = c.status()
+	require.True(t, status.IsBreached())
+	require.Equal(t, now.Add(time.Second), *status.CheckpointLag.BreachStartTime)
+	require.Equal(t, float64(10), status.CheckpointLag.BreachDurationInSec)
+
+	// The SLO is recovered.
+	c.check(now.Add(21*time.Second), 10*time.Second, time.Hour)
+	require.Len(t, events, 2)
+	require.False(t, events[1].Breached)
+	require.Equal(t, float64(20), events[1].BreachDurationInSec)
+	status = c.status()
+	require.False(t, status.IsBreached())
+	require.Nil(t, status.CheckpointLag.BreachStartTime)
+}
+
+func TestSLOCheckerRestore(t *testing.T) {
+	t.Parallel()
+
+	id := model.DefaultChangeFeedID("test")
+	cfg := &config.SLOConfig{MaxCheckpointLagInSec: 60, MaxResolvedLagInSec: 10}
+	c := newSLOChecker(id, cfg, nil)
+	var events []*sloEvent
+	c.notify = func(event *sloEvent) { events = append(events, event) }
+	now := time.Unix(1000, 0)
+	require.Nil(t, c.breachStartMs())
+	c.check(now, 90*time.Second, time.Second)
+	require.Len(t, events, 1)
+	breachStartMs := c.breachStartMs()
+	require.Equal(t, map[string]int64{sloKindCheckpointLag: now.UnixMilli()}, breachStartMs)
+
+	// The breach is restored by a new owner, it's not notified again, and
+	// the breach duration counts from the persisted start.
+	c = newSLOChecker(id, cfg, breachStartMs)
+	events = nil
+	c.notify = func(event *sloEvent) { events = append(events, event) }
+	c.check(now.Add(10*time.Second), 100*time.Second, time.Second)
+	require.Empty(t, events)
+	status := c.status()
+	require.True(t, status.CheckpointLag.Breached)
+	require.Equal(t, float64(10), status.CheckpointLag.BreachDurationInSec)
+	require.False(t, status.ResolvedLag.Breached)
+
+	c.check(now.Add(30*time.Second), time.Second, time.Second)
+	require.Len(t, events, 1)
+	require.False(t, events[0].Breached)
+	require.Equal(t, float64(30), events[0].BreachDurationInSec)
+	require.Nil(t, c.breachStartMs())
+
+	// The breaches of the SLOs not set any more are dropped.
+	c = newSLOChecker(id, &config.SLOConfig{MaxResolvedLagInSec: 10}, breachStartMs)
+	require.Nil(t, c.breachStartMs())
+}
+
+func TestSLOWebhook(t *testing.T) {
+	t.Parallel()
+
+	received := make(chan *sloEvent, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		event := &sloEvent{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(event))
+		received <- event
+	}))
+	defer server.Close()
+
+	c := newSLOChecker(model.DefaultChangeFeedID("test"), &config.SLOConfig{
+		MaxResolvedLagInSec: 10,
+		WebhookURL:          server.URL,
+	}, nil)
+	c.check(time.Unix(1000, 0), time.Hour, time.Minute)
+	select {
+	case event := <-received:
+		require.Equal(t, "test", event.Changefeed)
+		require.Equal(t, sloKindResolvedLag, event.Kind)
+		require.True(t, event.Breached)
+		require.Equal(t, float64(60), event.LagInSec)
+	case <-time.After(10 * time.Second):
+		require.FailNow(t, "webhook is not notified")
+	}
+}
@@ -51,6 +51,10 @@ type StatusProvider interface {
 
 	// IsHealthy return true if the cluster is healthy
 	IsHealthy(ctx context.Context) (bool, error)
+
+	// GetChangefeedSLOStatus returns the lag SLO status of a changefeed,
+	// it returns nil if no lag SLO is set or the changefeed is not running.
+	GetChangefeedSLOStatus(ctx context.Context, changefeedID model.ChangeFeedID) (*model.ChangefeedSLOStatus, error)
 }
 
 // QueryType is the type of different queries.
@@ -71,6 +75,8 @@ const (
 	QueryCaptures
 	// QueryHealth is the type of query cluster health info.
 	QueryHealth
+	// QueryChangefeedSLO is the type of query the lag SLO status of a changefeed.
+	QueryChangefeedSLO
 )
 
 // Query wraps query command and return results.
@@ -186,6 +192,19 @@ func (p *ownerStatusProvider) IsHealthy(ctx context.Context) (bool, error) {
 	return query.Data.(bool), nil
 }
 
+func (p *ownerStatusProvider) GetChangefeedSLOStatus(ctx context.Context,
+	changefeedID model.ChangeFeedID,
+) (*model.ChangefeedSLOStatus, error) {
+	query := &Query{
+		Tp:           QueryChangefeedSLO,
+		ChangeFeedID: changefeedID,
+	}
+	if err := p.sendQueryToOwner(ctx, query); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return query.Data.(*model.ChangefeedSLOStatus), nil
+}
+
 func (p *ownerStatusProvider) sendQueryToOwner(ctx context.Context, query *Query) error {
 	doneCh := make(chan error, 1)
 	p.owner.Query(query, doneCh)
@@ -31,7 +31,10 @@ import (
 type pullerNode struct {
 	tableName string // quoted schema and table, used in metircs only
 
-	tableID    model.TableID
+	tableID model.TableID
+	// markSpans are the spans of the mark rows of the table, they are only
+	// subscribed in bidirectional replication.
+	markSpans  []regionspan.Span
 	startTs    model.Ts
 	changefeed model.ChangeFeedID
 	cancel     context.CancelFunc
@@ -40,12 +43,14 @@ type pullerNode struct {
 
 func newPullerNode(
 	tableID model.TableID,
+	markSpans []regionspan.Span,
 	startTs model.Ts,
 	tableName string,
 	changefeed model.ChangeFeedID,
 ) *pullerNode {
 	return &pullerNode{
 		tableID:    tableID,
+		markSpans:  markSpans,
 		startTs:    startTs,
 		tableName:  tableName,
 		changefeed: changefeed,
@@ -56,6 +61,7 @@ func (n *pullerNode) tableSpan() []regionspan.Span {
 	// start table puller
 	spans := make([]regionspan.Span, 0, 4)
 	spans = append(spans, regionspan.GetTableSpan(n.tableID))
+	spans = append(spans, n.markSpans...)
 	return spans
 }
 
@@ -72,18 +78,25 @@ func (n *pullerNode) start(ctx pipeline.NodeContext,
 	kvCfg := config.GetGlobalServerConfig().KVClient
 	// NOTICE: always pull the old value internally
 	// See also: https://github.com/pingcap/tiflow/issues/2301.
-	plr := puller.New(
-		ctxC,
-		up.PDClient,
-		up.GrpcPool,
-		up.RegionCache,
-		up.KVStorage,
-		up.PDClock,
-		n.startTs,
-		n.tableSpan(),
-		kvCfg,
-		n.changefeed,
-	)
+	var plr puller.Puller
+	if config.GetGlobalServerConfig().Debug.EnableSharedRegionSubscription &&
+		up.RegionMultiplexer != nil {
+		// Share the region subscriptions with other changefeeds in the capture.
+		plr = puller.NewWithClient(up.RegionMultiplexer, up.KVStorage, n.startTs, n.tableSpan())
+	} else {
+		plr = puller.New(
+			ctxC,
+			up.PDClient,
+			up.GrpcPool,
+			up.RegionCache,
+			up.KVStorage,
+			up.PDClock,
+			n.startTs,
+			n.tableSpan(),
+			kvCfg,
+			n.changefeed,
+		)
+	}
 	n.wg.Go(func() error {
 		ctx.Throw(errors.Trace(plr.Run(ctxC)))
 		return nil
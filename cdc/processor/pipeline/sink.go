@@ -26,6 +26,7 @@ import (
 	"github.com/pingcap/tiflow/cdc/redo"
 	sinkv1 "github.com/pingcap/tiflow/cdc/sink"
 	sinkv2 "github.com/pingcap/tiflow/cdc/sinkv2/tablesink"
+	"github.com/pingcap/tiflow/pkg/bdr"
 	cerror "github.com/pingcap/tiflow/pkg/errors"
 	pmessage "github.com/pingcap/tiflow/pkg/pipeline/message"
 	"go.uber.org/zap"
@@ -50,6 +51,13 @@ type sinkNode struct {
 
 	enableOldValue bool
 	splitTxn       bool
+
+	// loopFilter is nil if the bidirectional replication is not enabled.
+	loopFilter *bdr.LoopFilter
+	// txnBuffer buffers the row events with the same commit ts, so that the
+	// transactions written by the other side of the bidirectional replication
+	// are dropped as a whole.
+	txnBuffer []*model.PolymorphicEvent
 }
 
 func newSinkNode(
@@ -362,12 +370,23 @@ func (n *sinkNode) HandleMessage(ctx context.Context, msg pmessage.Message) (boo
 				resolved = *(event.Resolved)
 			}
 
+			if n.txnBufferResolved(resolved) {
+				if err := n.flushTxnBuffer(ctx); err != nil {
+					return false, errors.Trace(err)
+				}
+			}
 			if err := n.flushSink(ctx, resolved); err != nil {
 				return false, errors.Trace(err)
 			}
 			n.resolvedTs.Store(resolved)
 			return true, nil
 		}
+		if n.loopFilter != nil {
+			if err := n.bufferRow(ctx, event); err != nil {
+				return false, errors.Trace(err)
+			}
+			return true, nil
+		}
 		if err := n.emitRowToSink(ctx, event); err != nil {
 			return false, errors.Trace(err)
 		}
@@ -389,6 +408,50 @@ func (n *sinkNode) HandleMessage(ctx context.Context, msg pmessage.Message) (boo
 	return true, nil
 }
 
+// bufferRow buffers the row event until all events of its commit ts are
+// received.
+func (n *sinkNode) bufferRow(ctx context.Context, event *model.PolymorphicEvent) error {
+	if len(n.txnBuffer) != 0 && n.txnBuffer[0].CRTs != event.CRTs {
+		if err := n.flushTxnBuffer(ctx); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	n.txnBuffer = append(n.txnBuffer, event)
+	return nil
+}
+
+// txnBufferResolved returns true if all events of the buffered transaction
+// have been received. A batch resolved ts sent by the flow controller splits
+// a transaction at its commit ts, so the rest of it, including its mark rows,
+// may still be on the way.
+func (n *sinkNode) txnBufferResolved(resolved model.ResolvedTs) bool {
+	if len(n.txnBuffer) == 0 {
+		return false
+	}
+	if resolved.IsBatchMode() {
+		return resolved.Ts > n.txnBuffer[0].CRTs
+	}
+	return resolved.Ts >= n.txnBuffer[0].CRTs
+}
+
+// flushTxnBuffer emits the buffered row events to sink, except the ones
+// dropped by the loop filter.
+func (n *sinkNode) flushTxnBuffer(ctx context.Context) error {
+	if len(n.txnBuffer) == 0 {
+		return nil
+	}
+	for _, event := range n.loopFilter.FilterTxns(n.txnBuffer) {
+		if err := n.emitRowToSink(ctx, event); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	for i := range n.txnBuffer {
+		n.txnBuffer[i] = nil
+	}
+	n.txnBuffer = n.txnBuffer[:0]
+	return nil
+}
+
 func (n *sinkNode) updateBarrierTs(ctx context.Context, ts model.Ts) error {
 	atomic.StoreUint64(&n.barrierTs, ts)
 	if err := n.flushSink(ctx, n.getResolvedTs()); err != nil {
@@ -23,6 +23,8 @@ import (
 	"github.com/pingcap/tiflow/cdc/model"
 	"github.com/pingcap/tiflow/cdc/redo"
 	mocksink "github.com/pingcap/tiflow/cdc/sink/mock"
+	"github.com/pingcap/tiflow/pkg/bdr"
+	"github.com/pingcap/tiflow/pkg/config"
 	cerrors "github.com/pingcap/tiflow/pkg/errors"
 	pmessage "github.com/pingcap/tiflow/pkg/pipeline/message"
 	"github.com/stretchr/testify/require"
@@ -425,6 +427,101 @@ func TestIgnoreEmptyRowChangeEvent(t *testing.T) {
 	require.Len(t, sink.Received, 0)
 }
 
+func TestDropBidirectionalLoopTxns(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	state := TableStatePreparing
+	sink := mocksink.NewNormalMockSink()
+	node := newSinkNode(1, sink, nil, 0, 10, &mockFlowController{}, redo.NewDisabledManager(),
+		&state, model.DefaultChangeFeedID("changefeed-id-test"), true, false)
+	node.loopFilter = bdr.NewLoopFilter(&config.BidirectionalConfig{
+		Enable: true, ReplicaID: 1, FilterReplicaIDs: []uint64{2},
+	})
+
+	table := &model.TableName{Schema: "test", Table: "t"}
+	rowEvent := func(startTs, commitTs uint64, table *model.TableName) pmessage.Message {
+		return pmessage.PolymorphicEventMessage(&model.PolymorphicEvent{
+			StartTs: startTs, CRTs: commitTs,
+			RawKV: &model.RawKVEntry{OpType: model.OpTypePut},
+			Row: &model.RowChangedEvent{
+				StartTs: startTs, CommitTs: commitTs, Table: table,
+				Columns: []*model.Column{{Name: "id", Value: 1}},
+			},
+		})
+	}
+	// The transaction 2 is written by replica 2.
+	for _, msg := range []pmessage.Message{
+		rowEvent(1, 5, table),
+		rowEvent(2, 5, table),
+		rowEvent(2, 5, bdr.MarkTableName(2)),
+		rowEvent(3, 6, table),
+	} {
+		ok, err := node.HandleMessage(ctx, msg)
+		require.Nil(t, err)
+		require.True(t, ok)
+	}
+	// The rows of commit ts 5 are emitted when a row of commit ts 6 comes.
+	require.Len(t, sink.Received, 1)
+	require.Equal(t, uint64(1), sink.Received[0].Row.StartTs)
+
+	ok, err := node.HandleMessage(ctx,
+		pmessage.PolymorphicEventMessage(model.NewResolvedPolymorphicEvent(0, 6)))
+	require.Nil(t, err)
+	require.True(t, ok)
+	require.Len(t, sink.Received, 2)
+	require.Equal(t, uint64(3), sink.Received[1].Row.StartTs)
+}
+
+func TestKeepBidirectionalLoopTxnAcrossBatchResolved(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	state := TableStatePreparing
+	sink := mocksink.NewNormalMockSink()
+	node := newSinkNode(1, sink, nil, 0, 10, &mockFlowController{}, redo.NewDisabledManager(),
+		&state, model.DefaultChangeFeedID("changefeed-id-test"), true, true)
+	node.loopFilter = bdr.NewLoopFilter(&config.BidirectionalConfig{
+		Enable: true, ReplicaID: 1, FilterReplicaIDs: []uint64{2},
+	})
+
+	table := &model.TableName{Schema: "test", Table: "t"}
+	rowEvent := func(startTs, commitTs uint64, table *model.TableName) pmessage.Message {
+		return pmessage.PolymorphicEventMessage(&model.PolymorphicEvent{
+			StartTs: startTs, CRTs: commitTs,
+			RawKV: &model.RawKVEntry{OpType: model.OpTypePut},
+			Row: &model.RowChangedEvent{
+				StartTs: startTs, CommitTs: commitTs, Table: table,
+				Columns: []*model.Column{{Name: "id", Value: 1}},
+			},
+		})
+	}
+	batchResolved := func(ts, batchID uint64) pmessage.Message {
+		event := model.NewResolvedPolymorphicEvent(0, ts)
+		event.Resolved = &model.ResolvedTs{
+			Mode: model.BatchResolvedMode, Ts: ts, BatchID: batchID,
+		}
+		return pmessage.PolymorphicEventMessage(event)
+	}
+	// The transaction is written by replica 2 and split by the flow
+	// controller before its mark row comes.
+	for _, msg := range []pmessage.Message{
+		rowEvent(2, 5, table),
+		batchResolved(5, 1),
+		rowEvent(2, 5, table),
+		batchResolved(5, 2),
+		rowEvent(2, 5, bdr.MarkTableName(2)),
+		pmessage.PolymorphicEventMessage(model.NewResolvedPolymorphicEvent(0, 5)),
+	} {
+		ok, err := node.HandleMessage(ctx, msg)
+		require.Nil(t, err)
+		require.True(t, ok)
+	}
+	for _, data := range sink.Received {
+		require.Nil(t, data.Row)
+	}
+	require.Len(t, node.txnBuffer, 0)
+	require.Equal(t, model.NewResolvedTs(5), node.getResolvedTs())
+}
+
 func TestSplitUpdateEventWhenEnableOldValue(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -29,10 +29,12 @@ import (
 	sinkv2 "github.com/pingcap/tiflow/cdc/sinkv2/tablesink"
 	"github.com/pingcap/tiflow/pkg/actor"
 	"github.com/pingcap/tiflow/pkg/actor/message"
+	"github.com/pingcap/tiflow/pkg/bdr"
 	serverConfig "github.com/pingcap/tiflow/pkg/config"
 	cdcContext "github.com/pingcap/tiflow/pkg/context"
 	cerror "github.com/pingcap/tiflow/pkg/errors"
 	pmessage "github.com/pingcap/tiflow/pkg/pipeline/message"
+	"github.com/pingcap/tiflow/pkg/regionspan"
 	"github.com/pingcap/tiflow/pkg/upstream"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
@@ -78,6 +80,7 @@ type tableActor struct {
 
 	// TODO: try to reduce these config fields below in the future
 	tableID        int64
+	markSpans      []regionspan.Span
 	targetTs       model.Ts
 	memoryQuota    uint64
 	replicaInfo    *model.TableReplicaInfo
@@ -104,6 +107,7 @@ func NewTableActor(cdcCtx cdcContext.Context,
 	mounter entry.Mounter,
 	tableID model.TableID,
 	tableName string,
+	markSpans []regionspan.Span,
 	replicaInfo *model.TableReplicaInfo,
 	sinkV1 sinkv1.Sink,
 	sinkV2 sinkv2.TableSink,
@@ -131,6 +135,7 @@ func NewTableActor(cdcCtx cdcContext.Context,
 		state:         TableStatePreparing,
 		tableID:       tableID,
 		tableName:     tableName,
+		markSpans:     markSpans,
 		memoryQuota:   serverConfig.GetGlobalServerConfig().PerTableMemoryQuota,
 		upstream:      up,
 		mounter:       mounter,
@@ -298,7 +303,8 @@ func (t *tableActor) start(sdtTableContext context.Context) error {
 		return err
 	}
 
-	pullerNode := newPullerNode(t.tableID, t.replicaInfo.StartTs, t.tableName, t.changefeedVars.ID)
+	pullerNode := newPullerNode(t.tableID, t.markSpans,
+		t.replicaInfo.StartTs, t.tableName, t.changefeedVars.ID)
 	pullerActorNodeContext := newContext(sdtTableContext,
 		t.tableName,
 		t.globalVars.TableActorSystem.Router(),
@@ -322,6 +328,7 @@ func (t *tableActor) start(sdtTableContext context.Context) error {
 		t.replicaInfo.StartTs, t.targetTs, flowController, t.redoManager,
 		&t.state, t.changefeedID, t.replicaConfig.EnableOldValue, splitTxn,
 	)
+	actorSinkNode.loopFilter = bdr.NewLoopFilter(t.replicaConfig.Bidirectional)
 	t.sinkNode = actorSinkNode
 
 	// construct sink actor node, it gets message from sortNode
@@ -395,7 +395,7 @@ func TestNewTableActor(t *testing.T) {
 	startSorter = func(t *tableActor, ctx *actorNodeContext) error {
 		return nil
 	}
-	tbl, err := NewTableActor(cctx, upstream.NewUpstream4Test(&mockPD{}), nil, 1, "t1",
+	tbl, err := NewTableActor(cctx, upstream.NewUpstream4Test(&mockPD{}), nil, 1, "t1", nil,
 		&model.TableReplicaInfo{
 			StartTs: 0,
 		}, mocksink.NewNormalMockSink(), nil, redo.NewDisabledManager(), 10)
@@ -411,7 +411,7 @@ func TestNewTableActor(t *testing.T) {
 		return errors.New("failed to start puller")
 	}
 
-	tbl, err = NewTableActor(cctx, upstream.NewUpstream4Test(&mockPD{}), nil, 1, "t1",
+	tbl, err = NewTableActor(cctx, upstream.NewUpstream4Test(&mockPD{}), nil, 1, "t1", nil,
 		&model.TableReplicaInfo{
 			StartTs: 0,
 		}, mocksink.NewNormalMockSink(), nil, redo.NewDisabledManager(), 10)
@@ -36,11 +36,13 @@ import (
 	sinkv1 "github.com/pingcap/tiflow/cdc/sink"
 	sinkmetric "github.com/pingcap/tiflow/cdc/sink/metrics"
 	"github.com/pingcap/tiflow/cdc/sinkv2/eventsink/factory"
+	"github.com/pingcap/tiflow/pkg/bdr"
 	"github.com/pingcap/tiflow/pkg/config"
 	cdcContext "github.com/pingcap/tiflow/pkg/context"
 	cerror "github.com/pingcap/tiflow/pkg/errors"
 	"github.com/pingcap/tiflow/pkg/filter"
 	"github.com/pingcap/tiflow/pkg/orchestrator"
+	"github.com/pingcap/tiflow/pkg/regionspan"
 	"github.com/pingcap/tiflow/pkg/retry"
 	"github.com/pingcap/tiflow/pkg/upstream"
 	"github.com/pingcap/tiflow/pkg/util"
@@ -64,7 +66,10 @@ type processor struct {
 	tables map[model.TableID]pipeline.TablePipeline
 
 	schemaStorage entry.SchemaStorage
-	lastSchemaTs  model.Ts
+	// schemaSubscription is not nil if the processor subscribes
+	// the shared schema store of the upstream.
+	schemaSubscription *upstream.SchemaSubscription
+	lastSchemaTs       model.Ts
 
 	filter        filter.Filter
 	mounter       entry.Mounter
@@ -189,6 +194,17 @@ func (p *processor) AddTable(
 	table, err := p.createTablePipeline(
 		ctx.(cdcContext.Context), tableID, &model.TableReplicaInfo{StartTs: startTs})
 	if err != nil {
+		// The mark tables are created by the changefeeds replicating in the
+		// opposite direction, wait for them instead of failing the changefeed.
+		if cerror.ErrBidirectionalMarkTableNotFound.Equal(err) {
+			log.Info("mark table not found, wait for it to be created",
+				zap.String("captureID", p.captureInfo.ID),
+				zap.String("namespace", p.changefeedID.Namespace),
+				zap.String("changefeed", p.changefeedID.ID),
+				zap.Int64("tableID", tableID),
+				zap.Error(err))
+			return false, nil
+		}
 		return false, errors.Trace(err)
 	}
 	p.tables[tableID] = table
@@ -765,6 +781,15 @@ func (p *processor) createAndDriveSchemaStorage(ctx cdcContext.Context) (entry.S
 	kvStorage := p.upstream.KVStorage
 	checkpointTs := p.changefeed.Info.GetCheckpointTs(p.changefeed.Status)
 	kvCfg := config.GetGlobalServerConfig().KVClient
+	if config.GetGlobalServerConfig().Debug.EnableSharedSchemaStore {
+		sub, err := p.upstream.SubscribeSchemaStorage(p.changefeedID, checkpointTs,
+			p.changefeed.Info.Config.ForceReplicate, newSharedDDLJobPuller, p.sendError)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		p.schemaSubscription = sub
+		return sub, nil
+	}
 	stdCtx := contextutil.PutTableInfoInCtx(ctx, -1, puller.DDLPullerTableName)
 	stdCtx = contextutil.PutChangefeedIDInCtx(stdCtx, p.changefeedID)
 	stdCtx = contextutil.PutRoleInCtx(stdCtx, util.RoleProcessor)
@@ -827,6 +852,27 @@ func (p *processor) createAndDriveSchemaStorage(ctx cdcContext.Context) (entry.S
 	return schemaStorage, nil
 }
 
+// newSharedDDLJobPuller creates the DDL job puller of
+// the shared schema store of the upstream.
+func newSharedDDLJobPuller(
+	ctx context.Context, up *upstream.Upstream, startTs uint64, id model.ChangeFeedID,
+) (upstream.DDLJobPuller, error) {
+	stdCtx := contextutil.PutTableInfoInCtx(ctx, -1, puller.DDLPullerTableName)
+	stdCtx = contextutil.PutChangefeedIDInCtx(stdCtx, id)
+	stdCtx = contextutil.PutRoleInCtx(stdCtx, util.RoleProcessor)
+	return puller.NewDDLJobPuller(
+		stdCtx,
+		up.PDClient,
+		up.GrpcPool,
+		up.RegionCache,
+		up.KVStorage,
+		up.PDClock,
+		startTs,
+		config.GetGlobalServerConfig().KVClient,
+		id,
+	)
+}
+
 func (p *processor) sendError(err error) {
 	if err == nil {
 		return
@@ -918,6 +964,28 @@ func (p *processor) getTableName(ctx context.Context, tableID model.TableID) str
 	return tableName.QuoteString()
 }
 
+// getMarkSpans returns the spans of the mark rows of the table in the
+// bidirectional replication, it returns nil if the bidirectional replication
+// is not enabled.
+// NOTE: the mark rows are identified by the table name, as the table ids are
+// different in the two clusters. A table pipeline keeps subscribing the mark
+// rows of the old name after the table is renamed, so the changefeed should
+// be paused and resumed after renaming tables.
+func (p *processor) getMarkSpans(tableID model.TableID) ([]regionspan.Span, error) {
+	loopFilter := bdr.NewLoopFilter(p.changefeed.Info.Config.Bidirectional)
+	if loopFilter == nil {
+		return nil, nil
+	}
+	snap := p.schemaStorage.GetLastSnapshot()
+	tableInfo, ok := snap.PhysicalTableByID(tableID)
+	if !ok {
+		return nil, cerror.ErrSnapshotTableNotFound.GenWithStackByArgs(tableID)
+	}
+	// The mark tables may be created after the start ts of the table, so the
+	// last snapshot is used to get their table ids.
+	return loopFilter.MarkSpans(&tableInfo.TableName, snap.TableIDByName)
+}
+
 func (p *processor) createTablePipelineImpl(
 	ctx cdcContext.Context,
 	tableID model.TableID,
@@ -932,6 +1000,11 @@ func (p *processor) createTablePipelineImpl(
 		return nil
 	})
 
+	markSpans, err := p.getMarkSpans(tableID)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
 	if p.redoManager.Enabled() {
 		p.redoManager.AddTable(tableID, replicaInfo.StartTs)
 	}
@@ -949,6 +1022,7 @@ func (p *processor) createTablePipelineImpl(
 			p.mounter,
 			tableID,
 			tableName,
+			markSpans,
 			replicaInfo,
 			s,
 			nil,
@@ -966,6 +1040,7 @@ func (p *processor) createTablePipelineImpl(
 			p.mounter,
 			tableID,
 			tableName,
+			markSpans,
 			replicaInfo,
 			nil,
 			s,
@@ -1053,6 +1128,11 @@ func (p *processor) Close() error {
 	p.cancel()
 	p.wg.Wait()
 
+	if p.schemaSubscription != nil {
+		p.schemaSubscription.Close()
+		p.schemaSubscription = nil
+	}
+
 	if p.agent != nil {
 		if err := p.agent.Close(); err != nil {
 			log.Warn("close agent meet error", zap.Error(err))
@@ -535,6 +535,38 @@ func TestTableExecutorAddingTableDirectly(t *testing.T) {
 	require.Nil(t, p.agent)
 }
 
+func TestAddTableWaitMarkTable(t *testing.T) {
+	ctx := cdcContext.NewBackendContext4Test(true)
+	liveness := model.LivenessCaptureAlive
+	p, tester := initProcessor4Test(ctx, t, &liveness)
+
+	err := p.Tick(ctx)
+	require.NoError(t, err)
+	tester.MustApplyPatches()
+
+	markTableCreated := false
+	p.createTablePipeline = func(
+		ctx cdcContext.Context, tableID model.TableID, replicaInfo *model.TableReplicaInfo,
+	) (pipeline.TablePipeline, error) {
+		if !markTableCreated {
+			return nil, cerror.ErrBidirectionalMarkTableNotFound.GenWithStackByArgs("bdr_mark_2")
+		}
+		return newMockTablePipeline(ctx, tableID, replicaInfo)
+	}
+
+	// The table is not added until the mark table is created.
+	ok, err := p.AddTable(ctx, 1, 20, false)
+	require.NoError(t, err)
+	require.False(t, ok)
+	require.Len(t, p.tables, 0)
+
+	markTableCreated = true
+	ok, err = p.AddTable(ctx, 1, 20, false)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Len(t, p.tables, 1)
+}
+
 func TestProcessorError(t *testing.T) {
 	ctx := cdcContext.NewBackendContext4Test(true)
 	liveness := model.LivenessCaptureAlive
@@ -72,6 +72,18 @@ func New(ctx context.Context,
 	spans []regionspan.Span,
 	cfg *config.KVClientConfig,
 	changefeed model.ChangeFeedID,
+) Puller {
+	kvCli := kv.NewCDCKVClient(
+		ctx, pdCli, grpcPool, regionCache, pdClock, changefeed, cfg)
+	return NewWithClient(kvCli, kvStorage, checkpointTs, spans)
+}
+
+// NewWithClient creates a new Puller which fetches events by the given kv client.
+func NewWithClient(
+	kvCli kv.CDCKVClient,
+	kvStorage tidbkv.Storage,
+	checkpointTs uint64,
+	spans []regionspan.Span,
 ) Puller {
 	tikvStorage, ok := kvStorage.(tikv.Storage)
 	if !ok {
@@ -85,8 +97,6 @@ func New(ctx context.Context,
 	// the initial ts for frontier to 0. Once the puller level resolved ts
 	// initialized, the ts should advance to a non-zero value.
 	tsTracker := frontier.NewFrontier(0, comparableSpans...)
-	kvCli := kv.NewCDCKVClient(
-		ctx, pdCli, grpcPool, regionCache, pdClock, changefeed, cfg)
 	p := &pullerImpl{
 		kvCli:        kvCli,
 		kvStorage:    tikvStorage,
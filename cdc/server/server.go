@@ -38,6 +38,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/backoff"
 
+	"github.com/pingcap/tiflow/cdc/api/middleware"
 	"github.com/pingcap/tiflow/cdc/capture"
 	"github.com/pingcap/tiflow/cdc/kv"
 	"github.com/pingcap/tiflow/cdc/sorter/unified"
@@ -205,6 +206,12 @@ func (s *server) startStatusHTTP(lis net.Listener) error {
 	router := gin.New()
 	// add gin.Recovery() to handle unexpected panic
 	router.Use(gin.Recovery())
+	authenticator, err := middleware.NewAuthenticator(conf.HTTPAuth)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	router.Use(middleware.AuthMiddleware(authenticator))
+	router.Use(middleware.AuditLogMiddleware())
 	// router.
 	// Register APIs.
 	cdc.RegisterRoutes(router, s.capture, registry)
@@ -215,6 +222,7 @@ func (s *server) startStatusHTTP(lis net.Listener) error {
 		Handler:      router,
 		ReadTimeout:  httpConnectionTimeout,
 		WriteTimeout: httpConnectionTimeout,
+		ConnContext:  middleware.ConnContext,
 	}
 
 	go func() {
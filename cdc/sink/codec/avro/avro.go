@@ -45,6 +45,8 @@ type BatchEncoder struct {
 	valueSchemaManager *schemaManager
 	resultBuf          []*common.Message
 	maxMessageBytes    int
+	// claimCheckEnabled indicates the oversized messages are offloaded by the sink.
+	claimCheckEnabled bool
 
 	enableTiDBExtension        bool
 	decimalHandlingMode        string
@@ -113,7 +115,7 @@ func (a *BatchEncoder) AppendRowChangedEvent(
 	}
 	message.IncRowsCount()
 
-	if message.Length() > a.maxMessageBytes {
+	if message.Length() > a.maxMessageBytes && !a.claimCheckEnabled {
 		log.Error(
 			"Single message too large",
 			zap.Int(
@@ -846,6 +848,7 @@ func (b *batchEncoderBuilder) Build() codec.EventBatchEncoder {
 	encoder.valueSchemaManager = b.valueSchemaManager
 	encoder.resultBuf = make([]*common.Message, 0, 4096)
 	encoder.maxMessageBytes = b.config.MaxMessageBytes
+	encoder.claimCheckEnabled = b.config.ClaimCheckStorageURI != ""
 	encoder.enableTiDBExtension = b.config.EnableTiDBExtension
 	encoder.decimalHandlingMode = b.config.AvroDecimalHandlingMode
 	encoder.bigintUnsignedHandlingMode = b.config.AvroBigintUnsignedHandlingMode
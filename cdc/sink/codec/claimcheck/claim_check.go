@@ -0,0 +1,166 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package claimcheck
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tidb/br/pkg/storage"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/sink/codec/common"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// referenceMagic is the prefix of the value of a reference message,
+// it's used to distinguish reference messages from the messages of all protocols.
+var referenceMagic = []byte("\x00ticdc-claim-check\x00")
+
+// Reference is the content of a reference message, it points to
+// the object in the external storage which holds the original message.
+type Reference struct {
+	// Key is the key of the original message.
+	Key []byte `json:"key"`
+	// CommitTs is the commit ts of the original message.
+	CommitTs uint64 `json:"commit-ts"`
+	// Location is the path of the object relative to the claim-check storage.
+	Location string `json:"location"`
+}
+
+// object is the content of the object stored in the external storage.
+type object struct {
+	Key   []byte `json:"key"`
+	Value []byte `json:"value"`
+}
+
+// ClaimCheck offloads the messages which exceed the max message bytes to
+// the external storage, and replaces them by small reference messages.
+// It also fetches the original messages back for the consumers.
+type ClaimCheck struct {
+	storage         storage.ExternalStorage
+	changefeedID    model.ChangeFeedID
+	maxMessageBytes int
+}
+
+// New creates a ClaimCheck by the storage URI, such as `s3://bucket/prefix`
+// or `file:///tmp/claim-check`.
+func New(
+	ctx context.Context,
+	storageURI string,
+	changefeedID model.ChangeFeedID,
+	maxMessageBytes int,
+) (*ClaimCheck, error) {
+	backend, err := storage.ParseBackend(storageURI, &storage.BackendOptions{})
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrClaimCheckStorage, err)
+	}
+	s, err := storage.New(ctx, backend, &storage.ExternalStorageOptions{
+		SendCredentials: false,
+	})
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrClaimCheckStorage, err)
+	}
+	log.Info("claim-check storage created",
+		zap.String("namespace", changefeedID.Namespace),
+		zap.String("changefeed", changefeedID.ID),
+		zap.String("storage", s.URI()),
+		zap.Int("maxMessageBytes", maxMessageBytes))
+	return &ClaimCheck{
+		storage:         s,
+		changefeedID:    changefeedID,
+		maxMessageBytes: maxMessageBytes,
+	}, nil
+}
+
+// OffloadIfOversized writes the message to the external storage and
+// replaces its value by a reference if the message exceeds the max message bytes.
+// The key, ts, callback and rows count of the message are kept unchanged.
+func (c *ClaimCheck) OffloadIfOversized(ctx context.Context, message *common.Message) error {
+	if message.Length() <= c.maxMessageBytes {
+		return nil
+	}
+
+	data, err := json.Marshal(&object{Key: message.Key, Value: message.Value})
+	if err != nil {
+		return cerror.WrapError(cerror.ErrClaimCheckStorage, err)
+	}
+	// Use a flat object name since the local storage doesn't create sub directories.
+	location := fmt.Sprintf("%s_%s_%d_%s.json", c.changefeedID.Namespace,
+		c.changefeedID.ID, message.Ts, uuid.New().String())
+	if err := c.storage.WriteFile(ctx, location, data); err != nil {
+		return cerror.WrapError(cerror.ErrClaimCheckStorage, err)
+	}
+
+	value, err := json.Marshal(&Reference{
+		Key:      message.Key,
+		CommitTs: message.Ts,
+		Location: location,
+	})
+	if err != nil {
+		return cerror.WrapError(cerror.ErrClaimCheckStorage, err)
+	}
+	log.Debug("oversized message is offloaded to claim-check storage",
+		zap.String("namespace", c.changefeedID.Namespace),
+		zap.String("changefeed", c.changefeedID.ID),
+		zap.Int("length", message.Length()),
+		zap.Uint64("commitTs", message.Ts),
+		zap.String("location", location))
+	message.Value = append(append(make([]byte, 0, len(referenceMagic)+len(value)),
+		referenceMagic...), value...)
+	return nil
+}
+
+// IsReference returns whether the value is the value of a reference message.
+func IsReference(value []byte) bool {
+	return bytes.HasPrefix(value, referenceMagic)
+}
+
+// DecodeReference decodes the value of a reference message.
+func DecodeReference(value []byte) (*Reference, error) {
+	if !IsReference(value) {
+		return nil, cerror.ErrClaimCheckStorage.GenWithStack("not a claim-check reference message")
+	}
+	ref := &Reference{}
+	if err := json.Unmarshal(value[len(referenceMagic):], ref); err != nil {
+		return nil, cerror.WrapError(cerror.ErrClaimCheckStorage, err)
+	}
+	return ref, nil
+}
+
+// Resolve returns the key and value of the original message if the message
+// is a reference message, otherwise the key and value are returned as they are.
+func (c *ClaimCheck) Resolve(ctx context.Context, key, value []byte) ([]byte, []byte, error) {
+	if !IsReference(value) {
+		return key, value, nil
+	}
+	ref, err := DecodeReference(value)
+	if err != nil {
+		return nil, nil, errors.Trace(err)
+	}
+	data, err := c.storage.ReadFile(ctx, ref.Location)
+	if err != nil {
+		return nil, nil, cerror.WrapError(cerror.ErrClaimCheckStorage, err)
+	}
+	obj := &object{}
+	if err := json.Unmarshal(data, obj); err != nil {
+		return nil, nil, cerror.WrapError(cerror.ErrClaimCheckStorage, err)
+	}
+	return obj.Key, obj.Value, nil
+}
@@ -0,0 +1,89 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package claimcheck
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/sink/codec/common"
+	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOffloadAndResolve(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	changefeedID := model.DefaultChangeFeedID("test")
+	c, err := New(ctx, fmt.Sprintf("file://%s", t.TempDir()), changefeedID, 64)
+	require.Nil(t, err)
+
+	// The small message is kept as it is.
+	small := common.NewMsg(config.ProtocolOpen, []byte("key"), []byte("value"),
+		1, model.MessageTypeRow, nil, nil)
+	err = c.OffloadIfOversized(ctx, small)
+	require.Nil(t, err)
+	require.Equal(t, []byte("value"), small.Value)
+	require.False(t, IsReference(small.Value))
+
+	// The oversized message is replaced by a reference.
+	value := bytes.Repeat([]byte("v"), 128)
+	large := common.NewMsg(config.ProtocolOpen, []byte("key"), value,
+		2, model.MessageTypeRow, nil, nil)
+	err = c.OffloadIfOversized(ctx, large)
+	require.Nil(t, err)
+	require.True(t, IsReference(large.Value))
+	require.Equal(t, []byte("key"), large.Key)
+	require.Equal(t, uint64(2), large.Ts)
+
+	ref, err := DecodeReference(large.Value)
+	require.Nil(t, err)
+	require.Equal(t, []byte("key"), ref.Key)
+	require.Equal(t, uint64(2), ref.CommitTs)
+	require.Contains(t, ref.Location, "default_test_2_")
+
+	key, resolved, err := c.Resolve(ctx, large.Key, large.Value)
+	require.Nil(t, err)
+	require.Equal(t, []byte("key"), key)
+	require.Equal(t, value, resolved)
+
+	// The normal message is resolved to itself.
+	key, resolved, err = c.Resolve(ctx, small.Key, small.Value)
+	require.Nil(t, err)
+	require.Equal(t, []byte("key"), key)
+	require.Equal(t, []byte("value"), resolved)
+}
+
+func TestDecodeReferenceError(t *testing.T) {
+	t.Parallel()
+
+	_, err := DecodeReference([]byte("value"))
+	require.ErrorIs(t, err, cerror.ErrClaimCheckStorage)
+
+	_, err = DecodeReference(append(append([]byte{}, referenceMagic...), '{'))
+	require.ErrorIs(t, err, cerror.ErrClaimCheckStorage)
+}
+
+func TestNewWithInvalidURI(t *testing.T) {
+	t.Parallel()
+
+	_, err := New(context.Background(), "unknown://bucket",
+		model.DefaultChangeFeedID("test"), 64)
+	require.ErrorIs(t, err, cerror.ErrClaimCheckStorage)
+}
@@ -40,6 +40,10 @@ type Config struct {
 	AvroSchemaRegistry             string
 	AvroDecimalHandlingMode        string
 	AvroBigintUnsignedHandlingMode string
+
+	// ClaimCheckStorageURI is the external storage to offload the messages
+	// which exceed `MaxMessageBytes`, claim-check is disabled if it's empty.
+	ClaimCheckStorageURI string
 }
 
 // NewConfig return a Config for codec
@@ -64,6 +68,7 @@ const (
 	codecOPTAvroDecimalHandlingMode        = "avro-decimal-handling-mode"
 	codecOPTAvroBigintUnsignedHandlingMode = "avro-bigint-unsigned-handling-mode"
 	codecOPTAvroSchemaRegistry             = "schema-registry"
+	codecOPTClaimCheckStorageURI           = "claim-check-storage-uri"
 )
 
 const (
@@ -112,6 +117,10 @@ func (c *Config) Apply(sinkURI *url.URL, config *config.ReplicaConfig) error {
 		c.AvroBigintUnsignedHandlingMode = s
 	}
 
+	if s := params.Get(codecOPTClaimCheckStorageURI); s != "" {
+		c.ClaimCheckStorageURI = s
+	}
+
 	if config.Sink != nil && config.Sink.SchemaRegistry != "" {
 		c.AvroSchemaRegistry = config.Sink.SchemaRegistry
 	}
@@ -175,5 +184,13 @@ func (c *Config) Validate() error {
 		)
 	}
 
+	if c.ClaimCheckStorageURI != "" {
+		if _, err := url.Parse(c.ClaimCheckStorageURI); err != nil {
+			return cerror.ErrCodecInvalidConfig.Wrap(
+				errors.Annotatef(err, "invalid %s", codecOPTClaimCheckStorageURI),
+			)
+		}
+	}
+
 	return nil
 }
@@ -169,6 +169,19 @@ func TestConfigApplyValidate(t *testing.T) {
 		`bigint-unsigned-handling-mode value could only be "long" or "string"`,
 	)
 
+	// claim-check-storage-uri
+	uri = "kafka://127.0.0.1:9092/abc?protocol=open-protocol&claim-check-storage-uri=s3%3A%2F%2Fbucket%2Fprefix"
+	sinkURI, err = url.Parse(uri)
+	require.NoError(t, err)
+
+	c = NewConfig(config.ProtocolOpen)
+	err = c.Apply(sinkURI, replicaConfig)
+	require.NoError(t, err)
+	require.Equal(t, "s3://bucket/prefix", c.ClaimCheckStorageURI)
+
+	err = c.Validate()
+	require.NoError(t, err)
+
 	// Illegal max-message-bytes.
 	uri = "kafka://127.0.0.1:9092/abc?kafka-version=2.6.0&max-message-bytes=a"
 	sinkURI, err = url.Parse(uri)
@@ -36,8 +36,9 @@ type BatchEncoder struct {
 	curBatchSize int
 
 	// configs
-	MaxMessageBytes int
-	MaxBatchSize    int
+	MaxMessageBytes   int
+	MaxBatchSize      int
+	ClaimCheckEnabled bool
 }
 
 // AppendRowChangedEvent implements the EventBatchEncoder interface
@@ -62,10 +63,11 @@ func (d *BatchEncoder) AppendRowChangedEvent(
 	var valueLenByte [8]byte
 	binary.BigEndian.PutUint64(valueLenByte[:], uint64(len(value)))
 
-	// for single message that longer than max-message-size, do not send it.
+	// for single message that longer than max-message-size, do not send it,
+	// unless claim-check is enabled, then it's offloaded by the sink.
 	// 16 is the length of `keyLenByte` and `valueLenByte`, 8 is the length of `versionHead`
 	length := len(key) + len(value) + common.MaxRecordOverhead + 16 + 8
-	if length > d.MaxMessageBytes {
+	if length > d.MaxMessageBytes && !d.ClaimCheckEnabled {
 		log.Warn("Single message too large",
 			zap.Int("max-message-size", d.MaxMessageBytes), zap.Int("length", length), zap.Any("table", e.Table))
 		return cerror.ErrOpenProtocolCodecRowTooLarge.GenWithStackByArgs()
@@ -191,6 +193,7 @@ func (b *batchEncoderBuilder) Build() codec.EventBatchEncoder {
 	encoder := NewBatchEncoder()
 	encoder.(*BatchEncoder).MaxMessageBytes = b.config.MaxMessageBytes
 	encoder.(*BatchEncoder).MaxBatchSize = b.config.MaxBatchSize
+	encoder.(*BatchEncoder).ClaimCheckEnabled = b.config.ClaimCheckStorageURI != ""
 
 	return encoder
 }
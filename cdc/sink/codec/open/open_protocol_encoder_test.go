@@ -63,6 +63,14 @@ func TestMaxMessageBytes(t *testing.T) {
 	err = encoder.AppendRowChangedEvent(ctx, topic, testEvent, nil)
 	require.NotNil(t, err)
 
+	// the oversized message is kept when claim-check is enabled
+	config.ClaimCheckStorageURI = "file:///tmp/claim-check"
+	encoder = NewBatchEncoderBuilder(config).Build()
+	err = encoder.AppendRowChangedEvent(ctx, topic, testEvent, nil)
+	require.Nil(t, err)
+	require.Len(t, encoder.Build(), 1)
+	config.ClaimCheckStorageURI = ""
+
 	// make sure each batch's `Length` not greater than `max-message-bytes`
 	config = config.WithMaxMessageBytes(256)
 	encoder = NewBatchEncoderBuilder(config).Build()
@@ -13,28 +13,115 @@
 
 package manager
 
-// pulsarTopicManager is the interface
-// that wraps the basic Pulsar topic management operations.
-// Right now it doesn't have any implementation,
-// Pulsar doesn't support multiple topics yet.
-// So it now just returns a fixed number of partitions for a fixed topic.
+import (
+	"sync"
+	"time"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+)
+
+// pulsarTopicManager is a manager for pulsar topics.
+// Pulsar creates topics automatically when they are looked up or produced to
+// if the broker allows it, so the manager only discovers the partitions of topics.
 type pulsarTopicManager struct {
-	partitionNum int32
+	client pulsar.Client
+
+	topics sync.Map
+
+	lastMetadataRefresh atomic.Int64
 }
 
 // NewPulsarTopicManager creates a new TopicManager.
-func NewPulsarTopicManager(partitionNum int32) *pulsarTopicManager {
-	return &pulsarTopicManager{
-		partitionNum: partitionNum,
+func NewPulsarTopicManager(client pulsar.Client) *pulsarTopicManager {
+	mgr := &pulsarTopicManager{
+		client: client,
 	}
+	mgr.lastMetadataRefresh.Store(time.Now().Unix())
+	return mgr
 }
 
 // GetPartitionNum returns the number of partitions of the topic.
-func (m *pulsarTopicManager) GetPartitionNum(_ string) (int32, error) {
-	return m.partitionNum, nil
+// It may also try to update the topics' information maintained by manager.
+func (m *pulsarTopicManager) GetPartitionNum(topic string) (int32, error) {
+	err := m.tryRefreshMeta()
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+
+	if partitions, ok := m.topics.Load(topic); ok {
+		return partitions.(int32), nil
+	}
+
+	partitionNum, err := m.CreateTopicAndWaitUntilVisible(topic)
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+
+	return partitionNum, nil
 }
 
-// CreateTopic do nothing.
-func (m *pulsarTopicManager) CreateTopicAndWaitUntilVisible(_ string) (int32, error) {
-	return m.partitionNum, nil
+// CreateTopicAndWaitUntilVisible discovers the partitions of the topic,
+// the topic is created by the broker if it doesn't exist and auto creation is allowed.
+func (m *pulsarTopicManager) CreateTopicAndWaitUntilVisible(topic string) (int32, error) {
+	partitions, err := m.client.TopicPartitions(topic)
+	if err != nil {
+		return 0, cerror.WrapError(cerror.ErrPulsarNewProducer, err)
+	}
+	if len(partitions) == 0 {
+		return 0, cerror.ErrPulsarNewProducer.GenWithStack("topic %s has no partition", topic)
+	}
+	m.tryUpdatePartitionsAndLogging(topic, int32(len(partitions)))
+	return int32(len(partitions)), nil
+}
+
+// tryRefreshMeta try to refresh the partitions of the topics maintained by manager.
+func (m *pulsarTopicManager) tryRefreshMeta() error {
+	if time.Since(time.Unix(m.lastMetadataRefresh.Load(), 0)) <= time.Minute {
+		return nil
+	}
+
+	var err error
+	m.topics.Range(func(key, _ any) bool {
+		topic := key.(string)
+		var partitions []string
+		partitions, err = m.client.TopicPartitions(topic)
+		if err != nil {
+			return false
+		}
+		m.tryUpdatePartitionsAndLogging(topic, int32(len(partitions)))
+		return true
+	})
+	if err != nil {
+		return cerror.WrapError(cerror.ErrPulsarNewProducer, err)
+	}
+	m.lastMetadataRefresh.Store(time.Now().Unix())
+	return nil
+}
+
+// tryUpdatePartitionsAndLogging try to update the partitions of the topic.
+func (m *pulsarTopicManager) tryUpdatePartitionsAndLogging(topic string, partitions int32) {
+	oldPartitions, ok := m.topics.Load(topic)
+	if ok {
+		if oldPartitions.(int32) != partitions {
+			m.topics.Store(topic, partitions)
+			log.Info(
+				"update pulsar topic partition number",
+				zap.String("topic", topic),
+				zap.Int32("oldPartitionNumber", oldPartitions.(int32)),
+				zap.Int32("newPartitionNumber", partitions),
+			)
+		}
+	} else {
+		m.topics.Store(topic, partitions)
+		log.Info(
+			"store pulsar topic partition number",
+			zap.String("topic", topic),
+			zap.Int32("partitionNumber", partitions),
+		)
+	}
 }
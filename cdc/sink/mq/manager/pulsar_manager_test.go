@@ -0,0 +1,94 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manager
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/stretchr/testify/require"
+)
+
+type mockPulsarClient struct {
+	pulsar.Client
+
+	mu         sync.Mutex
+	partitions map[string]int
+	lookups    int
+}
+
+func (c *mockPulsarClient) TopicPartitions(topic string) ([]string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.lookups++
+	num, ok := c.partitions[topic]
+	if !ok {
+		return nil, fmt.Errorf("topic %s not found", topic)
+	}
+	partitions := make([]string, 0, num)
+	for i := 0; i < num; i++ {
+		partitions = append(partitions, fmt.Sprintf("%s-partition-%d", topic, i))
+	}
+	return partitions, nil
+}
+
+func TestPulsarPartitions(t *testing.T) {
+	t.Parallel()
+
+	client := &mockPulsarClient{partitions: map[string]int{"topic-1": 3, "topic-2": 1}}
+	manager := NewPulsarTopicManager(client)
+
+	partitionNum, err := manager.CreateTopicAndWaitUntilVisible("topic-1")
+	require.NoError(t, err)
+	require.Equal(t, int32(3), partitionNum)
+	partitionNum, err = manager.GetPartitionNum("topic-1")
+	require.NoError(t, err)
+	require.Equal(t, int32(3), partitionNum)
+	require.Equal(t, 1, client.lookups)
+
+	// partitions of a new topic are discovered on demand.
+	partitionNum, err = manager.GetPartitionNum("topic-2")
+	require.NoError(t, err)
+	require.Equal(t, int32(1), partitionNum)
+	require.Equal(t, 2, client.lookups)
+
+	_, err = manager.GetPartitionNum("topic-3")
+	require.Error(t, err)
+}
+
+func TestPulsarTryRefreshMeta(t *testing.T) {
+	t.Parallel()
+
+	client := &mockPulsarClient{partitions: map[string]int{"topic-1": 3}}
+	manager := NewPulsarTopicManager(client)
+	partitionNum, err := manager.GetPartitionNum("topic-1")
+	require.NoError(t, err)
+	require.Equal(t, int32(3), partitionNum)
+
+	client.mu.Lock()
+	client.partitions["topic-1"] = 5
+	client.mu.Unlock()
+	partitionNum, err = manager.GetPartitionNum("topic-1")
+	require.NoError(t, err)
+	require.Equal(t, int32(3), partitionNum)
+
+	// the partitions are refreshed after the refresh interval.
+	manager.lastMetadataRefresh.Store(time.Now().Add(-2 * time.Minute).Unix())
+	partitionNum, err = manager.GetPartitionNum("topic-1")
+	require.NoError(t, err)
+	require.Equal(t, int32(5), partitionNum)
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/pingcap/tiflow/cdc/model"
 	"github.com/pingcap/tiflow/cdc/sink/codec"
 	"github.com/pingcap/tiflow/cdc/sink/codec/builder"
+	"github.com/pingcap/tiflow/cdc/sink/codec/claimcheck"
 	"github.com/pingcap/tiflow/cdc/sink/codec/common"
 	"github.com/pingcap/tiflow/cdc/sink/metrics"
 	"github.com/pingcap/tiflow/cdc/sink/mq/dispatcher"
@@ -85,9 +86,18 @@ func newMqSink(
 	changefeedID := contextutil.ChangefeedIDFromCtx(ctx)
 	role := contextutil.RoleFromCtx(ctx)
 
+	var claimCheck *claimcheck.ClaimCheck
+	if encoderConfig.ClaimCheckStorageURI != "" {
+		claimCheck, err = claimcheck.New(ctx, encoderConfig.ClaimCheckStorageURI,
+			changefeedID, encoderConfig.MaxMessageBytes)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+	}
+
 	encoder := encoderBuilder.Build()
 	statistics := metrics.NewStatistics(ctx, captureAddr, metrics.SinkTypeMQ)
-	flushWorker := newFlushWorker(encoder, mqProducer, statistics)
+	flushWorker := newFlushWorker(encoder, mqProducer, claimCheck, statistics)
 
 	s := &mqSink{
 		mqProducer:     mqProducer,
@@ -502,14 +512,16 @@ func NewPulsarSink(ctx context.Context, sinkURI *url.URL,
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
-	fakeTopicManager := manager.NewPulsarTopicManager(
-		producer.GetPartitionNum(),
-	)
+	topicManager := manager.NewPulsarTopicManager(producer.Client())
+	if _, err := topicManager.CreateTopicAndWaitUntilVisible(producer.DefaultTopic()); err != nil {
+		_ = producer.Close()
+		return nil, errors.Trace(err)
+	}
 	sink, err := newMqSink(
 		ctx,
-		fakeTopicManager,
+		topicManager,
 		producer,
-		"",
+		producer.DefaultTopic(),
 		replicaConfig,
 		encoderConfig,
 		errCh,
@@ -21,6 +21,7 @@ import (
 	"github.com/pingcap/log"
 	"github.com/pingcap/tiflow/cdc/model"
 	"github.com/pingcap/tiflow/cdc/sink/codec"
+	"github.com/pingcap/tiflow/cdc/sink/codec/claimcheck"
 	"github.com/pingcap/tiflow/cdc/sink/metrics"
 	"github.com/pingcap/tiflow/cdc/sink/mq/producer"
 	"github.com/pingcap/tiflow/pkg/chann"
@@ -62,8 +63,11 @@ type flushWorker struct {
 	// It is also used to notify that the flush has completed.
 	needsFlush chan<- struct{}
 
-	encoder    codec.EventBatchEncoder
-	producer   producer.Producer
+	encoder  codec.EventBatchEncoder
+	producer producer.Producer
+	// claimCheck is used to offload the oversized messages,
+	// it's nil if claim-check is disabled.
+	claimCheck *claimcheck.ClaimCheck
 	statistics *metrics.Statistics
 }
 
@@ -71,6 +75,7 @@ type flushWorker struct {
 func newFlushWorker(
 	encoder codec.EventBatchEncoder,
 	producer producer.Producer,
+	claimCheck *claimcheck.ClaimCheck,
 	statistics *metrics.Statistics,
 ) *flushWorker {
 	w := &flushWorker{
@@ -78,6 +83,7 @@ func newFlushWorker(
 		ticker:     time.NewTicker(FlushInterval),
 		encoder:    encoder,
 		producer:   producer,
+		claimCheck: claimCheck,
 		statistics: statistics,
 	}
 	return w
@@ -172,6 +178,11 @@ func (w *flushWorker) asyncSend(
 		err := w.statistics.RecordBatchExecution(func() (int, error) {
 			thisBatchSize := 0
 			for _, message := range w.encoder.Build() {
+				if w.claimCheck != nil {
+					if err := w.claimCheck.OffloadIfOversized(ctx, message); err != nil {
+						return 0, errors.Trace(err)
+					}
+				}
 				err := w.producer.AsyncSendMessage(ctx, key.Topic, key.Partition, message)
 				if err != nil {
 					return 0, err
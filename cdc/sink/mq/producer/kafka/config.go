@@ -28,6 +28,7 @@ import (
 	"github.com/pingcap/tiflow/pkg/config"
 	cerror "github.com/pingcap/tiflow/pkg/errors"
 	"github.com/pingcap/tiflow/pkg/security"
+	pkafka "github.com/pingcap/tiflow/pkg/sink/kafka"
 	"go.uber.org/zap"
 )
 
@@ -313,6 +314,32 @@ func (c *Config) applySASL(params url.Values) error {
 		c.SASL.GSSAPI.DisablePAFXFAST = disablePAFXFAST
 	}
 
+	s = params.Get("sasl-oauth-token-url")
+	if s != "" {
+		c.SASL.OAuth2.TokenURL = s
+	}
+
+	s = params.Get("sasl-oauth-client-id")
+	if s != "" {
+		c.SASL.OAuth2.ClientID = s
+	}
+
+	s = params.Get("sasl-oauth-client-secret-file")
+	if s != "" {
+		c.SASL.OAuth2.ClientSecretFile = s
+	}
+
+	s = params.Get("sasl-oauth-scopes")
+	if s != "" {
+		c.SASL.OAuth2.Scopes = strings.Split(s, ",")
+	}
+
+	if c.SASL.SASLMechanism == security.OAuthMechanism {
+		if err := c.SASL.OAuth2.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -432,12 +459,14 @@ func NewSaramaConfig(ctx context.Context, c *Config) (*sarama.Config, error) {
 		}
 	}
 
-	completeSaramaSASLConfig(config, c)
+	if err = completeSaramaSASLConfig(ctx, config, c); err != nil {
+		return nil, errors.Trace(err)
+	}
 
 	return config, err
 }
 
-func completeSaramaSASLConfig(config *sarama.Config, c *Config) {
+func completeSaramaSASLConfig(ctx context.Context, config *sarama.Config, c *Config) error {
 	if c.SASL != nil && c.SASL.SASLMechanism != "" {
 		config.Net.SASL.Enable = true
 		config.Net.SASL.Mechanism = sarama.SASLMechanism(c.SASL.SASLMechanism)
@@ -467,6 +496,13 @@ func completeSaramaSASLConfig(config *sarama.Config, c *Config) {
 			case security.KeyTabAuth:
 				config.Net.SASL.GSSAPI.KeyTabPath = c.SASL.GSSAPI.KeyTabPath
 			}
+		case sarama.SASLTypeOAuth:
+			tokenProvider, err := pkafka.NewTokenProvider(ctx, &c.SASL.OAuth2)
+			if err != nil {
+				return errors.Trace(err)
+			}
+			config.Net.SASL.TokenProvider = tokenProvider
 		}
 	}
+	return nil
 }
@@ -469,6 +469,23 @@ func TestApplySASL(t *testing.T) {
 				"&sasl-gssapi-realm=realm&sasl-gssapi-disable-pafxfast=false",
 			exceptErr: "",
 		},
+		{
+			name: "valid OAUTHBEARER SASL",
+			URI: "kafka://127.0.0.1:9092/abc?kafka-version=2.6.0&partition-num=0" +
+				"&sasl-mechanism=OAUTHBEARER" +
+				"&sasl-oauth-token-url=https%3A%2F%2F127.0.0.1%2Foauth2%2Ftoken" +
+				"&sasl-oauth-client-id=ticdc&sasl-oauth-client-secret-file=/root/secret" +
+				"&sasl-oauth-scopes=kafka,produce",
+			exceptErr: "",
+		},
+		{
+			name: "OAUTHBEARER SASL without client id",
+			URI: "kafka://127.0.0.1:9092/abc?kafka-version=2.6.0&partition-num=0" +
+				"&sasl-mechanism=oauthbearer" +
+				"&sasl-oauth-token-url=https%3A%2F%2F127.0.0.1%2Foauth2%2Ftoken" +
+				"&sasl-oauth-client-secret-file=/root/secret",
+			exceptErr: "client id is not specified",
+		},
 		{
 			name: "invalid mechanism",
 			URI: "kafka://127.0.0.1:9092/abc?kafka-version=2.6.0&partition-num=0" +
@@ -567,6 +584,7 @@ func TestApplyTLS(t *testing.T) {
 func TestCompleteSaramaSASLConfig(t *testing.T) {
 	t.Parallel()
 
+	ctx := context.Background()
 	// Test that SASL is turned on correctly.
 	cfg := NewConfig()
 	cfg.SASL = &security.SASL{
@@ -576,10 +594,10 @@ func TestCompleteSaramaSASLConfig(t *testing.T) {
 		GSSAPI:        security.GSSAPI{},
 	}
 	saramaConfig := sarama.NewConfig()
-	completeSaramaSASLConfig(saramaConfig, cfg)
+	require.Nil(t, completeSaramaSASLConfig(ctx, saramaConfig, cfg))
 	require.False(t, saramaConfig.Net.SASL.Enable)
 	cfg.SASL.SASLMechanism = "plain"
-	completeSaramaSASLConfig(saramaConfig, cfg)
+	require.Nil(t, completeSaramaSASLConfig(ctx, saramaConfig, cfg))
 	require.True(t, saramaConfig.Net.SASL.Enable)
 	// Test that the SCRAMClientGeneratorFunc is set up correctly.
 	cfg = NewConfig()
@@ -590,9 +608,26 @@ func TestCompleteSaramaSASLConfig(t *testing.T) {
 		GSSAPI:        security.GSSAPI{},
 	}
 	saramaConfig = sarama.NewConfig()
-	completeSaramaSASLConfig(saramaConfig, cfg)
+	require.Nil(t, completeSaramaSASLConfig(ctx, saramaConfig, cfg))
 	require.Nil(t, saramaConfig.Net.SASL.SCRAMClientGeneratorFunc)
 	cfg.SASL.SASLMechanism = "SCRAM-SHA-512"
-	completeSaramaSASLConfig(saramaConfig, cfg)
+	require.Nil(t, completeSaramaSASLConfig(ctx, saramaConfig, cfg))
 	require.NotNil(t, saramaConfig.Net.SASL.SCRAMClientGeneratorFunc)
+	// Test that the TokenProvider is set up correctly.
+	cfg = NewConfig()
+	cfg.SASL = &security.SASL{
+		SASLMechanism: security.OAuthMechanism,
+	}
+	saramaConfig = sarama.NewConfig()
+	require.Regexp(t, "token url is not specified",
+		completeSaramaSASLConfig(ctx, saramaConfig, cfg))
+	cfg.SASL.OAuth2 = security.OAuth2{
+		TokenURL:         "https://127.0.0.1/oauth2/token",
+		ClientID:         "ticdc",
+		ClientSecretFile: "/path/to/secret",
+	}
+	require.Nil(t, completeSaramaSASLConfig(ctx, saramaConfig, cfg))
+	require.True(t, saramaConfig.Net.SASL.Enable)
+	require.Equal(t, sarama.SASLMechanism(sarama.SASLTypeOAuth), saramaConfig.Net.SASL.Mechanism)
+	require.NotNil(t, saramaConfig.Net.SASL.TokenProvider)
 }
@@ -0,0 +1,53 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pulsar
+
+import (
+	"fmt"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+)
+
+// mockClient is a pulsar client only used by the `MockPulsar` failpoint and tests.
+// Every topic has the same number of partitions, and it can't create producers or consumers.
+type mockClient struct {
+	pulsar.Client
+	partitionNum int
+}
+
+func newMockClient(partitionNum int) *mockClient {
+	return &mockClient{partitionNum: partitionNum}
+}
+
+// NewMockClient creates a mock pulsar client whose topics have partitionNum partitions.
+func NewMockClient(partitionNum int) pulsar.Client {
+	return newMockClient(partitionNum)
+}
+
+// CreateProducer implements pulsar.Client.
+func (c *mockClient) CreateProducer(_ pulsar.ProducerOptions) (pulsar.Producer, error) {
+	return nil, fmt.Errorf("mock pulsar client doesn't support creating producer")
+}
+
+// TopicPartitions implements pulsar.Client.
+func (c *mockClient) TopicPartitions(topic string) ([]string, error) {
+	partitions := make([]string, 0, c.partitionNum)
+	for i := 0; i < c.partitionNum; i++ {
+		partitions = append(partitions, fmt.Sprintf("%s-partition-%d", topic, i))
+	}
+	return partitions, nil
+}
+
+// Close implements pulsar.Client.
+func (c *mockClient) Close() {}
@@ -22,6 +22,7 @@ import (
 	"time"
 
 	"github.com/apache/pulsar-client-go/pulsar"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
 )
 
 // Option is pulsar producer's option.
@@ -32,6 +33,26 @@ type Option struct {
 
 const route = "$route"
 
+// NewOption parses the options of pulsar client and producer from the sink-uri.
+func NewOption(u *url.URL) (*Option, error) {
+	opt, err := parseSinkOptions(u)
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrPulsarNewProducer, err)
+	}
+	return opt, nil
+}
+
+// ClientOptions returns the options to create a pulsar client.
+func (o *Option) ClientOptions() pulsar.ClientOptions {
+	return *o.clientOptions
+}
+
+// ProducerOptions returns the options to create a pulsar producer,
+// the topic of the options is the one specified in the sink-uri.
+func (o *Option) ProducerOptions() pulsar.ProducerOptions {
+	return *o.producerOptions
+}
+
 func parseSinkOptions(u *url.URL) (opt *Option, err error) {
 	switch u.Scheme {
 	case "pulsar", "pulsar+ssl":
@@ -17,8 +17,10 @@ import (
 	"context"
 	"net/url"
 	"strconv"
+	"sync"
 
 	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/pingcap/errors"
 	"github.com/pingcap/failpoint"
 	"github.com/pingcap/log"
 	"github.com/pingcap/tiflow/cdc/sink/codec/common"
@@ -27,48 +29,101 @@ import (
 )
 
 // NewProducer create a pulsar producer.
+// Producers of topics other than the default one are created on demand.
 func NewProducer(u *url.URL, errCh chan error) (*Producer, error) {
+	opt, err := parseSinkOptions(u)
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrPulsarNewProducer, err)
+	}
+	if opt.producerOptions.Topic == "" {
+		return nil, cerror.ErrPulsarNewProducer.GenWithStack("no topic is specified in sink-uri")
+	}
+
 	failpoint.Inject("MockPulsar", func() {
 		failpoint.Return(&Producer{
-			errCh:        errCh,
-			partitionNum: 4,
+			errCh:     errCh,
+			opt:       *opt,
+			client:    newMockClient(4),
+			producers: make(map[string]pulsar.Producer),
 		}, nil)
 	})
 
-	opt, err := parseSinkOptions(u)
-	if err != nil {
-		return nil, cerror.WrapError(cerror.ErrPulsarNewProducer, err)
-	}
 	client, err := pulsar.NewClient(*opt.clientOptions)
 	if err != nil {
 		return nil, cerror.WrapError(cerror.ErrPulsarNewProducer, err)
 	}
-	producer, err := client.CreateProducer(*opt.producerOptions)
-	if err != nil {
-		client.Close()
-		return nil, cerror.WrapError(cerror.ErrPulsarNewProducer, err)
+	p := &Producer{
+		errCh:     errCh,
+		opt:       *opt,
+		client:    client,
+		producers: make(map[string]pulsar.Producer),
 	}
-	partitions, err := client.TopicPartitions(opt.producerOptions.Topic)
-	if err != nil {
+	// create the producer of the default topic to check the connection.
+	if _, err = p.getProducer(opt.producerOptions.Topic); err != nil {
 		client.Close()
-		return nil, cerror.WrapError(cerror.ErrPulsarNewProducer, err)
+		return nil, errors.Trace(err)
 	}
-	return &Producer{
-		errCh:        errCh,
-		opt:          *opt,
-		client:       client,
-		producer:     producer,
-		partitionNum: len(partitions),
-	}, nil
+	return p, nil
 }
 
 // Producer provide a way to send msg to pulsar.
 type Producer struct {
-	opt          Option
-	client       pulsar.Client
-	producer     pulsar.Producer
-	errCh        chan error
-	partitionNum int
+	opt    Option
+	client pulsar.Client
+	errCh  chan error
+
+	mu sync.RWMutex
+	// topic -> producer of the topic.
+	producers map[string]pulsar.Producer
+}
+
+// DefaultTopic returns the topic specified in the sink-uri.
+func (p *Producer) DefaultTopic() string {
+	return p.opt.producerOptions.Topic
+}
+
+// Client returns the pulsar client used by the producer.
+func (p *Producer) Client() pulsar.Client {
+	return p.client
+}
+
+// getProducer returns the producer of the topic, creates it if not exists.
+func (p *Producer) getProducer(topic string) (pulsar.Producer, error) {
+	if topic == "" {
+		topic = p.DefaultTopic()
+	}
+	p.mu.RLock()
+	producer, ok := p.producers[topic]
+	p.mu.RUnlock()
+	if ok {
+		return producer, nil
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if producer, ok = p.producers[topic]; ok {
+		return producer, nil
+	}
+	opts := *p.opt.producerOptions
+	opts.Topic = topic
+	producer, err := p.client.CreateProducer(opts)
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrPulsarNewProducer, err)
+	}
+	p.producers[topic] = producer
+	log.Info("pulsar producer created", zap.String("topic", topic))
+	return producer, nil
+}
+
+// NewProducerMessage creates a pulsar message of the message, the partition
+// is carried by the properties and picked up by the message router.
+func NewProducerMessage(message *common.Message, partition int32) *pulsar.ProducerMessage {
+	return &pulsar.ProducerMessage{
+		Payload:    message.Value,
+		Key:        string(message.Key),
+		Properties: createProperties(message, partition),
+		EventTime:  message.PhysicalTime(),
+	}
 }
 
 func createProperties(message *common.Message, partition int32) map[string]string {
@@ -87,14 +142,13 @@ func createProperties(message *common.Message, partition int32) map[string]strin
 
 // AsyncSendMessage send key-value msg to target partition.
 func (p *Producer) AsyncSendMessage(
-	ctx context.Context, _ string, partition int32, message *common.Message,
+	ctx context.Context, topic string, partition int32, message *common.Message,
 ) error {
-	p.producer.SendAsync(ctx, &pulsar.ProducerMessage{
-		Payload:    message.Value,
-		Key:        string(message.Key),
-		Properties: createProperties(message, partition),
-		EventTime:  message.PhysicalTime(),
-	}, p.errors)
+	producer, err := p.getProducer(topic)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	producer.SendAsync(ctx, NewProducerMessage(message, partition), p.errors)
 	return nil
 }
 
@@ -108,41 +162,50 @@ func (p *Producer) errors(_ pulsar.MessageID, _ *pulsar.ProducerMessage, err err
 	}
 }
 
-// SyncBroadcastMessage send key-value msg to all partition.
+// SyncBroadcastMessage send key-value msg to all partitions of the topic.
 func (p *Producer) SyncBroadcastMessage(
-	ctx context.Context, _ string, _ int32, message *common.Message,
+	ctx context.Context, topic string, partitionsNum int32, message *common.Message,
 ) error {
-	for partition := 0; partition < p.partitionNum; partition++ {
-		_, err := p.producer.Send(ctx, &pulsar.ProducerMessage{
-			Payload:    message.Value,
-			Key:        string(message.Key),
-			Properties: createProperties(message, int32(partition)),
-			EventTime:  message.PhysicalTime(),
-		})
+	producer, err := p.getProducer(topic)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	for partition := int32(0); partition < partitionsNum; partition++ {
+		_, err := producer.Send(ctx, NewProducerMessage(message, partition))
 		if err != nil {
-			return cerror.WrapError(cerror.ErrPulsarSendMessage, p.producer.Flush())
+			return cerror.WrapError(cerror.ErrPulsarSendMessage, err)
 		}
 	}
 	return nil
 }
 
-// Flush flushes all in memory msgs to server.
+// Flush flushes all in memory msgs of all topics to server.
 func (p *Producer) Flush(_ context.Context) error {
-	return cerror.WrapError(cerror.ErrPulsarSendMessage, p.producer.Flush())
-}
-
-// GetPartitionNum got current topic's partition size.
-func (p *Producer) GetPartitionNum() int32 {
-	return int32(p.partitionNum)
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	for _, producer := range p.producers {
+		if err := producer.Flush(); err != nil {
+			return cerror.WrapError(cerror.ErrPulsarSendMessage, err)
+		}
+	}
+	return nil
 }
 
-// Close closes the producer and client.
+// Close closes the producers and client.
 func (p *Producer) Close() error {
-	err := p.producer.Flush()
-	if err != nil {
-		return cerror.WrapError(cerror.ErrPulsarSendMessage, err)
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	var firstErr error
+	for topic, producer := range p.producers {
+		if err := producer.Flush(); err != nil {
+			log.Warn("flush pulsar producer failed", zap.String("topic", topic), zap.Error(err))
+			if firstErr == nil {
+				firstErr = cerror.WrapError(cerror.ErrPulsarSendMessage, err)
+			}
+		}
+		producer.Close()
 	}
-	p.producer.Close()
+	p.producers = make(map[string]pulsar.Producer)
 	p.client.Close()
-	return nil
+	return firstErr
 }
@@ -0,0 +1,112 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pulsar
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/pingcap/tiflow/cdc/sink/codec/common"
+	"github.com/stretchr/testify/require"
+)
+
+type testProducer struct {
+	pulsar.Producer
+
+	topic  string
+	mu     sync.Mutex
+	msgs   []*pulsar.ProducerMessage
+	closed bool
+}
+
+func (p *testProducer) Send(_ context.Context, msg *pulsar.ProducerMessage) (pulsar.MessageID, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.msgs = append(p.msgs, msg)
+	return nil, nil
+}
+
+func (p *testProducer) SendAsync(
+	_ context.Context, msg *pulsar.ProducerMessage,
+	callback func(pulsar.MessageID, *pulsar.ProducerMessage, error),
+) {
+	p.mu.Lock()
+	p.msgs = append(p.msgs, msg)
+	p.mu.Unlock()
+	callback(nil, msg, nil)
+}
+
+func (p *testProducer) Flush() error {
+	return nil
+}
+
+func (p *testProducer) Close() {
+	p.closed = true
+}
+
+type testClient struct {
+	*mockClient
+	producers map[string]*testProducer
+}
+
+func (c *testClient) CreateProducer(opts pulsar.ProducerOptions) (pulsar.Producer, error) {
+	p := &testProducer{topic: opts.Topic}
+	c.producers[opts.Topic] = p
+	return p, nil
+}
+
+func TestProducerMultiTopics(t *testing.T) {
+	t.Parallel()
+
+	u, err := url.Parse("pulsar://127.0.0.1:6650/default-topic")
+	require.NoError(t, err)
+	opt, err := parseSinkOptions(u)
+	require.NoError(t, err)
+	client := &testClient{mockClient: newMockClient(3), producers: make(map[string]*testProducer)}
+	p := &Producer{
+		opt:       *opt,
+		client:    client,
+		errCh:     make(chan error, 1),
+		producers: make(map[string]pulsar.Producer),
+	}
+	require.Equal(t, "default-topic", p.DefaultTopic())
+
+	ctx := context.Background()
+	msg := &common.Message{Key: []byte("key"), Value: []byte("value")}
+	require.NoError(t, p.AsyncSendMessage(ctx, "topic-1", 1, msg))
+	require.NoError(t, p.AsyncSendMessage(ctx, "topic-1", 2, msg))
+	require.NoError(t, p.AsyncSendMessage(ctx, "", 0, msg))
+	require.Len(t, client.producers, 2)
+	require.Len(t, client.producers["topic-1"].msgs, 2)
+	require.Len(t, client.producers["default-topic"].msgs, 1)
+
+	// broadcast to all partitions of the topic.
+	require.NoError(t, p.SyncBroadcastMessage(ctx, "topic-2", 3, msg))
+	require.Len(t, client.producers, 3)
+	msgs := client.producers["topic-2"].msgs
+	require.Len(t, msgs, 3)
+	for i, m := range msgs {
+		require.Equal(t, strconv.Itoa(i), m.Properties[route])
+	}
+
+	require.NoError(t, p.Flush(ctx))
+	require.NoError(t, p.Close())
+	for _, producer := range client.producers {
+		require.True(t, producer.closed)
+	}
+}
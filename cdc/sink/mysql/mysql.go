@@ -47,6 +47,7 @@ import (
 	"github.com/pingcap/tiflow/pkg/notify"
 	"github.com/pingcap/tiflow/pkg/quotes"
 	"github.com/pingcap/tiflow/pkg/retry"
+	pmysql "github.com/pingcap/tiflow/pkg/sink/mysql"
 )
 
 const (
@@ -76,7 +77,11 @@ type mysqlSink struct {
 	metricBucketSizeCounters        []prometheus.Counter
 
 	forceReplicate bool
-	cancel         func()
+	// conflictResolver is nil if no conflict rules are configured.
+	conflictResolver *pmysql.ConflictResolver
+	// bdrMarker is nil if the bidirectional replication is not enabled.
+	bdrMarker *pmysql.BDRMarker
+	cancel    func()
 
 	// error is set when the sink has encountered an
 	// error and cannot work anymore.
@@ -97,6 +102,11 @@ func NewMySQLSink(
 
 	params.enableOldValue = replicaConfig.EnableOldValue
 
+	conflictResolver, err := pmysql.NewConflictResolver(replicaConfig)
+	if err != nil {
+		return nil, err
+	}
+
 	// dsn format of the driver:
 	// [username[:password]@][protocol[(address)]]/dbname[?param1=value1&...&paramN=valueN]
 	username := sinkURI.User.Username()
@@ -167,6 +177,20 @@ func NewMySQLSink(
 		return nil, err
 	}
 
+	if conflictResolver != nil {
+		if err := conflictResolver.CreateConflictTables(ctx, db); err != nil {
+			_ = db.Close()
+			return nil, err
+		}
+	}
+	bdrMarker := pmysql.NewBDRMarker(replicaConfig)
+	if bdrMarker != nil {
+		if err := bdrMarker.CreateMarkTable(ctx, db); err != nil {
+			_ = db.Close()
+			return nil, err
+		}
+	}
+
 	log.Info("Start mysql sink")
 
 	db.SetMaxIdleConns(params.workerCount)
@@ -194,6 +218,8 @@ func NewMySQLSink(
 		resolvedCh:                      make(chan struct{}, 1),
 		errCh:                           make(chan error, 1),
 		forceReplicate:                  replicaConfig.ForceReplicate,
+		conflictResolver:                conflictResolver,
+		bdrMarker:                       bdrMarker,
 		cancel:                          cancel,
 	}
 
@@ -711,7 +737,7 @@ type preparedDMLs struct {
 }
 
 // prepareDMLs converts model.RowChangedEvent list to query string list and args list
-func (s *mysqlSink) prepareDMLs(rows []*model.RowChangedEvent, bucket int) *preparedDMLs {
+func (s *mysqlSink) prepareDMLs(rows []*model.RowChangedEvent, bucket int) (*preparedDMLs, error) {
 	startTs := make([]model.Ts, 0, 1)
 	sqls := make([]string, 0, len(rows))
 	values := make([][]interface{}, 0, len(rows))
@@ -748,6 +774,21 @@ func (s *mysqlSink) prepareDMLs(rows []*model.RowChangedEvent, bucket int) *prep
 			startTs = append(startTs, row.StartTs)
 		}
 
+		// The tables with conflict rules are written by the conflict resolver.
+		if s.conflictResolver != nil {
+			if res := s.conflictResolver.Match(row.Table); res != nil {
+				flushCacheDMLs()
+				resolvedSqls, resolvedValues, err := s.conflictResolver.PrepareDMLs(row, res)
+				if err != nil {
+					return nil, err
+				}
+				sqls = append(sqls, resolvedSqls...)
+				values = append(values, resolvedValues...)
+				rowCount++
+				continue
+			}
+		}
+
 		// If the old value is enabled, is not in safe mode and is an update event, then translate to UPDATE.
 		// NOTICE: Only update events with the old value feature enabled will have both columns and preColumns.
 		if translateToInsert && len(row.PreColumns) != 0 && len(row.Columns) != 0 {
@@ -807,13 +848,24 @@ func (s *mysqlSink) prepareDMLs(rows []*model.RowChangedEvent, bucket int) *prep
 	}
 	flushCacheDMLs()
 
+	// Tag the transaction by the mark rows of the bidirectional replication.
+	if s.bdrMarker != nil && len(rows) != 0 {
+		tables := make([]*model.TableName, 0, 1)
+		for _, row := range rows {
+			tables = append(tables, row.Table)
+		}
+		markSqls, markValues := s.bdrMarker.GenMarkDMLs(tables, bucket)
+		sqls = append(sqls, markSqls...)
+		values = append(values, markValues...)
+	}
+
 	dmls := &preparedDMLs{
 		startTs:  startTs,
 		sqls:     sqls,
 		values:   values,
 		rowCount: rowCount,
 	}
-	return dmls
+	return dmls, nil
 }
 
 func (s *mysqlSink) execDMLs(ctx context.Context, rows []*model.RowChangedEvent, bucket int) error {
@@ -824,7 +876,10 @@ func (s *mysqlSink) execDMLs(ctx context.Context, rows []*model.RowChangedEvent,
 		failpoint.Return(errors.Trace(dmysql.ErrInvalidConn))
 	})
 	s.statistics.ObserveRows(rows...)
-	dmls := s.prepareDMLs(rows, bucket)
+	dmls, err := s.prepareDMLs(rows, bucket)
+	if err != nil {
+		return errors.Trace(err)
+	}
 	log.Debug("prepare DMLs", zap.Any("rows", rows), zap.Strings("sqls", dmls.sqls), zap.Any("values", dmls.values))
 	if err := s.execDMLWithMaxRetries(ctx, dmls, bucket); err != nil {
 		if errors.Cause(err) != context.Canceled {
@@ -124,7 +124,8 @@ func TestPrepareDML(t *testing.T) {
 	defer cancel()
 	ms := newMySQLSink4Test(ctx, t)
 	for _, tc := range testCases {
-		dmls := ms.prepareDMLs(tc.input, 0)
+		dmls, err := ms.prepareDMLs(tc.input, 0)
+		require.Nil(t, err)
 		require.Equal(t, tc.expected, dmls)
 	}
 }
@@ -2443,7 +2444,8 @@ func TestMysqlSinkSafeModeOff(t *testing.T) {
 	ms.params.safeMode = false
 	ms.params.enableOldValue = true
 	for _, tc := range testCases {
-		dmls := ms.prepareDMLs(tc.input, 0)
+		dmls, err := ms.prepareDMLs(tc.input, 0)
+		require.Nil(t, err)
 		require.Equal(t, tc.expected, dmls, tc.name)
 	}
 }
@@ -17,6 +17,7 @@ import (
 	"context"
 	"strings"
 
+	"github.com/apache/pulsar-client-go/pulsar"
 	"github.com/pingcap/tiflow/cdc/sink/mq/producer/kafka"
 	"github.com/pingcap/tiflow/cdc/sinkv2/ddlsink"
 	"github.com/pingcap/tiflow/cdc/sinkv2/ddlsink/blackhole"
@@ -44,6 +45,9 @@ func New(
 	case sink.KafkaSchema, sink.KafkaSSLSchema:
 		return mq.NewKafkaDDLSink(ctx, sinkURI, cfg,
 			kafka.NewAdminClientImpl, ddlproducer.NewKafkaDDLProducer)
+	case sink.PulsarSchema, sink.PulsarSSLSchema:
+		return mq.NewPulsarDDLSink(ctx, sinkURI, cfg,
+			pulsar.NewClient, ddlproducer.NewPulsarDDLProducer)
 	case sink.BlackHoleSchema:
 		return blackhole.New(), nil
 	case sink.MySQLSSLSchema, sink.MySQLSchema, sink.TiDBSchema, sink.TiDBSSLSchema:
@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/Shopify/sarama"
+	"github.com/apache/pulsar-client-go/pulsar"
 	"github.com/pingcap/tiflow/cdc/sink/codec/common"
 	"github.com/pingcap/tiflow/pkg/sink/kafka"
 )
@@ -38,3 +39,8 @@ type DDLProducer interface {
 // Factory is a function to create a producer.
 type Factory func(ctx context.Context, client sarama.Client,
 	adminClient kafka.ClusterAdminClient) (DDLProducer, error)
+
+// PulsarFactory is a function to create a pulsar producer.
+// producerOptions is the template to create the producer of each topic.
+type PulsarFactory func(ctx context.Context, client pulsar.Client,
+	producerOptions pulsar.ProducerOptions) (DDLProducer, error)
@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/Shopify/sarama"
+	"github.com/apache/pulsar-client-go/pulsar"
 	"github.com/pingcap/tiflow/cdc/sink/codec/common"
 	mqv1 "github.com/pingcap/tiflow/cdc/sink/mq"
 	"github.com/pingcap/tiflow/pkg/sink/kafka"
@@ -38,6 +39,15 @@ func NewMockDDLProducer(_ context.Context, _ sarama.Client,
 	}, nil
 }
 
+// NewPulsarMockDDLProducer creates a mock producer for pulsar.
+func NewPulsarMockDDLProducer(_ context.Context, _ pulsar.Client,
+	_ pulsar.ProducerOptions,
+) (DDLProducer, error) {
+	return &MockDDLProducer{
+		events: make(map[mqv1.TopicPartitionKey][]*common.Message),
+	}, nil
+}
+
 // SyncBroadcastMessage stores a message to all partitions of the topic.
 func (m *MockDDLProducer) SyncBroadcastMessage(ctx context.Context, topic string,
 	totalPartitionsNum int32, message *common.Message,
@@ -0,0 +1,159 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ddlproducer
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/contextutil"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/sink/codec/common"
+	pulsarv1 "github.com/pingcap/tiflow/cdc/sink/mq/producer/pulsar"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// Assert DDLProducer implementation
+var _ DDLProducer = (*pulsarDDLProducer)(nil)
+
+// pulsarDDLProducer is used to send messages to pulsar synchronously.
+type pulsarDDLProducer struct {
+	// id indicates this sink belongs to which processor(changefeed).
+	id model.ChangeFeedID
+	// client is shared by the producers of all topics.
+	client pulsar.Client
+	// producerOptions is the template to create the producer of each topic.
+	producerOptions pulsar.ProducerOptions
+	// producers holds the producer of each topic, they are created on demand.
+	// It's protected by `closedMu`.
+	producers map[string]pulsar.Producer
+	// closedMu is used to protect `closed` and `producers`.
+	// We need to ensure that closed producers are never written to.
+	closedMu sync.Mutex
+	// closed is used to indicate whether the producer is closed.
+	// We also use it to guard against double closes.
+	closed bool
+}
+
+// NewPulsarDDLProducer creates a new pulsar producer for replicating DDL.
+func NewPulsarDDLProducer(ctx context.Context, client pulsar.Client,
+	producerOptions pulsar.ProducerOptions,
+) (DDLProducer, error) {
+	return &pulsarDDLProducer{
+		id:              contextutil.ChangefeedIDFromCtx(ctx),
+		client:          client,
+		producerOptions: producerOptions,
+		producers:       make(map[string]pulsar.Producer),
+		closed:          false,
+	}, nil
+}
+
+func (p *pulsarDDLProducer) SyncBroadcastMessage(ctx context.Context, topic string,
+	totalPartitionsNum int32, message *common.Message,
+) error {
+	p.closedMu.Lock()
+	defer p.closedMu.Unlock()
+
+	if p.closed {
+		return cerror.ErrPulsarProducerClosed.GenWithStackByArgs()
+	}
+
+	producer, err := p.getProducer(topic)
+	if err != nil {
+		return err
+	}
+	for i := int32(0); i < totalPartitionsNum; i++ {
+		if _, err := producer.Send(ctx, pulsarv1.NewProducerMessage(message, i)); err != nil {
+			return cerror.WrapError(cerror.ErrPulsarSendMessage, err)
+		}
+	}
+	return nil
+}
+
+func (p *pulsarDDLProducer) SyncSendMessage(ctx context.Context, topic string,
+	partitionNum int32, message *common.Message,
+) error {
+	p.closedMu.Lock()
+	defer p.closedMu.Unlock()
+
+	if p.closed {
+		return cerror.ErrPulsarProducerClosed.GenWithStackByArgs()
+	}
+
+	producer, err := p.getProducer(topic)
+	if err != nil {
+		return err
+	}
+	select {
+	case <-ctx.Done():
+		return errors.Trace(ctx.Err())
+	default:
+		_, err := producer.Send(ctx, pulsarv1.NewProducerMessage(message, partitionNum))
+		return cerror.WrapError(cerror.ErrPulsarSendMessage, err)
+	}
+}
+
+// getProducer returns the producer of the topic, creates it if not exists.
+// The caller must hold `closedMu`.
+func (p *pulsarDDLProducer) getProducer(topic string) (pulsar.Producer, error) {
+	if producer, ok := p.producers[topic]; ok {
+		return producer, nil
+	}
+	opts := p.producerOptions
+	opts.Topic = topic
+	producer, err := p.client.CreateProducer(opts)
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrPulsarNewProducer, err)
+	}
+	p.producers[topic] = producer
+	log.Info("Pulsar DDL producer created", zap.String("topic", topic),
+		zap.String("namespace", p.id.Namespace),
+		zap.String("changefeed", p.id.ID))
+	return producer, nil
+}
+
+func (p *pulsarDDLProducer) Close() {
+	// We have to hold the lock to prevent write to closed producer.
+	p.closedMu.Lock()
+	defer p.closedMu.Unlock()
+	// If the producer was already closed, we should skip the close operation.
+	if p.closed {
+		log.Warn("Pulsar DDL producer already closed",
+			zap.String("namespace", p.id.Namespace),
+			zap.String("changefeed", p.id.ID))
+		return
+	}
+	p.closed = true
+	producers := p.producers
+	p.producers = make(map[string]pulsar.Producer)
+	// We need to close it asynchronously. Otherwise, we might get stuck
+	// with an unhealthy state of pulsar, no data will be lost because
+	// the messages are sent synchronously.
+	go func() {
+		start := time.Now()
+		for _, producer := range producers {
+			producer.Close()
+		}
+		p.client.Close()
+		log.Info("Pulsar client closed in pulsar DDL producer",
+			zap.Duration("duration", time.Since(start)),
+			zap.String("namespace", p.id.Namespace),
+			zap.String("changefeed", p.id.ID))
+	}()
+}
@@ -0,0 +1,68 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mq
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	mm "github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tiflow/cdc/model"
+	mqv1 "github.com/pingcap/tiflow/cdc/sink/mq"
+	pulsarv1 "github.com/pingcap/tiflow/cdc/sink/mq/producer/pulsar"
+	"github.com/pingcap/tiflow/cdc/sinkv2/ddlsink/mq/ddlproducer"
+	"github.com/pingcap/tiflow/pkg/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPulsarWriteDDLEventToAllPartitions(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sinkURI, err := url.Parse("pulsar://127.0.0.1:6650/pulsar_topic?protocol=open-protocol")
+	require.Nil(t, err)
+	replicaConfig := config.GetDefaultReplicaConfig()
+	require.Nil(t, replicaConfig.ValidateAndAdjust(sinkURI))
+
+	clientCreator := func(pulsar.ClientOptions) (pulsar.Client, error) {
+		return pulsarv1.NewMockClient(3), nil
+	}
+	s, err := NewPulsarDDLSink(ctx, sinkURI, replicaConfig,
+		clientCreator, ddlproducer.NewPulsarMockDDLProducer)
+	require.Nil(t, err)
+	require.NotNil(t, s)
+
+	ddl := &model.DDLEvent{
+		CommitTs: 417318403368288260,
+		TableInfo: &model.SimpleTableInfo{
+			Schema: "cdc", Table: "person",
+		},
+		Query: "create table person(id int, name varchar(32), primary key(id))",
+		Type:  mm.ActionCreateTable,
+	}
+	err = s.WriteDDLEvent(ctx, ddl)
+	require.Nil(t, err)
+	require.Len(t, s.producer.(*ddlproducer.MockDDLProducer).GetAllEvents(),
+		3, "All partitions should be broadcast")
+	for i := int32(0); i < 3; i++ {
+		require.Len(t, s.producer.(*ddlproducer.MockDDLProducer).GetEvents(mqv1.TopicPartitionKey{
+			Topic:     "pulsar_topic",
+			Partition: i,
+		}), 1)
+	}
+}
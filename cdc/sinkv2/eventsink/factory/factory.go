@@ -17,6 +17,7 @@ import (
 	"context"
 	"strings"
 
+	"github.com/apache/pulsar-client-go/pulsar"
 	"github.com/pingcap/tiflow/cdc/model"
 	"github.com/pingcap/tiflow/cdc/sinkv2/eventsink"
 	"github.com/pingcap/tiflow/cdc/sinkv2/eventsink/blackhole"
@@ -70,6 +71,14 @@ func New(ctx context.Context,
 		}
 		s.rowSink = mqs
 		s.sinkType = sink.RowSink
+	case sink.PulsarSchema, sink.PulsarSSLSchema:
+		mqs, err := mq.NewPulsarDMLSink(ctx, sinkURI, cfg, errCh,
+			pulsar.NewClient, dmlproducer.NewPulsarDMLProducer)
+		if err != nil {
+			return nil, err
+		}
+		s.rowSink = mqs
+		s.sinkType = sink.RowSink
 	case sink.BlackHoleSchema:
 		bs := blackhole.New()
 		s.rowSink = bs
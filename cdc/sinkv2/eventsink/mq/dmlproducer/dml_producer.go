@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/Shopify/sarama"
+	"github.com/apache/pulsar-client-go/pulsar"
 	"github.com/pingcap/tiflow/cdc/sink/codec/common"
 	"github.com/pingcap/tiflow/pkg/sink/kafka"
 )
@@ -40,3 +41,8 @@ type DMLProducer interface {
 // It's usually a buffered channel.
 type Factory func(ctx context.Context, client sarama.Client,
 	adminClient kafka.ClusterAdminClient, errCh chan error) (DMLProducer, error)
+
+// PulsarFactory is a function to create a pulsar producer.
+// producerOptions is the template to create the producer of each topic.
+type PulsarFactory func(ctx context.Context, client pulsar.Client,
+	producerOptions pulsar.ProducerOptions, errCh chan error) (DMLProducer, error)
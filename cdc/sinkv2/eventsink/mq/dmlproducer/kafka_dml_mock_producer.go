@@ -18,6 +18,7 @@ import (
 	"sync"
 
 	"github.com/Shopify/sarama"
+	"github.com/apache/pulsar-client-go/pulsar"
 	"github.com/pingcap/tiflow/cdc/sink/codec/common"
 	mqv1 "github.com/pingcap/tiflow/cdc/sink/mq"
 	"github.com/pingcap/tiflow/pkg/sink/kafka"
@@ -40,6 +41,15 @@ func NewDMLMockProducer(_ context.Context, _ sarama.Client,
 	}, nil
 }
 
+// NewPulsarDMLMockProducer creates a mock producer for pulsar.
+func NewPulsarDMLMockProducer(_ context.Context, _ pulsar.Client,
+	_ pulsar.ProducerOptions, _ chan error,
+) (DMLProducer, error) {
+	return &MockDMLProducer{
+		events: make(map[mqv1.TopicPartitionKey][]*common.Message),
+	}, nil
+}
+
 // AsyncSendMessage appends a message to the mock producer.
 func (m *MockDMLProducer) AsyncSendMessage(_ context.Context, topic string,
 	partition int32, message *common.Message,
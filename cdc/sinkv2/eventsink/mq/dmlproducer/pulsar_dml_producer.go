@@ -0,0 +1,175 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dmlproducer
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/contextutil"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/sink/codec/common"
+	pulsarv1 "github.com/pingcap/tiflow/cdc/sink/mq/producer/pulsar"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"go.uber.org/zap"
+)
+
+var _ DMLProducer = (*pulsarDMLProducer)(nil)
+
+// pulsarDMLProducer is used to send messages to pulsar.
+type pulsarDMLProducer struct {
+	// id indicates which processor (changefeed) this sink belongs to.
+	id model.ChangeFeedID
+	// client is shared by the producers of all topics.
+	client pulsar.Client
+	// producerOptions is the template to create the producer of each topic.
+	producerOptions pulsar.ProducerOptions
+	// producersMu is used to protect `producers`.
+	producersMu sync.Mutex
+	// producers holds the producer of each topic, they are created on demand.
+	producers map[string]pulsar.Producer
+	// errCh is used to report the asynchronous send errors.
+	errCh chan error
+	// closedMu is used to protect `closed`.
+	// We need to ensure that closed producers are never written to.
+	closedMu sync.RWMutex
+	// closed is used to indicate whether the producer is closed.
+	// We also use it to guard against double closes.
+	closed bool
+}
+
+// NewPulsarDMLProducer creates a new pulsar producer.
+func NewPulsarDMLProducer(
+	ctx context.Context,
+	client pulsar.Client,
+	producerOptions pulsar.ProducerOptions,
+	errCh chan error,
+) (DMLProducer, error) {
+	changefeedID := contextutil.ChangefeedIDFromCtx(ctx)
+	log.Info("Starting pulsar DML producer ...",
+		zap.String("namespace", changefeedID.Namespace),
+		zap.String("changefeed", changefeedID.ID))
+
+	return &pulsarDMLProducer{
+		id:              changefeedID,
+		client:          client,
+		producerOptions: producerOptions,
+		producers:       make(map[string]pulsar.Producer),
+		errCh:           errCh,
+		closed:          false,
+	}, nil
+}
+
+func (p *pulsarDMLProducer) AsyncSendMessage(
+	ctx context.Context, topic string,
+	partition int32, message *common.Message,
+) error {
+	// We have to hold the lock to avoid writing to a closed producer.
+	p.closedMu.RLock()
+	defer p.closedMu.RUnlock()
+
+	// If the producer is closed, we should skip the message and return an error.
+	if p.closed {
+		return cerror.ErrPulsarProducerClosed.GenWithStackByArgs()
+	}
+
+	producer, err := p.getProducer(topic)
+	if err != nil {
+		return err
+	}
+	callback := message.Callback
+	producer.SendAsync(ctx, pulsarv1.NewProducerMessage(message, partition),
+		func(_ pulsar.MessageID, _ *pulsar.ProducerMessage, err error) {
+			if err != nil {
+				p.reportError(ctx, cerror.WrapError(cerror.ErrPulsarSendMessage, err))
+				return
+			}
+			if callback != nil {
+				callback()
+			}
+		})
+	return nil
+}
+
+// getProducer returns the producer of the topic, creates it if not exists.
+func (p *pulsarDMLProducer) getProducer(topic string) (pulsar.Producer, error) {
+	p.producersMu.Lock()
+	defer p.producersMu.Unlock()
+	if producer, ok := p.producers[topic]; ok {
+		return producer, nil
+	}
+	opts := p.producerOptions
+	opts.Topic = topic
+	producer, err := p.client.CreateProducer(opts)
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrPulsarNewProducer, err)
+	}
+	p.producers[topic] = producer
+	log.Info("Pulsar DML producer created", zap.String("topic", topic),
+		zap.String("namespace", p.id.Namespace),
+		zap.String("changefeed", p.id.ID))
+	return producer, nil
+}
+
+func (p *pulsarDMLProducer) reportError(ctx context.Context, err error) {
+	select {
+	case <-ctx.Done():
+	case p.errCh <- err:
+		log.Error("Pulsar DML producer send error", zap.Error(err),
+			zap.String("namespace", p.id.Namespace),
+			zap.String("changefeed", p.id.ID))
+	default:
+		log.Error("Error channel is full in pulsar DML producer", zap.Error(err),
+			zap.String("namespace", p.id.Namespace),
+			zap.String("changefeed", p.id.ID))
+	}
+}
+
+func (p *pulsarDMLProducer) Close() {
+	// We have to hold the lock to synchronize closing with writing.
+	p.closedMu.Lock()
+	defer p.closedMu.Unlock()
+	// If the producer has already been closed, we should skip this close operation.
+	if p.closed {
+		log.Warn("Pulsar DML producer already closed",
+			zap.String("namespace", p.id.Namespace),
+			zap.String("changefeed", p.id.ID))
+		return
+	}
+	p.closed = true
+
+	p.producersMu.Lock()
+	producers := p.producers
+	p.producers = make(map[string]pulsar.Producer)
+	p.producersMu.Unlock()
+
+	// We need to close it asynchronously, closing the producers flushes
+	// the pending messages, which might get stuck with an unhealthy pulsar cluster.
+	// For MQ sinks, duplicate data is acceptable and all table pipelines are
+	// cancelled before closing, so it's safe to not wait for the flush.
+	go func() {
+		start := time.Now()
+		for _, producer := range producers {
+			producer.Close()
+		}
+		p.client.Close()
+		log.Info("Pulsar client closed in pulsar DML producer",
+			zap.Duration("duration", time.Since(start)),
+			zap.String("namespace", p.id.Namespace),
+			zap.String("changefeed", p.id.ID))
+	}()
+}
@@ -0,0 +1,143 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dmlproducer
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/pingcap/tiflow/cdc/sink/codec/common"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
+)
+
+type testPulsarProducer struct {
+	pulsar.Producer
+
+	topic   string
+	sendErr error
+	mu      sync.Mutex
+	msgs    []*pulsar.ProducerMessage
+	closed  bool
+}
+
+func (p *testPulsarProducer) SendAsync(
+	_ context.Context, msg *pulsar.ProducerMessage,
+	callback func(pulsar.MessageID, *pulsar.ProducerMessage, error),
+) {
+	p.mu.Lock()
+	p.msgs = append(p.msgs, msg)
+	p.mu.Unlock()
+	callback(nil, msg, p.sendErr)
+}
+
+func (p *testPulsarProducer) Close() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.closed = true
+}
+
+type testPulsarClient struct {
+	pulsar.Client
+
+	sendErr   error
+	mu        sync.Mutex
+	producers map[string]*testPulsarProducer
+	closed    chan struct{}
+}
+
+func newTestPulsarClient(sendErr error) *testPulsarClient {
+	return &testPulsarClient{
+		sendErr:   sendErr,
+		producers: make(map[string]*testPulsarProducer),
+		closed:    make(chan struct{}),
+	}
+}
+
+func (c *testPulsarClient) CreateProducer(opts pulsar.ProducerOptions) (pulsar.Producer, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	p := &testPulsarProducer{topic: opts.Topic, sendErr: c.sendErr}
+	c.producers[opts.Topic] = p
+	return p, nil
+}
+
+func (c *testPulsarClient) Close() {
+	close(c.closed)
+}
+
+func TestPulsarProducerAck(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	client := newTestPulsarClient(nil)
+	errCh := make(chan error, 1)
+	p, err := NewPulsarDMLProducer(ctx, client,
+		pulsar.ProducerOptions{Topic: "default"}, errCh)
+	require.Nil(t, err)
+
+	count := atomic.NewInt64(0)
+	for _, topic := range []string{"default", "t1", "default", "t2"} {
+		err = p.AsyncSendMessage(ctx, topic, 1, &common.Message{
+			Key:      []byte("test-key"),
+			Value:    []byte("test-value"),
+			Callback: func() { count.Add(1) },
+		})
+		require.Nil(t, err)
+	}
+	require.Equal(t, int64(4), count.Load())
+	require.Len(t, client.producers, 3)
+	require.Len(t, client.producers["default"].msgs, 2)
+	require.Equal(t, "1", client.producers["t1"].msgs[0].Properties["$route"])
+	require.Len(t, errCh, 0)
+
+	p.Close()
+	<-client.closed
+	for _, producer := range client.producers {
+		require.True(t, producer.closed)
+	}
+	err = p.AsyncSendMessage(ctx, "default", 0, &common.Message{})
+	require.ErrorIs(t, err, cerror.ErrPulsarProducerClosed)
+	// Close again should not panic.
+	p.Close()
+}
+
+func TestPulsarProducerSendError(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	client := newTestPulsarClient(errors.New("pulsar send error"))
+	errCh := make(chan error, 1)
+	p, err := NewPulsarDMLProducer(ctx, client,
+		pulsar.ProducerOptions{Topic: "default"}, errCh)
+	require.Nil(t, err)
+
+	count := atomic.NewInt64(0)
+	err = p.AsyncSendMessage(ctx, "default", 0, &common.Message{
+		Callback: func() { count.Add(1) },
+	})
+	require.Nil(t, err)
+	require.Equal(t, int64(0), count.Load())
+	err = <-errCh
+	require.ErrorIs(t, err, cerror.ErrPulsarSendMessage)
+
+	p.Close()
+	<-client.closed
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/pingcap/tiflow/cdc/model"
 	"github.com/pingcap/tiflow/cdc/sink/codec"
 	"github.com/pingcap/tiflow/cdc/sink/codec/builder"
+	"github.com/pingcap/tiflow/cdc/sink/codec/claimcheck"
 	"github.com/pingcap/tiflow/cdc/sink/codec/common"
 	mqv1 "github.com/pingcap/tiflow/cdc/sink/mq"
 	"github.com/pingcap/tiflow/cdc/sink/mq/dispatcher"
@@ -72,8 +73,17 @@ func newSink(ctx context.Context,
 	}
 	encoder := encoderBuilder.Build()
 
+	var claimCheck *claimcheck.ClaimCheck
+	if encoderConfig.ClaimCheckStorageURI != "" {
+		claimCheck, err = claimcheck.New(ctx, encoderConfig.ClaimCheckStorageURI,
+			changefeedID, encoderConfig.MaxMessageBytes)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+	}
+
 	statistics := metrics.NewStatistics(ctx, sink.RowSink)
-	w := newWorker(changefeedID, encoder, producer, statistics)
+	w := newWorker(changefeedID, encoder, producer, claimCheck, statistics)
 
 	s := &dmlSink{
 		id:             changefeedID,
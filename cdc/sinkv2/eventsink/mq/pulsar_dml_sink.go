@@ -0,0 +1,94 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mq
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/sink/mq/dispatcher"
+	"github.com/pingcap/tiflow/cdc/sinkv2/eventsink/mq/dmlproducer"
+	mqutil "github.com/pingcap/tiflow/cdc/sinkv2/util/mq"
+	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// NewPulsarDMLSink will verify the config and create a PulsarSink.
+func NewPulsarDMLSink(
+	ctx context.Context,
+	sinkURI *url.URL,
+	replicaConfig *config.ReplicaConfig,
+	errCh chan error,
+	clientCreator mqutil.PulsarClientCreator,
+	producerCreator dmlproducer.PulsarFactory,
+) (_ *dmlSink, err error) {
+	log.Warn("Pulsar Sink is not recommended for production use.")
+	opt, err := mqutil.GetPulsarOption(sinkURI)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	producerOptions := opt.ProducerOptions()
+	topic := producerOptions.Topic
+
+	protocol, err := mqutil.GetProtocol(replicaConfig.Sink.Protocol)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	client, err := clientCreator(opt.ClientOptions())
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrPulsarNewProducer, err)
+	}
+
+	log.Info("Try to create a DML sink producer", zap.String("topic", topic))
+	p, err := producerCreator(ctx, client, producerOptions, errCh)
+	if err != nil {
+		client.Close()
+		return nil, cerror.WrapError(cerror.ErrPulsarNewProducer, err)
+	}
+	// Preventing leaks when error occurs.
+	// This also closes the client in p.Close().
+	defer func() {
+		if err != nil {
+			p.Close()
+		}
+	}()
+
+	topicManager, err := mqutil.GetPulsarTopicManagerAndTryCreateTopic(topic, client)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	eventRouter, err := dispatcher.NewEventRouter(replicaConfig, topic)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	// TODO: set by the `maxMessageSize` of pulsar broker.
+	encoderConfig, err := mqutil.GetEncoderConfig(sinkURI, protocol, replicaConfig,
+		config.DefaultMaxMessageBytes)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	s, err := newSink(ctx, p, topicManager, eventRouter, encoderConfig, errCh)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	return s, nil
+}
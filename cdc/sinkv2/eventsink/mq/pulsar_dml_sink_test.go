@@ -0,0 +1,92 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mq
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/pingcap/tiflow/cdc/model"
+	pulsarv1 "github.com/pingcap/tiflow/cdc/sink/mq/producer/pulsar"
+	"github.com/pingcap/tiflow/cdc/sinkv2/eventsink"
+	"github.com/pingcap/tiflow/cdc/sinkv2/eventsink/mq/dmlproducer"
+	"github.com/pingcap/tiflow/cdc/sinkv2/tablesink/state"
+	"github.com/pingcap/tiflow/pkg/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPulsarWriteEvents(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sinkURI, err := url.Parse("pulsar://127.0.0.1:6650/test?protocol=canal-json")
+	require.Nil(t, err)
+	replicaConfig := config.GetDefaultReplicaConfig()
+	require.Nil(t, replicaConfig.ValidateAndAdjust(sinkURI))
+	errCh := make(chan error, 1)
+
+	clientCreator := func(pulsar.ClientOptions) (pulsar.Client, error) {
+		return pulsarv1.NewMockClient(3), nil
+	}
+	s, err := NewPulsarDMLSink(ctx, sinkURI, replicaConfig, errCh,
+		clientCreator, dmlproducer.NewPulsarDMLMockProducer)
+	require.Nil(t, err)
+	require.NotNil(t, s)
+
+	tableStatus := state.TableSinkSinking
+	row := &model.RowChangedEvent{
+		CommitTs: 1,
+		Table:    &model.TableName{Schema: "a", Table: "b"},
+		Columns:  []*model.Column{{Name: "col1", Type: 1, Value: "aa"}},
+	}
+
+	events := make([]*eventsink.RowChangeCallbackableEvent, 0, 100)
+	for i := 0; i < 100; i++ {
+		events = append(events, &eventsink.RowChangeCallbackableEvent{
+			Event:     row,
+			Callback:  func() {},
+			SinkState: &tableStatus,
+		})
+	}
+
+	err = s.WriteEvents(events...)
+	// Wait for the events to be received by the worker.
+	time.Sleep(time.Second)
+	require.Nil(t, err)
+	require.Len(t, errCh, 0)
+	require.Len(t, s.worker.producer.(*dmlproducer.MockDMLProducer).GetAllEvents(), 100)
+	err = s.Close()
+	require.Nil(t, err)
+}
+
+func TestPulsarDMLSinkWithoutTopic(t *testing.T) {
+	t.Parallel()
+
+	sinkURI, err := url.Parse("pulsar://127.0.0.1:6650?protocol=canal-json")
+	require.Nil(t, err)
+	replicaConfig := config.GetDefaultReplicaConfig()
+	require.Nil(t, replicaConfig.ValidateAndAdjust(sinkURI))
+
+	clientCreator := func(pulsar.ClientOptions) (pulsar.Client, error) {
+		return pulsarv1.NewMockClient(3), nil
+	}
+	_, err = NewPulsarDMLSink(context.Background(), sinkURI, replicaConfig,
+		make(chan error, 1), clientCreator, dmlproducer.NewPulsarDMLMockProducer)
+	require.ErrorContains(t, err, "no topic is specified")
+}
@@ -21,6 +21,7 @@ import (
 	"github.com/pingcap/log"
 	"github.com/pingcap/tiflow/cdc/model"
 	"github.com/pingcap/tiflow/cdc/sink/codec"
+	"github.com/pingcap/tiflow/cdc/sink/codec/claimcheck"
 	mqv1 "github.com/pingcap/tiflow/cdc/sink/mq"
 	"github.com/pingcap/tiflow/cdc/sinkv2/eventsink"
 	"github.com/pingcap/tiflow/cdc/sinkv2/eventsink/mq/dmlproducer"
@@ -50,6 +51,9 @@ type worker struct {
 	encoder codec.EventBatchEncoder
 	// producer is used to send the messages to the Kafka/Pulsar broker.
 	producer dmlproducer.DMLProducer
+	// claimCheck is used to offload the oversized messages,
+	// it's nil if claim-check is disabled.
+	claimCheck *claimcheck.ClaimCheck
 	// statistics is used to record DML metrics.
 	statistics *metrics.Statistics
 }
@@ -59,6 +63,7 @@ func newWorker(
 	id model.ChangeFeedID,
 	encoder codec.EventBatchEncoder,
 	producer dmlproducer.DMLProducer,
+	claimCheck *claimcheck.ClaimCheck,
 	statistics *metrics.Statistics,
 ) *worker {
 	w := &worker{
@@ -67,6 +72,7 @@ func newWorker(
 		ticker:       time.NewTicker(mqv1.FlushInterval),
 		encoder:      encoder,
 		producer:     producer,
+		claimCheck:   claimCheck,
 		statistics:   statistics,
 	}
 
@@ -192,6 +198,11 @@ func (w *worker) asyncSend(
 		w.statistics.AddRowsCount(rowsCount)
 
 		for _, message := range w.encoder.Build() {
+			if w.claimCheck != nil {
+				if err := w.claimCheck.OffloadIfOversized(ctx, message); err != nil {
+					return errors.Trace(err)
+				}
+			}
 			err := w.statistics.RecordBatchExecution(func() (int, error) {
 				err := w.producer.AsyncSendMessage(ctx, key.Topic, key.Partition, message)
 				if err != nil {
@@ -50,6 +50,12 @@ type mysqlBackend struct {
 	db          *sql.DB
 	cfg         *pmysql.Config
 	dmlMaxRetry uint64
+	// conflictResolver is nil if no conflict rules are configured.
+	conflictResolver *pmysql.ConflictResolver
+	// bdrMarker is nil if the bidirectional replication is not enabled.
+	bdrMarker *pmysql.BDRMarker
+	// bucket is the index of the backend, it's used by the mark rows.
+	bucket int
 
 	events []*eventsink.TxnCallbackableEvent
 	rows   int
@@ -74,6 +80,11 @@ func NewMySQLBackends(
 		return nil, err
 	}
 
+	conflictResolver, err := pmysql.NewConflictResolver(replicaConfig)
+	if err != nil {
+		return nil, err
+	}
+
 	dsnStr, err := pmysql.GenerateDSN(ctx, sinkURI, cfg, dbConnFactory)
 	if err != nil {
 		return nil, err
@@ -83,6 +94,19 @@ func NewMySQLBackends(
 	if err != nil {
 		return nil, err
 	}
+	if conflictResolver != nil {
+		if err := conflictResolver.CreateConflictTables(ctx, db); err != nil {
+			_ = db.Close()
+			return nil, err
+		}
+	}
+	bdrMarker := pmysql.NewBDRMarker(replicaConfig)
+	if bdrMarker != nil {
+		if err := bdrMarker.CreateMarkTable(ctx, db); err != nil {
+			_ = db.Close()
+			return nil, err
+		}
+	}
 	db.SetMaxIdleConns(cfg.WorkerCount)
 	db.SetMaxOpenConns(cfg.WorkerCount)
 
@@ -94,6 +118,10 @@ func NewMySQLBackends(
 			cfg:         cfg,
 			dmlMaxRetry: defaultDMLMaxRetry,
 			statistics:  statistics,
+
+			conflictResolver: conflictResolver,
+			bdrMarker:        bdrMarker,
+			bucket:           i,
 		})
 	}
 
@@ -129,7 +157,10 @@ func (s *mysqlBackend) Flush(ctx context.Context) (err error) {
 		s.statistics.ObserveRows(event.Event.Rows...)
 	}
 
-	dmls := s.prepareDMLs()
+	dmls, err := s.prepareDMLs()
+	if err != nil {
+		return errors.Trace(err)
+	}
 	log.Debug("prepare DMLs", zap.Any("rows", s.rows),
 		zap.Strings("sqls", dmls.sqls), zap.Any("values", dmls.values))
 
@@ -175,7 +206,7 @@ type preparedDMLs struct {
 }
 
 // prepareDMLs converts model.RowChangedEvent list to query string list and args list
-func (s *mysqlBackend) prepareDMLs() *preparedDMLs {
+func (s *mysqlBackend) prepareDMLs() (*preparedDMLs, error) {
 	// TODO: use a sync.Pool to reduce allocations.
 	startTs := make([]uint64, 0, s.rows)
 	sqls := make([]string, 0, s.rows)
@@ -218,6 +249,21 @@ func (s *mysqlBackend) prepareDMLs() *preparedDMLs {
 				startTs = append(startTs, row.StartTs)
 			}
 
+			// The tables with conflict rules are written by the conflict resolver.
+			if s.conflictResolver != nil {
+				if res := s.conflictResolver.Match(row.Table); res != nil {
+					flushCacheDMLs()
+					resolvedSqls, resolvedValues, err := s.conflictResolver.PrepareDMLs(row, res)
+					if err != nil {
+						return nil, err
+					}
+					sqls = append(sqls, resolvedSqls...)
+					values = append(values, resolvedValues...)
+					rowCount++
+					continue
+				}
+			}
+
 			var query string
 			var args []interface{}
 			quoteTable := quotes.QuoteSchema(row.Table.Schema, row.Table.Table)
@@ -282,6 +328,17 @@ func (s *mysqlBackend) prepareDMLs() *preparedDMLs {
 	}
 	flushCacheDMLs()
 
+	// Tag the transaction by the mark rows of the bidirectional replication.
+	if s.bdrMarker != nil && len(s.events) != 0 {
+		tables := make([]*model.TableName, 0, len(s.events))
+		for _, event := range s.events {
+			tables = append(tables, event.Event.Table)
+		}
+		markSqls, markValues := s.bdrMarker.GenMarkDMLs(tables, s.bucket)
+		sqls = append(sqls, markSqls...)
+		values = append(values, markValues...)
+	}
+
 	if len(callbacks) == 0 {
 		callbacks = nil
 	}
@@ -292,7 +349,7 @@ func (s *mysqlBackend) prepareDMLs() *preparedDMLs {
 		values:    values,
 		callbacks: callbacks,
 		rowCount:  rowCount,
-	}
+	}, nil
 }
 
 func (s *mysqlBackend) execDMLWithMaxRetries(ctx context.Context, dmls *preparedDMLs) error {
@@ -144,7 +144,8 @@ func TestPrepareDML(t *testing.T) {
 			Event: &model.SingleTableTxn{Rows: tc.input},
 		}
 		ms.rows = len(tc.input)
-		dmls := ms.prepareDMLs()
+		dmls, err := ms.prepareDMLs()
+		require.Nil(t, err)
 		require.Equal(t, tc.expected, dmls)
 	}
 }
@@ -1182,7 +1183,8 @@ func TestMysqlSinkSafeModeOff(t *testing.T) {
 			Event: &model.SingleTableTxn{Rows: tc.input},
 		}
 		ms.rows = len(tc.input)
-		dmls := ms.prepareDMLs()
+		dmls, err := ms.prepareDMLs()
+		require.Nil(t, err)
 		require.Equal(t, tc.expected, dmls, tc.name)
 	}
 }
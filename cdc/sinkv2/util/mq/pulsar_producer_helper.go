@@ -0,0 +1,52 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mq
+
+import (
+	"net/url"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/pingcap/tiflow/cdc/sink/mq/manager"
+	pulsarv1 "github.com/pingcap/tiflow/cdc/sink/mq/producer/pulsar"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+)
+
+// PulsarClientCreator is a function to create a pulsar client.
+type PulsarClientCreator func(options pulsar.ClientOptions) (pulsar.Client, error)
+
+// GetPulsarOption returns the pulsar options from the sink URI,
+// the topic of the producer options is the default topic of the sink.
+func GetPulsarOption(sinkURI *url.URL) (*pulsarv1.Option, error) {
+	opt, err := pulsarv1.NewOption(sinkURI)
+	if err != nil {
+		return nil, err
+	}
+	if opt.ProducerOptions().Topic == "" {
+		return nil, cerror.ErrPulsarNewProducer.GenWithStack("no topic is specified in sink-uri")
+	}
+	return opt, nil
+}
+
+// GetPulsarTopicManagerAndTryCreateTopic returns the topic manager and
+// discovers the partitions of the topic.
+func GetPulsarTopicManagerAndTryCreateTopic(
+	topic string,
+	client pulsar.Client,
+) (manager.TopicManager, error) {
+	topicManager := manager.NewPulsarTopicManager(client)
+	if _, err := topicManager.CreateTopicAndWaitUntilVisible(topic); err != nil {
+		return nil, err
+	}
+	return topicManager, nil
+}
@@ -0,0 +1,104 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"go.uber.org/zap"
+
+	"github.com/pingcap/tiflow/dm/ctl/common"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/pingcap/tiflow/dm/simulator"
+	"github.com/pingcap/tiflow/pkg/version"
+)
+
+func main() {
+	fs := flag.NewFlagSet("dm-simulator", flag.ContinueOnError)
+	var (
+		printVersion bool
+		configFile   string
+		manifestFile string
+		logLevel     string
+		logFile      string
+	)
+	fs.BoolVar(&printVersion, "V", false, "prints version and exit")
+	fs.StringVar(&configFile, "config", "", "path to the workload config file")
+	fs.StringVar(&manifestFile, "manifest", "", "path to write the expected-state manifest, print to stdout if not set")
+	fs.StringVar(&logLevel, "L", "info", "log level: debug, info, warn, error, fatal")
+	fs.StringVar(&logFile, "log-file", "", "log file path")
+	if err := fs.Parse(os.Args[1:]); err != nil {
+		if err == flag.ErrHelp {
+			os.Exit(0)
+		}
+		common.PrintLinesf("parse cmd flags err: %s", terror.Message(err))
+		os.Exit(2)
+	}
+	if printVersion {
+		fmt.Println(version.GetRawInfo())
+		os.Exit(0)
+	}
+	if configFile == "" {
+		common.PrintLinesf("workload config file should be specified by -config")
+		os.Exit(2)
+	}
+
+	err := log.InitLogger(&log.Config{
+		File:  logFile,
+		Level: strings.ToLower(logLevel),
+	})
+	if err != nil {
+		common.PrintLinesf("init logger error %s", terror.Message(err))
+		os.Exit(2)
+	}
+	version.LogVersionInfo("dm-simulator")
+
+	sim, err := simulator.NewSimulator(configFile)
+	if err != nil {
+		common.PrintLinesf("load workload config error %s", terror.Message(err))
+		os.Exit(2)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	sc := make(chan os.Signal, 1)
+	signal.Notify(sc, syscall.SIGHUP, syscall.SIGINT, syscall.SIGTERM, syscall.SIGQUIT)
+	go func() {
+		sig := <-sc
+		log.L().Info("got signal to exit", zap.Stringer("signal", sig))
+		cancel()
+	}()
+
+	manifest, err := sim.Run(ctx)
+	if err != nil {
+		common.PrintLinesf("run workload error %s", terror.Message(err))
+		os.Exit(1)
+	}
+	if err = manifest.WriteFile(manifestFile); err != nil {
+		common.PrintLinesf("write manifest error %s", terror.Message(err))
+		os.Exit(1)
+	}
+	log.L().Info("dm-simulator exit")
+
+	if syncErr := log.L().Sync(); syncErr != nil {
+		fmt.Fprintln(os.Stderr, "sync log failed", syncErr)
+	}
+}
@@ -38,6 +38,7 @@ import (
 	"github.com/pingcap/tiflow/cdc/sink"
 	"github.com/pingcap/tiflow/cdc/sink/codec"
 	"github.com/pingcap/tiflow/cdc/sink/codec/canal"
+	"github.com/pingcap/tiflow/cdc/sink/codec/claimcheck"
 	"github.com/pingcap/tiflow/cdc/sink/codec/open"
 	"github.com/pingcap/tiflow/cdc/sink/mq/dispatcher"
 	cmdUtil "github.com/pingcap/tiflow/pkg/cmd/util"
@@ -65,6 +66,9 @@ var (
 	protocol            config.Protocol
 	enableTiDBExtension bool
 
+	// claimCheckStorageURI is the external storage holding the offloaded messages.
+	claimCheckStorageURI string
+
 	// eventRouterReplicaConfig only used to initialize the consumer's eventRouter
 	// which then can be used to check RowChangedEvent dispatched correctness
 	eventRouterReplicaConfig *config.ReplicaConfig
@@ -187,6 +191,12 @@ func init() {
 		enableTiDBExtension = b
 	}
 
+	s = upstreamURI.Query().Get("claim-check-storage-uri")
+	if s != "" {
+		claimCheckStorageURI = s
+	}
+	log.Info("Setting claim-check-storage-uri", zap.String("claim-check-storage-uri", claimCheckStorageURI))
+
 	if configFile != "" {
 		eventRouterReplicaConfig = config.GetDefaultReplicaConfig()
 		eventRouterReplicaConfig.Sink.Protocol = protocol.String()
@@ -375,6 +385,8 @@ type Consumer struct {
 
 	protocol            config.Protocol
 	enableTiDBExtension bool
+	// claimCheck is used to fetch the offloaded messages back.
+	claimCheck *claimcheck.ClaimCheck
 
 	eventRouter *dispatcher.EventRouter
 }
@@ -394,6 +406,13 @@ func NewConsumer(ctx context.Context) (*Consumer, error) {
 	}
 	c.protocol = protocol
 	c.enableTiDBExtension = enableTiDBExtension
+	if claimCheckStorageURI != "" {
+		c.claimCheck, err = claimcheck.New(ctx, claimCheckStorageURI,
+			model.DefaultChangeFeedID("kafka-consumer"), kafkaMaxMessageBytes)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+	}
 
 	// this means user has input config file to enable dispatcher check
 	// some protocol does not provide enough information to check the
@@ -504,11 +523,23 @@ func (c *Consumer) ConsumeClaim(session sarama.ConsumerGroupSession, claim saram
 			decoder codec.EventBatchDecoder
 			err     error
 		)
+		key, value := message.Key, message.Value
+		// Fetch the original message back if it was offloaded by claim-check.
+		if claimcheck.IsReference(value) {
+			if c.claimCheck == nil {
+				log.Panic("receive claim-check reference message, " +
+					"but claim-check-storage-uri is not specified in upstream-uri")
+			}
+			key, value, err = c.claimCheck.Resolve(ctx, key, value)
+			if err != nil {
+				log.Panic("fetch claim-check message failed", zap.Error(err))
+			}
+		}
 		switch c.protocol {
 		case config.ProtocolOpen, config.ProtocolDefault:
-			decoder, err = open.NewBatchDecoder(message.Key, message.Value)
+			decoder, err = open.NewBatchDecoder(key, value)
 		case config.ProtocolCanalJSON:
-			decoder = canal.NewBatchDecoder(message.Value, c.enableTiDBExtension)
+			decoder = canal.NewBatchDecoder(value, c.enableTiDBExtension)
 		default:
 			log.Panic("Protocol not supported", zap.Any("Protocol", c.protocol))
 		}
@@ -63,6 +63,8 @@ const (
 	ValidationNone = "none"
 	ValidationFast = "fast"
 	ValidationFull = "full"
+	// ValidationSnapshot compares whole tables at a consistent binlog location instead of validating row changes.
+	ValidationSnapshot = "snapshot"
 
 	DefaultValidatorWorkerCount       = 4
 	DefaultValidatorValidateInterval  = 10 * time.Second
@@ -71,6 +73,7 @@ const (
 	DefaultValidatorMetaFlushInterval = 5 * time.Minute
 	DefaultValidatorBatchQuerySize    = 100
 	DefaultValidatorMaxPendingRowSize = "500m"
+	DefaultValidatorSnapshotChunkSize = 10000
 
 	ValidatorMaxAccumulatedRow = 100000
 	// PendingRow is substantial in this version (in sysbench test)
@@ -364,14 +367,16 @@ type ValidatorConfig struct {
 	BatchQuerySize     int      `yaml:"batch-query-size" toml:"batch-query-size" json:"batch-query-size"`
 	MaxPendingRowSize  string   `yaml:"max-pending-row-size" toml:"max-pending-row-size" json:"max-pending-row-size"`
 	MaxPendingRowCount int      `yaml:"max-pending-row-count" toml:"max-pending-row-count" json:"max-pending-row-count"`
-	StartTime          string   `yaml:"-" toml:"start-time" json:"-"`
+	// SnapshotChunkSize is the number of rows of a chunk whose checksum is compared in snapshot mode.
+	SnapshotChunkSize int    `yaml:"snapshot-chunk-size" toml:"snapshot-chunk-size" json:"snapshot-chunk-size"`
+	StartTime         string `yaml:"-" toml:"start-time" json:"-"`
 }
 
 func (v *ValidatorConfig) Adjust() error {
 	if v.Mode == "" {
 		v.Mode = ValidationNone
 	}
-	if v.Mode != ValidationNone && v.Mode != ValidationFast && v.Mode != ValidationFull {
+	if v.Mode != ValidationNone && v.Mode != ValidationFast && v.Mode != ValidationFull && v.Mode != ValidationSnapshot {
 		return terror.ErrConfigValidationMode
 	}
 	if v.WorkerCount <= 0 {
@@ -403,6 +408,9 @@ func (v *ValidatorConfig) Adjust() error {
 	if v.MaxPendingRowCount == 0 {
 		v.MaxPendingRowCount = DefaultValidatorMaxPendingRow
 	}
+	if v.SnapshotChunkSize <= 0 {
+		v.SnapshotChunkSize = DefaultValidatorSnapshotChunkSize
+	}
 	return nil
 }
 
@@ -27,12 +27,17 @@ func NewBinlogCmd() *cobra.Command {
 		Short: "manage or show binlog operations",
 	}
 	cmd.PersistentFlags().StringP("binlog-pos", "b", "", "position used to match binlog event if matched the binlog operation will be applied. The format like \"mysql-bin|000001.000003:3270\"")
+	cmd.PersistentFlags().String("gtid-set", "", "apply the binlog operation to all DDLs in the GTID set, e.g. \"3ccc475b-2343-11e7-be21-6c0b84d59f30:1-14\"")
+	cmd.PersistentFlags().String("start-time", "", "apply the binlog operation to DDLs not earlier than the time, e.g. '2021-10-21 00:01:00' or 2021-10-21T00:01:00")
+	cmd.PersistentFlags().String("end-time", "", "apply the binlog operation to DDLs not later than the time, e.g. '2021-10-21 00:01:00' or 2021-10-21T00:01:00")
+	cmd.PersistentFlags().String("ddl-pattern", "", "apply the binlog operation to DDLs matching the regular expression")
 	cmd.AddCommand(
 		newBinlogSkipCmd(),
 		newBinlogReplaceCmd(),
 		newBinlogRevertCmd(),
 		newBinlogInjectCmd(),
 		newBinlogListCmd(),
+		newBinlogListEventsCmd(),
 	)
 
 	return cmd
@@ -41,7 +46,7 @@ func NewBinlogCmd() *cobra.Command {
 func newBinlogSkipCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "skip <task-name>",
-		Short: "skip the current error event or a specific binlog position (binlog-pos) event",
+		Short: "skip the current error event, a specific binlog position (binlog-pos) event, or DDLs matched by gtid-set, start-time, end-time and ddl-pattern",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if len(args) != 1 {
 				return cmd.Help()
@@ -78,10 +83,35 @@ func newBinlogListCmd() *cobra.Command {
 	return cmd
 }
 
+func newBinlogListEventsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-events <task-name>",
+		Short: "list DDL events matched by gtid-set, start-time, end-time and ddl-pattern from binlog position (binlog-pos) or the current checkpoint",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return cmd.Help()
+			}
+			limit, err := cmd.Flags().GetInt32("limit")
+			if err != nil {
+				return err
+			}
+			taskName := common.GetTaskNameFromArgOrFile(cmd.Flags().Arg(0))
+			request := &pb.HandleErrorRequest{
+				Op:    pb.ErrorOp_ListEvents,
+				Task:  taskName,
+				Limit: limit,
+			}
+			return sendHandleErrorRequest(cmd, request)
+		},
+	}
+	cmd.Flags().Int32("limit", 100, "max number of events to list for each source")
+	return cmd
+}
+
 func newBinlogReplaceCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "replace <task-name> <replace-sql1> <replace-sql2>...",
-		Short: "replace the current error event or a specific binlog position (binlog-pos) with some ddls",
+		Short: "replace the current error event, a specific binlog position (binlog-pos), or DDLs matched by gtid-set, start-time, end-time and ddl-pattern with some ddls",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if len(args) <= 1 {
 				return cmd.Help()
@@ -105,7 +135,7 @@ func newBinlogReplaceCmd() *cobra.Command {
 func newBinlogRevertCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "revert <task-name>",
-		Short: "revert the current binlog operation or a specific binlog position (binlog-pos) operation",
+		Short: "revert the current binlog operation, a specific binlog position (binlog-pos) operation, or the operation of gtid-set, start-time, end-time and ddl-pattern",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if len(args) == 0 {
 				return cmd.Help()
@@ -125,7 +155,7 @@ func newBinlogRevertCmd() *cobra.Command {
 func newBinlogInjectCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "inject <task-name> <inject-sql1> <inject-sql2>...",
-		Short: "inject the current error event or a specific binlog position (binlog-pos) with some ddls",
+		Short: "inject the current error event, a specific binlog position (binlog-pos), or DDLs matched by gtid-set, start-time, end-time and ddl-pattern with some ddls",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if len(args) <= 1 {
 				return cmd.Help()
@@ -16,13 +16,16 @@ package master
 import (
 	"context"
 	"errors"
+	"fmt"
 	"os"
+	"regexp"
 
 	"github.com/spf13/cobra"
 
 	"github.com/pingcap/tiflow/dm/ctl/common"
 	"github.com/pingcap/tiflow/dm/pb"
 	"github.com/pingcap/tiflow/dm/pkg/binlog"
+	"github.com/pingcap/tiflow/dm/pkg/utils"
 )
 
 // NewHandleErrorCmd creates a HandleError command.
@@ -104,6 +107,17 @@ func sendHandleErrorRequest(cmd *cobra.Command, request *pb.HandleErrorRequest)
 		}
 	}
 
+	if err = setEventFilterArgs(cmd, request); err != nil {
+		return err
+	}
+	hasFilter := request.GtidSet != "" || request.StartTime != "" || request.EndTime != "" || request.DdlPattern != ""
+	switch {
+	case request.Op == pb.ErrorOp_ListEvents:
+		// binlog-pos is the position to start reading binlog for list-events.
+	case hasFilter && len(binlogPos) != 0:
+		return errors.New("binlog-pos can't be used together with gtid-set, start-time, end-time or ddl-pattern")
+	}
+
 	sources, err := common.GetSourceArgs(cmd)
 	if err != nil {
 		return err
@@ -130,3 +144,44 @@ func sendHandleErrorRequest(cmd *cobra.Command, request *pb.HandleErrorRequest)
 	common.PrettyPrintResponse(resp)
 	return nil
 }
+
+// setEventFilterArgs sets the arguments used to match binlog events by GTID set, time range or DDL pattern.
+// Commands without these flags are ignored.
+func setEventFilterArgs(cmd *cobra.Command, request *pb.HandleErrorRequest) error {
+	getString := func(name string) (string, error) {
+		if cmd.Flags().Lookup(name) == nil {
+			return "", nil
+		}
+		return cmd.Flags().GetString(name)
+	}
+	var err error
+	if request.GtidSet, err = getString("gtid-set"); err != nil {
+		return err
+	}
+	for _, name := range []string{"start-time", "end-time"} {
+		timeStr, err2 := getString(name)
+		if err2 != nil {
+			return err2
+		}
+		if timeStr == "" {
+			continue
+		}
+		if _, err2 = utils.ParseStartTime(timeStr); err2 != nil {
+			return fmt.Errorf("%s should be in the format like '2006-01-02 15:04:05' or '2006-01-02T15:04:05'", name)
+		}
+		if name == "start-time" {
+			request.StartTime = timeStr
+		} else {
+			request.EndTime = timeStr
+		}
+	}
+	if request.DdlPattern, err = getString("ddl-pattern"); err != nil {
+		return err
+	}
+	if request.DdlPattern != "" {
+		if _, err = regexp.Compile(request.DdlPattern); err != nil {
+			return fmt.Errorf("invalid ddl-pattern: %w", err)
+		}
+	}
+	return nil
+}
@@ -55,6 +55,16 @@ func NewClearValidationErrorCmd() *cobra.Command {
 	return cmd
 }
 
+func NewRepairValidationErrorCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "repair-error <task-name> <error-id|--all>",
+		Short: "repair validation error row change by rewriting the upstream row to downstream",
+		RunE:  operateValidationError(pb.ValidationErrOp_RepairErrOp),
+	}
+	cmd.Flags().Bool("all", false, "all unprocessed errors")
+	return cmd
+}
+
 func operateValidationError(typ pb.ValidationErrOp) func(*cobra.Command, []string) error {
 	return func(cmd *cobra.Command, _ []string) error {
 		var (
@@ -26,6 +26,7 @@ func NewShardDDLLockCmd() *cobra.Command {
 	}
 	cmd.AddCommand(
 		newDDLLockUnlockCmd(),
+		newDDLLockSimulateCmd(),
 	)
 
 	return cmd
@@ -44,3 +45,15 @@ func newDDLLockUnlockCmd() *cobra.Command {
 	cmd.Flags().StringP("table", "t", "", "table name")
 	return cmd
 }
+
+func newDDLLockSimulateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate <task> <ddl>",
+		Short: "Simulate a DDL against the optimistic shard DDL locks of the task",
+		RunE:  simulateDDLLockFunc,
+	}
+	cmd.Flags().String("id", "", "shard DDL lock ID, simulate for all locks of the task if not specified")
+	cmd.Flags().StringP("database", "d", "", "database name of the tables which execute the DDL")
+	cmd.Flags().StringP("table", "t", "", "table name of the tables which execute the DDL")
+	return cmd
+}
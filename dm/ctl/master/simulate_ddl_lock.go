@@ -0,0 +1,82 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package master
+
+import (
+	"context"
+	"errors"
+	"os"
+
+	"github.com/pingcap/tiflow/dm/ctl/common"
+	"github.com/pingcap/tiflow/dm/pb"
+
+	"github.com/spf13/cobra"
+)
+
+// simulateDDLLockFunc simulates a DDL against the shard DDL locks of a task.
+func simulateDDLLockFunc(cmd *cobra.Command, _ []string) error {
+	if len(cmd.Flags().Args()) != 2 {
+		cmd.SetOut(os.Stdout)
+		common.PrintCmdUsage(cmd)
+		return errors.New("please check output to see error")
+	}
+	task := cmd.Flags().Arg(0)
+	ddl := cmd.Flags().Arg(1)
+
+	sources, err := common.GetSourceArgs(cmd)
+	if err != nil {
+		return err
+	}
+
+	lockID, err := cmd.Flags().GetString("id")
+	if err != nil {
+		return err
+	}
+
+	database, err := cmd.Flags().GetString("database")
+	if err != nil {
+		return err
+	}
+
+	table, err := cmd.Flags().GetString("table")
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	resp := &pb.SimulateDDLLockResponse{}
+	err = common.SendRequest(
+		ctx,
+		"SimulateDDLLock",
+		&pb.SimulateDDLLockRequest{
+			Task:     task,
+			DDL:      ddl,
+			ID:       lockID,
+			Sources:  sources,
+			Database: database,
+			Table:    table,
+		},
+		&resp,
+	)
+
+	if err != nil {
+		common.PrintLinesf("can not simulate DDL for task %s", task)
+		return err
+	}
+
+	common.PrettyPrintResponse(resp)
+	return nil
+}
@@ -39,7 +39,7 @@ func NewStartValidationCmd() *cobra.Command {
 		RunE:  startValidation,
 	}
 	cmd.Flags().Bool("all-task", false, "whether applied to all tasks")
-	cmd.Flags().String("mode", "full", "specify the mode of validation: full (default), fast; this flag will be ignored if the validation task has been ever enabled but currently paused")
+	cmd.Flags().String("mode", "full", "specify the mode of validation: full (default), fast, snapshot; this flag will be ignored if the validation task has been ever enabled but currently paused")
 	cmd.Flags().String("start-time", "", "specify the start time of binlog for validation, e.g. '2021-10-21 00:01:00' or 2021-10-21T00:01:00")
 	return cmd
 }
@@ -89,9 +89,9 @@ func parseValidationStartStopArgs(cmd *cobra.Command, op string) (validationStar
 			if err != nil {
 				return args, err.Error(), false
 			}
-			if args.mode != config.ValidationFull && args.mode != config.ValidationFast {
-				errMsg := fmt.Sprintf("mode should be either `%s` or `%s`, current is `%s`",
-					config.ValidationFull, config.ValidationFast, args.mode)
+			if args.mode != config.ValidationFull && args.mode != config.ValidationFast && args.mode != config.ValidationSnapshot {
+				errMsg := fmt.Sprintf("mode should be one of `%s`, `%s` or `%s`, current is `%s`",
+					config.ValidationFull, config.ValidationFast, config.ValidationSnapshot, args.mode)
 				return args, errMsg, false
 			}
 		}
@@ -28,6 +28,7 @@ func NewValidationCmd() *cobra.Command {
 		NewIgnoreValidationErrorCmd(),
 		NewResolveValidationErrorCmd(),
 		NewClearValidationErrorCmd(),
+		NewRepairValidationErrorCmd(),
 	)
 	return cmd
 }
@@ -518,18 +518,6 @@ func (s *Server) getTaskStatus(ctx context.Context, taskName string, req openapi
 	workerStatusList := s.getStatusFromWorkers(ctx, *req.SourceNameList, taskName, true)
 	subTaskStatusList := make([]openapi.SubTaskStatus, 0, len(workerStatusList))
 
-	handleProcessError := func(err *pb.ProcessError) string {
-		errorMsg := fmt.Sprintf("[code=%d:class=%s:scope=%s:level=%s], Message: %s", err.ErrCode, err.ErrClass, err.ErrScope, err.ErrLevel, err.Message)
-		if err.RawCause != "" {
-			errorMsg = fmt.Sprintf("%s, RawCause: %s", errorMsg, err.RawCause)
-		}
-		if err.Workaround != "" {
-			errorMsg = fmt.Sprintf("%s, Workaround: %s", errorMsg, err.Workaround)
-		}
-		errorMsg = fmt.Sprintf("%s.", errorMsg)
-		return errorMsg
-	}
-
 	for _, workerStatus := range workerStatusList {
 		if workerStatus == nil || workerStatus.SourceStatus == nil {
 			// this should not happen unless the rpc in the worker server has been modified
@@ -610,7 +598,7 @@ func (s *Server) getTaskStatus(ctx context.Context, taskName string, req openapi
 		if subTaskStatus.Result != nil && len(subTaskStatus.Result.Errors) > 0 {
 			var errorMsgs string
 			for _, err := range subTaskStatus.Result.Errors {
-				errorMsgs += fmt.Sprintf("%s\n", handleProcessError(err))
+				errorMsgs += fmt.Sprintf("%s\n", formatProcessError(err))
 			}
 			openapiSubTaskStatus.ErrorMsg = &errorMsgs
 		}
@@ -619,6 +607,69 @@ func (s *Server) getTaskStatus(ctx context.Context, taskName string, req openapi
 	return subTaskStatusList, nil
 }
 
+func formatProcessError(err *pb.ProcessError) string {
+	errorMsg := fmt.Sprintf("[code=%d:class=%s:scope=%s:level=%s], Message: %s", err.ErrCode, err.ErrClass, err.ErrScope, err.ErrLevel, err.Message)
+	if err.RawCause != "" {
+		errorMsg = fmt.Sprintf("%s, RawCause: %s", errorMsg, err.RawCause)
+	}
+	if err.Workaround != "" {
+		errorMsg = fmt.Sprintf("%s, Workaround: %s", errorMsg, err.Workaround)
+	}
+	errorMsg = fmt.Sprintf("%s.", errorMsg)
+	return errorMsg
+}
+
+func (s *Server) getValidationStatus(ctx context.Context, taskName string) (*openapi.GetValidationStatusResponse, error) {
+	resp, err := s.GetValidationStatus(ctx, &pb.GetValidationStatusRequest{TaskName: taskName})
+	if err != nil {
+		return nil, err
+	}
+	if !resp.Result {
+		return nil, terror.ErrOpenAPICommonError.New(resp.Msg)
+	}
+	validationStatus := &openapi.GetValidationStatusResponse{
+		Total:           len(resp.Validators),
+		Data:            make([]openapi.ValidationStatus, 0, len(resp.Validators)),
+		TableStatusList: make([]openapi.ValidationTableStatus, 0, len(resp.TableStatuses)),
+	}
+	for _, validator := range resp.Validators {
+		status := openapi.ValidationStatus{
+			SourceName:          validator.Source,
+			Mode:                validator.Mode,
+			Stage:               validator.Stage.String(),
+			ValidatorBinlog:     &validator.ValidatorBinlog,
+			ValidatorBinlogGtid: &validator.ValidatorBinlogGtid,
+			ProcessedRowsStatus: &validator.ProcessedRowsStatus,
+			PendingRowsStatus:   &validator.PendingRowsStatus,
+			ErrorRowsStatus:     &validator.ErrorRowsStatus,
+		}
+		if validator.SnapshotStatus != "" {
+			status.SnapshotStatus = &validator.SnapshotStatus
+		}
+		if validator.Result != nil && len(validator.Result.Errors) > 0 {
+			var errorMsgs string
+			for _, err := range validator.Result.Errors {
+				errorMsgs += fmt.Sprintf("%s\n", formatProcessError(err))
+			}
+			status.ErrorMsg = &errorMsgs
+		}
+		validationStatus.Data = append(validationStatus.Data, status)
+	}
+	for _, table := range resp.TableStatuses {
+		tableStatus := openapi.ValidationTableStatus{
+			SourceName:  table.Source,
+			SourceTable: table.SrcTable,
+			TargetTable: table.DstTable,
+			Stage:       table.Stage.String(),
+		}
+		if table.Message != "" {
+			tableStatus.Message = &table.Message
+		}
+		validationStatus.TableStatusList = append(validationStatus.TableStatusList, tableStatus)
+	}
+	return validationStatus, nil
+}
+
 func (s *Server) listTask(ctx context.Context, req openapi.DMAPIGetTaskListParams) ([]openapi.Task, error) {
 	subTaskConfigMap := s.scheduler.GetALlSubTaskCfgs()
 	taskList := config.SubTaskConfigsToOpenAPITaskList(subTaskConfigMap)
@@ -521,6 +521,16 @@ func (s *Server) DMAPIGetTaskStatus(c *gin.Context, taskName string, params open
 	c.IndentedJSON(http.StatusOK, resp)
 }
 
+// DMAPIGetValidationStatus url is:(GET /api/v1/tasks/{task-name}/validation/status).
+func (s *Server) DMAPIGetValidationStatus(c *gin.Context, taskName string) {
+	resp, err := s.getValidationStatus(c.Request.Context(), taskName)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.IndentedJSON(http.StatusOK, resp)
+}
+
 // DMAPIGetTaskList url is:(GET /api/v1/tasks).
 func (s *Server) DMAPIGetTaskList(c *gin.Context, params openapi.DMAPIGetTaskListParams) {
 	ctx := c.Request.Context()
@@ -69,6 +69,10 @@ const (
 
 	// getLeaderBlockTime is the max block time for get leader information from election.
 	getLeaderBlockTime = 10 * time.Minute
+
+	// validationRepairTimeout is the max time of repairing validation errors in dm-worker,
+	// it should be the same as the timeout of repairing in dm-worker.
+	validationRepairTimeout = 10 * time.Minute
 )
 
 var (
@@ -962,6 +966,40 @@ func (s *Server) UnlockDDLLock(ctx context.Context, req *pb.UnlockDDLLockRequest
 	return resp, nil
 }
 
+// SimulateDDLLock implements MasterServer.SimulateDDLLock.
+func (s *Server) SimulateDDLLock(ctx context.Context, req *pb.SimulateDDLLockRequest) (*pb.SimulateDDLLockResponse, error) {
+	var (
+		resp2 *pb.SimulateDDLLockResponse
+		err2  error
+	)
+	shouldRet := s.sharedLogic(ctx, req, &resp2, &err2)
+	if shouldRet {
+		return resp2, err2
+	}
+
+	resp := &pb.SimulateDDLLockResponse{}
+	subtasks := s.scheduler.GetSubTaskCfgsByTask(req.Task)
+	if len(subtasks) == 0 {
+		resp.Msg = terror.ErrSchedulerTaskNotExist.Generate(req.Task).Error()
+		return resp, nil
+	}
+	for _, subtask := range subtasks {
+		if subtask.ShardMode != config.ShardOptimistic {
+			resp.Msg = fmt.Sprintf("task %s is not in %s shard mode, only optimistic shard DDL locks can be simulated", req.Task, config.ShardOptimistic)
+			return resp, nil
+		}
+	}
+
+	locks, err := s.optimist.SimulateLocks(ctx, req.Task, req.ID, req.DDL, req.Sources, req.Database, req.Table)
+	if err != nil {
+		resp.Msg = err.Error()
+		return resp, nil
+	}
+	resp.Result = true
+	resp.Locks = locks
+	return resp, nil
+}
+
 // PurgeWorkerRelay implements MasterServer.PurgeWorkerRelay.
 func (s *Server) PurgeWorkerRelay(ctx context.Context, req *pb.PurgeWorkerRelayRequest) (*pb.PurgeWorkerRelayResponse, error) {
 	var (
@@ -1731,6 +1769,8 @@ func (s *Server) removeMetaData(ctx context.Context, taskName, metaSchema string
 		dbutil.TableName(metaSchema, cputil.ValidatorErrorChange(taskName))))
 	sqls = append(sqls, fmt.Sprintf("DROP TABLE IF EXISTS %s",
 		dbutil.TableName(metaSchema, cputil.ValidatorTableStatus(taskName))))
+	sqls = append(sqls, fmt.Sprintf("DROP TABLE IF EXISTS %s",
+		dbutil.TableName(metaSchema, cputil.ValidatorRepairLog(taskName))))
 
 	_, err = dbConn.ExecuteSQL(ctctx, nil, taskName, sqls)
 	if err == nil {
@@ -2503,10 +2543,15 @@ func (s *Server) HandleError(ctx context.Context, req *pb.HandleErrorRequest) (*
 	workerReq := workerrpc.Request{
 		Type: workerrpc.CmdHandleError,
 		HandleError: &pb.HandleWorkerErrorRequest{
-			Op:        req.Op,
-			Task:      req.Task,
-			BinlogPos: req.BinlogPos,
-			Sqls:      req.Sqls,
+			Op:         req.Op,
+			Task:       req.Task,
+			BinlogPos:  req.BinlogPos,
+			Sqls:       req.Sqls,
+			GtidSet:    req.GtidSet,
+			StartTime:  req.StartTime,
+			EndTime:    req.EndTime,
+			DdlPattern: req.DdlPattern,
+			Limit:      req.Limit,
 		},
 	}
 
@@ -2676,9 +2721,9 @@ func (s *Server) checkStartValidationParams(
 	explicitModeOrStartTime := req.Mode != nil || req.StartTime != nil
 	if req.Mode != nil {
 		mode := req.GetModeValue()
-		if mode != config.ValidationFull && mode != config.ValidationFast {
-			msg := fmt.Sprintf("validation mode should be either `%s` or `%s`",
-				config.ValidationFull, config.ValidationFast)
+		if mode != config.ValidationFull && mode != config.ValidationFast && mode != config.ValidationSnapshot {
+			msg := fmt.Sprintf("validation mode should be one of `%s`, `%s` or `%s`",
+				config.ValidationFull, config.ValidationFast, config.ValidationSnapshot)
 			return msg, false
 		}
 	}
@@ -2688,6 +2733,9 @@ func (s *Server) checkStartValidationParams(
 		if _, err := utils.ParseStartTime(startTime); err != nil {
 			return "start-time should be in the format like '2006-01-02 15:04:05' or '2006-01-02T15:04:05'", false
 		}
+		if req.GetModeValue() == config.ValidationSnapshot {
+			return "start-time cannot be used in `snapshot` mode, which always validates at the current location", false
+		}
 	}
 
 	var enabledValidator string
@@ -3069,7 +3117,7 @@ func sendValidationRequest[T any](
 	go s.ap.Emit(ctx, 0, func(args ...interface{}) {
 		// send request in parallel
 		defer wg.Done()
-		workerResp, err := worker.SendRequest(ctx, req, s.cfg.RPCTimeout)
+		workerResp, err := worker.SendRequest(ctx, req, s.validationRequestTimeout(req))
 		if err != nil {
 			resp := genValidationWorkerErrorResp(req, err, logMsg, worker.BaseInfo().Name, sourceID)
 			appendWorkerResp(workerRespMu, workerResps, resp.(T))
@@ -3085,6 +3133,15 @@ func sendValidationRequest[T any](
 	})
 }
 
+// validationRequestTimeout returns the timeout of sending the validation request to dm-worker.
+// repairing validation errors runs synchronously in dm-worker, so we wait until it finishes or times out.
+func (s *Server) validationRequestTimeout(req *workerrpc.Request) time.Duration {
+	if req.Type == workerrpc.CmdOperateValidationError && req.OperateValidationError.Op == pb.ValidationErrOp_RepairErrOp {
+		return s.cfg.RPCTimeout + validationRepairTimeout
+	}
+	return s.cfg.RPCTimeout
+}
+
 func getValidationWorkerResp(req *workerrpc.Request, resp *workerrpc.Response) interface{} {
 	switch req.Type {
 	case workerrpc.CmdGetValidationStatus:
@@ -1004,6 +1004,7 @@ func (t *testMaster) TestStartTaskWithRemoveMeta(c *check.C) {
 	mock.ExpectExec(fmt.Sprintf("DROP TABLE IF EXISTS `%s`.`%s`", cfg.MetaSchema, cputil.ValidatorPendingChange(cfg.Name))).WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectExec(fmt.Sprintf("DROP TABLE IF EXISTS `%s`.`%s`", cfg.MetaSchema, cputil.ValidatorErrorChange(cfg.Name))).WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectExec(fmt.Sprintf("DROP TABLE IF EXISTS `%s`.`%s`", cfg.MetaSchema, cputil.ValidatorTableStatus(cfg.Name))).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(fmt.Sprintf("DROP TABLE IF EXISTS `%s`.`%s`", cfg.MetaSchema, cputil.ValidatorRepairLog(cfg.Name))).WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectCommit()
 	c.Assert(len(server.pessimist.Locks()), check.Greater, 0)
 
@@ -1101,6 +1102,7 @@ func (t *testMaster) TestStartTaskWithRemoveMeta(c *check.C) {
 	mock.ExpectExec(fmt.Sprintf("DROP TABLE IF EXISTS `%s`.`%s`", cfg.MetaSchema, cputil.ValidatorPendingChange(cfg.Name))).WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectExec(fmt.Sprintf("DROP TABLE IF EXISTS `%s`.`%s`", cfg.MetaSchema, cputil.ValidatorErrorChange(cfg.Name))).WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectExec(fmt.Sprintf("DROP TABLE IF EXISTS `%s`.`%s`", cfg.MetaSchema, cputil.ValidatorTableStatus(cfg.Name))).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(fmt.Sprintf("DROP TABLE IF EXISTS `%s`.`%s`", cfg.MetaSchema, cputil.ValidatorRepairLog(cfg.Name))).WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectCommit()
 	c.Assert(len(server.optimist.Locks()), check.Greater, 0)
 
@@ -2285,7 +2287,7 @@ func (t *testMaster) TestStartStopValidation(c *check.C) {
 	startResp, err := server.StartValidation(context.Background(), validatorStartReq)
 	c.Assert(err, check.IsNil)
 	c.Assert(startResp.Result, check.IsFalse)
-	c.Assert(startResp.Msg, check.Matches, ".*validation mode should be either `full` or `fast`.*")
+	c.Assert(startResp.Msg, check.Matches, ".*validation mode should be one of `full`, `fast` or `snapshot`.*")
 	t.validatorStageMatch(c, taskName, sources[0], pb.Stage_InvalidStage)
 	t.validatorStageMatch(c, taskName, sources[1], pb.Stage_InvalidStage)
 	t.validatorModeMatch(c, server.scheduler, taskName, sources[0], config.ValidationNone, "")
@@ -2824,6 +2826,23 @@ func (t *testMaster) TestOperateValidationError(c *check.C) {
 	c.Assert(resp.Msg, check.Matches, ".*grpc error.*")
 }
 
+func (t *testMaster) TestValidationRequestTimeout(c *check.C) {
+	server := testDefaultMasterServer(c)
+	req := &workerrpc.Request{
+		Type:                   workerrpc.CmdOperateValidationError,
+		OperateValidationError: &pb.OperateValidationErrorRequest{Op: pb.ValidationErrOp_IgnoreErrOp},
+	}
+	c.Assert(server.validationRequestTimeout(req), check.Equals, server.cfg.RPCTimeout)
+	// repairing may take a long time in dm-worker
+	req.OperateValidationError.Op = pb.ValidationErrOp_RepairErrOp
+	c.Assert(server.validationRequestTimeout(req), check.Equals, server.cfg.RPCTimeout+validationRepairTimeout)
+	req = &workerrpc.Request{
+		Type:               workerrpc.CmdGetValidationError,
+		GetValidationError: &pb.GetValidationErrorRequest{},
+	}
+	c.Assert(server.validationRequestTimeout(req), check.Equals, server.cfg.RPCTimeout)
+}
+
 func (t *testMaster) TestDashboardAddress(c *check.C) {
 	// Temp file for test log output
 	file, err := ioutil.TempFile(c.MkDir(), "*")
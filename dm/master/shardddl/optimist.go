@@ -298,6 +298,78 @@ func (o *Optimist) UnlockLock(ctx context.Context, id, source, upstreamSchema, u
 	return nil
 }
 
+// SimulateLocks simulates a DDL for the shard DDL locks of the task, only used by `shard-ddl-lock simulate` command.
+// ID: the shard DDL lock ID, simulate for all locks of the task if it's empty.
+// sources, upstreamSchema, upstreamTable: the upstream tables which execute the DDL, empty means all tables.
+// NOTE: the simulation doesn't change the locks and doesn't put any operation into etcd.
+func (o *Optimist) SimulateLocks(ctx context.Context, task, id, ddl string, sources []string, upstreamSchema, upstreamTable string) ([]*pb.SimulateDDLLockResult, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.closed {
+		return nil, terror.ErrMasterOptimistNotStarted.Generate()
+	}
+
+	sourceSet := make(map[string]struct{}, len(sources))
+	for _, source := range sources {
+		sourceSet[source] = struct{}{}
+	}
+	match := func(source, schema, table string) bool {
+		if _, ok := sourceSet[source]; len(sourceSet) > 0 && !ok {
+			return false
+		}
+		return (upstreamSchema == "" || upstreamSchema == schema) && (upstreamTable == "" || upstreamTable == table)
+	}
+
+	locks := o.lk.FindLocksByTask(task)
+	ret := make([]*pb.SimulateDDLLockResult, 0, len(locks))
+	for _, lock := range locks {
+		if id != "" && lock.ID != id {
+			continue
+		}
+		matched := false
+		for source, schemaTables := range lock.Ready() {
+			for schema, tables := range schemaTables {
+				for table := range tables {
+					matched = matched || match(source, schema, table)
+				}
+			}
+		}
+		if !matched {
+			continue
+		}
+		res, err := lock.Simulate(ctx, ddl, match)
+		if err != nil {
+			return nil, err
+		}
+		lockRes := &pb.SimulateDDLLockResult{
+			ID:        lock.ID,
+			Conflict:  res.Conflict,
+			OldJoined: res.OldJoined,
+			NewJoined: res.NewJoined,
+			Stalled:   res.Stalled,
+			Tables:    make([]*pb.SimulateShardTableResult, 0, len(res.Tables)),
+		}
+		for _, tblRes := range res.Tables {
+			lockRes.Tables = append(lockRes.Tables, &pb.SimulateShardTableResult{
+				Source:        tblRes.Source,
+				Schema:        tblRes.Schema,
+				Table:         tblRes.Table,
+				ConflictStage: string(tblRes.ConflictStage),
+				ConflictMsg:   tblRes.ConflictMsg,
+				DDLs:          tblRes.DDLs,
+			})
+		}
+		ret = append(ret, lockRes)
+	}
+	if len(ret) == 0 {
+		if id != "" {
+			return nil, terror.ErrMasterLockNotFound.Generate(id)
+		}
+		return nil, terror.ErrMasterLockNotFound.Generatef("no shard DDL lock of task %s contains the specified upstream tables", task)
+	}
+	return ret, nil
+}
+
 // RemoveMetaDataWithTask removes meta data for a specified task
 // NOTE: this function can only be used when the specified task is not running.
 // This function only be used when --remove-meta or stop-task
@@ -35,6 +35,7 @@ import (
 	"github.com/pingcap/tiflow/dm/pb"
 	"github.com/pingcap/tiflow/dm/pkg/log"
 	"github.com/pingcap/tiflow/dm/pkg/shardddl/optimism"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
 )
 
 func TestOptimistSuite(t *testing.T) {
@@ -882,6 +883,26 @@ func (t *testOptimistSuite) TestOptimistLockMultipleTarget() {
 	expectedLock[lockID2].Unsynced = []string{}
 	checkLocksByMap(t.T(), o, expectedLock, []string{}, lockID1, lockID2)
 
+	// simulate DDLs for the locks.
+	simulated, err := o.SimulateLocks(ctx, task, "", "ALTER TABLE bar ADD COLUMN c2 INT", nil, "", "")
+	require.NoError(t.T(), err)
+	require.Len(t.T(), simulated, 2)
+	simulated, err = o.SimulateLocks(ctx, task, lockID1, "ALTER TABLE bar ADD COLUMN c2 INT", []string{source}, upSchema, upTables[0])
+	require.NoError(t.T(), err)
+	require.Len(t.T(), simulated, 1)
+	require.Equal(t.T(), lockID1, simulated[0].ID)
+	require.Len(t.T(), simulated[0].Tables, 1)
+	require.Equal(t.T(), upTables[0], simulated[0].Tables[0].Table)
+	simulated, err = o.SimulateLocks(ctx, task, "", "ALTER TABLE bar ADD COLUMN c2 INT", nil, upSchema, upTables[2])
+	require.NoError(t.T(), err)
+	require.Len(t.T(), simulated, 1)
+	require.Equal(t.T(), lockID2, simulated[0].ID)
+	_, err = o.SimulateLocks(ctx, task, "", "ALTER TABLE bar ADD COLUMN c2 INT", []string{"not-exist"}, "", "")
+	require.True(t.T(), terror.ErrMasterLockNotFound.Equal(err))
+	_, err = o.SimulateLocks(ctx, task, lockID1, "DROP TABLE bar", nil, "", "")
+	require.True(t.T(), terror.ErrMasterSimulateDDLLock.Equal(err))
+	require.Len(t.T(), o.Locks()[lockID1].Ready(), 1)
+
 	// wait operation for i12 become available.
 	opCh := make(chan optimism.Operation, 10)
 	errCh := make(chan error, 10)
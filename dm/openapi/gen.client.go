@@ -248,6 +248,9 @@ type ClientInterface interface {
 	DMAPIStopTaskWithBody(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	DMAPIStopTask(ctx context.Context, taskName string, body DMAPIStopTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DMAPIGetValidationStatus request
+	DMAPIGetValidationStatus(ctx context.Context, taskName string, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
 func (c *Client) DMAPIGetClusterInfo(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -946,6 +949,18 @@ func (c *Client) DMAPIStopTask(ctx context.Context, taskName string, body DMAPIS
 	return c.Client.Do(req)
 }
 
+func (c *Client) DMAPIGetValidationStatus(ctx context.Context, taskName string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDMAPIGetValidationStatusRequest(c.Server, taskName)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 // NewDMAPIGetClusterInfoRequest generates requests for DMAPIGetClusterInfo
 func NewDMAPIGetClusterInfoRequest(server string) (*http.Request, error) {
 	var err error
@@ -2803,6 +2818,40 @@ func NewDMAPIStopTaskRequestWithBody(server string, taskName string, contentType
 	return req, nil
 }
 
+// NewDMAPIGetValidationStatusRequest generates requests for DMAPIGetValidationStatus
+func NewDMAPIGetValidationStatusRequest(server string, taskName string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task-name", runtime.ParamLocationPath, taskName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/tasks/%s/validation/status", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
 func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
 	for _, r := range c.RequestEditors {
 		if err := r(ctx, req); err != nil {
@@ -3004,6 +3053,9 @@ type ClientWithResponsesInterface interface {
 	DMAPIStopTaskWithBodyWithResponse(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIStopTaskResponse, error)
 
 	DMAPIStopTaskWithResponse(ctx context.Context, taskName string, body DMAPIStopTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIStopTaskResponse, error)
+
+	// DMAPIGetValidationStatus request
+	DMAPIGetValidationStatusWithResponse(ctx context.Context, taskName string, reqEditors ...RequestEditorFn) (*DMAPIGetValidationStatusResponse, error)
 }
 
 type DMAPIGetClusterInfoResponse struct {
@@ -3971,6 +4023,29 @@ func (r DMAPIStopTaskResponse) StatusCode() int {
 	return 0
 }
 
+type DMAPIGetValidationStatusResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetValidationStatusResponse
+	JSON400      *ErrorWithMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r DMAPIGetValidationStatusResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DMAPIGetValidationStatusResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 // DMAPIGetClusterInfoWithResponse request returning *DMAPIGetClusterInfoResponse
 func (c *ClientWithResponses) DMAPIGetClusterInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DMAPIGetClusterInfoResponse, error) {
 	rsp, err := c.DMAPIGetClusterInfo(ctx, reqEditors...)
@@ -4478,6 +4553,15 @@ func (c *ClientWithResponses) DMAPIStopTaskWithResponse(ctx context.Context, tas
 	return ParseDMAPIStopTaskResponse(rsp)
 }
 
+// DMAPIGetValidationStatusWithResponse request returning *DMAPIGetValidationStatusResponse
+func (c *ClientWithResponses) DMAPIGetValidationStatusWithResponse(ctx context.Context, taskName string, reqEditors ...RequestEditorFn) (*DMAPIGetValidationStatusResponse, error) {
+	rsp, err := c.DMAPIGetValidationStatus(ctx, taskName, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDMAPIGetValidationStatusResponse(rsp)
+}
+
 // ParseDMAPIGetClusterInfoResponse parses an HTTP response from a DMAPIGetClusterInfoWithResponse call
 func ParseDMAPIGetClusterInfoResponse(rsp *http.Response) (*DMAPIGetClusterInfoResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
@@ -5692,6 +5776,39 @@ func ParseDMAPIStopTaskResponse(rsp *http.Response) (*DMAPIStopTaskResponse, err
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorWithMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDMAPIGetValidationStatusResponse parses an HTTP response from a DMAPIGetValidationStatusWithResponse call
+func ParseDMAPIGetValidationStatusResponse(rsp *http.Response) (*DMAPIGetValidationStatusResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DMAPIGetValidationStatusResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetValidationStatusResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest ErrorWithMessage
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
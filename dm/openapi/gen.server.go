@@ -149,6 +149,9 @@ type ServerInterface interface {
 	// stop a task
 	// (POST /api/v1/tasks/{task-name}/stop)
 	DMAPIStopTask(c *gin.Context, taskName string)
+	// get validation status of a task
+	// (GET /api/v1/tasks/{task-name}/validation/status)
+	DMAPIGetValidationStatus(c *gin.Context, taskName string)
 }
 
 // ServerInterfaceWrapper converts contexts to parameters.
@@ -1139,6 +1142,27 @@ func (siw *ServerInterfaceWrapper) DMAPIStopTask(c *gin.Context) {
 	siw.Handler.DMAPIStopTask(c, taskName)
 }
 
+// DMAPIGetValidationStatus operation middleware
+func (siw *ServerInterfaceWrapper) DMAPIGetValidationStatus(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "task-name" -------------
+	var taskName string
+
+	err = runtime.BindStyledParameter("simple", false, "task-name", c.Param("task-name"), &taskName)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": fmt.Sprintf("Invalid format for parameter task-name: %s", err)})
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+	}
+
+	siw.Handler.DMAPIGetValidationStatus(c, taskName)
+}
+
 // GinServerOptions provides options for the Gin server.
 type GinServerOptions struct {
 	BaseURL     string
@@ -1243,114 +1267,121 @@ func RegisterHandlersWithOptions(router *gin.Engine, si ServerInterface, options
 
 	router.POST(options.BaseURL+"/api/v1/tasks/:task-name/stop", wrapper.DMAPIStopTask)
 
+	router.GET(options.BaseURL+"/api/v1/tasks/:task-name/validation/status", wrapper.DMAPIGetValidationStatus)
+
 	return router
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
-	"H4sIAAAAAAAC/+x9bXPbOJLwX8Gj5z7sTEmWZDtO4qv9kMSerO+cl4o9tbe1lWMgEpSwJgEGAO3Rpvzf",
-	"r/BCEiQBkrItx5pkP+w4Igh0N/odjea3UUjTjBJEBB8dfxvxcIVSqP58lSAm3kECl4hd0owmdLmWv2eM",
-	"ZogJjNSoFeVC/hf9AdMsQaPj0Xz/+d5sb7Y3H41HYp3Jn7hgmCxHt+NRRll9+MvZy4NyHCYCLREb3d6O",
-	"Rwx9zTFD0ej4n3oR8/LncjRd/AuFQs76Jsm5QOwdlP/fhhFGkfo1QjxkOBOYktGx+hVxDmgMxAqBMGcM",
-	"EQFSNQkgNEKjsQut4xf7R07cYIKvUXsdShJMEOACityshrlZxl5BsByVsy4oTRAkctoEwQg54Mfcnknh",
-	"YIYOmJTAFNW3TU/jQKyxF+rNAtkSurEmcsfm+FkISkYLUs1pgbDG/QdD8eh49P+nFZNODYdOnex5Ox4t",
-	"GYwhgYPneavH21NoUpQzBAnWPI4FSnnffJoJ7ekMRSBjUP07YzRFYoVyPhjIj+Ur9sQ3lF3dGc6/q5f9",
-	"cN76t1K/+t3kbEFzEgWc5ixEQcHI9TXVEKCHADmklDtNs/ay6Zp/TSazrgUFXPqXkg97F1FjXSu0xVFP",
-	"MVwcJenrkLoI5ZRPSq4RkzwL+dUn9DVHmovqeysgv+pjKTmBYiTIr4KQkhgvgxgnDqLph0A+BJiANUwT",
-	"EFOWQgFWQmT8eDqNaMj3MkyWIcz2QppO/72aChwtplzARYKmcpGJnidnUM47kdNN4jxJ9pxk68OcZ5Rw",
-	"9KdE3eYYhY4DUidvMAQFulAc5GUNzWB9FNKTWGrLx/OTfqY3K/ohfiBWdlHOtegJ5nJjPqEErq1lG3ow",
-	"lH8AQQEXNAMQMDkcMDN+3IDSolKp2Pv1+XuYonM52snwJ3maXSg/pA1e5Z9EeZqBnOA2THLZBAkUBYoR",
-	"1W+ad0fHo4jmiwRVe0fydCF9ufEIcYFTKFAgqIBJwOjN0DdjTDBfoShYrAXa+KUNFtKQObDCRBwdjno9",
-	"1Nr74zahWqg0wXRTycVsp2QzXoNM9DKbehosMEnoMlgKHDn5gwlMluDt5dlJYczzjAuGYAr0qzVjh17C",
-	"eRzu709QOHsxmc/Ry8liH4aT2f7hPgzn89lsdnA8nzx/cfhyNB6RPEkkXg2XtTKRNRDdVr8EUeqzyup3",
-	"g6kN/wKTvZn83/5wWCJsvJ0Y5onklb2pfqCXqMMmwYgwQ6GgbA1uVoghBZrel4QuAeZSMUh+GgDBNrTD",
-	"KWOU/R2L1TvEudPXkSyj7A1AcmyLjdSvQSjdnta76hkItUvUlKaxeTXlS9+bqQGqzzZUE41teFyS9BYJ",
-	"49GekZj6HYBQDwpcYmGeASy3rdQauU9tSE0zzOVvhk1NPC2gunHTAYncdj+GERRwcORQj7YdAY5SYMrS",
-	"DlCaUlLk6t1IaP59eCRMKLNlJLTv84DQV87U9sHWDsODAm58kC2DL324B6R56eJvGeR3eMmUC8uWSPAH",
-	"BL428WNg8rCcky+qOR8D+ktpgC8Ey0ORM+THQgMYhCrwCPjXpB7UvPl0+uryFFy+en1+Cr6I+Rfwly84",
-	"+gIwEX+Zz38B7z9cgve/n5+DV79ffgjO3r/5dPru9P3l+OOns3evPv0D/PfpP/Qbv4Dpr5f/759G76Mo",
-	"wCRCf3wGb85/v7g8/XR6An6d/gJO3789e3/61zNC6MlrcHL626vfzy/Bm7+9+nRxevnXXMQv0sUhePPh",
-	"/PzV5Wnxb+lWudISBrV2pBYtnIkS5ew6hqvf5wMi0/L1Yi6Lqs6taiTvHjw9fTCbze6dnj6nMOoPuxIK",
-	"I3fY1REF+f2MFAlo3GVLKCpUreelx9+mB6NLhjh3PtRxynCYGlRrBUT2fNbSdVQcgLtI3sjC3pcvfOny",
-	"QTz0Yv9o3ksNw/V9rPRBeeCoO2EVrlB4FTDEVVjS5LiMoYkaAcwIOxqqHmIOMsg5ivaAW9Tvk0QZ12Hs",
-	"wbSpiXuDXh2nIKB0iDfojZOcr2oRnA626rP+nWGBuIrVNF5yAZXIlhhkFBMBuPwFCnDyDoSQaEnGAsBY",
-	"RgYMlXGpfK1Iv7WOZPjXJAgpEYg4cONfE7CmObiBRFgY1vbOYWnAl3BemZrCGkhzMwZfwn3/owP3o3vY",
-	"l/90Gpg1CdvI/p5FsKA5zQROMRc4BHwFWSTJKDWAtN7gBouVzribraEkWYOco0hG2ARAE6gCGoY54wAT",
-	"75wnJ+cgrQWn5dY0k4/WPrkY13FWs41T0/ubpY85cwX5VUYilPjnGchogsM1qGWc27H/Hxlmxq8r5GnW",
-	"FCY1SGcQBNb5mXI5O34uTIgnD2KZOfknu9aOX7nuwdGstfTlCoFisJSgDDFMIxzCJFkDo/LidkpGoxWN",
-	"gZkcXMMkR8dALSEZiqOQkojfDXqGUohJwDMYohoG82dN+N9hgtM8BTFDCESYXwH1loLh7eu7LH/r44kH",
-	"zWM/Yt6uL09XWzNDIY7XBnieL6zsXEwZaIG9B85iQKgA+k0seUKduUtVJQAlCNzgJAELpBTQHrhQkJqz",
-	"nWOwD9Hzo8ODw0n8/GU8mc/Ri8kiQvtFOlQ6mi80KvP+BGBD0ts0dsm72tY3Sojb9FAWTR9NFULZFnGV",
-	"eQ70w8ojtGzYzzzyTuWRb31c0h+t2Gq7ziWmeqIKPepTNGhYHIRqMdGGpSLqXxpUnY/B/OXzl7+4hL22",
-	"rof5XDx3D2brZi43CJpwRRWEBOjhAQihCFdBngVpWRFVB+JmJT0UJpW4GgvyTDtT5e5Y4ZdPzJ16dTP+",
-	"rPDem/J8oaZ0uYnu0ouCiJora9N9ygmRL/dpzjqzOpnIRte1wz6iF2C7VPGFclfL45i2nGl3Vukedbwz",
-	"rpJk/VmYRmLsAoU5w2LdXkY50aZKhvOk7uFp8xZjlESlZVvhKEJEO9dLJMqgxp6oNgmIGU3VEOV7xdLP",
-	"aaulRviKmAhgktAbFAUhaYP9hqYpJeC90cwXF+dAvoNjHEKdPCiJ1UsczpMghP7Ay5pYq6pipM1tTp6V",
-	"E0tMvFP/Zk0n8fh4+s54C9P/eTZ7WVSFNFDrX/UKrf2LvqnWk7uSMXwtUbtC67IkxVq8Z71mZFSnpYMG",
-	"bQCd0mGCsreM5pkjbRwl7VK33o2OMeMiSGiorYzrFRmNomizaYXOpruG5mTzCVvJEjX7uMK5hUgJtrWg",
-	"k6hllU5D1ejf3b5ezS+JYcJb6ZHSkqgoXGsAGTap12sq3rzetibGrazM5aD1qHSztRMpo7lcKiillbnW",
-	"OS7z7gUhTuA1dVgz/XtZ11fSquH2uSSxCPFd1AamJtJd+OjMAEDObyiLvDOWA+pTHhw+OxriiRYZBvfc",
-	"8qE178HB7MgVzWZFQqGzlFUNqlyVMh7peskOXaSgWhat88yoGCff6aoXtSpFB1eFaq9js6Lb3uNPyK+G",
-	"F3VcQn5VlXSMRzl3+XoGN/mwhR+jVAystgscGWqzZF2Ei391aKEOx8cq2fU7PnrUZJj3Y5Pct17pQbrq",
-	"WfqLUrRDxFXeT7pEN4y6fM+C53kJTC/PV6xyD/5lKEtwCD183CjHbGfNTDWz8baTNdAlzyYN7tCJG9Zx",
-	"FpxlA+LkHRmWd1Z2MpTSaxSkSJ8rD7Yk+j2VV1au7AJy5QlF9IaYeKj42Z26hzEKUhqhQOAUBVGRI21H",
-	"RzhFoHgszYp8s8g7W3p7xp0apyLXIP3QEDats5hQQDpgg/zKlAuqATZA+7PZ0WQ2n8z2wfzZ8ezwePZs",
-	"WIn1haBZ55bdHycJLM3FYKrfQKzjFo0vzeqkf8YHYlarR2g7qXmaDRR0qyp3g0K4wTonoTAaCIl1UG0d",
-	"ejrYpDij7+DQPiXlD/L7TN6FGmj89YGYXaxJWGGmTtndmMlHQMFmc4U6pxq7XHyGOE2uURQoD52GV4Hn",
-	"KL1TzRYXRpykcZ8U+3VnQUqDp1OVVuToyPFJrN0VCSb/oed1ILuQlMBkKaniWsI+dbtZ4XBVJsQwB8XL",
-	"G8XxrazjwPygw0SHiIhAZEMLLcwBULBAK0wiK+U25N0yQHQYFfmsE6PaCD9Guq4CXRd3PAfAZcrZB9PA",
-	"koOlDNq79lwPaGw7ZAjkZFLMYm99p1jXMgW90bRNCBvJ2q6PhyUF69vj3IymHLjoZIXvtlD52MolzKo8",
-	"4r65RF+JVlvSLk3lR1t5+tREjBNJP5brhAKMIizfgsnH2ug+vf8ak3O6/E1N9knO5TLLiKwgCVGgb90G",
-	"RXHeCpIl6q31sFxCHcMAnmcy0lFHgqp0QF/mjaIEZEm+xGTIZVu8JJShQB0yS2Yoyd+40KuGgYwhcxyt",
-	"hjl36xoxrpM//YoRCWjIUD9litKJcpSbRHA4vQp9Ligrqi+8BzbVpN4aKr87YXMjv3KHd5QEUa7CGeGY",
-	"bUVv5OatIIl0bjVOcChQpDBRkWme6gPTLNGp6OIigya+JV+WmpVKRrn37uOOG7hWBymUSl0EBZJmzVos",
-	"Q5ybepPReFQVn7gX02Z9WFpEeUPqBSs3cpe0RG9trQrvU11AXApycyelwJgxQI0ZDy9OVkrMVCg3hLuR",
-	"a92ANrrU+QQK+FqGbkWCxb2VBeRFNGZ2L86TRCJCQoZSRHTtMExUPWrFsDBJhjpuFQg92qrB7E38nbvS",
-	"ZCC3vXDoUtfphEBK4OXEHEBRnNgm6BolLV1vlJyyro7QRf5c+NUe/VcbUyMtiNJkiK4zMJga7HYFXQaF",
-	"QEzVrmib5AfGN7yC639PmIod+zP6zh34LU8Sw+9SeH0Xha1cgeTEUr4kF3HHBU3CMReIhI7TPqWjiGA0",
-	"AYXawsT4YeoAT5c7USYVZqwua5WzAch5ziSv1vcmF9RFAjmdp+5GUCaj1wizttrfmxbrB0Zht2bWAwKx",
-	"YghG9Wqzw6YlUwTTL0j6hZQYd9Ppw+LUO/P8yDm1fqN3ah8HnJGQbcYBlhLyMIA0bMECirBe2Dpv18PZ",
-	"c0kXdMUowf8ul1JzAPQHCnP1k5SHrzkkAqul3MVsWTKQfE1E7kzD+p0Wt3dRiYy6UdOimdGYlY/Ue8Ju",
-	"3hDFEZnluPguRijNvcES5o2hS7gTq2a9BsBNcBqL+UyGP8IofbjO+IJfDQ4vKp+mnVhrRLvVCrODOJzt",
-	"Hx1M9l+EzyfzOXo+gUfPDiZH4Wzx4jB69jI+mB3PJ89nh/PD/YPx7Nnh88PoILSGvzh4tj/Znx1Ei/3D",
-	"oyg6iI7nk/nzmbMlSL1+zGrxoR5UhXy+NzNaJ9ChMz2wnZx/Rxbet/k1L9MDyoShBErb0V0oLFVn6bSE",
-	"Zo/7PLmmtbzVHtnG8zR1bt3j9hK5idFgt9bi5L7shA2HdxuKHGnhnV4ImmUqe1BVPP1mLtY44wunr+0v",
-	"0tNOvaD2UYjt4vOBMX/DeqqHaoKCfx0qQz4edsbHO2sbBvKlHSN78idjcIOTKIQsKhID9eB3Mfn1nlnx",
-	"1hmnL1suqvKMdhA2AFbhhLXzfM4yFz47ITx2uOKeh9yMiCKuS7JNlqbAmDe2ZX5HCg5cwGeRG+QZ3sTG",
-	"Ebt2kLRK03TT9ElVpGynAuUuhSFbqppw1kmUNPHuOkozKR/e81J6jdgNwwJtdMBdvqW9bWFWKf/ov/VU",
-	"rdsPuu9eYgxxolri8Kt2fqqj8sJ5+bBUp/3drgoFVk3q1F1No5KHIeLcA+5mdXztucZtariA0lfhHrQB",
-	"13A1pBd/5F5ajU41XUelHeGGvwSlvdHVit47T+ZyEweF9RLUlMXwrsZdfQe9dyiZ6SuSabR1fPiLz97G",
-	"hFu9+XyrUj9CKuPkhIaOhN3JO/AhQ+TVxzNw8uGNVLksGR2P+nrqTaTxnGiXFlNiWuzp+CKmisWxUIi3",
-	"FigOYY5HR5KA6nQiQwRmeHQ8OlA/SY0vVgraKczw9Ho+Nf0bpsX0xl8qWyudRWqtVx/P6u2J1CGl1qxq",
-	"vv3ZzGT8ikJvmOlUsUTjX1wXwlR+VGcPVHcjJEX1hlnUikxtIs/TFLL16FjiAMpGSCSmgOfhCkAOat2R",
-	"BFxyq3PR6LMqGfVhr5VPkwBKDF/TaP1guLf7LLWQNsuChVz39gnvQ65oVtuKPSfhb8ctftQHzHwoS1Zd",
-	"pR6HMR1drLrIMh4dPiAYrc5ojqW1Oe8QDKvhbWG4NtmY6Tf9h4oIb7X+S5D2Ax079SGOE0yQJtt7fdqU",
-	"QQZTpHf5n63jLwu8IiZX7SKgWI0KQzCyYBjZalwffbvym/6+0p9bjHPo8MOf2I5STddG++JBG1k4DAMl",
-	"rGp59jgS5mixtmMSZrVd3kjCzMZMvxkvbCMJM97jAAmzwfNLmAXDjy1h9SbanRsZpXsFcE7JeovECQ3/",
-	"6+LDe48o1cGSc5X3/NrsFtEQqOUqqCIaNiAyPmoHOH+7fHc+CBw5sAecldAH5D5wdJDXr3qqRoV9zCzl",
-	"q7jvpW4Ol1coFE9/zRFbW0yNxSooRziY2F06dTt2fExhDRgSOdOtXHSZ1sR0cSiuIrhAqDUv2ASGz9vV",
-	"vo7ekA5JsS/YJkUH1wYfNIdU/FDE+CpG4779t5t9b8vZdvQT39zhnj8YPGVO5MnbOd0ID0ASFaWJEBB0",
-	"Y++6a8PbOmD6zTpZ6LdyJ+phyRSdOmGZ0IVqp5MT/DWv3wr3G7z6Qccgg+e9lddWGDHV97toVkACE25a",
-	"1xR9CVRCx5RTuFSHmuOeOmMHDK/mAwD7eGo8xIbsIq88jk3bpj3p0Gdlx+BDJy8aylMBYvWRkrZ96WKI",
-	"vjTOzvDE5+3YPVca/7aeCJXg3n4f1nhieshkseB9bds00p/lUElwv9tjPt6xWyzaFzM8OduiifwAm1o1",
-	"pujYU/2NjJ9bus0tLd3Q++6oCsk2E9ZPRX+6H9OcuL43dGvsya5qhqpBWJwT3WKyuHT1MAy2geL4wdnL",
-	"8YWhXeUuo6S2zlxl65sO3qp6q/64rNXuLzvcDX7anKY4oNYWc3Nesr6+OyDE1k0EhyRrt8A6/hY82w1w",
-	"640Td+SAqugypItXfcnZoewx/ab/qDJ4A5hF1Xw/PV4ZdxT4epavcB+4vLP+d6tcWr+Rv1tMquuf786j",
-	"ZVeRIRqsbLv1dKxh58WZRzkLanw2aUfYx/62s/2t8/t7WIJBwmPzwWm/e3Vphv3oucZ2OeufxcUqGKFU",
-	"VRRA/T0EXSvQw136iKdPMxVfjetlIMnzkF895um3uTe1WBfNy3SbJ9eaxbOhBqtsq9W1qkM+mss227mN",
-	"N0pPWzZzy6q29XFABxMqIiemzdzTUbQlVBW762r6Icf7l7pPz/YO9+3rAt/zaN/1pawdOucvvxNV3+Gm",
-	"OpuGxTfseY95NB+73+b+1z+n72UBHGsexhxgkuVC91Y2ulT3mS+w0l1Gqy/V6x7llIFrHCJwjRiHW2Wi",
-	"Bkq7w0aXqkBKUZmYRq2mnTyNAWz26G8RdW8A5xV3x4aZ1OJ22CPUs+64ai8v591Lx19WN/u2IevmTtf3",
-	"U+8+AJ6oPq/t7CbCNdVNZnqU+5ka9Ej73ryjujkb7G8Jnt3Rz6Z10N3Z4ptqkbdJDV+DOzaKju0ufY6w",
-	"uIRlYFDsa++303Vz/pvVTQU+2FjuzjbNfjjF3rbXXVvuLZCr7lj/3PSdKU0buu8t/X03rf1UOaKr2FrB",
-	"gK4RAThWXeoBzxdF2MfKXkU/y619kf4AM7EzfPEIudLvoZ0aQeShrzNeR1G1f/f7SqqfMgNstYr6fgnG",
-	"2Y+eYCyrqwcmGC2T5TmfK3rwFf01h6SDan07+c4oskcvjnCesegu0aY78chX9PDr8Bl1I+nuCdWYXx//",
-	"TLzNLTt3Mq7O6uzqCkgi043W/MBoLsxdNFy7WHx3qRxcS1ZWkb1eS1q/ItHdTtB/EKH8Wd3Wxd/uErd7",
-	"c/GGJW9lsdtPlv5ZhLezsuSsxHtgUZLvLRK0YUpikaALwfJQ5OynTD01mRr7O9r6SF5wwGCau78Vtfvp",
-	"+5rkcYvFN03O/JSQnxIy/z7BUp35dj9Y6hRDf5asTM/8FMWNF/9RBPHhU5RWUrAph3+uWmwtcRuazW6v",
-	"VcDeOpfyE+A/WOa79enzXb2Pq78vfrfk87CbRdaHDHdQ2ZctzXe9tn5HLzGZaxWaezbjTpr1Ki/9Lfwf",
-	"TndptHdfddHMr7nUx0fYdbGj9ebza5rvRTSFmKjW8yNJajOBWxeM+rrdRzQc3OLe9LSffs1xeDVRGnii",
-	"y1InVVewmo4ZuTwzhfZ2obrBYjWJUgsetWwbmqILbDmu+OH28+3/BQAA//8+kDDk07YAAA==",
+
+	"H4sIAAAAAAAC/+w9XXPbOJJ/Bae7h5kpyZJsx0l8tQ9J7Mn6zvmo2HNzW1M5BSJBCWsSYADQHm3K//0K",
+	"HyRBEiApW3KscfZhxxFBoNHo7240vw0CmqSUICL44PjbgAdLlED156sYMfEOErhA7JKmNKaLlfw9ZTRF",
+	"TGCkRi0pF/K/6E+YpDEaHA+m+8/3JnuTvelgOBCrVP7EBcNkMbgdDlLKqsNfTl4eFOMwEWiB2OD2djhg",
+	"6GuGGQoHx3/oRczLn4vRdP5PFAg565s44wKxd1D+fxNGGIbq1xDxgOFUYEoGx+pXxDmgERBLBIKMMUQE",
+	"SNQkgNAQDYaubR2/2D9y7g3G+Bo116EkxgQBLqDIzGqYm2XsFQTLUDHrnNIYQSKnjREMkQN+zO2Z1B7M",
+	"0B6TEpig6rHpaRwbq52FejPfbAHdUCO55XD8JAQloc0STWkzYY37D4aiwfHg38clkY4NhY6d5Hk7HCwY",
+	"jCCBved5q8fbU2hUFDPMYqxpHAuU8K75NBHa0xmMQMag+nfKaILEEmW8N5Afi1fsiW8ou7oznL+rl/1w",
+	"3vqPUr/63fhsTjMSzjjNWIBmOSFX11RDgB4C5JCC7zTOmssmK/41Hk3aFhRw4V9KPuxcRI11rdBkRz1F",
+	"f3aUqK9C6kKUkz8puUZM0izkV5/Q1wxpKqqerYD8qouk5ASKkCC/mgWURHgxi3DsQJp+CORDgAlYwSQG",
+	"EWUJFGApRMqPx+OQBnwvxWQRwHQvoMn4X8uxwOF8zAWcx2gsFxnpeTIG5bwjOd0oyuJ4z4m2rp3zlBKO",
+	"/pJbtylGbccBqZM2GIICXSgK8pKGJrAuDOlJLLHlo/lRN9GbFf0Qb4iUXZhzLXqCuTyYTyiGK2vZmhwM",
+	"5B9AUMAFTQEETA4HzIwf1qC0sFQI9m55/h4m6FyOdhL8SZakF8oOaYJX2idhlqQgI7gJk1w2RgKFM0WI",
+	"6jdNu4PjQUizeYzKsyNZMpe23HCAuMAJFGgmqIDxjNGbvm9GmGC+ROFsvhJo7ZfWWEhD5tgVJuLocNBp",
+	"oVbeHzYR1dhKHUw3llzEdkrWozXIRCexqaezOSYxXcwWAodO+mACkwV4e3l2kivzLOWCIZgA/WpF2aGX",
+	"cBoF+/sjFExejKZT9HI034fBaLJ/uA+D6XQymRwcT0fPXxy+HAwHJItjua+ayVqqyAqIbq1fgCjlWan1",
+	"28HUin+Oyd5E/m+/PywhNtZOBLNY0sreWD/QS1Rhk2CEmKFAULYCN0vEkAJNn0tMFwBzKRgkPfWAYBvS",
+	"4ZQxyn7HYvkOce60dSTJKH0DkBzbICP16yyQZk/jXfUMBNokqnPT0Lya8IXvzcQA1aUbyomGNjwuTnqL",
+	"hLFoz0hE/QZAoAfNXGxhngEsj62QGplPbEhJ08/kr7tN9X1aQLXvTTsk8tj9OwyhgL09h6q37XBwlABT",
+	"mraH0JScIldv34Sm381vwrgyW96Etn02CH1pTG0fbG0wbBRwY4NsGXxpw20Q54WJv2WQ3+EFUyYsWyDB",
+	"Nwh8ZeKH2MlmKSebl3M+BPSXUgFfCJYFImPIvwsN4CxQjseMf42rTs2bT6evLk/B5avX56fgi5h+AT99",
+	"weEXgIn4aTr9Gbz/cAne/3Z+Dl79dvlhdvb+zafTd6fvL4cfP529e/XpH+C/T/+h3/gZjH+5/Lc/jNxH",
+	"4QyTEP35Gbw5/+3i8vTT6Qn4ZfwzOH3/9uz96d/OCKEnr8HJ6a+vfju/BG/+/urTxenl3zIRvUjmh+DN",
+	"h/PzV5en+b+lWeUKS5itNT21cO4MlChj1zFc/T7t4ZkWr+dzWVj1HNX/wBiHyijZKLnVp3VSnAJRO07r",
+	"Bd/K2Q2VbZSoXZA5sVcLfW48uH8wmUzuHdw/pzDsdlpjCkO309riQ/qttAQJaJwNC/vlVq3nhb/UxAej",
+	"C4Y4dz7UXl5/mGpYa7iT9nzW0tWtOAB3obwWw74vXfiSDb1o6MX+0bQTG0ZmdJHSB+W/oPZwX7BEwdWM",
+	"Ia6cujrFpQyN1AhgRti+ZPkQc5BCzlG4B9yC8j4hqGEVxo6d1vVYZ8hAe3kIKCHiDRlEccaXFf9Xu6rV",
+	"WX9nWCCuPF29L7mASgPIHaQUEwG4/AUKcPIOBJBoTsYCwEj6VQwVXr18LQ9eNhJa/Gs8CygRiDj2xr/G",
+	"YEUzcAOJsHZYOTuHngZfgmmpqHNdKpX1EHwJ9v2PDtyP7qGd/9OpnlckaG72tzSEOc5pKnCCucAB4EvI",
+	"QolGKQGkmgA3WCx1vsIcDSXxCmQcheBmiQiAxs0HNAgyxgEm3jlPTs5BUnHti6Oph26tc3IRriPTtY2c",
+	"8/3V0seMuUIkZTwnkPvPUpDSGAcrUInXNyMnf6aYGTMl56dJnZnUIB1/EVhHt4rl7OhDrkI8USRLzck/",
+	"2bW2MIp1D44mjaUvlwjkgyUHpYhhGuIAxvEKGJEXNQNaelvhEJjJwTWMM3QM1BKSoDgKKAn53aBnKIGY",
+	"zHgKA1TZwfRZHf53mOAkS0DEEAIh5ldAvaVgePv6Lsvf+mhio1mAB4x6dkU5K2umKMDRygDPs7kV24wo",
+	"Aw2w98BZBAgVQL+JJU2oigUpqgSgBIEbHMdgjpQA2gMXClKTGTsG+xA9Pzo8OBxFz19Go+kUvRjNQ7Sf",
+	"B5OloflCb2XaHT6tcXoTxy5+V8f6RjFxEx9Ko+nEXs6UTRZXcfuZflhahJYO+xGF36ko/K2PSrq9FVts",
+	"V6nE1J6Urkd1ihoO8zSyZhOtWEqk/lTD6nQIpi+fv/zZxeyVdT3E56K5exBbO3G5QdCIy2tIJECbByCA",
+	"IljOsnSWFPVkVSBultJCYVKIq7EgS7UxVZyO5X752NwpV9ejz3Lfe2OezdWULjPRXbiSI1FTZWW6Txkh",
+	"8uUuyVklVicR2dt1nbAP6TnYLlF8oczVIpnV5DNtzirZo4IfwzIq0x3DqkVgLlCQMSxWzWWUEW1qjDiP",
+	"qxaeVm8RRnFYaLYlDkNEtHG9QKJwauyJKpOAiNFEDVG2VyTtnKZYqrmviIkZjGN6g8JZQJpgv6FJQgl4",
+	"byTzxcU5kO/gCAdQBw8KZHUih/N4FkC/42VNrEVVPtKmNifNyonlTrxT/2pNJ/fx8fSdsRbG//ts8jKv",
+	"qaltrXvVK7TyL/qmXE+eSsrwtdzaFVoVBT3W4h3r1T2jKi4dOGgC6OQO45S9ZTRLHVHQMG7GKjsPOsKM",
+	"i1lMA61lXK9IbxSF600rdC7CNTQj60/YCJao2YflnhsbKcC2FnQitahxqoka/bvb1qvYJRGMeSM8UmgS",
+	"5YVrCSDdJvV6RcSb15vaxJiVpbrstR6VZrY2IqU3l0kBpaQy1zLHpd69IEQxvKYObaZ/L6oiC1zVzD4X",
+	"J+YuvgvbwFSUustGnREAyPkNZaF3xmJAdcqDw2dHfSzRPMLgnls+tOY9OJgcubzZNA8otBYCq0GlqVL4",
+	"I20v2a6LZFRLo7Vm3PJx8p22alurzrZ3Te1dsiadyWPIr/qXxFxCflUWxAwHGXfZemZv8mFjf4xS0bNW",
+	"ceaIUJslqyyc/6tFCrUYPlbBs9/w0aNG/awfG+W+9QoL0lUN1F3Sow0iruJ+0iS6YdRle+Y0zwtgOmm+",
+	"JJV70C9DaYwD6KHjWjFrM2pmasGNtR2vgC4YN2Fwh0xcswo2pywbECftSLe8tS6WoYReo1mCdJq0tybR",
+	"76m4sjJl55ArSyikN8T4Q/nP7tA9jNAsoSGaCZygWZjHSJveEU4QyB9LtSLfzOPOltyecKfEKdHVSz7U",
+	"mE3LLCYUkA7YIL8yxZZqgA3Q/mRyNJpMR5N9MH12PDk8njzrV6B+IWjaemT335MElmaiN9ZvINZ+i94v",
+	"Tauof8Z77qxSzdE0UrMk7cnoVk3zGmWEvWVOTGHYExIrUW0lPR1kklc4tFBol5DyO/ldKu9CDTT2es+d",
+	"XaxIUO5MZdndO5OPgILNpgqVpxq6THyGOI2vUThTFjoNrmaeVHqrmM2v2zhR484U+2VnjkqzT6coLdHR",
+	"EuOTu3ZXJJj4h57Xsdm5xAQmC4kV1xJ21u1miYNlERDDHOQvr+XHN6KOPeODDhUdICJmIu1baGESQLM5",
+	"WmISWiG3Pu8WDqJDqchnrTuqjPDvSNdVoOv8hmwPuMxlgN44sPhgIZ32tjPXA2rHDhkCGRnls9hH38rW",
+	"lUhBpzdtI8LeZOXUh/2CgtXjcR5GnQ9ceLLcd5upfGTlYmZVHnHfWKKvwK3JaZem8qMpPH1iIsKxxB/L",
+	"dEABhiGWb8H4Y2V0l9x/jck5XfyqJvsk53KpZUSWkARopu8sz/LSxiUkC9RZ62GZhNqHATxLpaejUoKq",
+	"dEBfhQ7DGKRxtsCkz1VlvCCUoZlKMktiKNBfuw6thoGUIZOOVsOcp3WNGNfBn27BiAQ0aKhmmcJkpAzl",
+	"OhIcRq/aPheU5dUX3oRNOam3hspvTtjUyK/c7h0lszBT7oxwzLakN/LwlpCEOrYaxTgQKASmqhCRLNEJ",
+	"0zTWoej8GohGvsVflpiVQkaZ9+50xw1cqUQKpVIWQYGkWrMWSxHnpt5kMByUxSfuxbRa7xcWUdaQesGK",
+	"jdwlLNFZmazc+0SXXxeMXD9JyTBmDFBjhv1Lu5UQM/XdNeauxVrXwI0uFD+BAr6WrlseYHEfZQ557o2Z",
+	"04uyOJYbIQFDCSK6SBXGqpq3JFgYx30NtxKEDmlVI/b6/p2nUicgt75wyFJXdkIgxfByYg6gyDO2MbpG",
+	"cUPWGyGntKvDdZE/53a1R/5VxlRQC8Ik7iPrDAymgr1ZQZdCIRBTtStaJ/mB8Q0v4fq/E6Z8x+6IvvME",
+	"fs3i2NC7ZF7fNWsrViApseAvSUXccb2VcMwFIoEj26dkFBGMxiAXW5gYO0wl8HS5E2VSYEbqqlsxG4Cc",
+	"Z0zSavVsMkFdKJDTeepuBGXSew0xa4r9vXG+/iwvA6/PrAfMxJIhGFarzQ7rmkwhTL8g8RdQYsxNpw2L",
+	"E+/M0yPn1PqNzql9FHBGArYeBVhCyEMAUrHN5lAE1cLWabMezp5LmqBLRgn+V7GUmgOgP1GQqZ8kP3zN",
+	"IBFYLeUuZkvjnuirb+TOOKzeCHJbFyXLqPtIDZwZiVnaSJ0ZdvOGyFNkluHiu1aiJPcaS5g3+i7hDqya",
+	"9WoA18GpLeZTGX4Po7DhWv0LftXbvShtmmZgrebtlitMDqJgsn90MNp/ETwfTafo+QgePTsYHQWT+YvD",
+	"8NnL6GByPB09nxxOD/cPhpNnh88Pw4PAGv7i4Nn+aH9yEM73D4/C8CA8no6mzyfOhirV+jGrQYp6UBby",
+	"+d5MaRVBh87wwHZi/i1ReN/hV6xMDygjhmIodUd7obAUnYXREpgz7rLk6tryVltka89Tl7lVi9uL5PqO",
+	"epu1FiV3RSdsOLzHkMdIc+v0QtA0VdGDsuLpV3OxxulfOG1tf5GeNuoFtVMhtonPe/r8Ne2pHqoJcvp1",
+	"iAz5uF+Oj7fWNvSkS9tH9sRPhuAGx2EAWZgHBqrO73z0yz2j4o0cpy9aLsryjKYT1gNW4YS1NT9nqQuf",
+	"nhAePVxSzyYPI6SI65JsE6XJd8xrxzK9IwZ7LuDTyDX09G8B5PBdW1BahmnacfqoKlK2U4Fyl8KQLVVN",
+	"OOskCpx4Tx0lqeQPb76UXiN2w7BAayW4i7e0tS3MKsUf3beeynW7QffdS4wgjlVDIX7VjE+1VF44Lx8W",
+	"4rS7V1guwMpJnbKrrlSyIECce8Bdr46vOdewiQ0XUPoq3Ebbl/UXQ3rxB+5E1ri23k4bm8iQ6wkZveFW",
+	"NrmZP3RGDa8LcJtlHCZ02BR1iISYLDpXTBmVVGMabLWN5ASmfEmFNaZ+6VeX86u0rhkMStiH+uYkR0Jd",
+	"acsHVC9EbjjN78SjyjPo5HWPYv5h+Zr3vokJWqaUY31vbYlAudgS8vxfKATuYFZ9DV+mtdV4Mqj0Xwlw",
+	"N1RoEH9Sdrba7LF4Qxpfwvn0y94XnSD80nqkvY7MH9noWqndOnWGOPJ/+vFe6yvWVprREt7wl7w1FUu5",
+	"oveOpblMyUFuLQtqyvB4W5vFrsKSO5TodRXl1Zrwbr7RgreN7FY7LdyqULOQxl98QgOHRD15Bz6kiLz6",
+	"eAZOPryRJh6LB8eDrg6oI2msj7QLjSkxDVF1PCOiiq2xUBtvLJAnfY8HRxKBKhuaIgJTPDgeHKifpIUp",
+	"lgraMUzx+Ho6Nt12xvn0xj8rGuGdhWqtVx/Pqs3kVFGEtuTUfPuTickw5BdLYKpTU3Ib/+S68K7021o7",
+	"Vrvb1ims16SWNpzUIfIsSSBbDY7lHkDRto5EFPAsWALIQaWXnYALbvWZG3xWJeq+3Wtjp44AxYavabja",
+	"2N6bXfEamzbLgrlc9/YRn0OmcFY5ij0n4m+HDXrUBS28L0mWPQAfhjAdPQfb0DIcHG4QjEYfS8fS2n1o",
+	"YQyrPXmuuNY5mPE3/YeKQN1q+Rcj7Xc6TupDFMWYII2299rYSSGDCdKn/Ecj3W6Bl8cAlaUKxXKQK4KB",
+	"BcPAFuO61MaVT/F/BeBzg3AOHWbSIztRqvFaazbf6yBzg6Enh5UNKh+GwxwNMXeMw6wm+WtxmDmY8Tdj",
+	"ha3FYcZ67MFhNnh+DrNgeNocVv3kQetBhsleDpyTs94icUKD/7r48N7DSlWw5FzFveImuYU0AGq5EqqQ",
+	"BjWIjI3aAs7fL9+d9wJHDuwAZyl0QY4PHO2MdYuesq1sFzFL/srvl6pOBcWVLUXTXzPEVhZRY7GcFSMc",
+	"ROwu1bwdOj59swIMiYzpyIEuCx2ZrjH51ScXCJVmKevA8Hm70tfRydfBKfaF/jjvt12jg/qQkh7ymKLy",
+	"0bjv/O1PM2zL2HZ8/WF9g3u6MXiKGOyj13O6bSmAJMxLoSEg6MY+ddeBN2XA+JuVyezWcifqYUEUrTJh",
+	"EdO5at+VEfw1q3ah8Cu8amK1l8Lz3gJuCoyI6vukNM0hgTE3rbLyPigqoGPKt1yiQ81xT5mxA4pX0wGA",
+	"XTQ17KNDdpFWHkanbVOftMizor/7oZMWDeapAJH6pFRTv7QRRFcYZ2do4vN29J4rbXhbDYRKcG+/D2k8",
+	"MjlkoljwvrptHOqPKKkguN/sMZ9a2i0S7fIZHp1u0UjewKGWjXBazlR/0ejHkW7zSAsz9L4nqlyy9Zj1",
+	"U94P82mqE9fX4W6NPtlVyVA2JIwyolva5pc8N0NgawiOJ05eju/B7Sp1GSG1deIqWm210FbZy/npklaz",
+	"n3V/M/hxU5qigEob3vVpyfpWeg8XWzct7ROs3QLp+Ft+bdfBrTZq3ZEEVd7VTBfL+4Kzfclj/E3/UUbw",
+	"ehCLKmJ7fLQybLlQ4Fm+3HvP5Z33DbZKpdUOILtFpPq+xd1ptKiT7CPBijZ/j0cbtl7Ue5BcUO2rYztC",
+	"PvaX+MuWVZuwsASDhEf6UkiLeXVphj31WGOznPWvYmLlhFCIKgqg/v6KrhXooC6d4umSTPk3PjsJSNI8",
+	"5FcPmf029zTnq7xZoq7Md62ZP+ursIo2fm2rOvijvmy9feRwrfC0pTO3LGobn3J1EKFCcmzaWj4eQVtA",
+	"VZK7vr3TJ71/qfuCbS+5b19P+p6pfdeX+XYoz198l656wnVxNg4ouUYsr9xtO349cJvnn4PSQQI40jSM",
+	"OcAkzYTu5W5kqf6uRb4r3dUY8ivTP0l/E4EycI0DBK4R43CrRFTb0u6Q0aUqkFJYJqYxtPl8BY0ArH8T",
+	"pIHUvR6Ul99V7adS89uoD1DPuuOivbgMfC8Zf1neJN4Gr5s7pN9PvPsAeKTyvHKy6zDXWDe16hDuZ2rQ",
+	"A517/U78+mSwvyV4dkc+m1ZldyeLb6ol5zo1fDXqWMs7truCOtziApaeTrGvnehO1835OznUBXhvZbk7",
+	"xzR5coK9qa/bjtxbIFf2dPhx6DtTmtb33Bvy+25S+7FSRFuxtYIBXSMCcKR6fgCezXO3jxXdCH6UW/s8",
+	"/R5qYmfo4gFipd9DOtWcyENfJ86Womr/6XeVVD9mAthqFfX9AoyTpx5gLKqrewYYLZXlyc/lPT/zfr59",
+	"wkGVPsF8ZwTZgxdHOHMsuiu96YY+8BU9/NJ/Rt24vn1CNeaXh8+JN6ll5zLjKldnV1dAEpru1+YHRjNh",
+	"7qLhysXiu3Nl71qyoors9Uri+hUJ75ZBfyJM+aO6rY2+3SVu96biNUveimK3HyT9owhvZ3nJWYm3YVaS",
+	"781jtGZIQvVDZFkgMvaDpx4bTw39HbR9KM8poDfO3d+m2/3wfYXzuEXi6wZnfnDIDw6Zfh9nqUp8u+8s",
+	"tbKhP0pWhGd+sOLaiz8VRtx8iNIKCtb58K9Vi605bk212W61CthZ53IhxzzByHex712/j6sO+Y7B5343",
+	"i6wPp+6gsC9amu96bf2OXmIy1yo09axHnTTtFF40fZKyS29790UXTe8mucrvevQVYo2PrjzBmiAHGnZN",
+	"nFgfoymvRHpJSH0vi13nJ1z9fsGKZnshTSAm6usFA4l6M4FbnQy6PpgQ0qD3VxLMZxHGXzMcXI2UEh/p",
+	"yuZR2ViuoqYGLuNebXu7UN1gsRyFiQWPWrYJTd5IuBiX/3D7+fb/AwAA//+E9OILxL4AAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -209,6 +209,13 @@ type GetTaskTableStructureResponse struct {
 	TableName       string  `json:"table_name"`
 }
 
+// GetValidationStatusResponse defines model for GetValidationStatusResponse.
+type GetValidationStatusResponse struct {
+	Data            []ValidationStatus      `json:"data"`
+	TableStatusList []ValidationTableStatus `json:"table_status_list"`
+	Total           int                     `json:"total"`
+}
+
 // GrafanaTopology defines model for GrafanaTopology.
 type GrafanaTopology struct {
 	Host string `json:"host"`
@@ -666,6 +673,42 @@ type UpdateTaskRequest struct {
 	Task Task `json:"task"`
 }
 
+// ValidationStatus defines model for ValidationStatus.
+type ValidationStatus struct {
+	// error message when something wrong
+	ErrorMsg        *string `json:"error_msg,omitempty"`
+	ErrorRowsStatus *string `json:"error_rows_status,omitempty"`
+
+	// validation mode
+	Mode                string  `json:"mode"`
+	PendingRowsStatus   *string `json:"pending_rows_status,omitempty"`
+	ProcessedRowsStatus *string `json:"processed_rows_status,omitempty"`
+
+	// progress of snapshot validation, only set in snapshot mode
+	SnapshotStatus *string `json:"snapshot_status,omitempty"`
+
+	// source name
+	SourceName string `json:"source_name"`
+
+	// validator stage
+	Stage string `json:"stage"`
+
+	// binlog position the validator has validated to
+	ValidatorBinlog     *string `json:"validator_binlog,omitempty"`
+	ValidatorBinlogGtid *string `json:"validator_binlog_gtid,omitempty"`
+}
+
+// ValidationTableStatus defines model for ValidationTableStatus.
+type ValidationTableStatus struct {
+	Message *string `json:"message,omitempty"`
+
+	// source name
+	SourceName  string `json:"source_name"`
+	SourceTable string `json:"source_table"`
+	Stage       string `json:"stage"`
+	TargetTable string `json:"target_table"`
+}
+
 // worker name list
 type WorkerNameList []string
 
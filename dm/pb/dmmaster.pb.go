@@ -1049,6 +1049,320 @@ func (m *UnlockDDLLockResponse) GetMsg() string {
 	return ""
 }
 
+// SimulateDDLLockRequest used to simulate a DDL for the optimistic shard DDL locks of a task,
+// the DDL is executed by upstream tables matched by sources, database and table.
+type SimulateDDLLockRequest struct {
+	Task     string   `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	DDL      string   `protobuf:"bytes,2,opt,name=DDL,proto3" json:"DDL,omitempty"`
+	ID       string   `protobuf:"bytes,3,opt,name=ID,proto3" json:"ID,omitempty"`
+	Sources  []string `protobuf:"bytes,4,rep,name=sources,proto3" json:"sources,omitempty"`
+	Database string   `protobuf:"bytes,5,opt,name=database,proto3" json:"database,omitempty"`
+	Table    string   `protobuf:"bytes,6,opt,name=table,proto3" json:"table,omitempty"`
+}
+
+func (m *SimulateDDLLockRequest) Reset()         { *m = SimulateDDLLockRequest{} }
+func (m *SimulateDDLLockRequest) String() string { return proto.CompactTextString(m) }
+func (*SimulateDDLLockRequest) ProtoMessage()    {}
+func (*SimulateDDLLockRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9bef11f2a341f03, []int{13}
+}
+func (m *SimulateDDLLockRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SimulateDDLLockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SimulateDDLLockRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SimulateDDLLockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulateDDLLockRequest.Merge(m, src)
+}
+func (m *SimulateDDLLockRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SimulateDDLLockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulateDDLLockRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulateDDLLockRequest proto.InternalMessageInfo
+
+func (m *SimulateDDLLockRequest) GetTask() string {
+	if m != nil {
+		return m.Task
+	}
+	return ""
+}
+
+func (m *SimulateDDLLockRequest) GetDDL() string {
+	if m != nil {
+		return m.DDL
+	}
+	return ""
+}
+
+func (m *SimulateDDLLockRequest) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+func (m *SimulateDDLLockRequest) GetSources() []string {
+	if m != nil {
+		return m.Sources
+	}
+	return nil
+}
+
+func (m *SimulateDDLLockRequest) GetDatabase() string {
+	if m != nil {
+		return m.Database
+	}
+	return ""
+}
+
+func (m *SimulateDDLLockRequest) GetTable() string {
+	if m != nil {
+		return m.Table
+	}
+	return ""
+}
+
+type SimulateShardTableResult struct {
+	Source        string   `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	Schema        string   `protobuf:"bytes,2,opt,name=schema,proto3" json:"schema,omitempty"`
+	Table         string   `protobuf:"bytes,3,opt,name=table,proto3" json:"table,omitempty"`
+	ConflictStage string   `protobuf:"bytes,4,opt,name=conflictStage,proto3" json:"conflictStage,omitempty"`
+	ConflictMsg   string   `protobuf:"bytes,5,opt,name=conflictMsg,proto3" json:"conflictMsg,omitempty"`
+	DDLs          []string `protobuf:"bytes,6,rep,name=DDLs,proto3" json:"DDLs,omitempty"`
+}
+
+func (m *SimulateShardTableResult) Reset()         { *m = SimulateShardTableResult{} }
+func (m *SimulateShardTableResult) String() string { return proto.CompactTextString(m) }
+func (*SimulateShardTableResult) ProtoMessage()    {}
+func (*SimulateShardTableResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9bef11f2a341f03, []int{14}
+}
+func (m *SimulateShardTableResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SimulateShardTableResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SimulateShardTableResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SimulateShardTableResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulateShardTableResult.Merge(m, src)
+}
+func (m *SimulateShardTableResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *SimulateShardTableResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulateShardTableResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulateShardTableResult proto.InternalMessageInfo
+
+func (m *SimulateShardTableResult) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *SimulateShardTableResult) GetSchema() string {
+	if m != nil {
+		return m.Schema
+	}
+	return ""
+}
+
+func (m *SimulateShardTableResult) GetTable() string {
+	if m != nil {
+		return m.Table
+	}
+	return ""
+}
+
+func (m *SimulateShardTableResult) GetConflictStage() string {
+	if m != nil {
+		return m.ConflictStage
+	}
+	return ""
+}
+
+func (m *SimulateShardTableResult) GetConflictMsg() string {
+	if m != nil {
+		return m.ConflictMsg
+	}
+	return ""
+}
+
+func (m *SimulateShardTableResult) GetDDLs() []string {
+	if m != nil {
+		return m.DDLs
+	}
+	return nil
+}
+
+type SimulateDDLLockResult struct {
+	ID        string                      `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Conflict  bool                        `protobuf:"varint,2,opt,name=conflict,proto3" json:"conflict,omitempty"`
+	OldJoined string                      `protobuf:"bytes,3,opt,name=oldJoined,proto3" json:"oldJoined,omitempty"`
+	NewJoined string                      `protobuf:"bytes,4,opt,name=newJoined,proto3" json:"newJoined,omitempty"`
+	Stalled   []string                    `protobuf:"bytes,5,rep,name=stalled,proto3" json:"stalled,omitempty"`
+	Tables    []*SimulateShardTableResult `protobuf:"bytes,6,rep,name=tables,proto3" json:"tables,omitempty"`
+}
+
+func (m *SimulateDDLLockResult) Reset()         { *m = SimulateDDLLockResult{} }
+func (m *SimulateDDLLockResult) String() string { return proto.CompactTextString(m) }
+func (*SimulateDDLLockResult) ProtoMessage()    {}
+func (*SimulateDDLLockResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9bef11f2a341f03, []int{15}
+}
+func (m *SimulateDDLLockResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SimulateDDLLockResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SimulateDDLLockResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SimulateDDLLockResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulateDDLLockResult.Merge(m, src)
+}
+func (m *SimulateDDLLockResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *SimulateDDLLockResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulateDDLLockResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulateDDLLockResult proto.InternalMessageInfo
+
+func (m *SimulateDDLLockResult) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+func (m *SimulateDDLLockResult) GetConflict() bool {
+	if m != nil {
+		return m.Conflict
+	}
+	return false
+}
+
+func (m *SimulateDDLLockResult) GetOldJoined() string {
+	if m != nil {
+		return m.OldJoined
+	}
+	return ""
+}
+
+func (m *SimulateDDLLockResult) GetNewJoined() string {
+	if m != nil {
+		return m.NewJoined
+	}
+	return ""
+}
+
+func (m *SimulateDDLLockResult) GetStalled() []string {
+	if m != nil {
+		return m.Stalled
+	}
+	return nil
+}
+
+func (m *SimulateDDLLockResult) GetTables() []*SimulateShardTableResult {
+	if m != nil {
+		return m.Tables
+	}
+	return nil
+}
+
+type SimulateDDLLockResponse struct {
+	Result bool                     `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+	Msg    string                   `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Locks  []*SimulateDDLLockResult `protobuf:"bytes,3,rep,name=locks,proto3" json:"locks,omitempty"`
+}
+
+func (m *SimulateDDLLockResponse) Reset()         { *m = SimulateDDLLockResponse{} }
+func (m *SimulateDDLLockResponse) String() string { return proto.CompactTextString(m) }
+func (*SimulateDDLLockResponse) ProtoMessage()    {}
+func (*SimulateDDLLockResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9bef11f2a341f03, []int{16}
+}
+func (m *SimulateDDLLockResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SimulateDDLLockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SimulateDDLLockResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SimulateDDLLockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulateDDLLockResponse.Merge(m, src)
+}
+func (m *SimulateDDLLockResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SimulateDDLLockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulateDDLLockResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulateDDLLockResponse proto.InternalMessageInfo
+
+func (m *SimulateDDLLockResponse) GetResult() bool {
+	if m != nil {
+		return m.Result
+	}
+	return false
+}
+
+func (m *SimulateDDLLockResponse) GetMsg() string {
+	if m != nil {
+		return m.Msg
+	}
+	return ""
+}
+
+func (m *SimulateDDLLockResponse) GetLocks() []*SimulateDDLLockResult {
+	if m != nil {
+		return m.Locks
+	}
+	return nil
+}
+
 // OperateWorkerRelayRequest represents a request for some dm-workers to operate relay unit
 type OperateWorkerRelayRequest struct {
 	Op      RelayOp  `protobuf:"varint,1,opt,name=op,proto3,enum=pb.RelayOp" json:"op,omitempty"`
@@ -1059,7 +1373,7 @@ func (m *OperateWorkerRelayRequest) Reset()         { *m = OperateWorkerRelayReq
 func (m *OperateWorkerRelayRequest) String() string { return proto.CompactTextString(m) }
 func (*OperateWorkerRelayRequest) ProtoMessage()    {}
 func (*OperateWorkerRelayRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{13}
+	return fileDescriptor_f9bef11f2a341f03, []int{17}
 }
 func (m *OperateWorkerRelayRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1113,7 +1427,7 @@ func (m *OperateWorkerRelayResponse) Reset()         { *m = OperateWorkerRelayRe
 func (m *OperateWorkerRelayResponse) String() string { return proto.CompactTextString(m) }
 func (*OperateWorkerRelayResponse) ProtoMessage()    {}
 func (*OperateWorkerRelayResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{14}
+	return fileDescriptor_f9bef11f2a341f03, []int{18}
 }
 func (m *OperateWorkerRelayResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1188,7 +1502,7 @@ func (m *PurgeWorkerRelayRequest) Reset()         { *m = PurgeWorkerRelayRequest
 func (m *PurgeWorkerRelayRequest) String() string { return proto.CompactTextString(m) }
 func (*PurgeWorkerRelayRequest) ProtoMessage()    {}
 func (*PurgeWorkerRelayRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{15}
+	return fileDescriptor_f9bef11f2a341f03, []int{19}
 }
 func (m *PurgeWorkerRelayRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1262,7 +1576,7 @@ func (m *PurgeWorkerRelayResponse) Reset()         { *m = PurgeWorkerRelayRespon
 func (m *PurgeWorkerRelayResponse) String() string { return proto.CompactTextString(m) }
 func (*PurgeWorkerRelayResponse) ProtoMessage()    {}
 func (*PurgeWorkerRelayResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{16}
+	return fileDescriptor_f9bef11f2a341f03, []int{20}
 }
 func (m *PurgeWorkerRelayResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1323,7 +1637,7 @@ func (m *CheckTaskRequest) Reset()         { *m = CheckTaskRequest{} }
 func (m *CheckTaskRequest) String() string { return proto.CompactTextString(m) }
 func (*CheckTaskRequest) ProtoMessage()    {}
 func (*CheckTaskRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{17}
+	return fileDescriptor_f9bef11f2a341f03, []int{21}
 }
 func (m *CheckTaskRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1389,7 +1703,7 @@ func (m *CheckTaskResponse) Reset()         { *m = CheckTaskResponse{} }
 func (m *CheckTaskResponse) String() string { return proto.CompactTextString(m) }
 func (*CheckTaskResponse) ProtoMessage()    {}
 func (*CheckTaskResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{18}
+	return fileDescriptor_f9bef11f2a341f03, []int{22}
 }
 func (m *CheckTaskResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1443,7 +1757,7 @@ func (m *OperateSourceRequest) Reset()         { *m = OperateSourceRequest{} }
 func (m *OperateSourceRequest) String() string { return proto.CompactTextString(m) }
 func (*OperateSourceRequest) ProtoMessage()    {}
 func (*OperateSourceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{19}
+	return fileDescriptor_f9bef11f2a341f03, []int{23}
 }
 func (m *OperateSourceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1510,7 +1824,7 @@ func (m *OperateSourceResponse) Reset()         { *m = OperateSourceResponse{} }
 func (m *OperateSourceResponse) String() string { return proto.CompactTextString(m) }
 func (*OperateSourceResponse) ProtoMessage()    {}
 func (*OperateSourceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{20}
+	return fileDescriptor_f9bef11f2a341f03, []int{24}
 }
 func (m *OperateSourceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1569,7 +1883,7 @@ func (m *RegisterWorkerRequest) Reset()         { *m = RegisterWorkerRequest{} }
 func (m *RegisterWorkerRequest) String() string { return proto.CompactTextString(m) }
 func (*RegisterWorkerRequest) ProtoMessage()    {}
 func (*RegisterWorkerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{21}
+	return fileDescriptor_f9bef11f2a341f03, []int{25}
 }
 func (m *RegisterWorkerRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1621,7 +1935,7 @@ func (m *RegisterWorkerResponse) Reset()         { *m = RegisterWorkerResponse{}
 func (m *RegisterWorkerResponse) String() string { return proto.CompactTextString(m) }
 func (*RegisterWorkerResponse) ProtoMessage()    {}
 func (*RegisterWorkerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{22}
+	return fileDescriptor_f9bef11f2a341f03, []int{26}
 }
 func (m *RegisterWorkerResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1673,7 +1987,7 @@ func (m *OfflineMemberRequest) Reset()         { *m = OfflineMemberRequest{} }
 func (m *OfflineMemberRequest) String() string { return proto.CompactTextString(m) }
 func (*OfflineMemberRequest) ProtoMessage()    {}
 func (*OfflineMemberRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{23}
+	return fileDescriptor_f9bef11f2a341f03, []int{27}
 }
 func (m *OfflineMemberRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1725,7 +2039,7 @@ func (m *OfflineMemberResponse) Reset()         { *m = OfflineMemberResponse{} }
 func (m *OfflineMemberResponse) String() string { return proto.CompactTextString(m) }
 func (*OfflineMemberResponse) ProtoMessage()    {}
 func (*OfflineMemberResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{24}
+	return fileDescriptor_f9bef11f2a341f03, []int{28}
 }
 func (m *OfflineMemberResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1776,7 +2090,7 @@ func (m *OperateLeaderRequest) Reset()         { *m = OperateLeaderRequest{} }
 func (m *OperateLeaderRequest) String() string { return proto.CompactTextString(m) }
 func (*OperateLeaderRequest) ProtoMessage()    {}
 func (*OperateLeaderRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{25}
+	return fileDescriptor_f9bef11f2a341f03, []int{29}
 }
 func (m *OperateLeaderRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1821,7 +2135,7 @@ func (m *OperateLeaderResponse) Reset()         { *m = OperateLeaderResponse{} }
 func (m *OperateLeaderResponse) String() string { return proto.CompactTextString(m) }
 func (*OperateLeaderResponse) ProtoMessage()    {}
 func (*OperateLeaderResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{26}
+	return fileDescriptor_f9bef11f2a341f03, []int{30}
 }
 func (m *OperateLeaderResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1876,7 +2190,7 @@ func (m *MasterInfo) Reset()         { *m = MasterInfo{} }
 func (m *MasterInfo) String() string { return proto.CompactTextString(m) }
 func (*MasterInfo) ProtoMessage()    {}
 func (*MasterInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{27}
+	return fileDescriptor_f9bef11f2a341f03, []int{31}
 }
 func (m *MasterInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1951,7 +2265,7 @@ func (m *WorkerInfo) Reset()         { *m = WorkerInfo{} }
 func (m *WorkerInfo) String() string { return proto.CompactTextString(m) }
 func (*WorkerInfo) ProtoMessage()    {}
 func (*WorkerInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{28}
+	return fileDescriptor_f9bef11f2a341f03, []int{32}
 }
 func (m *WorkerInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2018,7 +2332,7 @@ func (m *ListLeaderMember) Reset()         { *m = ListLeaderMember{} }
 func (m *ListLeaderMember) String() string { return proto.CompactTextString(m) }
 func (*ListLeaderMember) ProtoMessage()    {}
 func (*ListLeaderMember) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{29}
+	return fileDescriptor_f9bef11f2a341f03, []int{33}
 }
 func (m *ListLeaderMember) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2077,7 +2391,7 @@ func (m *ListMasterMember) Reset()         { *m = ListMasterMember{} }
 func (m *ListMasterMember) String() string { return proto.CompactTextString(m) }
 func (*ListMasterMember) ProtoMessage()    {}
 func (*ListMasterMember) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{30}
+	return fileDescriptor_f9bef11f2a341f03, []int{34}
 }
 func (m *ListMasterMember) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2129,7 +2443,7 @@ func (m *ListWorkerMember) Reset()         { *m = ListWorkerMember{} }
 func (m *ListWorkerMember) String() string { return proto.CompactTextString(m) }
 func (*ListWorkerMember) ProtoMessage()    {}
 func (*ListWorkerMember) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{31}
+	return fileDescriptor_f9bef11f2a341f03, []int{35}
 }
 func (m *ListWorkerMember) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2184,7 +2498,7 @@ func (m *Members) Reset()         { *m = Members{} }
 func (m *Members) String() string { return proto.CompactTextString(m) }
 func (*Members) ProtoMessage()    {}
 func (*Members) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{32}
+	return fileDescriptor_f9bef11f2a341f03, []int{36}
 }
 func (m *Members) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2281,7 +2595,7 @@ func (m *ListMemberRequest) Reset()         { *m = ListMemberRequest{} }
 func (m *ListMemberRequest) String() string { return proto.CompactTextString(m) }
 func (*ListMemberRequest) ProtoMessage()    {}
 func (*ListMemberRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{33}
+	return fileDescriptor_f9bef11f2a341f03, []int{37}
 }
 func (m *ListMemberRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2348,7 +2662,7 @@ func (m *ListMemberResponse) Reset()         { *m = ListMemberResponse{} }
 func (m *ListMemberResponse) String() string { return proto.CompactTextString(m) }
 func (*ListMemberResponse) ProtoMessage()    {}
 func (*ListMemberResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{34}
+	return fileDescriptor_f9bef11f2a341f03, []int{38}
 }
 func (m *ListMemberResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2415,7 +2729,7 @@ func (m *OperateSchemaRequest) Reset()         { *m = OperateSchemaRequest{} }
 func (m *OperateSchemaRequest) String() string { return proto.CompactTextString(m) }
 func (*OperateSchemaRequest) ProtoMessage()    {}
 func (*OperateSchemaRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{35}
+	return fileDescriptor_f9bef11f2a341f03, []int{39}
 }
 func (m *OperateSchemaRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2524,7 +2838,7 @@ func (m *OperateSchemaResponse) Reset()         { *m = OperateSchemaResponse{} }
 func (m *OperateSchemaResponse) String() string { return proto.CompactTextString(m) }
 func (*OperateSchemaResponse) ProtoMessage()    {}
 func (*OperateSchemaResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{36}
+	return fileDescriptor_f9bef11f2a341f03, []int{40}
 }
 func (m *OperateSchemaResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2583,7 +2897,7 @@ func (m *GetSubTaskCfgRequest) Reset()         { *m = GetSubTaskCfgRequest{} }
 func (m *GetSubTaskCfgRequest) String() string { return proto.CompactTextString(m) }
 func (*GetSubTaskCfgRequest) ProtoMessage()    {}
 func (*GetSubTaskCfgRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{37}
+	return fileDescriptor_f9bef11f2a341f03, []int{41}
 }
 func (m *GetSubTaskCfgRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2629,7 +2943,7 @@ func (m *GetSubTaskCfgResponse) Reset()         { *m = GetSubTaskCfgResponse{} }
 func (m *GetSubTaskCfgResponse) String() string { return proto.CompactTextString(m) }
 func (*GetSubTaskCfgResponse) ProtoMessage()    {}
 func (*GetSubTaskCfgResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{38}
+	return fileDescriptor_f9bef11f2a341f03, []int{42}
 }
 func (m *GetSubTaskCfgResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2688,7 +3002,7 @@ func (m *GetCfgRequest) Reset()         { *m = GetCfgRequest{} }
 func (m *GetCfgRequest) String() string { return proto.CompactTextString(m) }
 func (*GetCfgRequest) ProtoMessage()    {}
 func (*GetCfgRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{39}
+	return fileDescriptor_f9bef11f2a341f03, []int{43}
 }
 func (m *GetCfgRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2741,7 +3055,7 @@ func (m *GetCfgResponse) Reset()         { *m = GetCfgResponse{} }
 func (m *GetCfgResponse) String() string { return proto.CompactTextString(m) }
 func (*GetCfgResponse) ProtoMessage()    {}
 func (*GetCfgResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{40}
+	return fileDescriptor_f9bef11f2a341f03, []int{44}
 }
 func (m *GetCfgResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2798,7 +3112,7 @@ func (m *GetMasterCfgRequest) Reset()         { *m = GetMasterCfgRequest{} }
 func (m *GetMasterCfgRequest) String() string { return proto.CompactTextString(m) }
 func (*GetMasterCfgRequest) ProtoMessage()    {}
 func (*GetMasterCfgRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{41}
+	return fileDescriptor_f9bef11f2a341f03, []int{45}
 }
 func (m *GetMasterCfgRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2835,7 +3149,7 @@ func (m *GetMasterCfgResponse) Reset()         { *m = GetMasterCfgResponse{} }
 func (m *GetMasterCfgResponse) String() string { return proto.CompactTextString(m) }
 func (*GetMasterCfgResponse) ProtoMessage()    {}
 func (*GetMasterCfgResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{42}
+	return fileDescriptor_f9bef11f2a341f03, []int{46}
 }
 func (m *GetMasterCfgResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2872,18 +3186,23 @@ func (m *GetMasterCfgResponse) GetCfg() string {
 }
 
 type HandleErrorRequest struct {
-	Op        ErrorOp  `protobuf:"varint,1,opt,name=op,proto3,enum=pb.ErrorOp" json:"op,omitempty"`
-	Task      string   `protobuf:"bytes,2,opt,name=task,proto3" json:"task,omitempty"`
-	Sources   []string `protobuf:"bytes,3,rep,name=sources,proto3" json:"sources,omitempty"`
-	BinlogPos string   `protobuf:"bytes,4,opt,name=binlogPos,proto3" json:"binlogPos,omitempty"`
-	Sqls      []string `protobuf:"bytes,5,rep,name=sqls,proto3" json:"sqls,omitempty"`
+	Op         ErrorOp  `protobuf:"varint,1,opt,name=op,proto3,enum=pb.ErrorOp" json:"op,omitempty"`
+	Task       string   `protobuf:"bytes,2,opt,name=task,proto3" json:"task,omitempty"`
+	Sources    []string `protobuf:"bytes,3,rep,name=sources,proto3" json:"sources,omitempty"`
+	BinlogPos  string   `protobuf:"bytes,4,opt,name=binlogPos,proto3" json:"binlogPos,omitempty"`
+	Sqls       []string `protobuf:"bytes,5,rep,name=sqls,proto3" json:"sqls,omitempty"`
+	GtidSet    string   `protobuf:"bytes,6,opt,name=gtidSet,proto3" json:"gtidSet,omitempty"`
+	StartTime  string   `protobuf:"bytes,7,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	EndTime    string   `protobuf:"bytes,8,opt,name=endTime,proto3" json:"endTime,omitempty"`
+	DdlPattern string   `protobuf:"bytes,9,opt,name=ddlPattern,proto3" json:"ddlPattern,omitempty"`
+	Limit      int32    `protobuf:"varint,10,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
 func (m *HandleErrorRequest) Reset()         { *m = HandleErrorRequest{} }
 func (m *HandleErrorRequest) String() string { return proto.CompactTextString(m) }
 func (*HandleErrorRequest) ProtoMessage()    {}
 func (*HandleErrorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{43}
+	return fileDescriptor_f9bef11f2a341f03, []int{47}
 }
 func (m *HandleErrorRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2947,6 +3266,41 @@ func (m *HandleErrorRequest) GetSqls() []string {
 	return nil
 }
 
+func (m *HandleErrorRequest) GetGtidSet() string {
+	if m != nil {
+		return m.GtidSet
+	}
+	return ""
+}
+
+func (m *HandleErrorRequest) GetStartTime() string {
+	if m != nil {
+		return m.StartTime
+	}
+	return ""
+}
+
+func (m *HandleErrorRequest) GetEndTime() string {
+	if m != nil {
+		return m.EndTime
+	}
+	return ""
+}
+
+func (m *HandleErrorRequest) GetDdlPattern() string {
+	if m != nil {
+		return m.DdlPattern
+	}
+	return ""
+}
+
+func (m *HandleErrorRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
 type HandleErrorResponse struct {
 	Result  bool                    `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
 	Msg     string                  `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
@@ -2957,7 +3311,7 @@ func (m *HandleErrorResponse) Reset()         { *m = HandleErrorResponse{} }
 func (m *HandleErrorResponse) String() string { return proto.CompactTextString(m) }
 func (*HandleErrorResponse) ProtoMessage()    {}
 func (*HandleErrorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{44}
+	return fileDescriptor_f9bef11f2a341f03, []int{48}
 }
 func (m *HandleErrorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3016,7 +3370,7 @@ func (m *TransferSourceRequest) Reset()         { *m = TransferSourceRequest{} }
 func (m *TransferSourceRequest) String() string { return proto.CompactTextString(m) }
 func (*TransferSourceRequest) ProtoMessage()    {}
 func (*TransferSourceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{45}
+	return fileDescriptor_f9bef11f2a341f03, []int{49}
 }
 func (m *TransferSourceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3068,7 +3422,7 @@ func (m *TransferSourceResponse) Reset()         { *m = TransferSourceResponse{}
 func (m *TransferSourceResponse) String() string { return proto.CompactTextString(m) }
 func (*TransferSourceResponse) ProtoMessage()    {}
 func (*TransferSourceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{46}
+	return fileDescriptor_f9bef11f2a341f03, []int{50}
 }
 func (m *TransferSourceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3121,7 +3475,7 @@ func (m *OperateRelayRequest) Reset()         { *m = OperateRelayRequest{} }
 func (m *OperateRelayRequest) String() string { return proto.CompactTextString(m) }
 func (*OperateRelayRequest) ProtoMessage()    {}
 func (*OperateRelayRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{47}
+	return fileDescriptor_f9bef11f2a341f03, []int{51}
 }
 func (m *OperateRelayRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3181,7 +3535,7 @@ func (m *OperateRelayResponse) Reset()         { *m = OperateRelayResponse{} }
 func (m *OperateRelayResponse) String() string { return proto.CompactTextString(m) }
 func (*OperateRelayResponse) ProtoMessage()    {}
 func (*OperateRelayResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{48}
+	return fileDescriptor_f9bef11f2a341f03, []int{52}
 }
 func (m *OperateRelayResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3249,7 +3603,7 @@ func (m *StartValidationRequest) Reset()         { *m = StartValidationRequest{}
 func (m *StartValidationRequest) String() string { return proto.CompactTextString(m) }
 func (*StartValidationRequest) ProtoMessage()    {}
 func (*StartValidationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{49}
+	return fileDescriptor_f9bef11f2a341f03, []int{53}
 }
 func (m *StartValidationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3358,7 +3712,7 @@ func (m *StartValidationResponse) Reset()         { *m = StartValidationResponse
 func (m *StartValidationResponse) String() string { return proto.CompactTextString(m) }
 func (*StartValidationResponse) ProtoMessage()    {}
 func (*StartValidationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{50}
+	return fileDescriptor_f9bef11f2a341f03, []int{54}
 }
 func (m *StartValidationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3417,7 +3771,7 @@ func (m *StopValidationRequest) Reset()         { *m = StopValidationRequest{} }
 func (m *StopValidationRequest) String() string { return proto.CompactTextString(m) }
 func (*StopValidationRequest) ProtoMessage()    {}
 func (*StopValidationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{51}
+	return fileDescriptor_f9bef11f2a341f03, []int{55}
 }
 func (m *StopValidationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3470,7 +3824,7 @@ func (m *StopValidationResponse) Reset()         { *m = StopValidationResponse{}
 func (m *StopValidationResponse) String() string { return proto.CompactTextString(m) }
 func (*StopValidationResponse) ProtoMessage()    {}
 func (*StopValidationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{52}
+	return fileDescriptor_f9bef11f2a341f03, []int{56}
 }
 func (m *StopValidationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3539,6 +3893,10 @@ func init() {
 	proto.RegisterType((*ShowDDLLocksResponse)(nil), "pb.ShowDDLLocksResponse")
 	proto.RegisterType((*UnlockDDLLockRequest)(nil), "pb.UnlockDDLLockRequest")
 	proto.RegisterType((*UnlockDDLLockResponse)(nil), "pb.UnlockDDLLockResponse")
+	proto.RegisterType((*SimulateDDLLockRequest)(nil), "pb.SimulateDDLLockRequest")
+	proto.RegisterType((*SimulateShardTableResult)(nil), "pb.SimulateShardTableResult")
+	proto.RegisterType((*SimulateDDLLockResult)(nil), "pb.SimulateDDLLockResult")
+	proto.RegisterType((*SimulateDDLLockResponse)(nil), "pb.SimulateDDLLockResponse")
 	proto.RegisterType((*OperateWorkerRelayRequest)(nil), "pb.OperateWorkerRelayRequest")
 	proto.RegisterType((*OperateWorkerRelayResponse)(nil), "pb.OperateWorkerRelayResponse")
 	proto.RegisterType((*PurgeWorkerRelayRequest)(nil), "pb.PurgeWorkerRelayRequest")
@@ -3584,155 +3942,172 @@ func init() {
 func init() { proto.RegisterFile("dmmaster.proto", fileDescriptor_f9bef11f2a341f03) }
 
 var fileDescriptor_f9bef11f2a341f03 = []byte{
-	// 2366 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x3a, 0x4b, 0x6f, 0x1b, 0xc9,
-	0xd1, 0x1c, 0x92, 0xa2, 0xc8, 0xa2, 0x44, 0x53, 0x2d, 0x89, 0x1a, 0x8f, 0x65, 0x5a, 0xee, 0x7d,
-	0x40, 0x10, 0x3e, 0x58, 0xb0, 0xbe, 0x9c, 0x16, 0xd8, 0x20, 0x6b, 0xd1, 0x6b, 0x0b, 0x91, 0xd7,
-	0x9b, 0x91, 0xed, 0x64, 0x11, 0x20, 0x9b, 0x21, 0xd9, 0xa4, 0x08, 0x0d, 0x67, 0xc6, 0x33, 0x43,
-	0x69, 0x0d, 0x63, 0x73, 0xc8, 0x29, 0xa7, 0x3c, 0xb0, 0x41, 0xf6, 0x98, 0x43, 0xfe, 0x40, 0x7e,
-	0x46, 0x8e, 0x0b, 0xe4, 0x92, 0x4b, 0x80, 0xc0, 0xce, 0x3d, 0x7f, 0x21, 0xe8, 0xea, 0x9e, 0x99,
-	0x9e, 0x07, 0xb9, 0xe1, 0x02, 0x11, 0x72, 0x9b, 0xaa, 0x6a, 0xd6, 0xbb, 0xab, 0xab, 0x0a, 0x84,
-	0xd6, 0x70, 0x3a, 0xb5, 0x82, 0x90, 0xf9, 0xf7, 0x3c, 0xdf, 0x0d, 0x5d, 0x52, 0xf6, 0xfa, 0x46,
-	0x6b, 0x38, 0xbd, 0x72, 0xfd, 0x8b, 0x08, 0x67, 0xec, 0x8e, 0x5d, 0x77, 0x6c, 0xb3, 0x43, 0xcb,
-	0x9b, 0x1c, 0x5a, 0x8e, 0xe3, 0x86, 0x56, 0x38, 0x71, 0x9d, 0x40, 0x50, 0xe9, 0x2f, 0xa0, 0x7d,
-	0x16, 0x5a, 0x7e, 0xf8, 0xcc, 0x0a, 0x2e, 0x4c, 0xf6, 0x72, 0xc6, 0x82, 0x90, 0x10, 0xa8, 0x86,
-	0x56, 0x70, 0xa1, 0x6b, 0x7b, 0xda, 0x7e, 0xc3, 0xc4, 0x6f, 0xa2, 0xc3, 0x6a, 0xe0, 0xce, 0xfc,
-	0x01, 0x0b, 0xf4, 0xf2, 0x5e, 0x65, 0xbf, 0x61, 0x46, 0x20, 0xe9, 0x02, 0xf8, 0x6c, 0xea, 0x5e,
-	0xb2, 0x27, 0x2c, 0xb4, 0xf4, 0xca, 0x9e, 0xb6, 0x5f, 0x37, 0x15, 0x0c, 0xd9, 0x85, 0x46, 0x80,
-	0x12, 0x26, 0x53, 0xa6, 0x57, 0x91, 0x65, 0x82, 0xa0, 0x5f, 0x69, 0xb0, 0xa1, 0x28, 0x10, 0x78,
-	0xae, 0x13, 0x30, 0xd2, 0x81, 0x9a, 0xcf, 0x82, 0x99, 0x1d, 0xa2, 0x0e, 0x75, 0x53, 0x42, 0xa4,
-	0x0d, 0x95, 0x69, 0x30, 0xd6, 0xcb, 0xc8, 0x85, 0x7f, 0x92, 0xa3, 0x44, 0xaf, 0xca, 0x5e, 0x65,
-	0xbf, 0x79, 0xa4, 0xdf, 0xf3, 0xfa, 0xf7, 0x8e, 0xdd, 0xe9, 0xd4, 0x75, 0x7e, 0x8c, 0x6e, 0x88,
-	0x98, 0x26, 0x1a, 0xef, 0x41, 0x73, 0x70, 0xce, 0x06, 0x5c, 0x1c, 0x17, 0x21, 0x74, 0x52, 0x51,
-	0xf4, 0x67, 0x40, 0x9e, 0x7a, 0xcc, 0xb7, 0x42, 0xa6, 0xfa, 0xc5, 0x80, 0xb2, 0xeb, 0xa1, 0x46,
-	0xad, 0x23, 0xe0, 0x62, 0x38, 0xf1, 0xa9, 0x67, 0x96, 0x5d, 0x8f, 0xfb, 0xcc, 0xb1, 0xa6, 0x4c,
-	0xaa, 0x86, 0xdf, 0xaa, 0xcf, 0x2a, 0x29, 0x9f, 0xd1, 0xdf, 0x68, 0xb0, 0x99, 0x12, 0x20, 0xed,
-	0x5e, 0x24, 0x21, 0xf1, 0x49, 0xb9, 0xc8, 0x27, 0x95, 0x42, 0x9f, 0x54, 0xff, 0x43, 0x9f, 0xd0,
-	0x8f, 0x60, 0xe3, 0xb9, 0x37, 0xcc, 0x18, 0xbc, 0x54, 0x22, 0xd0, 0xdf, 0x6b, 0x40, 0x54, 0x1e,
-	0xff, 0x23, 0xb1, 0xfc, 0x18, 0x3a, 0x3f, 0x9a, 0x31, 0xff, 0xd5, 0x59, 0x68, 0x85, 0xb3, 0xe0,
-	0x74, 0x12, 0x84, 0x8a, 0x79, 0x18, 0x33, 0xad, 0x38, 0x66, 0x19, 0xf3, 0x2e, 0x61, 0x27, 0xc7,
-	0x67, 0x69, 0x13, 0xef, 0x67, 0x4d, 0xdc, 0xe1, 0x26, 0x2a, 0x7c, 0xf3, 0x91, 0x39, 0x86, 0xcd,
-	0xb3, 0x73, 0xf7, 0xaa, 0xd7, 0x3b, 0x3d, 0x75, 0x07, 0x17, 0xc1, 0x77, 0x8b, 0xcd, 0x1f, 0x35,
-	0x58, 0x95, 0x1c, 0x48, 0x0b, 0xca, 0x27, 0x3d, 0xf9, 0xbb, 0xf2, 0x49, 0x2f, 0xe6, 0x54, 0x56,
-	0x38, 0x11, 0xa8, 0x4e, 0xdd, 0x21, 0x93, 0x59, 0x85, 0xdf, 0x64, 0x0b, 0x56, 0xdc, 0x2b, 0x87,
-	0xf9, 0xd2, 0xc9, 0x02, 0xe0, 0x27, 0x7b, 0xbd, 0xd3, 0x40, 0x5f, 0x41, 0x81, 0xf8, 0xcd, 0xfd,
-	0x11, 0xbc, 0x72, 0x06, 0x6c, 0xa8, 0xd7, 0x10, 0x2b, 0x21, 0x62, 0x40, 0x7d, 0xe6, 0x48, 0xca,
-	0x2a, 0x52, 0x62, 0x98, 0x0e, 0x60, 0x2b, 0x6d, 0xe6, 0xd2, 0xbe, 0xbd, 0x0b, 0x2b, 0x36, 0xff,
-	0xa9, 0xf4, 0x6c, 0x93, 0x7b, 0x56, 0xb2, 0x33, 0x05, 0x85, 0xfe, 0x5d, 0x83, 0xad, 0xe7, 0x0e,
-	0xff, 0x8e, 0x08, 0xd2, 0x9b, 0x59, 0x9f, 0x50, 0x58, 0xf3, 0x99, 0x67, 0x5b, 0x03, 0xf6, 0x14,
-	0x4d, 0x16, 0x62, 0x52, 0x38, 0x9e, 0x7a, 0x23, 0xd7, 0x1f, 0x30, 0x13, 0x6b, 0x9d, 0xac, 0x7c,
-	0x2a, 0x8a, 0xbc, 0x83, 0xd7, 0xb9, 0x8a, 0xd7, 0x79, 0x93, 0xab, 0x93, 0x92, 0x2d, 0xef, 0xb5,
-	0x12, 0xb4, 0x95, 0x74, 0x65, 0x35, 0xa0, 0x3e, 0xb4, 0x42, 0xab, 0x6f, 0x05, 0x4c, 0xaf, 0xa1,
-	0x02, 0x31, 0xcc, 0x83, 0x11, 0x5a, 0x7d, 0x9b, 0xe9, 0xab, 0x22, 0x18, 0x08, 0xd0, 0x8f, 0x60,
-	0x3b, 0x63, 0xde, 0xb2, 0x5e, 0xa4, 0x26, 0xdc, 0x94, 0x95, 0x29, 0xba, 0x72, 0xb6, 0xf5, 0x2a,
-	0x72, 0xd3, 0x2d, 0xa5, 0x3e, 0xa1, 0x7f, 0x91, 0x9a, 0x37, 0x24, 0x93, 0x7d, 0x5f, 0x6b, 0x60,
-	0x14, 0x31, 0x95, 0xca, 0x2d, 0xe4, 0xfa, 0xdf, 0x2d, 0x7b, 0x5f, 0x6b, 0xb0, 0xf3, 0xe9, 0xcc,
-	0x1f, 0x17, 0x19, 0xab, 0xd8, 0xa3, 0xe5, 0x02, 0x33, 0x71, 0xac, 0x41, 0x38, 0xb9, 0x64, 0x52,
-	0xab, 0x18, 0xc6, 0xdb, 0xc4, 0x5f, 0x3a, 0xae, 0x58, 0xc5, 0xc4, 0x6f, 0x7e, 0x7e, 0x34, 0xb1,
-	0x19, 0x16, 0x1b, 0x71, 0x79, 0x62, 0x18, 0xef, 0xca, 0xac, 0xdf, 0x9b, 0xf8, 0xfa, 0x0a, 0x52,
-	0x24, 0x44, 0xbf, 0x00, 0x3d, 0xaf, 0xd8, 0x75, 0x94, 0x54, 0x7a, 0x09, 0xed, 0x63, 0x5e, 0x3f,
-	0xbf, 0xed, 0x25, 0xe8, 0x40, 0x8d, 0xf9, 0xfe, 0xb1, 0x23, 0x22, 0x53, 0x31, 0x25, 0xc4, 0xfd,
-	0x76, 0x65, 0xf9, 0x0e, 0x27, 0x08, 0x27, 0x44, 0xe0, 0xb7, 0xb4, 0x02, 0x1f, 0xc2, 0x86, 0x22,
-	0x77, 0xe9, 0xc4, 0xfd, 0x95, 0x06, 0x5b, 0x32, 0xc9, 0xce, 0xd0, 0x92, 0x48, 0xf7, 0x5d, 0x25,
-	0xbd, 0xd6, 0xb8, 0xf9, 0x82, 0x9c, 0xe4, 0xd7, 0xc0, 0x75, 0x46, 0x93, 0xb1, 0x4c, 0x5a, 0x09,
-	0xf1, 0x98, 0x09, 0x87, 0x9c, 0xf4, 0xe4, 0xeb, 0x1d, 0xc3, 0xbc, 0xe5, 0x11, 0x2d, 0xd6, 0x27,
-	0x49, 0x44, 0x15, 0x0c, 0x9d, 0xc1, 0x76, 0x46, 0x93, 0x6b, 0x09, 0xdc, 0x43, 0xd8, 0x36, 0xd9,
-	0x78, 0xc2, 0xfb, 0xc1, 0xe8, 0xc8, 0xc2, 0x87, 0xce, 0x1a, 0x0e, 0x7d, 0x16, 0x04, 0x52, 0x6c,
-	0x04, 0xd2, 0x07, 0xd0, 0xc9, 0xb2, 0x59, 0x3a, 0x18, 0xdf, 0x87, 0xad, 0xa7, 0xa3, 0x91, 0x3d,
-	0x71, 0xd8, 0x13, 0x36, 0xed, 0xa7, 0x34, 0x09, 0x5f, 0x79, 0xb1, 0x26, 0xfc, 0xbb, 0xa8, 0x75,
-	0xe2, 0x85, 0x2c, 0xf3, 0xfb, 0xa5, 0x55, 0xf8, 0x5e, 0x9c, 0x0e, 0xa7, 0xcc, 0x1a, 0x26, 0x2a,
-	0xe4, 0xd2, 0x41, 0x90, 0x45, 0x3a, 0xa0, 0xe0, 0xf4, 0xaf, 0x96, 0x16, 0xfc, 0x6b, 0x0d, 0xe0,
-	0x09, 0x76, 0xe5, 0x27, 0xce, 0xc8, 0x2d, 0x74, 0xbe, 0x01, 0xf5, 0x29, 0xda, 0x75, 0xd2, 0xc3,
-	0x5f, 0x56, 0xcd, 0x18, 0xe6, 0x95, 0xdd, 0xb2, 0x27, 0xf1, 0x83, 0x22, 0x00, 0xfe, 0x0b, 0x8f,
-	0x31, 0xff, 0xb9, 0x79, 0x2a, 0xaa, 0x5b, 0xc3, 0x8c, 0x61, 0x9e, 0x8e, 0x03, 0x7b, 0xc2, 0x9c,
-	0x10, 0xa9, 0xe2, 0x11, 0x51, 0x30, 0xb4, 0x0f, 0x20, 0x02, 0x39, 0x57, 0x1f, 0x02, 0x55, 0x1e,
-	0xfd, 0x28, 0x04, 0xfc, 0x9b, 0xeb, 0x11, 0x84, 0xd6, 0x38, 0xea, 0x01, 0x04, 0x80, 0xe5, 0x0a,
-	0xd3, 0x4d, 0xa6, 0xbd, 0x84, 0xe8, 0x29, 0xb4, 0x79, 0x4b, 0x24, 0x9c, 0x26, 0x62, 0x16, 0xb9,
-	0x46, 0x4b, 0xb2, 0xba, 0xa8, 0x4b, 0x8e, 0x64, 0x57, 0x12, 0xd9, 0xf4, 0x13, 0xc1, 0x4d, 0x78,
-	0x71, 0x2e, 0xb7, 0x7d, 0x58, 0x15, 0xd3, 0x8f, 0x78, 0x70, 0x9a, 0x47, 0x2d, 0x1e, 0xce, 0xc4,
-	0xf5, 0x66, 0x44, 0x8e, 0xf8, 0x09, 0x2f, 0x2c, 0xe2, 0x27, 0x2e, 0x71, 0x8a, 0x5f, 0xe2, 0x3a,
-	0x33, 0x22, 0xd3, 0x3f, 0x69, 0xb0, 0x2a, 0xd8, 0x04, 0xe4, 0x1e, 0xd4, 0x6c, 0xb4, 0x1a, 0x59,
-	0x35, 0x8f, 0xb6, 0x30, 0xa7, 0x32, 0xbe, 0x78, 0x5c, 0x32, 0xe5, 0x29, 0x7e, 0x5e, 0xa8, 0x85,
-	0x5e, 0x50, 0xce, 0xab, 0xd6, 0xf2, 0xf3, 0xe2, 0x14, 0x3f, 0x2f, 0xc4, 0xa2, 0x87, 0x94, 0xf3,
-	0xaa, 0x35, 0xfc, 0xbc, 0x38, 0xf5, 0xa0, 0x0e, 0x35, 0x91, 0x4b, 0xf4, 0x25, 0x6c, 0x20, 0xdf,
-	0xd4, 0x0d, 0xec, 0xa4, 0xd4, 0xad, 0xc7, 0x6a, 0x75, 0x52, 0x6a, 0xd5, 0x63, 0xf1, 0x9d, 0x94,
-	0xf8, 0x7a, 0x24, 0x86, 0xa7, 0x07, 0x0f, 0x5f, 0x94, 0x8d, 0x02, 0xa0, 0x0c, 0x88, 0x2a, 0x72,
-	0xe9, 0xb2, 0xf7, 0x1e, 0xac, 0x0a, 0xe5, 0x53, 0x5d, 0x9c, 0x74, 0xb5, 0x19, 0xd1, 0xe8, 0x1f,
-	0xca, 0x49, 0xad, 0x1f, 0x9c, 0xb3, 0xa9, 0x35, 0xbf, 0xd6, 0x23, 0x39, 0x19, 0xd2, 0x72, 0x9d,
-	0xee, 0xdc, 0x21, 0x2d, 0xd5, 0x7e, 0x55, 0xe7, 0xb5, 0x5f, 0x2b, 0x4a, 0xfb, 0x85, 0x97, 0x03,
-	0xe5, 0xc9, 0x76, 0x4d, 0x42, 0xfc, 0xf4, 0xc8, 0x9e, 0x05, 0xe7, 0xd8, 0xac, 0xd5, 0x4d, 0x01,
-	0x70, 0x6d, 0x78, 0xef, 0xab, 0xd7, 0x11, 0x89, 0xdf, 0xfc, 0x2a, 0x8f, 0x7c, 0x77, 0x2a, 0x9e,
-	0x0d, 0xbd, 0x21, 0x86, 0xe9, 0x04, 0x13, 0xd1, 0x9f, 0x59, 0xfe, 0x98, 0x85, 0x3a, 0x24, 0x74,
-	0x81, 0x51, 0x5f, 0x1e, 0xe9, 0x97, 0x6b, 0x79, 0x79, 0x0e, 0x60, 0xeb, 0x11, 0x0b, 0xcf, 0x66,
-	0x7d, 0xfe, 0x76, 0x1f, 0x8f, 0xc6, 0x0b, 0x1e, 0x1e, 0xfa, 0x1c, 0xb6, 0x33, 0x67, 0x97, 0x56,
-	0x91, 0x40, 0x75, 0x30, 0x1a, 0x47, 0x01, 0xc3, 0x6f, 0xda, 0x83, 0xf5, 0x47, 0x2c, 0x54, 0x64,
-	0xdf, 0x51, 0x9e, 0x1a, 0xd9, 0x57, 0x1e, 0x8f, 0xc6, 0xcf, 0x5e, 0x79, 0x6c, 0xc1, 0xbb, 0x73,
-	0x0a, 0xad, 0x88, 0xcb, 0xd2, 0x5a, 0xb5, 0xa1, 0x32, 0x18, 0xc5, 0x1d, 0xe9, 0x60, 0x34, 0xa6,
-	0xdb, 0xb0, 0xf9, 0x88, 0xc9, 0x7b, 0x9d, 0x68, 0x46, 0xf7, 0xd1, 0x5b, 0x0a, 0x5a, 0x8a, 0x92,
-	0x0c, 0xb4, 0x84, 0xc1, 0xef, 0x34, 0x20, 0x8f, 0x2d, 0x67, 0x68, 0xb3, 0x87, 0xbe, 0xef, 0xfa,
-	0x73, 0xdb, 0x70, 0xa4, 0x7e, 0xa7, 0x24, 0xdf, 0x85, 0x46, 0x7f, 0xe2, 0xd8, 0xee, 0xf8, 0x53,
-	0x37, 0x88, 0x5a, 0xb2, 0x18, 0x81, 0x29, 0xfa, 0xd2, 0x8e, 0x87, 0x3b, 0xfe, 0x4d, 0x03, 0xd8,
-	0x4c, 0xa9, 0x74, 0x2d, 0x09, 0xf6, 0x08, 0xb6, 0x9f, 0xf9, 0x96, 0x13, 0x8c, 0x98, 0x9f, 0x6e,
-	0xee, 0x92, 0xf7, 0x48, 0x53, 0xdf, 0x23, 0xa5, 0x6c, 0x09, 0xc9, 0x12, 0xe2, 0xcd, 0x4d, 0x96,
-	0xd1, 0xd2, 0x0f, 0xfc, 0x30, 0x5e, 0xde, 0xa4, 0xe6, 0x85, 0xdb, 0x4a, 0x54, 0xd6, 0x95, 0x31,
-	0xe6, 0xc5, 0x51, 0xd4, 0x68, 0x4a, 0x4d, 0xcb, 0x73, 0x34, 0x15, 0xa1, 0x89, 0x34, 0x0d, 0xe3,
-	0x12, 0x77, 0x9d, 0xcd, 0xff, 0x9f, 0x35, 0xe8, 0xe0, 0x3e, 0xee, 0x85, 0x65, 0x4f, 0x86, 0xb8,
-	0x2a, 0x4c, 0x2e, 0x14, 0x4c, 0xdd, 0x21, 0xfb, 0xfc, 0xd2, 0xb2, 0x67, 0xd2, 0xdd, 0x8f, 0x4b,
-	0x66, 0x83, 0xe3, 0x5e, 0x70, 0x14, 0x39, 0x80, 0x36, 0x76, 0xf3, 0x9f, 0xf3, 0xa1, 0x47, 0x1e,
-	0x43, 0x75, 0x1e, 0x6b, 0x66, 0x2b, 0xee, 0xf3, 0xc5, 0xd9, 0x85, 0x65, 0x97, 0xe7, 0xac, 0xd2,
-	0x5a, 0xc7, 0xf0, 0x83, 0x9a, 0x58, 0x4b, 0x3c, 0x68, 0x2a, 0x83, 0x04, 0xbd, 0x82, 0x9d, 0x9c,
-	0xc6, 0xd7, 0xe2, 0xab, 0x27, 0xb0, 0x7d, 0x16, 0xba, 0x5e, 0xde, 0x53, 0x0b, 0x27, 0xc7, 0xd8,
-	0xb8, 0x72, 0xda, 0x38, 0x7a, 0xc9, 0x3d, 0x9f, 0x66, 0x77, 0x1d, 0x66, 0x1c, 0xfc, 0x00, 0x6e,
-	0x64, 0xf6, 0x12, 0x64, 0x03, 0xd6, 0x4f, 0x9c, 0x4b, 0xae, 0x88, 0x40, 0xb4, 0x4b, 0x64, 0x0d,
-	0xea, 0x67, 0x17, 0x13, 0x8f, 0xc3, 0x6d, 0x8d, 0x43, 0x0f, 0xbf, 0x60, 0x03, 0x84, 0xca, 0x07,
-	0x7d, 0xa8, 0x47, 0x33, 0x15, 0xd9, 0x84, 0x1b, 0xf2, 0xa7, 0x11, 0xaa, 0x5d, 0x22, 0x37, 0xa0,
-	0x89, 0x21, 0x12, 0xa8, 0xb6, 0x46, 0xda, 0xb0, 0x26, 0x56, 0x85, 0x12, 0x53, 0x26, 0x2d, 0x00,
-	0x6e, 0xbd, 0x84, 0x2b, 0x08, 0x9f, 0xbb, 0x57, 0x12, 0xae, 0x1e, 0xfc, 0x10, 0xea, 0x51, 0xa3,
-	0xae, 0xc8, 0x88, 0x50, 0xed, 0x12, 0xd7, 0xf9, 0xe1, 0xe5, 0x64, 0x10, 0xc6, 0x28, 0x8d, 0xec,
-	0xc0, 0xe6, 0xb1, 0xe5, 0x0c, 0x98, 0x9d, 0x26, 0x94, 0x0f, 0x1c, 0x58, 0x95, 0x6f, 0x01, 0x57,
-	0x4d, 0xf2, 0xe2, 0xa0, 0x30, 0x94, 0xbf, 0x4c, 0x08, 0x69, 0x5c, 0x0d, 0x51, 0xa8, 0x11, 0x46,
-	0x35, 0x85, 0x1f, 0x11, 0x16, 0x6a, 0xa2, 0x8a, 0x08, 0x57, 0xc9, 0x16, 0xb4, 0xf1, 0xd7, 0x6c,
-	0xea, 0xd9, 0x56, 0x28, 0xb0, 0x2b, 0x07, 0x3d, 0x68, 0xc4, 0xc5, 0x80, 0x1f, 0x91, 0x12, 0x63,
-	0x5c, 0xbb, 0xc4, 0x3d, 0x82, 0x2e, 0x42, 0xdc, 0x8b, 0xa3, 0xb6, 0x26, 0x9c, 0xe6, 0x7a, 0x11,
-	0xa2, 0x7c, 0xf4, 0xaf, 0x0d, 0xa8, 0x09, 0x65, 0xc8, 0x67, 0xd0, 0x88, 0xb7, 0xe6, 0x04, 0x3b,
-	0xc2, 0xec, 0x16, 0xdf, 0xd8, 0xce, 0x60, 0x45, 0xd8, 0xe9, 0x9d, 0x5f, 0xfe, 0xf5, 0x9f, 0x5f,
-	0x95, 0x6f, 0xd2, 0xad, 0x43, 0xcb, 0x9b, 0x04, 0x87, 0x97, 0xf7, 0x2d, 0xdb, 0x3b, 0xb7, 0xee,
-	0x1f, 0xf2, 0x34, 0x0c, 0x3e, 0xd0, 0x0e, 0xc8, 0x08, 0x9a, 0xca, 0x6a, 0x9a, 0x74, 0x38, 0x9b,
-	0xfc, 0x32, 0xdc, 0xd8, 0xc9, 0xe1, 0xa5, 0x80, 0xf7, 0x51, 0xc0, 0x9e, 0x71, 0xab, 0x48, 0xc0,
-	0xe1, 0x6b, 0xfe, 0xcc, 0x7e, 0xc9, 0xe5, 0x7c, 0x08, 0x90, 0x6c, 0x8b, 0x09, 0x6a, 0x9b, 0xdb,
-	0x40, 0x1b, 0x9d, 0x2c, 0x5a, 0x0a, 0x29, 0x11, 0x1b, 0x9a, 0xca, 0xda, 0x94, 0x18, 0x99, 0x3d,
-	0xaa, 0xb2, 0xe7, 0x35, 0x6e, 0x15, 0xd2, 0x24, 0xa7, 0x77, 0x51, 0xdd, 0x2e, 0xd9, 0xcd, 0xa8,
-	0x1b, 0xe0, 0x51, 0xa9, 0x2f, 0x39, 0x86, 0x35, 0x75, 0x3b, 0x49, 0xd0, 0xfa, 0x82, 0xb5, 0xac,
-	0xa1, 0xe7, 0x09, 0xb1, 0xca, 0x1f, 0xc3, 0x7a, 0xea, 0xa2, 0x11, 0x3d, 0xb7, 0x13, 0x8c, 0xd8,
-	0xdc, 0x2c, 0xa0, 0xc4, 0x7c, 0x3e, 0x83, 0x4e, 0x7e, 0x9b, 0x86, 0x5e, 0xbc, 0xad, 0x04, 0x25,
-	0xbf, 0xd1, 0x32, 0xba, 0xf3, 0xc8, 0x31, 0xeb, 0xa7, 0xd0, 0xce, 0x6e, 0x9d, 0x08, 0xba, 0x6f,
-	0xce, 0x92, 0xcc, 0xd8, 0x2d, 0x26, 0xc6, 0x0c, 0x3f, 0x80, 0x46, 0xbc, 0xd4, 0x11, 0x89, 0x9a,
-	0xdd, 0x2d, 0x89, 0x44, 0xcd, 0x6d, 0x7e, 0x68, 0x89, 0x8c, 0x61, 0x3d, 0xb5, 0x46, 0x11, 0xfe,
-	0x2a, 0xda, 0xf1, 0x08, 0x7f, 0x15, 0xee, 0x5c, 0xe8, 0x5d, 0x0c, 0xf0, 0x2d, 0xa3, 0x93, 0x0d,
-	0xb0, 0x28, 0x7f, 0x3c, 0x15, 0x4f, 0xa0, 0x95, 0xde, 0x78, 0x90, 0x9b, 0xe2, 0xfd, 0x2e, 0x58,
-	0xa6, 0x18, 0x46, 0x11, 0x29, 0xd6, 0xd9, 0x87, 0xf5, 0xd4, 0xe2, 0x42, 0xea, 0x5c, 0xb0, 0x0b,
-	0x91, 0x3a, 0x17, 0x6d, 0x39, 0xe8, 0xff, 0xa1, 0xce, 0xef, 0x1f, 0xbc, 0x9b, 0xd1, 0x59, 0xce,
-	0x3f, 0x87, 0xaf, 0x79, 0x03, 0xfb, 0x65, 0x94, 0x9c, 0x17, 0xb1, 0x9f, 0x44, 0x89, 0x4b, 0xf9,
-	0x29, 0xb5, 0xfc, 0x48, 0xf9, 0x29, 0xbd, 0xe0, 0xa0, 0xef, 0xa1, 0xcc, 0x3b, 0x86, 0x91, 0x91,
-	0x29, 0xe6, 0xc3, 0xc3, 0xd7, 0xae, 0x87, 0xd7, 0xf6, 0xa7, 0x00, 0xc9, 0x84, 0x27, 0xae, 0x6d,
-	0x6e, 0xc8, 0x14, 0xd7, 0x36, 0x3f, 0x08, 0xd2, 0x2e, 0xca, 0xd0, 0x49, 0xa7, 0xd8, 0x2e, 0x32,
-	0x4a, 0x22, 0x2e, 0x26, 0xa7, 0x54, 0xc4, 0xd5, 0x49, 0x2f, 0x1d, 0xf1, 0xd4, 0xac, 0x43, 0xf7,
-	0x50, 0x8a, 0x61, 0x6c, 0x67, 0x23, 0x8e, 0xc7, 0xb8, 0x11, 0x36, 0x0e, 0x0b, 0xc9, 0x0c, 0x22,
-	0xe4, 0x14, 0x8d, 0x30, 0x42, 0x4e, 0xe1, 0xc0, 0x12, 0x55, 0x3a, 0xd2, 0xcd, 0xca, 0x99, 0xf5,
-	0xd5, 0x62, 0x47, 0x9e, 0x41, 0x4d, 0x0c, 0x15, 0x64, 0x43, 0x32, 0x53, 0xf8, 0x13, 0x15, 0x25,
-	0x19, 0xbf, 0x83, 0x8c, 0x6f, 0x93, 0x45, 0x25, 0x94, 0xfc, 0x1c, 0x9a, 0x4a, 0x1f, 0x2e, 0xea,
-	0x74, 0x7e, 0x56, 0x10, 0x75, 0xba, 0xa0, 0x61, 0x9f, 0xeb, 0x25, 0xc6, 0x4f, 0xe1, 0xb5, 0x38,
-	0x86, 0x35, 0x75, 0x4e, 0x11, 0x45, 0xaf, 0x60, 0xa0, 0x31, 0xf4, 0x3c, 0x21, 0xbe, 0x10, 0x27,
-	0xd0, 0x4a, 0x37, 0xdc, 0xe2, 0x6e, 0x15, 0x76, 0xf3, 0xe2, 0x6e, 0x15, 0xf7, 0xe7, 0xb4, 0xc4,
-	0xf5, 0x51, 0x3b, 0x62, 0xa2, 0x3e, 0x41, 0xa9, 0xa2, 0xa4, 0xe7, 0x09, 0x31, 0x93, 0x53, 0xb8,
-	0x91, 0xe9, 0x16, 0xc5, 0xdb, 0x51, 0xdc, 0xf4, 0x8a, 0xb7, 0x63, 0x4e, 0x7b, 0x29, 0xac, 0x4b,
-	0xf7, 0x6c, 0xc2, 0xba, 0xc2, 0xb6, 0xd0, 0x30, 0x8a, 0x48, 0x31, 0xab, 0x9f, 0xe0, 0xb0, 0x98,
-	0x90, 0xe4, 0xc3, 0xd6, 0x95, 0xbe, 0xcd, 0x12, 0x22, 0xa6, 0x77, 0xe6, 0xd2, 0x63, 0xce, 0xcf,
-	0x81, 0xa4, 0x0e, 0x88, 0x84, 0xb9, 0x9d, 0xfb, 0x61, 0x2a, 0x6f, 0xba, 0xf3, 0xc8, 0x31, 0x5b,
-	0x2b, 0x7e, 0x86, 0xb2, 0xac, 0xef, 0x2a, 0xfe, 0x9f, 0xc3, 0x9e, 0x2e, 0x3a, 0x12, 0x89, 0x78,
-	0xa0, 0xff, 0xe5, 0x4d, 0x57, 0xfb, 0xe6, 0x4d, 0x57, 0xfb, 0xc7, 0x9b, 0xae, 0xf6, 0xdb, 0xb7,
-	0xdd, 0xd2, 0x37, 0x6f, 0xbb, 0xa5, 0xbf, 0xbd, 0xed, 0x96, 0xfa, 0x35, 0xfc, 0xfb, 0xc2, 0xff,
-	0xff, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x85, 0xf5, 0x22, 0xfb, 0x02, 0x21, 0x00, 0x00,
+	// 2626 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x1a, 0x4d, 0x6f, 0x23, 0x49,
+	0xd5, 0x6d, 0x3b, 0x8e, 0xf3, 0xf2, 0x31, 0x4e, 0x25, 0x71, 0x7a, 0x7a, 0x32, 0x9e, 0x6c, 0xef,
+	0x87, 0xa2, 0x08, 0x4d, 0xb4, 0x61, 0x4f, 0x2b, 0x2d, 0x62, 0x27, 0x9e, 0x9d, 0x09, 0x78, 0x76,
+	0x86, 0x76, 0x66, 0x60, 0x85, 0xc4, 0xd2, 0xb6, 0xcb, 0x4e, 0x2b, 0xed, 0x6e, 0x4f, 0x77, 0x3b,
+	0xd9, 0xd1, 0x68, 0x39, 0x70, 0xe2, 0x04, 0x48, 0x8b, 0x58, 0xc1, 0x85, 0x03, 0x7f, 0x80, 0x1f,
+	0x00, 0x77, 0x6e, 0xac, 0xc4, 0x85, 0x0b, 0x12, 0x9a, 0xe1, 0x87, 0xa0, 0x7a, 0x55, 0xd5, 0x5d,
+	0xfd, 0x61, 0x2f, 0x5e, 0x89, 0x88, 0x5b, 0xbd, 0xf7, 0xaa, 0xdf, 0x57, 0xbd, 0xaa, 0xf7, 0x61,
+	0xc3, 0xc6, 0x60, 0x3c, 0xb6, 0xc3, 0x88, 0x06, 0x77, 0x27, 0x81, 0x1f, 0xf9, 0xa4, 0x3c, 0xe9,
+	0x19, 0x1b, 0x83, 0xf1, 0x95, 0x1f, 0x5c, 0x48, 0x9c, 0xb1, 0x37, 0xf2, 0xfd, 0x91, 0x4b, 0x8f,
+	0xec, 0x89, 0x73, 0x64, 0x7b, 0x9e, 0x1f, 0xd9, 0x91, 0xe3, 0x7b, 0x21, 0xa7, 0x9a, 0x3f, 0x83,
+	0x46, 0x37, 0xb2, 0x83, 0xe8, 0xcc, 0x0e, 0x2f, 0x2c, 0xfa, 0x7c, 0x4a, 0xc3, 0x88, 0x10, 0xa8,
+	0x46, 0x76, 0x78, 0xa1, 0x6b, 0xfb, 0xda, 0xc1, 0x8a, 0x85, 0x6b, 0xa2, 0xc3, 0x72, 0xe8, 0x4f,
+	0x83, 0x3e, 0x0d, 0xf5, 0xf2, 0x7e, 0xe5, 0x60, 0xc5, 0x92, 0x20, 0x69, 0x01, 0x04, 0x74, 0xec,
+	0x5f, 0xd2, 0x47, 0x34, 0xb2, 0xf5, 0xca, 0xbe, 0x76, 0x50, 0xb7, 0x14, 0x0c, 0xd9, 0x83, 0x95,
+	0x10, 0x25, 0x38, 0x63, 0xaa, 0x57, 0x91, 0x65, 0x82, 0x30, 0xbf, 0xd0, 0x60, 0x53, 0x51, 0x20,
+	0x9c, 0xf8, 0x5e, 0x48, 0x49, 0x13, 0x6a, 0x01, 0x0d, 0xa7, 0x6e, 0x84, 0x3a, 0xd4, 0x2d, 0x01,
+	0x91, 0x06, 0x54, 0xc6, 0xe1, 0x48, 0x2f, 0x23, 0x17, 0xb6, 0x24, 0xc7, 0x89, 0x5e, 0x95, 0xfd,
+	0xca, 0xc1, 0xea, 0xb1, 0x7e, 0x77, 0xd2, 0xbb, 0x7b, 0xe2, 0x8f, 0xc7, 0xbe, 0xf7, 0x43, 0x74,
+	0x83, 0x64, 0x9a, 0x68, 0xbc, 0x0f, 0xab, 0xfd, 0x73, 0xda, 0x67, 0xe2, 0x98, 0x08, 0xae, 0x93,
+	0x8a, 0x32, 0x7f, 0x02, 0xe4, 0xf1, 0x84, 0x06, 0x76, 0x44, 0x55, 0xbf, 0x18, 0x50, 0xf6, 0x27,
+	0xa8, 0xd1, 0xc6, 0x31, 0x30, 0x31, 0x8c, 0xf8, 0x78, 0x62, 0x95, 0xfd, 0x09, 0xf3, 0x99, 0x67,
+	0x8f, 0xa9, 0x50, 0x0d, 0xd7, 0xaa, 0xcf, 0x2a, 0x29, 0x9f, 0x99, 0xbf, 0xd2, 0x60, 0x2b, 0x25,
+	0x40, 0xd8, 0x3d, 0x4f, 0x42, 0xe2, 0x93, 0x72, 0x91, 0x4f, 0x2a, 0x85, 0x3e, 0xa9, 0xfe, 0x97,
+	0x3e, 0x31, 0x3f, 0x84, 0xcd, 0xa7, 0x93, 0x41, 0xc6, 0xe0, 0x85, 0x02, 0xc1, 0xfc, 0x8d, 0x06,
+	0x44, 0xe5, 0xf1, 0x7f, 0x72, 0x96, 0x1f, 0x41, 0xf3, 0x07, 0x53, 0x1a, 0xbc, 0xe8, 0x46, 0x76,
+	0x34, 0x0d, 0x3b, 0x4e, 0x18, 0x29, 0xe6, 0xe1, 0x99, 0x69, 0xc5, 0x67, 0x96, 0x31, 0xef, 0x12,
+	0x76, 0x73, 0x7c, 0x16, 0x36, 0xf1, 0xdd, 0xac, 0x89, 0xbb, 0xcc, 0x44, 0x85, 0x6f, 0xfe, 0x64,
+	0x4e, 0x60, 0xab, 0x7b, 0xee, 0x5f, 0xb5, 0xdb, 0x9d, 0x8e, 0xdf, 0xbf, 0x08, 0xbf, 0xd9, 0xd9,
+	0xfc, 0x41, 0x83, 0x65, 0xc1, 0x81, 0x6c, 0x40, 0xf9, 0xb4, 0x2d, 0xbe, 0x2b, 0x9f, 0xb6, 0x63,
+	0x4e, 0x65, 0x85, 0x13, 0x81, 0xea, 0xd8, 0x1f, 0x50, 0x11, 0x55, 0xb8, 0x26, 0xdb, 0xb0, 0xe4,
+	0x5f, 0x79, 0x34, 0x10, 0x4e, 0xe6, 0x00, 0xdb, 0xd9, 0x6e, 0x77, 0x42, 0x7d, 0x09, 0x05, 0xe2,
+	0x9a, 0xf9, 0x23, 0x7c, 0xe1, 0xf5, 0xe9, 0x40, 0xaf, 0x21, 0x56, 0x40, 0xc4, 0x80, 0xfa, 0xd4,
+	0x13, 0x94, 0x65, 0xa4, 0xc4, 0xb0, 0xd9, 0x87, 0xed, 0xb4, 0x99, 0x0b, 0xfb, 0xf6, 0x0d, 0x58,
+	0x72, 0xd9, 0xa7, 0xc2, 0xb3, 0xab, 0xcc, 0xb3, 0x82, 0x9d, 0xc5, 0x29, 0xe6, 0x3f, 0x35, 0xd8,
+	0x7e, 0xea, 0xb1, 0xb5, 0x24, 0x08, 0x6f, 0x66, 0x7d, 0x62, 0xc2, 0x5a, 0x40, 0x27, 0xae, 0xdd,
+	0xa7, 0x8f, 0xd1, 0x64, 0x2e, 0x26, 0x85, 0x63, 0xa1, 0x37, 0xf4, 0x83, 0x3e, 0xb5, 0xf0, 0xad,
+	0x13, 0x2f, 0x9f, 0x8a, 0x22, 0x6f, 0xe2, 0x75, 0xae, 0xe2, 0x75, 0xde, 0x62, 0xea, 0xa4, 0x64,
+	0x8b, 0x7b, 0xad, 0x1c, 0xda, 0x52, 0xfa, 0x65, 0x35, 0xa0, 0x3e, 0xb0, 0x23, 0xbb, 0x67, 0x87,
+	0x54, 0xaf, 0xa1, 0x02, 0x31, 0xcc, 0x0e, 0x23, 0xb2, 0x7b, 0x2e, 0xd5, 0x97, 0xf9, 0x61, 0x20,
+	0x60, 0x7e, 0x08, 0x3b, 0x19, 0xf3, 0x16, 0xf5, 0xa2, 0xf9, 0x7b, 0x0d, 0x9a, 0x5d, 0x67, 0x3c,
+	0x75, 0xed, 0x88, 0x66, 0x9c, 0x54, 0x14, 0x72, 0x0d, 0xa8, 0xb4, 0xdb, 0x1d, 0xc9, 0xa0, 0xdd,
+	0xee, 0x08, 0x57, 0x56, 0x62, 0x57, 0xea, 0xe9, 0xd7, 0x68, 0x86, 0x7d, 0x4b, 0xb3, 0xec, 0xab,
+	0xa9, 0xf6, 0xfd, 0x59, 0x03, 0x5d, 0x2a, 0xd7, 0x3d, 0xb7, 0x83, 0xc1, 0x19, 0x43, 0xf3, 0x8b,
+	0x8e, 0x51, 0x87, 0x9c, 0x85, 0x82, 0x02, 0x42, 0x7c, 0xff, 0x9c, 0x8e, 0x6d, 0xa1, 0xa5, 0x80,
+	0x12, 0x11, 0x15, 0x45, 0x04, 0x79, 0x0b, 0xd6, 0xfb, 0xbe, 0x37, 0x74, 0x9d, 0x7e, 0xd4, 0x8d,
+	0xec, 0x91, 0x4c, 0x59, 0x69, 0x24, 0x3e, 0x3b, 0x02, 0xf1, 0x28, 0x1c, 0x09, 0xed, 0x55, 0x54,
+	0x7c, 0x2f, 0x6a, 0xc9, 0xbd, 0x30, 0xff, 0xa6, 0xc1, 0x4e, 0xce, 0xb7, 0xa8, 0x7b, 0x36, 0xfe,
+	0x0c, 0xa8, 0x4b, 0x66, 0xe2, 0xb9, 0x8f, 0x61, 0x96, 0x50, 0x7d, 0x77, 0xf0, 0x3d, 0xdf, 0xf1,
+	0xe8, 0x40, 0xe8, 0x9e, 0x20, 0x18, 0xd5, 0xa3, 0x57, 0x82, 0x2a, 0xd2, 0x6d, 0x8c, 0xc0, 0xc3,
+	0x88, 0x6c, 0xd7, 0xa5, 0x83, 0x38, 0xd8, 0x38, 0x48, 0xde, 0x83, 0x1a, 0x3a, 0x80, 0x6b, 0xbc,
+	0x7a, 0xbc, 0xc7, 0xe2, 0x75, 0x96, 0xaf, 0x2d, 0xb1, 0xd7, 0x8c, 0x60, 0x37, 0x6f, 0xd0, 0xa2,
+	0x17, 0xf7, 0x28, 0x7d, 0x71, 0x6f, 0xaa, 0x92, 0x53, 0x6e, 0x92, 0xd7, 0xd8, 0x82, 0x9b, 0x22,
+	0x7b, 0xca, 0xb4, 0xe0, 0xda, 0x2f, 0x64, 0x94, 0xde, 0x52, 0x72, 0x28, 0xbe, 0x01, 0x48, 0xcd,
+	0x5f, 0xb6, 0xcc, 0x0b, 0xf9, 0xa5, 0x06, 0x46, 0x11, 0x53, 0x61, 0xcd, 0x5c, 0xae, 0xff, 0xdb,
+	0xd4, 0xfc, 0xa5, 0x06, 0xbb, 0x4f, 0xa6, 0xc1, 0xa8, 0xc8, 0x58, 0xc5, 0x1e, 0x2d, 0x77, 0xb9,
+	0x1c, 0xcf, 0xee, 0x47, 0xce, 0x25, 0x95, 0x11, 0x24, 0x61, 0xbc, 0xc8, 0xac, 0x1a, 0x63, 0x8a,
+	0x55, 0x2c, 0x5c, 0xb3, 0xfd, 0x43, 0xc7, 0xa5, 0x98, 0x10, 0x79, 0xd8, 0xc4, 0x30, 0xde, 0xa0,
+	0x69, 0xaf, 0xed, 0x04, 0x22, 0xd0, 0x05, 0x64, 0x7e, 0x06, 0x7a, 0x5e, 0xb1, 0xeb, 0x48, 0xfb,
+	0xe6, 0x25, 0x34, 0x4e, 0x58, 0x8e, 0xff, 0xba, 0x6a, 0xa5, 0x09, 0x35, 0x1a, 0x04, 0x27, 0x1e,
+	0x3f, 0x99, 0x8a, 0x25, 0x20, 0xe6, 0xb7, 0x2b, 0x3b, 0xf0, 0x18, 0x81, 0x3b, 0x41, 0x82, 0x5f,
+	0x53, 0xae, 0x7e, 0x00, 0x9b, 0x8a, 0xdc, 0x85, 0x1f, 0xd7, 0x5f, 0x68, 0xb0, 0x2d, 0x82, 0xac,
+	0x8b, 0x96, 0x48, 0xdd, 0xf7, 0x94, 0xf0, 0x5a, 0xc3, 0xf8, 0x47, 0x72, 0x12, 0x5f, 0xec, 0xf6,
+	0x3b, 0x23, 0x11, 0xb4, 0x02, 0x62, 0x67, 0xc6, 0x1d, 0x82, 0x0f, 0x2e, 0xe6, 0x53, 0x09, 0xb3,
+	0xb2, 0x9c, 0xb7, 0x01, 0x1f, 0x27, 0x27, 0xaa, 0x60, 0xcc, 0x29, 0xec, 0x64, 0x34, 0xb9, 0x96,
+	0x83, 0xbb, 0x0f, 0x3b, 0x16, 0x1d, 0x39, 0xac, 0x67, 0x91, 0x5b, 0xe6, 0x16, 0x63, 0xf6, 0x60,
+	0x10, 0xd0, 0x30, 0x14, 0x62, 0x25, 0x68, 0xde, 0x83, 0x66, 0x96, 0xcd, 0xc2, 0x87, 0xf1, 0x1d,
+	0xd8, 0x7e, 0x3c, 0x1c, 0xba, 0x8e, 0x47, 0x1f, 0xd1, 0x71, 0x2f, 0xa5, 0x49, 0xf4, 0x62, 0x12,
+	0x6b, 0xc2, 0xd6, 0x45, 0xe5, 0x3d, 0x4b, 0xb6, 0x99, 0xef, 0x17, 0x56, 0xe1, 0xbd, 0x38, 0x1c,
+	0x3a, 0xd4, 0x1e, 0x24, 0x2a, 0xe4, 0xc2, 0x81, 0x93, 0x79, 0x38, 0xa0, 0xe0, 0xf4, 0x57, 0x0b,
+	0x0b, 0xfe, 0xa5, 0x06, 0xf0, 0x08, 0x3b, 0xc7, 0x53, 0x6f, 0xe8, 0x17, 0x3a, 0xdf, 0x80, 0xfa,
+	0x18, 0xed, 0x3a, 0x6d, 0xe3, 0x97, 0x55, 0x2b, 0x86, 0x59, 0xea, 0xb4, 0x5d, 0x27, 0x2e, 0x7a,
+	0x38, 0xc0, 0xbe, 0x98, 0x50, 0x1a, 0x3c, 0xb5, 0x3a, 0x32, 0xd5, 0xc7, 0x30, 0x0b, 0xc7, 0xbe,
+	0xeb, 0x50, 0x2f, 0x42, 0x2a, 0xcf, 0x3d, 0x0a, 0xc6, 0xec, 0x01, 0xf0, 0x83, 0x9c, 0xa9, 0x0f,
+	0x81, 0x2a, 0x3b, 0x7d, 0x79, 0x04, 0x6c, 0xcd, 0xf4, 0x08, 0x31, 0x49, 0x8b, 0x14, 0x8e, 0x80,
+	0x52, 0x08, 0x54, 0xd5, 0x42, 0xc0, 0xec, 0x40, 0x83, 0x95, 0xed, 0xdc, 0x69, 0xfc, 0xcc, 0xa4,
+	0x6b, 0xb4, 0x24, 0xaa, 0x8b, 0x3a, 0x39, 0x29, 0xbb, 0x92, 0xc8, 0x36, 0x3f, 0xe6, 0xdc, 0xb8,
+	0x17, 0x67, 0x72, 0x3b, 0x80, 0x65, 0xde, 0xa1, 0xf3, 0x84, 0xb3, 0x7a, 0xbc, 0xc1, 0x8e, 0x33,
+	0x71, 0xbd, 0x25, 0xc9, 0x92, 0x1f, 0xf7, 0xc2, 0x3c, 0x7e, 0xfc, 0x12, 0xa7, 0xf8, 0x25, 0xae,
+	0xb3, 0x24, 0xd9, 0xfc, 0xa3, 0x06, 0xcb, 0x9c, 0x4d, 0x48, 0xee, 0x42, 0xcd, 0x45, 0xab, 0x91,
+	0xd5, 0xea, 0xf1, 0x36, 0xc6, 0x54, 0xc6, 0x17, 0x0f, 0x4b, 0x96, 0xd8, 0xc5, 0xf6, 0x73, 0xb5,
+	0xd0, 0x0b, 0xca, 0x7e, 0xd5, 0x5a, 0xb6, 0x9f, 0xef, 0x62, 0xfb, 0xb9, 0x58, 0xf4, 0x90, 0xb2,
+	0x5f, 0xb5, 0x86, 0xed, 0xe7, 0xbb, 0xee, 0xd5, 0xa1, 0xc6, 0x63, 0xc9, 0x7c, 0x0e, 0x9b, 0xc8,
+	0x37, 0x75, 0x03, 0x9b, 0x29, 0x75, 0xeb, 0xb1, 0x5a, 0xcd, 0x94, 0x5a, 0xf5, 0x58, 0x7c, 0x33,
+	0x25, 0xbe, 0x2e, 0xc5, 0xb0, 0xf0, 0x60, 0xc7, 0x27, 0xa3, 0x91, 0x03, 0x26, 0x05, 0xa2, 0x8a,
+	0x5c, 0xf8, 0xd9, 0x7b, 0x1b, 0x96, 0xb9, 0xf2, 0xa9, 0x4e, 0x43, 0xb8, 0xda, 0x92, 0x34, 0xf3,
+	0xb7, 0xe5, 0xe4, 0xad, 0xc7, 0x82, 0x73, 0xf6, 0x5b, 0x8f, 0xe4, 0x64, 0x90, 0x90, 0xeb, 0xc6,
+	0x66, 0x0e, 0x12, 0x52, 0x25, 0x74, 0x75, 0x56, 0x09, 0xbd, 0xa4, 0xd6, 0xb7, 0x49, 0x35, 0x5c,
+	0xcb, 0x56, 0xc3, 0x43, 0x77, 0x1a, 0x9e, 0x63, 0x43, 0x51, 0xb7, 0x38, 0xc0, 0xb4, 0x61, 0xfd,
+	0x99, 0x5e, 0x47, 0x24, 0xae, 0xd9, 0x55, 0x1e, 0x06, 0xfe, 0x98, 0xa7, 0x0d, 0x7d, 0x85, 0x0f,
+	0x7c, 0x12, 0x8c, 0xa4, 0x9f, 0xd9, 0xc1, 0x88, 0x46, 0x3a, 0x24, 0x74, 0x8e, 0x51, 0x33, 0x8f,
+	0xf0, 0xcb, 0xb5, 0x64, 0x9e, 0x43, 0xd8, 0x7e, 0x40, 0xa3, 0xee, 0xb4, 0xc7, 0x72, 0xf7, 0xc9,
+	0x70, 0x34, 0x27, 0xf1, 0x98, 0x4f, 0x61, 0x27, 0xb3, 0x77, 0x61, 0x15, 0x09, 0x54, 0xfb, 0xc3,
+	0x91, 0x3c, 0x30, 0x5c, 0x9b, 0x6d, 0x58, 0x7f, 0x40, 0x23, 0x45, 0xf6, 0x1d, 0x25, 0xd5, 0x88,
+	0xba, 0xf2, 0x64, 0x38, 0x3a, 0x7b, 0x31, 0xa1, 0x73, 0xf2, 0x4e, 0x07, 0x36, 0x24, 0x97, 0x85,
+	0xb5, 0x6a, 0x40, 0xa5, 0x3f, 0x8c, 0x2b, 0xd2, 0xfe, 0x70, 0x64, 0xee, 0xc0, 0xd6, 0x03, 0x2a,
+	0xee, 0x75, 0xa2, 0x99, 0x79, 0x80, 0xde, 0x52, 0xd0, 0x42, 0x94, 0x60, 0xa0, 0x25, 0x0c, 0x7e,
+	0x57, 0x06, 0xf2, 0xd0, 0xf6, 0x06, 0x2e, 0xbd, 0x1f, 0x04, 0x7e, 0x30, 0xb3, 0x0c, 0x47, 0xea,
+	0x37, 0x0a, 0xf2, 0x3d, 0x58, 0xe9, 0x39, 0x9e, 0xeb, 0x8f, 0x9e, 0xf8, 0xa1, 0x2c, 0xc9, 0x62,
+	0x04, 0x86, 0xe8, 0x73, 0x37, 0x1e, 0x40, 0xb0, 0x35, 0xe3, 0x35, 0x8a, 0x9c, 0x41, 0x97, 0x46,
+	0x22, 0xca, 0x25, 0x98, 0x2e, 0xef, 0x96, 0x33, 0xe5, 0x1d, 0xfb, 0x8e, 0x7a, 0x03, 0xa4, 0xd5,
+	0xf9, 0x77, 0x02, 0x64, 0x41, 0x3d, 0x18, 0xb8, 0x4f, 0xec, 0x28, 0xa2, 0x81, 0x87, 0x41, 0xbf,
+	0x62, 0x29, 0x18, 0x76, 0x7d, 0x5c, 0x67, 0xec, 0xf0, 0x78, 0x5f, 0xb2, 0x38, 0x60, 0x86, 0xb0,
+	0x95, 0x72, 0xcd, 0xb5, 0x04, 0xfa, 0x03, 0xd8, 0x39, 0x0b, 0x6c, 0x2f, 0x1c, 0xd2, 0x20, 0x5d,
+	0x64, 0xce, 0x69, 0x90, 0xc5, 0xf3, 0x29, 0x1a, 0x64, 0x0e, 0xb1, 0x22, 0x2b, 0xcb, 0x68, 0xe1,
+	0x42, 0x63, 0x10, 0x0f, 0x3a, 0x53, 0x7d, 0xcb, 0x6d, 0x25, 0x3a, 0xd6, 0x95, 0x76, 0xea, 0xd9,
+	0xb1, 0x2c, 0x78, 0x85, 0xa6, 0xe5, 0x19, 0x9a, 0xf2, 0x10, 0x91, 0x9a, 0x46, 0xf1, 0x53, 0x7b,
+	0x9d, 0x4d, 0xc8, 0x9f, 0x34, 0x68, 0xe2, 0xec, 0xfa, 0x99, 0xed, 0x3a, 0x03, 0x1c, 0xab, 0x27,
+	0x17, 0x1b, 0xc6, 0xfe, 0x80, 0x7e, 0x7a, 0x69, 0xbb, 0x53, 0xe1, 0xee, 0x87, 0x25, 0x6b, 0x85,
+	0xe1, 0x9e, 0x31, 0x14, 0x39, 0x84, 0x06, 0x86, 0xdd, 0xa7, 0xac, 0xf9, 0x12, 0xdb, 0x50, 0x9d,
+	0x87, 0x9a, 0xb5, 0x11, 0x07, 0x24, 0xdf, 0x3b, 0xf7, 0xf9, 0x67, 0x77, 0x47, 0x29, 0xf1, 0x63,
+	0xf8, 0x5e, 0x8d, 0x8f, 0xf0, 0xee, 0xad, 0x2a, 0x11, 0x6f, 0x5e, 0xc1, 0x6e, 0x4e, 0xe3, 0x6b,
+	0xf1, 0xd5, 0x23, 0xd8, 0xe9, 0x46, 0xfe, 0x24, 0xef, 0xa9, 0xb9, 0x1d, 0x6c, 0x6c, 0x5c, 0x39,
+	0x6d, 0x9c, 0x79, 0xc9, 0x3c, 0x9f, 0x66, 0x77, 0x1d, 0x66, 0x1c, 0x7e, 0x17, 0x6e, 0x64, 0x66,
+	0x78, 0x64, 0x13, 0xd6, 0x4f, 0xbd, 0x4b, 0xa6, 0x08, 0x47, 0x34, 0x4a, 0x64, 0x0d, 0xea, 0xdd,
+	0x0b, 0x67, 0xc2, 0xe0, 0x86, 0xc6, 0xa0, 0xfb, 0x9f, 0xd1, 0x3e, 0x42, 0xe5, 0xc3, 0x1e, 0xd4,
+	0x65, 0x6f, 0x47, 0xb6, 0xe0, 0x86, 0xf8, 0x54, 0xa2, 0x1a, 0x25, 0x72, 0x03, 0x56, 0xf1, 0x88,
+	0x38, 0xaa, 0xa1, 0x91, 0x06, 0xac, 0xf1, 0xb1, 0xba, 0xc0, 0x94, 0xc9, 0x06, 0x00, 0xb3, 0x5e,
+	0xc0, 0x15, 0x84, 0xcf, 0xfd, 0x2b, 0x01, 0x57, 0x0f, 0xbf, 0x0f, 0x75, 0xd9, 0x30, 0x28, 0x32,
+	0x24, 0xaa, 0x51, 0x62, 0x3a, 0xdf, 0xbf, 0x74, 0xfa, 0x51, 0x8c, 0xd2, 0xc8, 0x2e, 0x6c, 0x9d,
+	0xd8, 0x5e, 0x9f, 0xba, 0x69, 0x42, 0xf9, 0xd0, 0x83, 0x65, 0x91, 0x93, 0x98, 0x6a, 0x82, 0x17,
+	0x03, 0xb9, 0xa1, 0x2c, 0x43, 0x22, 0xa4, 0x31, 0x35, 0x78, 0xc2, 0x40, 0x18, 0xd5, 0xe4, 0x7e,
+	0x44, 0x98, 0xab, 0x89, 0x2a, 0x22, 0x5c, 0x25, 0xdb, 0xd0, 0xc0, 0xaf, 0xe9, 0x78, 0xe2, 0xda,
+	0x11, 0xc7, 0x2e, 0x1d, 0xb6, 0x61, 0x25, 0x7e, 0x0c, 0xd8, 0x16, 0x21, 0x31, 0xc6, 0x35, 0x4a,
+	0xcc, 0x23, 0xe8, 0x22, 0xc4, 0x3d, 0x3b, 0x6e, 0x68, 0xdc, 0x69, 0xfe, 0x44, 0x22, 0xca, 0xc7,
+	0x7f, 0x21, 0x50, 0xe3, 0xca, 0x90, 0x4f, 0x60, 0x25, 0xfe, 0x85, 0x89, 0x60, 0x65, 0x9a, 0xfd,
+	0xc5, 0xcb, 0xd8, 0xc9, 0x60, 0xf9, 0xb1, 0x9b, 0x77, 0x7e, 0xfe, 0xf7, 0x7f, 0x7f, 0x51, 0xbe,
+	0x69, 0x6e, 0x1f, 0xd9, 0x13, 0x27, 0x3c, 0xba, 0x7c, 0xd7, 0x76, 0x27, 0xe7, 0xf6, 0xbb, 0x47,
+	0x2c, 0x0c, 0xc3, 0xf7, 0xb5, 0x43, 0x32, 0x84, 0x55, 0xe5, 0x67, 0x1c, 0xd2, 0x64, 0x6c, 0xf2,
+	0x3f, 0x1c, 0x19, 0xbb, 0x39, 0xbc, 0x10, 0xf0, 0x0e, 0x0a, 0xd8, 0x37, 0x6e, 0x15, 0x09, 0x38,
+	0x7a, 0xc9, 0xd2, 0xfd, 0xe7, 0x4c, 0xce, 0x07, 0x00, 0xc9, 0x2f, 0x2b, 0x04, 0xb5, 0xcd, 0xfd,
+	0x5a, 0x63, 0x34, 0xb3, 0x68, 0x21, 0xa4, 0x44, 0x5c, 0x58, 0x55, 0x7e, 0x62, 0x20, 0x46, 0xe6,
+	0x37, 0x07, 0xe5, 0x37, 0x11, 0xe3, 0x56, 0x21, 0x4d, 0x70, 0x7a, 0x0b, 0xd5, 0x6d, 0x91, 0xbd,
+	0x8c, 0xba, 0x21, 0x6e, 0x15, 0xfa, 0x92, 0x13, 0x58, 0x53, 0x27, 0xf9, 0x04, 0xad, 0x2f, 0xf8,
+	0x09, 0xc3, 0xd0, 0xf3, 0x84, 0x58, 0xe5, 0x8f, 0x60, 0x3d, 0x75, 0xd1, 0x88, 0x9e, 0x9b, 0x9f,
+	0x4b, 0x36, 0x37, 0x0b, 0x28, 0x31, 0x9f, 0x0e, 0xdc, 0xc8, 0x8c, 0x12, 0xb9, 0xf9, 0xc5, 0x23,
+	0x6e, 0x6e, 0xfe, 0x8c, 0x89, 0xa6, 0x59, 0x22, 0x9f, 0x40, 0x33, 0x3f, 0x23, 0xc4, 0x33, 0xb9,
+	0xad, 0x1c, 0x71, 0x7e, 0x4e, 0x67, 0xb4, 0x66, 0x91, 0x63, 0xd6, 0x8f, 0xa1, 0x91, 0x9d, 0xa5,
+	0x11, 0xd4, 0x66, 0xc6, 0xe8, 0xcf, 0xd8, 0x2b, 0x26, 0xc6, 0x0c, 0xdf, 0x87, 0x95, 0x78, 0x54,
+	0xc5, 0xc3, 0x3e, 0x3b, 0x31, 0xe3, 0x61, 0x9f, 0x9b, 0x67, 0x99, 0x25, 0x32, 0x82, 0xf5, 0xd4,
+	0x70, 0x88, 0x7b, 0xbf, 0x68, 0x72, 0xc5, 0xbd, 0x5f, 0x38, 0x49, 0x32, 0xdf, 0xc0, 0x70, 0xb9,
+	0x65, 0x34, 0xb3, 0xe1, 0xc2, 0x1f, 0x53, 0x16, 0xd8, 0xa7, 0xb0, 0x91, 0x9e, 0xe3, 0x90, 0x9b,
+	0xbc, 0x1a, 0x28, 0x18, 0x11, 0x19, 0x46, 0x11, 0x29, 0xd6, 0x39, 0x80, 0xf5, 0xd4, 0x38, 0x46,
+	0xe8, 0x5c, 0x30, 0xe1, 0x11, 0x3a, 0x17, 0xcd, 0x6e, 0xcc, 0x6f, 0xa1, 0xce, 0xef, 0x1c, 0xbe,
+	0x95, 0xd1, 0x59, 0x74, 0x75, 0x47, 0x2f, 0x59, 0x59, 0xfe, 0xb9, 0x0c, 0xf5, 0x8b, 0xd8, 0x4f,
+	0xfc, 0xc1, 0x4c, 0xf9, 0x29, 0x35, 0xd2, 0x49, 0xf9, 0x29, 0x3d, 0xb6, 0x31, 0xdf, 0x46, 0x99,
+	0x77, 0x0c, 0x23, 0x23, 0x93, 0x77, 0xbd, 0x47, 0x2f, 0xfd, 0x09, 0x3e, 0x02, 0x3f, 0x06, 0x48,
+	0xfa, 0x56, 0xfe, 0x08, 0xe4, 0x5a, 0x67, 0xfe, 0x08, 0xe4, 0xdb, 0x5b, 0xb3, 0x85, 0x32, 0x74,
+	0xd2, 0x2c, 0xb6, 0x8b, 0x0c, 0x93, 0x13, 0xe7, 0xfd, 0x60, 0xea, 0xc4, 0xd5, 0xfe, 0x35, 0x7d,
+	0xe2, 0xa9, 0x0e, 0xce, 0xdc, 0x47, 0x29, 0x86, 0xb1, 0x93, 0x3d, 0x71, 0xdc, 0xc6, 0x8c, 0x70,
+	0xb1, 0x05, 0x4a, 0x3a, 0x2b, 0x2e, 0xa7, 0xa8, 0x31, 0xe3, 0x72, 0x0a, 0xdb, 0x30, 0xf9, 0x6e,
+	0x92, 0x56, 0x56, 0xce, 0xb4, 0xa7, 0x3e, 0x9d, 0xe4, 0x0c, 0x6a, 0xbc, 0x55, 0x22, 0x9b, 0x82,
+	0x99, 0xc2, 0x9f, 0xa8, 0x28, 0xc1, 0xf8, 0x4d, 0x64, 0x7c, 0x9b, 0xcc, 0x7b, 0x90, 0xc9, 0x4f,
+	0x61, 0x55, 0xa9, 0xea, 0xf9, 0xab, 0x9f, 0xef, 0x80, 0xf8, 0xab, 0x5f, 0x50, 0xfe, 0xcf, 0xf4,
+	0x12, 0x65, 0xbb, 0xf0, 0x5a, 0x9c, 0xc0, 0x9a, 0xda, 0x7d, 0xf1, 0x27, 0xb4, 0xa0, 0x4d, 0x33,
+	0xf4, 0x3c, 0x21, 0xbe, 0x10, 0xa7, 0xb0, 0x91, 0x2e, 0xdf, 0xf9, 0xdd, 0x2a, 0xec, 0x0d, 0xf8,
+	0xdd, 0x2a, 0xae, 0xf6, 0xcd, 0x12, 0xd3, 0x47, 0xad, 0xaf, 0x89, 0x9a, 0xd0, 0x52, 0x8f, 0x92,
+	0x9e, 0x27, 0xa4, 0x9e, 0xe2, 0x74, 0xed, 0x29, 0x9e, 0xe2, 0xc2, 0x12, 0x5a, 0x3c, 0xc5, 0xc5,
+	0xc5, 0x2a, 0xb7, 0x2e, 0x5d, 0x01, 0x72, 0xeb, 0x0a, 0x8b, 0x4c, 0xc3, 0x28, 0x22, 0xc5, 0xac,
+	0x7e, 0x84, 0x2d, 0x70, 0x42, 0x12, 0x69, 0xb2, 0x25, 0x7c, 0x9b, 0x25, 0x48, 0xa6, 0x77, 0x66,
+	0xd2, 0x63, 0xce, 0x4f, 0x81, 0xa4, 0x36, 0xf0, 0x80, 0xb9, 0x9d, 0xfb, 0x30, 0x15, 0x37, 0xad,
+	0x59, 0xe4, 0x98, 0xad, 0x1d, 0xa7, 0xa1, 0x2c, 0xeb, 0x37, 0x14, 0xff, 0xcf, 0x60, 0x6f, 0xce,
+	0xdb, 0x22, 0x45, 0xdc, 0xd3, 0xff, 0xfa, 0xaa, 0xa5, 0x7d, 0xf5, 0xaa, 0xa5, 0xfd, 0xeb, 0x55,
+	0x4b, 0xfb, 0xf5, 0xeb, 0x56, 0xe9, 0xab, 0xd7, 0xad, 0xd2, 0x3f, 0x5e, 0xb7, 0x4a, 0xbd, 0x1a,
+	0xfe, 0x71, 0xe8, 0xdb, 0xff, 0x09, 0x00, 0x00, 0xff, 0xff, 0xad, 0x12, 0x2c, 0xc1, 0x7c, 0x24,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -3755,6 +4130,8 @@ type MasterClient interface {
 	ShowDDLLocks(ctx context.Context, in *ShowDDLLocksRequest, opts ...grpc.CallOption) (*ShowDDLLocksResponse, error)
 	// used by dmctl to manually unlock DDL lock
 	UnlockDDLLock(ctx context.Context, in *UnlockDDLLockRequest, opts ...grpc.CallOption) (*UnlockDDLLockResponse, error)
+	// used by dmctl to simulate a DDL against the optimistic shard DDL locks of a task
+	SimulateDDLLock(ctx context.Context, in *SimulateDDLLockRequest, opts ...grpc.CallOption) (*SimulateDDLLockResponse, error)
 	// OperateWorkerRelayTask requests some dm-workers to operate relay unit
 	OperateWorkerRelayTask(ctx context.Context, in *OperateWorkerRelayRequest, opts ...grpc.CallOption) (*OperateWorkerRelayResponse, error)
 	// PurgeWorkerRelay purges relay log files for some dm-workers
@@ -3850,6 +4227,15 @@ func (c *masterClient) UnlockDDLLock(ctx context.Context, in *UnlockDDLLockReque
 	return out, nil
 }
 
+func (c *masterClient) SimulateDDLLock(ctx context.Context, in *SimulateDDLLockRequest, opts ...grpc.CallOption) (*SimulateDDLLockResponse, error) {
+	out := new(SimulateDDLLockResponse)
+	err := c.cc.Invoke(ctx, "/pb.Master/SimulateDDLLock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *masterClient) OperateWorkerRelayTask(ctx context.Context, in *OperateWorkerRelayRequest, opts ...grpc.CallOption) (*OperateWorkerRelayResponse, error) {
 	out := new(OperateWorkerRelayResponse)
 	err := c.cc.Invoke(ctx, "/pb.Master/OperateWorkerRelayTask", in, out, opts...)
@@ -4040,6 +4426,8 @@ type MasterServer interface {
 	ShowDDLLocks(context.Context, *ShowDDLLocksRequest) (*ShowDDLLocksResponse, error)
 	// used by dmctl to manually unlock DDL lock
 	UnlockDDLLock(context.Context, *UnlockDDLLockRequest) (*UnlockDDLLockResponse, error)
+	// used by dmctl to simulate a DDL against the optimistic shard DDL locks of a task
+	SimulateDDLLock(context.Context, *SimulateDDLLockRequest) (*SimulateDDLLockResponse, error)
 	// OperateWorkerRelayTask requests some dm-workers to operate relay unit
 	OperateWorkerRelayTask(context.Context, *OperateWorkerRelayRequest) (*OperateWorkerRelayResponse, error)
 	// PurgeWorkerRelay purges relay log files for some dm-workers
@@ -4095,6 +4483,9 @@ func (*UnimplementedMasterServer) ShowDDLLocks(ctx context.Context, req *ShowDDL
 func (*UnimplementedMasterServer) UnlockDDLLock(ctx context.Context, req *UnlockDDLLockRequest) (*UnlockDDLLockResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnlockDDLLock not implemented")
 }
+func (*UnimplementedMasterServer) SimulateDDLLock(ctx context.Context, req *SimulateDDLLockRequest) (*SimulateDDLLockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateDDLLock not implemented")
+}
 func (*UnimplementedMasterServer) OperateWorkerRelayTask(ctx context.Context, req *OperateWorkerRelayRequest) (*OperateWorkerRelayResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method OperateWorkerRelayTask not implemented")
 }
@@ -4268,6 +4659,24 @@ func _Master_UnlockDDLLock_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Master_SimulateDDLLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateDDLLockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MasterServer).SimulateDDLLock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Master/SimulateDDLLock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasterServer).SimulateDDLLock(ctx, req.(*SimulateDDLLockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Master_OperateWorkerRelayTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(OperateWorkerRelayRequest)
 	if err := dec(in); err != nil {
@@ -4656,6 +5065,10 @@ var _Master_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UnlockDDLLock",
 			Handler:    _Master_UnlockDDLLock_Handler,
 		},
+		{
+			MethodName: "SimulateDDLLock",
+			Handler:    _Master_SimulateDDLLock_Handler,
+		},
 		{
 			MethodName: "OperateWorkerRelayTask",
 			Handler:    _Master_OperateWorkerRelayTask_Handler,
@@ -5440,7 +5853,7 @@ func (m *UnlockDDLLockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *OperateWorkerRelayRequest) Marshal() (dAtA []byte, err error) {
+func (m *SimulateDDLLockRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5450,34 +5863,64 @@ func (m *OperateWorkerRelayRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *OperateWorkerRelayRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *SimulateDDLLockRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OperateWorkerRelayRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SimulateDDLLockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Table) > 0 {
+		i -= len(m.Table)
+		copy(dAtA[i:], m.Table)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Table)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Database) > 0 {
+		i -= len(m.Database)
+		copy(dAtA[i:], m.Database)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Database)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.Sources) > 0 {
 		for iNdEx := len(m.Sources) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Sources[iNdEx])
 			copy(dAtA[i:], m.Sources[iNdEx])
 			i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Sources[iNdEx])))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x22
 		}
 	}
-	if m.Op != 0 {
-		i = encodeVarintDmmaster(dAtA, i, uint64(m.Op))
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.ID)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x1a
+	}
+	if len(m.DDL) > 0 {
+		i -= len(m.DDL)
+		copy(dAtA[i:], m.DDL)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.DDL)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Task) > 0 {
+		i -= len(m.Task)
+		copy(dAtA[i:], m.Task)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Task)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *OperateWorkerRelayResponse) Marshal() (dAtA []byte, err error) {
+func (m *SimulateShardTableResult) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5487,56 +5930,64 @@ func (m *OperateWorkerRelayResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *OperateWorkerRelayResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *SimulateShardTableResult) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OperateWorkerRelayResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SimulateShardTableResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Sources) > 0 {
-		for iNdEx := len(m.Sources) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Sources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDmmaster(dAtA, i, uint64(size))
-			}
+	if len(m.DDLs) > 0 {
+		for iNdEx := len(m.DDLs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DDLs[iNdEx])
+			copy(dAtA[i:], m.DDLs[iNdEx])
+			i = encodeVarintDmmaster(dAtA, i, uint64(len(m.DDLs[iNdEx])))
 			i--
-			dAtA[i] = 0x22
+			dAtA[i] = 0x32
 		}
 	}
-	if len(m.Msg) > 0 {
-		i -= len(m.Msg)
-		copy(dAtA[i:], m.Msg)
-		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Msg)))
+	if len(m.ConflictMsg) > 0 {
+		i -= len(m.ConflictMsg)
+		copy(dAtA[i:], m.ConflictMsg)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.ConflictMsg)))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x2a
 	}
-	if m.Result {
+	if len(m.ConflictStage) > 0 {
+		i -= len(m.ConflictStage)
+		copy(dAtA[i:], m.ConflictStage)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.ConflictStage)))
 		i--
-		if m.Result {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+		dAtA[i] = 0x22
+	}
+	if len(m.Table) > 0 {
+		i -= len(m.Table)
+		copy(dAtA[i:], m.Table)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Table)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x1a
 	}
-	if m.Op != 0 {
-		i = encodeVarintDmmaster(dAtA, i, uint64(m.Op))
+	if len(m.Schema) > 0 {
+		i -= len(m.Schema)
+		copy(dAtA[i:], m.Schema)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Schema)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x12
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *PurgeWorkerRelayRequest) Marshal() (dAtA []byte, err error) {
+func (m *SimulateDDLLockResult) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5546,38 +5997,56 @@ func (m *PurgeWorkerRelayRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PurgeWorkerRelayRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *SimulateDDLLockResult) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PurgeWorkerRelayRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SimulateDDLLockResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.SubDir) > 0 {
-		i -= len(m.SubDir)
-		copy(dAtA[i:], m.SubDir)
-		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.SubDir)))
-		i--
-		dAtA[i] = 0x2a
+	if len(m.Tables) > 0 {
+		for iNdEx := len(m.Tables) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tables[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDmmaster(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
 	}
-	if len(m.Filename) > 0 {
-		i -= len(m.Filename)
-		copy(dAtA[i:], m.Filename)
-		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Filename)))
+	if len(m.Stalled) > 0 {
+		for iNdEx := len(m.Stalled) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Stalled[iNdEx])
+			copy(dAtA[i:], m.Stalled[iNdEx])
+			i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Stalled[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.NewJoined) > 0 {
+		i -= len(m.NewJoined)
+		copy(dAtA[i:], m.NewJoined)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.NewJoined)))
 		i--
 		dAtA[i] = 0x22
 	}
-	if m.Time != 0 {
-		i = encodeVarintDmmaster(dAtA, i, uint64(m.Time))
+	if len(m.OldJoined) > 0 {
+		i -= len(m.OldJoined)
+		copy(dAtA[i:], m.OldJoined)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.OldJoined)))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x1a
 	}
-	if m.Inactive {
+	if m.Conflict {
 		i--
-		if m.Inactive {
+		if m.Conflict {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
@@ -5585,19 +6054,17 @@ func (m *PurgeWorkerRelayRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i--
 		dAtA[i] = 0x10
 	}
-	if len(m.Sources) > 0 {
-		for iNdEx := len(m.Sources) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Sources[iNdEx])
-			copy(dAtA[i:], m.Sources[iNdEx])
-			i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Sources[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *PurgeWorkerRelayResponse) Marshal() (dAtA []byte, err error) {
+func (m *SimulateDDLLockResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5607,20 +6074,20 @@ func (m *PurgeWorkerRelayResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PurgeWorkerRelayResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *SimulateDDLLockResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PurgeWorkerRelayResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SimulateDDLLockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Sources) > 0 {
-		for iNdEx := len(m.Sources) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Locks) > 0 {
+		for iNdEx := len(m.Locks) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Sources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Locks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -5651,7 +6118,7 @@ func (m *PurgeWorkerRelayResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *CheckTaskRequest) Marshal() (dAtA []byte, err error) {
+func (m *OperateWorkerRelayRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5661,36 +6128,247 @@ func (m *CheckTaskRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CheckTaskRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *OperateWorkerRelayRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CheckTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *OperateWorkerRelayRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.StartTime) > 0 {
-		i -= len(m.StartTime)
-		copy(dAtA[i:], m.StartTime)
-		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.StartTime)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.WarnCnt != 0 {
-		i = encodeVarintDmmaster(dAtA, i, uint64(m.WarnCnt))
-		i--
-		dAtA[i] = 0x18
+	if len(m.Sources) > 0 {
+		for iNdEx := len(m.Sources) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Sources[iNdEx])
+			copy(dAtA[i:], m.Sources[iNdEx])
+			i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Sources[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if m.ErrCnt != 0 {
-		i = encodeVarintDmmaster(dAtA, i, uint64(m.ErrCnt))
+	if m.Op != 0 {
+		i = encodeVarintDmmaster(dAtA, i, uint64(m.Op))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x8
 	}
-	if len(m.Task) > 0 {
-		i -= len(m.Task)
-		copy(dAtA[i:], m.Task)
+	return len(dAtA) - i, nil
+}
+
+func (m *OperateWorkerRelayResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OperateWorkerRelayResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperateWorkerRelayResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sources) > 0 {
+		for iNdEx := len(m.Sources) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDmmaster(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Result {
+		i--
+		if m.Result {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Op != 0 {
+		i = encodeVarintDmmaster(dAtA, i, uint64(m.Op))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PurgeWorkerRelayRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PurgeWorkerRelayRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PurgeWorkerRelayRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SubDir) > 0 {
+		i -= len(m.SubDir)
+		copy(dAtA[i:], m.SubDir)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.SubDir)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Filename) > 0 {
+		i -= len(m.Filename)
+		copy(dAtA[i:], m.Filename)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Filename)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Time != 0 {
+		i = encodeVarintDmmaster(dAtA, i, uint64(m.Time))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Inactive {
+		i--
+		if m.Inactive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sources) > 0 {
+		for iNdEx := len(m.Sources) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Sources[iNdEx])
+			copy(dAtA[i:], m.Sources[iNdEx])
+			i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Sources[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PurgeWorkerRelayResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PurgeWorkerRelayResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PurgeWorkerRelayResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sources) > 0 {
+		for iNdEx := len(m.Sources) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDmmaster(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Result {
+		i--
+		if m.Result {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CheckTaskRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckTaskRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StartTime) > 0 {
+		i -= len(m.StartTime)
+		copy(dAtA[i:], m.StartTime)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.StartTime)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.WarnCnt != 0 {
+		i = encodeVarintDmmaster(dAtA, i, uint64(m.WarnCnt))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ErrCnt != 0 {
+		i = encodeVarintDmmaster(dAtA, i, uint64(m.ErrCnt))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Task) > 0 {
+		i -= len(m.Task)
+		copy(dAtA[i:], m.Task)
 		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Task)))
 		i--
 		dAtA[i] = 0xa
@@ -6917,6 +7595,39 @@ func (m *HandleErrorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Limit != 0 {
+		i = encodeVarintDmmaster(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.DdlPattern) > 0 {
+		i -= len(m.DdlPattern)
+		copy(dAtA[i:], m.DdlPattern)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.DdlPattern)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.EndTime) > 0 {
+		i -= len(m.EndTime)
+		copy(dAtA[i:], m.EndTime)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.EndTime)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.StartTime) > 0 {
+		i -= len(m.StartTime)
+		copy(dAtA[i:], m.StartTime)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.StartTime)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.GtidSet) > 0 {
+		i -= len(m.GtidSet)
+		copy(dAtA[i:], m.GtidSet)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.GtidSet)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.Sqls) > 0 {
 		for iNdEx := len(m.Sqls) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Sqls[iNdEx])
@@ -7751,14 +8462,23 @@ func (m *UnlockDDLLockResponse) Size() (n int) {
 	return n
 }
 
-func (m *OperateWorkerRelayRequest) Size() (n int) {
+func (m *SimulateDDLLockRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Op != 0 {
-		n += 1 + sovDmmaster(uint64(m.Op))
+	l = len(m.Task)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	l = len(m.DDL)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
 	}
 	if len(m.Sources) > 0 {
 		for _, s := range m.Sources {
@@ -7766,10 +8486,129 @@ func (m *OperateWorkerRelayRequest) Size() (n int) {
 			n += 1 + l + sovDmmaster(uint64(l))
 		}
 	}
+	l = len(m.Database)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	l = len(m.Table)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
 	return n
 }
 
-func (m *OperateWorkerRelayResponse) Size() (n int) {
+func (m *SimulateShardTableResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	l = len(m.Schema)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	l = len(m.Table)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	l = len(m.ConflictStage)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	l = len(m.ConflictMsg)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	if len(m.DDLs) > 0 {
+		for _, s := range m.DDLs {
+			l = len(s)
+			n += 1 + l + sovDmmaster(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SimulateDDLLockResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	if m.Conflict {
+		n += 2
+	}
+	l = len(m.OldJoined)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	l = len(m.NewJoined)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	if len(m.Stalled) > 0 {
+		for _, s := range m.Stalled {
+			l = len(s)
+			n += 1 + l + sovDmmaster(uint64(l))
+		}
+	}
+	if len(m.Tables) > 0 {
+		for _, e := range m.Tables {
+			l = e.Size()
+			n += 1 + l + sovDmmaster(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SimulateDDLLockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Result {
+		n += 2
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	if len(m.Locks) > 0 {
+		for _, e := range m.Locks {
+			l = e.Size()
+			n += 1 + l + sovDmmaster(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *OperateWorkerRelayRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Op != 0 {
+		n += 1 + sovDmmaster(uint64(m.Op))
+	}
+	if len(m.Sources) > 0 {
+		for _, s := range m.Sources {
+			l = len(s)
+			n += 1 + l + sovDmmaster(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *OperateWorkerRelayResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -8427,6 +9266,25 @@ func (m *HandleErrorRequest) Size() (n int) {
 			n += 1 + l + sovDmmaster(uint64(l))
 		}
 	}
+	l = len(m.GtidSet)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	l = len(m.StartTime)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	l = len(m.EndTime)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	l = len(m.DdlPattern)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovDmmaster(uint64(m.Limit))
+	}
 	return n
 }
 
@@ -10321,7 +11179,842 @@ func (m *ShowDDLLocksResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnlockDDLLockRequest) Unmarshal(dAtA []byte) error {
+func (m *UnlockDDLLockRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmmaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnlockDDLLockRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnlockDDLLockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplaceOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReplaceOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForceRemove", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ForceRemove = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
+			}
+			m.Op = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Op |= UnlockDDLLockOp(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sources = append(m.Sources, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Database", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Database = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Table", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Table = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmmaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnlockDDLLockResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmmaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnlockDDLLockResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnlockDDLLockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Result = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmmaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SimulateDDLLockRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmmaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SimulateDDLLockRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SimulateDDLLockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Task = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DDL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DDL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sources = append(m.Sources, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Database", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Database = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Table", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Table = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmmaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SimulateShardTableResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmmaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SimulateShardTableResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SimulateShardTableResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schema = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Table", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Table = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConflictStage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConflictStage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConflictMsg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConflictMsg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DDLs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DDLs = append(m.DDLs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmmaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SimulateDDLLockResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10344,10 +12037,10 @@ func (m *UnlockDDLLockRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnlockDDLLockRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SimulateDDLLockResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnlockDDLLockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SimulateDDLLockResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10383,40 +12076,8 @@ func (m *UnlockDDLLockRequest) Unmarshal(dAtA []byte) error {
 			m.ID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReplaceOwner", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDmmaster
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDmmaster
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDmmaster
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ReplaceOwner = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForceRemove", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Conflict", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -10433,12 +12094,12 @@ func (m *UnlockDDLLockRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.ForceRemove = bool(v != 0)
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
+			m.Conflict = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldJoined", wireType)
 			}
-			m.Op = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmmaster
@@ -10448,14 +12109,27 @@ func (m *UnlockDDLLockRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Op |= UnlockDDLLockOp(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldJoined = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewJoined", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10483,11 +12157,11 @@ func (m *UnlockDDLLockRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sources = append(m.Sources, string(dAtA[iNdEx:postIndex]))
+			m.NewJoined = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Database", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stalled", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10515,13 +12189,13 @@ func (m *UnlockDDLLockRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Database = string(dAtA[iNdEx:postIndex])
+			m.Stalled = append(m.Stalled, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 7:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Table", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tables", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmmaster
@@ -10531,23 +12205,25 @@ func (m *UnlockDDLLockRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDmmaster
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDmmaster
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Table = string(dAtA[iNdEx:postIndex])
+			m.Tables = append(m.Tables, &SimulateShardTableResult{})
+			if err := m.Tables[len(m.Tables)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10570,7 +12246,7 @@ func (m *UnlockDDLLockRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnlockDDLLockResponse) Unmarshal(dAtA []byte) error {
+func (m *SimulateDDLLockResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10593,10 +12269,10 @@ func (m *UnlockDDLLockResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnlockDDLLockResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SimulateDDLLockResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnlockDDLLockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SimulateDDLLockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10651,6 +12327,40 @@ func (m *UnlockDDLLockResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.Msg = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Locks = append(m.Locks, &SimulateDDLLockResult{})
+			if err := m.Locks[len(m.Locks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDmmaster(dAtA[iNdEx:])
@@ -14785,6 +16495,153 @@ func (m *HandleErrorRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Sqls = append(m.Sqls, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GtidSet", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GtidSet = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartTime = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EndTime = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DdlPattern", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DdlPattern = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDmmaster(dAtA[iNdEx:])
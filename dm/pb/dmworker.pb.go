@@ -285,6 +285,7 @@ const (
 	ErrorOp_Revert         ErrorOp = 3
 	ErrorOp_Inject         ErrorOp = 4
 	ErrorOp_List           ErrorOp = 5
+	ErrorOp_ListEvents     ErrorOp = 6
 )
 
 var ErrorOp_name = map[int32]string{
@@ -294,6 +295,7 @@ var ErrorOp_name = map[int32]string{
 	3: "Revert",
 	4: "Inject",
 	5: "List",
+	6: "ListEvents",
 }
 
 var ErrorOp_value = map[string]int32{
@@ -303,6 +305,7 @@ var ErrorOp_value = map[string]int32{
 	"Revert":         3,
 	"Inject":         4,
 	"List":           5,
+	"ListEvents":     6,
 }
 
 func (x ErrorOp) String() string {
@@ -379,6 +382,7 @@ const (
 	ValidationErrOp_IgnoreErrOp  ValidationErrOp = 1
 	ValidationErrOp_ResolveErrOp ValidationErrOp = 2
 	ValidationErrOp_ClearErrOp   ValidationErrOp = 3
+	ValidationErrOp_RepairErrOp  ValidationErrOp = 4
 )
 
 var ValidationErrOp_name = map[int32]string{
@@ -386,6 +390,7 @@ var ValidationErrOp_name = map[int32]string{
 	1: "IgnoreErrOp",
 	2: "ResolveErrOp",
 	3: "ClearErrOp",
+	4: "RepairErrOp",
 }
 
 var ValidationErrOp_value = map[string]int32{
@@ -393,6 +398,7 @@ var ValidationErrOp_value = map[string]int32{
 	"IgnoreErrOp":  1,
 	"ResolveErrOp": 2,
 	"ClearErrOp":   3,
+	"RepairErrOp":  4,
 }
 
 func (x ValidationErrOp) String() string {
@@ -2532,10 +2538,15 @@ func (m *OperateV1MetaResponse) GetMeta() map[string]*V1SubTaskMeta {
 }
 
 type HandleWorkerErrorRequest struct {
-	Op        ErrorOp  `protobuf:"varint,1,opt,name=op,proto3,enum=pb.ErrorOp" json:"op,omitempty"`
-	Task      string   `protobuf:"bytes,2,opt,name=task,proto3" json:"task,omitempty"`
-	BinlogPos string   `protobuf:"bytes,3,opt,name=binlogPos,proto3" json:"binlogPos,omitempty"`
-	Sqls      []string `protobuf:"bytes,4,rep,name=sqls,proto3" json:"sqls,omitempty"`
+	Op         ErrorOp  `protobuf:"varint,1,opt,name=op,proto3,enum=pb.ErrorOp" json:"op,omitempty"`
+	Task       string   `protobuf:"bytes,2,opt,name=task,proto3" json:"task,omitempty"`
+	BinlogPos  string   `protobuf:"bytes,3,opt,name=binlogPos,proto3" json:"binlogPos,omitempty"`
+	Sqls       []string `protobuf:"bytes,4,rep,name=sqls,proto3" json:"sqls,omitempty"`
+	GtidSet    string   `protobuf:"bytes,5,opt,name=gtidSet,proto3" json:"gtidSet,omitempty"`
+	StartTime  string   `protobuf:"bytes,6,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	EndTime    string   `protobuf:"bytes,7,opt,name=endTime,proto3" json:"endTime,omitempty"`
+	DdlPattern string   `protobuf:"bytes,8,opt,name=ddlPattern,proto3" json:"ddlPattern,omitempty"`
+	Limit      int32    `protobuf:"varint,9,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
 func (m *HandleWorkerErrorRequest) Reset()         { *m = HandleWorkerErrorRequest{} }
@@ -2599,6 +2610,41 @@ func (m *HandleWorkerErrorRequest) GetSqls() []string {
 	return nil
 }
 
+func (m *HandleWorkerErrorRequest) GetGtidSet() string {
+	if m != nil {
+		return m.GtidSet
+	}
+	return ""
+}
+
+func (m *HandleWorkerErrorRequest) GetStartTime() string {
+	if m != nil {
+		return m.StartTime
+	}
+	return ""
+}
+
+func (m *HandleWorkerErrorRequest) GetEndTime() string {
+	if m != nil {
+		return m.EndTime
+	}
+	return ""
+}
+
+func (m *HandleWorkerErrorRequest) GetDdlPattern() string {
+	if m != nil {
+		return m.DdlPattern
+	}
+	return ""
+}
+
+func (m *HandleWorkerErrorRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
 type GetWorkerCfgRequest struct {
 }
 
@@ -2838,6 +2884,8 @@ type ValidationStatus struct {
 	ProcessedRowsStatus string         `protobuf:"bytes,8,opt,name=processedRowsStatus,proto3" json:"processedRowsStatus,omitempty"`
 	PendingRowsStatus   string         `protobuf:"bytes,9,opt,name=pendingRowsStatus,proto3" json:"pendingRowsStatus,omitempty"`
 	ErrorRowsStatus     string         `protobuf:"bytes,10,opt,name=errorRowsStatus,proto3" json:"errorRowsStatus,omitempty"`
+	// progress of snapshot validation, only set when mode is snapshot
+	SnapshotStatus string `protobuf:"bytes,11,opt,name=snapshotStatus,proto3" json:"snapshotStatus,omitempty"`
 }
 
 func (m *ValidationStatus) Reset()         { *m = ValidationStatus{} }
@@ -2943,6 +2991,13 @@ func (m *ValidationStatus) GetErrorRowsStatus() string {
 	return ""
 }
 
+func (m *ValidationStatus) GetSnapshotStatus() string {
+	if m != nil {
+		return m.SnapshotStatus
+	}
+	return ""
+}
+
 type ValidationTableStatus struct {
 	Source   string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
 	SrcTable string `protobuf:"bytes,2,opt,name=srcTable,proto3" json:"srcTable,omitempty"`
@@ -3494,184 +3549,190 @@ func init() {
 func init() { proto.RegisterFile("dmworker.proto", fileDescriptor_51a1b9e17fd67b10) }
 
 var fileDescriptor_51a1b9e17fd67b10 = []byte{
-	// 2829 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x1a, 0x4d, 0x6f, 0x24, 0x57,
-	0x71, 0xba, 0xe7, 0xbb, 0x66, 0xec, 0x6d, 0xbf, 0xf5, 0x2e, 0x13, 0x67, 0x77, 0xe2, 0xf4, 0x46,
-	0xc1, 0xb1, 0x82, 0x95, 0x98, 0xa0, 0xa0, 0x48, 0x90, 0x64, 0xed, 0x8d, 0x77, 0x83, 0x37, 0xde,
-	0x6d, 0x3b, 0xe6, 0x84, 0x44, 0xbb, 0xfb, 0x79, 0xdc, 0xb8, 0xa7, 0xbb, 0xb7, 0xbb, 0xc7, 0x96,
-	0x0f, 0x88, 0x0b, 0xe2, 0x0a, 0x17, 0x90, 0x40, 0x70, 0x00, 0x09, 0x89, 0x13, 0x07, 0x0e, 0x1c,
-	0x39, 0x02, 0xc7, 0x88, 0x13, 0x47, 0x94, 0xfd, 0x23, 0xa8, 0xea, 0xbd, 0xd7, 0xfd, 0x7a, 0x3e,
-	0xbc, 0x59, 0x24, 0x6e, 0x5d, 0x1f, 0xaf, 0xaa, 0x5e, 0x7d, 0xbd, 0xaa, 0xb1, 0x61, 0xd9, 0x1f,
-	0x5f, 0xc6, 0xe9, 0x39, 0x4f, 0xb7, 0x92, 0x34, 0xce, 0x63, 0x66, 0x26, 0x27, 0xf6, 0x06, 0xb0,
-	0xa7, 0x13, 0x9e, 0x5e, 0x1d, 0xe6, 0x6e, 0x3e, 0xc9, 0x1c, 0xfe, 0x6c, 0xc2, 0xb3, 0x9c, 0x31,
-	0x68, 0x44, 0xee, 0x98, 0x0f, 0x8c, 0x75, 0x63, 0xa3, 0xeb, 0xd0, 0xb7, 0x9d, 0xc0, 0xea, 0x4e,
-	0x3c, 0x1e, 0xc7, 0xd1, 0xf7, 0x49, 0x86, 0xc3, 0xb3, 0x24, 0x8e, 0x32, 0xce, 0x6e, 0x43, 0x2b,
-	0xe5, 0xd9, 0x24, 0xcc, 0x89, 0xbb, 0xe3, 0x48, 0x88, 0x59, 0x50, 0x1f, 0x67, 0xa3, 0x81, 0x49,
-	0x22, 0xf0, 0x13, 0x39, 0xb3, 0x78, 0x92, 0x7a, 0x7c, 0x50, 0x27, 0xa4, 0x84, 0x10, 0x2f, 0xec,
-	0x1a, 0x34, 0x04, 0x5e, 0x40, 0xf6, 0x9f, 0x0d, 0xb8, 0x59, 0x31, 0xee, 0xa5, 0x35, 0xbe, 0x07,
-	0x7d, 0xa1, 0x43, 0x48, 0x20, 0xbd, 0xbd, 0x6d, 0x6b, 0x2b, 0x39, 0xd9, 0x3a, 0xd4, 0xf0, 0x4e,
-	0x85, 0x8b, 0xbd, 0x0f, 0x4b, 0xd9, 0xe4, 0xe4, 0xc8, 0xcd, 0xce, 0xe5, 0xb1, 0xc6, 0x7a, 0x7d,
-	0xa3, 0xb7, 0xbd, 0x42, 0xc7, 0x74, 0x82, 0x53, 0xe5, 0xb3, 0xff, 0x68, 0x40, 0x6f, 0xe7, 0x8c,
-	0x7b, 0x12, 0x46, 0x43, 0x13, 0x37, 0xcb, 0xb8, 0xaf, 0x0c, 0x15, 0x10, 0x5b, 0x85, 0x66, 0x1e,
-	0xe7, 0x6e, 0x48, 0xa6, 0x36, 0x1d, 0x01, 0xb0, 0x21, 0x40, 0x36, 0xf1, 0x3c, 0x9e, 0x65, 0xa7,
-	0x93, 0x90, 0x4c, 0x6d, 0x3a, 0x1a, 0x06, 0xa5, 0x9d, 0xba, 0x41, 0xc8, 0x7d, 0x72, 0x53, 0xd3,
-	0x91, 0x10, 0x1b, 0x40, 0xfb, 0xd2, 0x4d, 0xa3, 0x20, 0x1a, 0x0d, 0x9a, 0x44, 0x50, 0x20, 0x9e,
-	0xf0, 0x79, 0xee, 0x06, 0xe1, 0xa0, 0xb5, 0x6e, 0x6c, 0xf4, 0x1d, 0x09, 0xd9, 0x5f, 0x18, 0x00,
-	0xbb, 0x93, 0x71, 0x22, 0xcd, 0x5c, 0x87, 0x1e, 0x59, 0x70, 0xe4, 0x9e, 0x84, 0x3c, 0x23, 0x5b,
-	0xeb, 0x8e, 0x8e, 0x62, 0x1b, 0x70, 0xc3, 0x8b, 0xc7, 0x49, 0xc8, 0x73, 0xee, 0x4b, 0x2e, 0x34,
-	0xdd, 0x70, 0xa6, 0xd1, 0xec, 0x0d, 0x58, 0x3a, 0x0d, 0xa2, 0x20, 0x3b, 0xe3, 0xfe, 0xfd, 0xab,
-	0x9c, 0x0b, 0x97, 0x1b, 0x4e, 0x15, 0xc9, 0x6c, 0xe8, 0x2b, 0x84, 0x13, 0x5f, 0x66, 0x74, 0x21,
-	0xc3, 0xa9, 0xe0, 0xd8, 0xdb, 0xb0, 0xc2, 0xb3, 0x3c, 0x18, 0xbb, 0x39, 0x3f, 0x42, 0x53, 0x88,
-	0xb1, 0x49, 0x8c, 0xb3, 0x04, 0xfb, 0xaf, 0x06, 0xc0, 0x7e, 0xec, 0xfa, 0xf2, 0x4a, 0x33, 0x66,
-	0x88, 0x4b, 0x4d, 0x99, 0x31, 0x04, 0xa0, 0x5b, 0x0a, 0x16, 0x93, 0x58, 0x34, 0x0c, 0x5b, 0x83,
-	0x4e, 0x92, 0xc6, 0xa3, 0x94, 0x67, 0x99, 0x4c, 0xd9, 0x02, 0xc6, 0xb3, 0x63, 0x9e, 0xbb, 0xf7,
-	0x83, 0x28, 0x8c, 0x47, 0x32, 0x71, 0x35, 0x0c, 0x7b, 0x13, 0x96, 0x4b, 0x68, 0xef, 0xe8, 0xd1,
-	0x2e, 0xd9, 0xde, 0x75, 0xa6, 0xb0, 0xf6, 0x2f, 0x0d, 0x58, 0x3a, 0x3c, 0x73, 0x53, 0x3f, 0x88,
-	0x46, 0x7b, 0x69, 0x3c, 0x49, 0x30, 0x6a, 0xb9, 0x9b, 0x8e, 0x78, 0x2e, 0xcb, 0x4f, 0x42, 0x58,
-	0x94, 0xbb, 0xbb, 0xfb, 0x68, 0x67, 0x1d, 0x8b, 0x12, 0xbf, 0xc5, 0x3d, 0xd3, 0x2c, 0xdf, 0x8f,
-	0x3d, 0x37, 0x0f, 0xe2, 0x48, 0x9a, 0x59, 0x45, 0x52, 0xe1, 0x5d, 0x45, 0x1e, 0x65, 0x4e, 0x9d,
-	0x0a, 0x8f, 0x20, 0xbc, 0xdf, 0x24, 0x92, 0x94, 0x26, 0x51, 0x0a, 0xd8, 0xfe, 0x5d, 0x03, 0xe0,
-	0xf0, 0x2a, 0xf2, 0xa6, 0x72, 0xe4, 0xc1, 0x05, 0x8f, 0xf2, 0x6a, 0x8e, 0x08, 0x14, 0x0a, 0x13,
-	0x29, 0x93, 0x28, 0x57, 0x16, 0x30, 0xbb, 0x03, 0xdd, 0x94, 0x7b, 0x3c, 0xca, 0x91, 0x58, 0x27,
-	0x62, 0x89, 0xc0, 0x6c, 0x18, 0xbb, 0x59, 0xce, 0xd3, 0x8a, 0x33, 0x2b, 0x38, 0xb6, 0x09, 0x96,
-	0x0e, 0xef, 0xe5, 0x81, 0x2f, 0x1d, 0x3a, 0x83, 0x47, 0x79, 0x74, 0x09, 0x25, 0xaf, 0x25, 0xe4,
-	0xe9, 0x38, 0x94, 0xa7, 0xc3, 0x24, 0xaf, 0x2d, 0xe4, 0x4d, 0xe3, 0x51, 0xde, 0x49, 0x18, 0x7b,
-	0xe7, 0x41, 0x34, 0xa2, 0x00, 0x74, 0xc8, 0x55, 0x15, 0x1c, 0xfb, 0x0e, 0x58, 0x93, 0x28, 0xe5,
-	0x59, 0x1c, 0x5e, 0x70, 0x9f, 0xe2, 0x98, 0x0d, 0xba, 0x5a, 0xdb, 0xd0, 0x23, 0xec, 0xcc, 0xb0,
-	0x6a, 0x11, 0x02, 0xd1, 0x29, 0x64, 0x84, 0x86, 0x00, 0x27, 0x64, 0xc8, 0xd1, 0x55, 0xc2, 0x07,
-	0x3d, 0x91, 0x65, 0x25, 0x86, 0xbd, 0x03, 0x37, 0x33, 0xee, 0xc5, 0x91, 0x9f, 0xdd, 0xe7, 0x67,
-	0x41, 0xe4, 0x3f, 0x26, 0x5f, 0x0c, 0xfa, 0xe4, 0xe2, 0x79, 0x24, 0xcc, 0x18, 0x32, 0x7c, 0x77,
-	0x77, 0xff, 0xe0, 0x32, 0xe2, 0xe9, 0x60, 0x49, 0x64, 0x4c, 0x05, 0x89, 0xe1, 0xf6, 0xe2, 0xe8,
-	0x34, 0x0c, 0xbc, 0xfc, 0x71, 0x36, 0x1a, 0x2c, 0x13, 0x8f, 0x8e, 0xb2, 0x7f, 0x6b, 0x40, 0x5f,
-	0xef, 0xa1, 0x5a, 0x77, 0x37, 0x16, 0x74, 0x77, 0x53, 0xef, 0xee, 0xec, 0xad, 0xa2, 0x8b, 0x8b,
-	0xae, 0x4c, 0x7e, 0x7a, 0x92, 0xc6, 0xd8, 0xee, 0x1c, 0x22, 0x14, 0x8d, 0xfd, 0x5d, 0xe8, 0xa5,
-	0x3c, 0x74, 0xaf, 0x8a, 0x76, 0x8c, 0xfc, 0x37, 0x90, 0xdf, 0x29, 0xd1, 0x8e, 0xce, 0x63, 0xff,
-	0xc3, 0x84, 0x9e, 0x46, 0x9c, 0xc9, 0x31, 0xe3, 0x2b, 0xe6, 0x98, 0xb9, 0x20, 0xc7, 0xd6, 0x95,
-	0x49, 0x93, 0x93, 0xdd, 0x20, 0x95, 0x65, 0xa7, 0xa3, 0x0a, 0x8e, 0x4a, 0x52, 0xeb, 0x28, 0xec,
-	0xaa, 0x1a, 0xa8, 0xa5, 0xf4, 0x34, 0x9a, 0x6d, 0x01, 0x23, 0xd4, 0x8e, 0x9b, 0x7b, 0x67, 0x9f,
-	0x27, 0x32, 0xca, 0x2d, 0x4a, 0x95, 0x39, 0x14, 0xf6, 0x1a, 0x34, 0xb3, 0xdc, 0x1d, 0x71, 0x4a,
-	0xe9, 0xe5, 0xed, 0x2e, 0xa5, 0x20, 0x22, 0x1c, 0x81, 0xd7, 0x9c, 0xdf, 0x79, 0x81, 0xf3, 0xed,
-	0xbf, 0xd4, 0x61, 0xa9, 0xf2, 0xea, 0xcd, 0x9b, 0x0e, 0x4a, 0x8d, 0xe6, 0x02, 0x8d, 0xeb, 0xd0,
-	0x98, 0x44, 0x81, 0x08, 0xf6, 0xf2, 0x76, 0x1f, 0xe9, 0x9f, 0x47, 0x41, 0x8e, 0x59, 0xec, 0x10,
-	0x45, 0xb3, 0xa9, 0xf1, 0xa2, 0x84, 0x78, 0x07, 0x6e, 0x96, 0x25, 0xb4, 0xbb, 0xbb, 0xbf, 0x1f,
-	0x7b, 0xe7, 0x45, 0x87, 0x9d, 0x47, 0x62, 0x4c, 0xcc, 0x06, 0xd4, 0x0a, 0x1e, 0xd6, 0xc4, 0x74,
-	0xf0, 0x75, 0x68, 0x7a, 0xf8, 0x5a, 0x93, 0x97, 0x64, 0x42, 0x69, 0xcf, 0xf7, 0xc3, 0x9a, 0x23,
-	0xe8, 0xec, 0x0d, 0x68, 0xf8, 0x93, 0x71, 0x22, 0x7d, 0xb5, 0x8c, 0x7c, 0xe5, 0xf3, 0xf9, 0xb0,
-	0xe6, 0x10, 0x15, 0xb9, 0xc2, 0xd8, 0xf5, 0x07, 0xdd, 0x92, 0xab, 0x7c, 0x91, 0x90, 0x0b, 0xa9,
-	0xc8, 0x85, 0xb5, 0x4d, 0x75, 0x2e, 0xb9, 0xca, 0x36, 0x8b, 0x5c, 0x48, 0x65, 0xef, 0x01, 0x5c,
-	0xb8, 0x61, 0xe0, 0x8b, 0xa6, 0xde, 0x23, 0xde, 0x55, 0xe4, 0x3d, 0x2e, 0xb0, 0x32, 0xeb, 0x35,
-	0xbe, 0xfb, 0x1d, 0x68, 0x65, 0x22, 0xfd, 0xbf, 0x0b, 0x2b, 0x95, 0x98, 0xed, 0x07, 0x19, 0x39,
-	0x58, 0x90, 0x07, 0xc6, 0xa2, 0x81, 0x46, 0x9d, 0x1f, 0x02, 0x90, 0x27, 0x1e, 0xa4, 0x69, 0x9c,
-	0xaa, 0xc1, 0xca, 0x28, 0x06, 0x2b, 0xfb, 0x2e, 0x74, 0xd1, 0x03, 0xd7, 0x90, 0xf1, 0xea, 0x8b,
-	0xc8, 0x09, 0xf4, 0xe9, 0xce, 0x4f, 0xf7, 0x17, 0x70, 0xb0, 0x6d, 0x58, 0x15, 0xd3, 0x8d, 0x28,
-	0x82, 0x27, 0x71, 0x16, 0x90, 0x27, 0x44, 0x39, 0xce, 0xa5, 0xe1, 0x03, 0xc4, 0x51, 0xdc, 0xe1,
-	0xd3, 0x7d, 0xf5, 0x5a, 0x2b, 0xd8, 0xfe, 0x16, 0x74, 0x51, 0xa3, 0x50, 0xb7, 0x01, 0x2d, 0x22,
-	0x28, 0x3f, 0x58, 0x45, 0x10, 0xa4, 0x41, 0x8e, 0xa4, 0xdb, 0x3f, 0x37, 0xa0, 0x27, 0x9a, 0x9c,
-	0x38, 0xf9, 0xb2, 0x3d, 0x6e, 0xbd, 0x72, 0x5c, 0x75, 0x09, 0x5d, 0xe2, 0x16, 0x00, 0xb5, 0x29,
-	0xc1, 0xd0, 0x28, 0x93, 0xa2, 0xc4, 0x3a, 0x1a, 0x07, 0x06, 0xa6, 0x84, 0xe6, 0xb8, 0xf6, 0xd7,
-	0x26, 0xf4, 0x65, 0x48, 0x05, 0xcb, 0xff, 0xa9, 0x58, 0x65, 0x3d, 0x35, 0xf4, 0x7a, 0x7a, 0x53,
-	0xd5, 0x53, 0xb3, 0xbc, 0x46, 0x99, 0x45, 0x65, 0x39, 0xdd, 0x93, 0xe5, 0xd4, 0x22, 0xb6, 0x25,
-	0x55, 0x4e, 0x8a, 0x4b, 0x54, 0xd3, 0x3d, 0x59, 0x4d, 0xed, 0x92, 0xa9, 0x48, 0xa9, 0xa2, 0x98,
-	0xee, 0xc9, 0x62, 0xea, 0x94, 0x4c, 0x45, 0x98, 0x55, 0x2d, 0xdd, 0x6f, 0x43, 0x93, 0xc2, 0x69,
-	0x7f, 0x00, 0x96, 0xee, 0x1a, 0xaa, 0x89, 0x37, 0x25, 0xb1, 0x92, 0x0a, 0x1a, 0x93, 0x23, 0xcf,
-	0x3e, 0x83, 0xa5, 0x4a, 0x2b, 0xc2, 0x97, 0x39, 0xc8, 0x76, 0xdc, 0xc8, 0xe3, 0x61, 0x31, 0xdf,
-	0x6b, 0x18, 0x2d, 0xc9, 0xcc, 0x52, 0xb2, 0x14, 0x51, 0x49, 0x32, 0x6d, 0x4a, 0xaf, 0x57, 0xa6,
-	0xf4, 0x7f, 0x19, 0xd0, 0xd7, 0x0f, 0xe0, 0xa0, 0xff, 0x20, 0x4d, 0x77, 0x62, 0x5f, 0x44, 0xb3,
-	0xe9, 0x28, 0x10, 0x53, 0x1f, 0x3f, 0x43, 0x37, 0xcb, 0x64, 0x06, 0x16, 0xb0, 0xa4, 0x1d, 0x7a,
-	0x71, 0xa2, 0xf6, 0xae, 0x02, 0x96, 0xb4, 0x7d, 0x7e, 0xc1, 0x43, 0xf9, 0x40, 0x15, 0x30, 0x6a,
-	0x7b, 0xcc, 0xb3, 0x0c, 0xd3, 0x44, 0xf4, 0x55, 0x05, 0xe2, 0x29, 0xc7, 0xbd, 0xdc, 0x71, 0x27,
-	0x19, 0x97, 0xb3, 0x55, 0x01, 0xa3, 0x5b, 0x70, 0x3f, 0x74, 0xd3, 0x78, 0x12, 0xa9, 0x89, 0x4a,
-	0xc3, 0xd8, 0x97, 0xb0, 0xf2, 0x64, 0x92, 0x8e, 0x38, 0x25, 0xb1, 0x5a, 0x37, 0xd7, 0xa0, 0x13,
-	0x44, 0xae, 0x97, 0x07, 0x17, 0x5c, 0x7a, 0xb2, 0x80, 0x31, 0x7f, 0xf3, 0x60, 0xcc, 0xe5, 0x48,
-	0x49, 0xdf, 0xc8, 0x7f, 0x1a, 0x84, 0x9c, 0xf2, 0x5a, 0x5e, 0x49, 0xc1, 0x54, 0xa2, 0xe2, 0x4d,
-	0x96, 0xcb, 0xa4, 0x80, 0xec, 0xdf, 0x98, 0xb0, 0x76, 0x90, 0xf0, 0xd4, 0xcd, 0xb9, 0x58, 0x60,
-	0x0f, 0xbd, 0x33, 0x3e, 0x76, 0x95, 0x09, 0x77, 0xc0, 0x8c, 0x13, 0x52, 0x2e, 0xf3, 0x5d, 0x90,
-	0x0f, 0x12, 0xc7, 0x8c, 0x13, 0x32, 0xc2, 0xcd, 0xce, 0xa5, 0x6f, 0xe9, 0x7b, 0xe1, 0x36, 0xbb,
-	0x06, 0x1d, 0xdf, 0xcd, 0xdd, 0x13, 0x37, 0xe3, 0xca, 0xa7, 0x0a, 0xa6, 0xc5, 0x0f, 0xf7, 0x24,
-	0xe9, 0x51, 0x01, 0x90, 0x24, 0xd2, 0x26, 0xbd, 0x29, 0x21, 0xe4, 0x3e, 0x0d, 0x27, 0xd9, 0x19,
-	0xb9, 0xb1, 0xe3, 0x08, 0x00, 0x6d, 0x29, 0x72, 0xbe, 0x23, 0x9f, 0x8b, 0x21, 0xc0, 0x69, 0x1a,
-	0x8f, 0x45, 0x63, 0xa1, 0x07, 0xa8, 0xe3, 0x68, 0x18, 0x45, 0x3f, 0x12, 0x6b, 0x05, 0x94, 0x74,
-	0x81, 0xb1, 0x73, 0x58, 0x3a, 0x7e, 0x57, 0xa6, 0xfd, 0x63, 0x9e, 0xbb, 0x6c, 0x4d, 0x73, 0x07,
-	0xa0, 0x3b, 0x90, 0x22, 0x9d, 0xf1, 0xc2, 0xee, 0xa1, 0x5a, 0x4e, 0x5d, 0x6b, 0x39, 0xca, 0x83,
-	0x0d, 0x4a, 0x71, 0xfa, 0xb6, 0xdf, 0x83, 0x55, 0x19, 0x91, 0xe3, 0x77, 0x51, 0xeb, 0xc2, 0x58,
-	0x08, 0xb2, 0x50, 0x6f, 0xff, 0xdd, 0x80, 0x5b, 0x53, 0xc7, 0x5e, 0xfa, 0x77, 0x81, 0xf7, 0xa1,
-	0x81, 0x6b, 0xd8, 0xa0, 0x4e, 0xa5, 0x79, 0x0f, 0x75, 0xcc, 0x15, 0xb9, 0x85, 0xc0, 0x83, 0x28,
-	0x4f, 0xaf, 0x1c, 0x3a, 0xb0, 0xf6, 0x29, 0x74, 0x0b, 0x14, 0xca, 0x3d, 0xe7, 0x57, 0xaa, 0xfb,
-	0x9e, 0xf3, 0x2b, 0x9c, 0x28, 0x2e, 0xdc, 0x70, 0x22, 0x5c, 0x23, 0x1f, 0xd8, 0x8a, 0x63, 0x1d,
-	0x41, 0xff, 0xc0, 0xfc, 0xb6, 0x61, 0xff, 0x18, 0x06, 0x0f, 0xdd, 0xc8, 0x0f, 0x65, 0x3e, 0x8a,
-	0xa6, 0x20, 0x5d, 0xf0, 0xaa, 0xe6, 0x82, 0x1e, 0x4a, 0x21, 0xea, 0x35, 0xd9, 0x78, 0x07, 0xba,
-	0x27, 0xea, 0x39, 0x94, 0x8e, 0x2f, 0x11, 0x94, 0x33, 0xcf, 0xc2, 0x4c, 0xae, 0x7f, 0xf4, 0x6d,
-	0xdf, 0x82, 0x9b, 0x7b, 0x3c, 0x17, 0xba, 0x77, 0x4e, 0x47, 0x52, 0xb3, 0xbd, 0x01, 0xab, 0x55,
-	0xb4, 0x74, 0xae, 0x05, 0x75, 0xef, 0xb4, 0x78, 0x6a, 0xbc, 0xd3, 0x91, 0x7d, 0x08, 0x77, 0xc5,
-	0xb4, 0x34, 0x39, 0x41, 0x13, 0xb0, 0xf5, 0x7d, 0x9e, 0xf8, 0x6e, 0xce, 0xd5, 0x25, 0xb6, 0x61,
-	0x35, 0x13, 0xb4, 0x9d, 0xd3, 0xd1, 0x51, 0x3c, 0x0e, 0x0f, 0xf3, 0x34, 0x88, 0x94, 0x8c, 0xb9,
-	0x34, 0x7b, 0x1f, 0x86, 0x8b, 0x84, 0x4a, 0x43, 0x06, 0xd0, 0x96, 0x3f, 0x8a, 0xc8, 0x30, 0x2b,
-	0x70, 0x36, 0xce, 0xf6, 0x08, 0xd6, 0xf6, 0x78, 0x3e, 0x33, 0x33, 0x95, 0x6d, 0x07, 0x75, 0x7c,
-	0x56, 0x3e, 0x8f, 0x05, 0xcc, 0xbe, 0x01, 0xfd, 0xd3, 0x20, 0xcc, 0x79, 0x2a, 0x77, 0x8e, 0x99,
-	0x5c, 0xaf, 0x90, 0xed, 0x9f, 0xd6, 0xc1, 0x9a, 0x56, 0x53, 0xc4, 0xc9, 0x98, 0xdb, 0x35, 0xcc,
-	0x4a, 0xd7, 0x60, 0xd0, 0x18, 0x63, 0x63, 0x97, 0x35, 0x83, 0xdf, 0x65, 0xa1, 0x35, 0x16, 0x14,
-	0xda, 0x06, 0xdc, 0x90, 0xd3, 0x5f, 0xac, 0xf6, 0x1a, 0xb9, 0x40, 0x4c, 0xa1, 0x71, 0x60, 0x9e,
-	0x42, 0xd1, 0xba, 0x21, 0xfa, 0xcd, 0x3c, 0x92, 0x36, 0x8d, 0xb7, 0xbf, 0xc2, 0x34, 0x9e, 0x08,
-	0x82, 0xf8, 0xe9, 0x46, 0xba, 0xac, 0x23, 0x84, 0xcf, 0x21, 0xb1, 0xb7, 0x61, 0x25, 0xe1, 0x11,
-	0x2e, 0xc4, 0x1a, 0x7f, 0x97, 0xf8, 0x67, 0x09, 0x78, 0x4d, 0x7a, 0x2a, 0x35, 0x5e, 0x10, 0xd7,
-	0x9c, 0x42, 0xdb, 0x7f, 0x30, 0xe0, 0x56, 0x19, 0x06, 0xfa, 0x49, 0xea, 0x05, 0xdb, 0xe9, 0x1a,
-	0x74, 0xb2, 0xd4, 0x23, 0x4e, 0xf5, 0x72, 0x2a, 0x98, 0x3a, 0x79, 0x96, 0x0b, 0x9a, 0x7c, 0x66,
-	0x14, 0xfc, 0xe2, 0xd8, 0x0c, 0xa0, 0x3d, 0xae, 0x3e, 0x9f, 0x12, 0xb4, 0xff, 0x66, 0xc0, 0xab,
-	0x73, 0xb3, 0xf2, 0x7f, 0xf8, 0x79, 0x13, 0x8a, 0xd0, 0x65, 0xb2, 0x99, 0x5d, 0xbf, 0x25, 0xe0,
-	0xbc, 0xf1, 0x21, 0x2c, 0xe5, 0xa5, 0x67, 0xb8, 0xfa, 0x79, 0xf3, 0x95, 0xea, 0x41, 0xcd, 0x79,
-	0x4e, 0x95, 0xdf, 0x3e, 0x87, 0x57, 0x2a, 0xf6, 0x57, 0x3a, 0xd7, 0x36, 0x4d, 0xe1, 0xc8, 0xcb,
-	0x65, 0xff, 0xba, 0xad, 0x09, 0x16, 0x53, 0x2f, 0x51, 0x9d, 0x82, 0xaf, 0x52, 0x88, 0x66, 0xb5,
-	0x10, 0xed, 0xdf, 0x9b, 0x70, 0x63, 0x4a, 0x15, 0x5b, 0x06, 0x33, 0xf0, 0x65, 0x20, 0xcd, 0xc0,
-	0x5f, 0x58, 0x54, 0x7a, 0x70, 0xeb, 0x53, 0xc1, 0xc5, 0x36, 0x92, 0x7a, 0xbb, 0x6e, 0xee, 0xca,
-	0x57, 0x5a, 0x81, 0x95, 0xb0, 0x37, 0xa7, 0xc2, 0x3e, 0x80, 0xb6, 0x9f, 0xe5, 0x74, 0x4a, 0xd4,
-	0x8e, 0x02, 0xb1, 0x01, 0x53, 0x36, 0xd2, 0x0f, 0x35, 0x62, 0xee, 0x29, 0x11, 0x6c, 0xab, 0x58,
-	0xbd, 0x3a, 0xd7, 0xfa, 0x44, 0x72, 0x15, 0x53, 0x4f, 0x57, 0xb6, 0x0e, 0x9c, 0x7a, 0xb4, 0x8c,
-	0x82, 0x6a, 0x46, 0x3d, 0x9b, 0x6a, 0x73, 0x32, 0x20, 0x2f, 0x9d, 0x4f, 0x6f, 0xa9, 0x61, 0x58,
-	0xa4, 0xd2, 0xcd, 0x6a, 0x46, 0x54, 0xe6, 0xe1, 0x5f, 0x19, 0x70, 0x57, 0x3d, 0x99, 0xf3, 0x13,
-	0xe1, 0x9e, 0xf6, 0x84, 0xcd, 0x4a, 0x92, 0x4f, 0x19, 0x4d, 0xd1, 0x1f, 0x87, 0xa1, 0x58, 0x7f,
-	0x4c, 0x35, 0x45, 0x2b, 0x4c, 0x25, 0x33, 0xea, 0x53, 0x2d, 0x7a, 0x95, 0xac, 0x7d, 0x24, 0x7e,
-	0x0e, 0x6f, 0x38, 0x02, 0xb0, 0x3f, 0x85, 0xe1, 0x22, 0xbb, 0x5e, 0xd6, 0x1f, 0x9b, 0xe7, 0xd0,
-	0x12, 0x73, 0x0f, 0x5b, 0x82, 0xee, 0xa3, 0x88, 0x6a, 0xe8, 0x20, 0xb1, 0x6a, 0xac, 0x03, 0x8d,
-	0xc3, 0x3c, 0x4e, 0x2c, 0x83, 0x75, 0xa1, 0xf9, 0x04, 0x07, 0x5f, 0xcb, 0x64, 0x00, 0x2d, 0x6c,
-	0x8c, 0x63, 0x6e, 0xd5, 0x11, 0x7d, 0x98, 0xbb, 0x69, 0x6e, 0x35, 0x10, 0x2d, 0x5e, 0x30, 0xab,
-	0xc9, 0x96, 0x01, 0x3e, 0x9e, 0xe4, 0xb1, 0x64, 0x6b, 0x21, 0x6d, 0x97, 0x87, 0x3c, 0xe7, 0x56,
-	0x7b, 0xf3, 0x27, 0x74, 0x64, 0x84, 0x2f, 0x6d, 0x5f, 0xea, 0x22, 0xd8, 0xaa, 0xb1, 0x36, 0xd4,
-	0x3f, 0xe3, 0x97, 0x96, 0xc1, 0x7a, 0xd0, 0x76, 0x26, 0x51, 0x14, 0x44, 0x23, 0xa1, 0x8f, 0x54,
-	0xfb, 0x56, 0x1d, 0x09, 0x68, 0x50, 0xc2, 0x7d, 0xab, 0xc1, 0xfa, 0xd0, 0xf9, 0x44, 0xfe, 0xce,
-	0x6d, 0x35, 0x91, 0x84, 0x6c, 0x78, 0xa6, 0x85, 0x24, 0x52, 0x8e, 0x50, 0x1b, 0x21, 0x3a, 0x85,
-	0x50, 0x67, 0xf3, 0x00, 0x3a, 0x6a, 0xc9, 0x63, 0x37, 0xa0, 0x27, 0x6d, 0x40, 0x94, 0x55, 0xc3,
-	0x0b, 0xd1, 0xbb, 0x6c, 0x19, 0x78, 0x79, 0x5c, 0xd7, 0x2c, 0x13, 0xbf, 0x70, 0x27, 0xb3, 0xea,
-	0xe4, 0x90, 0xab, 0xc8, 0xb3, 0x1a, 0xc8, 0x48, 0xb3, 0xbd, 0xe5, 0x6f, 0x3e, 0x86, 0x36, 0x7d,
-	0x1e, 0xe0, 0xc8, 0xb2, 0x2c, 0xe5, 0x49, 0x8c, 0x55, 0x43, 0x9f, 0xa2, 0x76, 0xc1, 0x6d, 0xa0,
-	0x6f, 0xe8, 0x3a, 0x02, 0x36, 0xd1, 0x04, 0xe1, 0x27, 0x81, 0xa8, 0x6f, 0xfe, 0xcc, 0x80, 0x8e,
-	0x9a, 0xca, 0xd9, 0x4d, 0xb8, 0xa1, 0x9c, 0x24, 0x51, 0x42, 0xe2, 0x1e, 0xcf, 0x05, 0xc2, 0x32,
-	0x48, 0x41, 0x01, 0x9a, 0xe8, 0x57, 0x87, 0x8f, 0xe3, 0x0b, 0x2e, 0x31, 0x75, 0x54, 0x89, 0x4b,
-	0xa0, 0x84, 0x1b, 0x78, 0x00, 0x61, 0x2a, 0x75, 0xab, 0xc9, 0x6e, 0x03, 0x43, 0xf0, 0x71, 0x30,
-	0xc2, 0x74, 0x12, 0xa3, 0x72, 0x66, 0xb5, 0x36, 0x3f, 0x82, 0x8e, 0x9a, 0x48, 0x35, 0x3b, 0x14,
-	0xaa, 0xb0, 0x43, 0x20, 0x2c, 0xa3, 0x54, 0x2c, 0x31, 0xe6, 0xe6, 0x31, 0x6d, 0x72, 0x38, 0xd0,
-	0x69, 0x9e, 0x91, 0x18, 0x99, 0x5e, 0xe7, 0x41, 0x22, 0x03, 0xce, 0x93, 0xd0, 0xf5, 0x8a, 0x04,
-	0xbb, 0xe0, 0x69, 0x6e, 0xd5, 0xf1, 0xfb, 0x51, 0xf4, 0x23, 0xee, 0x61, 0x86, 0x61, 0x18, 0x82,
-	0x2c, 0xb7, 0x9a, 0x9b, 0xfb, 0xd0, 0x3b, 0x56, 0x8d, 0xfe, 0x20, 0xc1, 0x0b, 0x28, 0xe3, 0x4a,
-	0xac, 0x55, 0x43, 0x9d, 0x94, 0x9d, 0x05, 0xd6, 0x32, 0xd8, 0x0a, 0x2c, 0x61, 0x34, 0x4a, 0x94,
-	0xb9, 0xf9, 0x14, 0xd8, 0x6c, 0x8b, 0x42, 0xa7, 0x95, 0x06, 0x5b, 0x35, 0xb4, 0xe4, 0x33, 0x7e,
-	0x89, 0xdf, 0x14, 0xc3, 0x47, 0xa3, 0x28, 0x4e, 0x39, 0xd1, 0x54, 0x0c, 0xe9, 0xa7, 0x38, 0x44,
-	0xd4, 0x37, 0x8f, 0xa7, 0x9a, 0xf9, 0x41, 0xa2, 0xa5, 0x3b, 0xc1, 0x56, 0x8d, 0x92, 0x8f, 0xa4,
-	0x08, 0x84, 0x74, 0x20, 0x89, 0x11, 0x18, 0x13, 0x15, 0xed, 0x84, 0xdc, 0x4d, 0x05, 0x5c, 0xdf,
-	0xfe, 0x53, 0x0b, 0x5a, 0x62, 0x66, 0x65, 0x1f, 0x41, 0x4f, 0xfb, 0xa3, 0x21, 0xa3, 0x4e, 0x3b,
-	0xfb, 0x27, 0xce, 0xb5, 0xaf, 0xcd, 0xe0, 0x45, 0x7b, 0xb0, 0x6b, 0xec, 0x43, 0x80, 0x72, 0x47,
-	0x65, 0xb7, 0x68, 0xf0, 0x99, 0xde, 0x59, 0xd7, 0x06, 0xf4, 0xeb, 0xc6, 0x9c, 0x3f, 0x88, 0xda,
-	0x35, 0xf6, 0x3d, 0x58, 0x92, 0x3d, 0x48, 0xa4, 0x16, 0x1b, 0x6a, 0x1b, 0xc6, 0x9c, 0xed, 0xf3,
-	0x5a, 0x61, 0x9f, 0x14, 0xc2, 0x44, 0xfa, 0xb0, 0xc1, 0x9c, 0x75, 0x45, 0x88, 0x79, 0x65, 0xe1,
-	0x22, 0x63, 0xd7, 0xd8, 0x1e, 0xf4, 0xc4, 0xba, 0x21, 0x3a, 0xeb, 0x1d, 0xe4, 0x5d, 0xb4, 0x7f,
-	0x5c, 0x6b, 0xd0, 0x0e, 0xf4, 0xf5, 0x0d, 0x81, 0x91, 0x27, 0xe7, 0xac, 0x12, 0x42, 0xc8, 0xbc,
-	0x65, 0xc2, 0xae, 0x31, 0x17, 0x6e, 0xcf, 0x9f, 0xf3, 0xd9, 0xeb, 0xe5, 0xcf, 0xb0, 0x0b, 0x16,
-	0x8b, 0x35, 0xfb, 0x3a, 0x96, 0x42, 0xc5, 0x0f, 0x60, 0x50, 0x28, 0x2f, 0xd2, 0x5a, 0x66, 0xc5,
-	0x50, 0x9a, 0xb6, 0x60, 0x35, 0x58, 0x7b, 0x6d, 0x21, 0xbd, 0x10, 0x7f, 0x04, 0x2b, 0x25, 0x43,
-	0x2c, 0xdc, 0xc7, 0xee, 0xce, 0x9c, 0xab, 0xb8, 0x75, 0xb8, 0x88, 0x5c, 0x48, 0xfd, 0x61, 0xb9,
-	0xdc, 0x56, 0x25, 0xbf, 0xae, 0xc7, 0x76, 0xbe, 0x74, 0xfb, 0x3a, 0x16, 0xa5, 0xe1, 0xfe, 0xe0,
-	0x9f, 0x5f, 0x0e, 0x8d, 0x2f, 0xbe, 0x1c, 0x1a, 0xff, 0xf9, 0x72, 0x68, 0xfc, 0xe2, 0xf9, 0xb0,
-	0xf6, 0xc5, 0xf3, 0x61, 0xed, 0xdf, 0xcf, 0x87, 0xb5, 0x93, 0x16, 0xfd, 0x5b, 0xc0, 0x37, 0xff,
-	0x1b, 0x00, 0x00, 0xff, 0xff, 0x92, 0x9d, 0xb5, 0xc0, 0x28, 0x20, 0x00, 0x00,
+	// 2918 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x5a, 0xcd, 0x6f, 0x24, 0x47,
+	0x15, 0x9f, 0xee, 0xf9, 0xf0, 0xcc, 0x1b, 0xdb, 0xdb, 0x5b, 0xeb, 0x5d, 0x26, 0xce, 0xee, 0x64,
+	0xd3, 0x1b, 0x2d, 0x8e, 0x15, 0x56, 0x89, 0x09, 0x0a, 0x8a, 0x04, 0x49, 0xd6, 0xde, 0x78, 0x37,
+	0x78, 0xe3, 0xdd, 0xb6, 0xb3, 0x9c, 0x90, 0x68, 0x77, 0x97, 0xc7, 0x8d, 0x7b, 0xba, 0x7b, 0xbb,
+	0x6b, 0x6c, 0xf9, 0xc4, 0x09, 0xae, 0x70, 0x01, 0x09, 0x04, 0x07, 0x90, 0x90, 0x38, 0x20, 0x0e,
+	0x1c, 0x38, 0x72, 0x04, 0x8e, 0x11, 0x27, 0x8e, 0x28, 0xf9, 0x47, 0xd0, 0x7b, 0x55, 0xd5, 0x5d,
+	0x3d, 0x1f, 0xde, 0x2c, 0x12, 0xb7, 0x7e, 0x1f, 0xf5, 0xea, 0xd5, 0xab, 0xdf, 0x7b, 0xf5, 0xde,
+	0xd8, 0xb0, 0x1a, 0x8e, 0xcf, 0xd3, 0xfc, 0x94, 0xe7, 0xf7, 0xb2, 0x3c, 0x15, 0x29, 0xb3, 0xb3,
+	0x23, 0x77, 0x03, 0xd8, 0xd3, 0x09, 0xcf, 0x2f, 0x0e, 0x84, 0x2f, 0x26, 0x85, 0xc7, 0x9f, 0x4f,
+	0x78, 0x21, 0x18, 0x83, 0x56, 0xe2, 0x8f, 0xf9, 0xc0, 0xba, 0x6d, 0x6d, 0xf4, 0x3c, 0xfa, 0x76,
+	0x33, 0x58, 0xdb, 0x4e, 0xc7, 0xe3, 0x34, 0xf9, 0x3e, 0xd9, 0xf0, 0x78, 0x91, 0xa5, 0x49, 0xc1,
+	0xd9, 0x0d, 0xe8, 0xe4, 0xbc, 0x98, 0xc4, 0x82, 0xb4, 0xbb, 0x9e, 0xa2, 0x98, 0x03, 0xcd, 0x71,
+	0x31, 0x1a, 0xd8, 0x64, 0x02, 0x3f, 0x51, 0xb3, 0x48, 0x27, 0x79, 0xc0, 0x07, 0x4d, 0x62, 0x2a,
+	0x0a, 0xf9, 0xd2, 0xaf, 0x41, 0x4b, 0xf2, 0x25, 0xe5, 0xfe, 0xd9, 0x82, 0x6b, 0x35, 0xe7, 0x5e,
+	0x7a, 0xc7, 0x77, 0x61, 0x59, 0xee, 0x21, 0x2d, 0xd0, 0xbe, 0xfd, 0x2d, 0xe7, 0x5e, 0x76, 0x74,
+	0xef, 0xc0, 0xe0, 0x7b, 0x35, 0x2d, 0xf6, 0x1e, 0xac, 0x14, 0x93, 0xa3, 0x43, 0xbf, 0x38, 0x55,
+	0xcb, 0x5a, 0xb7, 0x9b, 0x1b, 0xfd, 0xad, 0xab, 0xb4, 0xcc, 0x14, 0x78, 0x75, 0x3d, 0xf7, 0x0f,
+	0x16, 0xf4, 0xb7, 0x4f, 0x78, 0xa0, 0x68, 0x74, 0x34, 0xf3, 0x8b, 0x82, 0x87, 0xda, 0x51, 0x49,
+	0xb1, 0x35, 0x68, 0x8b, 0x54, 0xf8, 0x31, 0xb9, 0xda, 0xf6, 0x24, 0xc1, 0x86, 0x00, 0xc5, 0x24,
+	0x08, 0x78, 0x51, 0x1c, 0x4f, 0x62, 0x72, 0xb5, 0xed, 0x19, 0x1c, 0xb4, 0x76, 0xec, 0x47, 0x31,
+	0x0f, 0x29, 0x4c, 0x6d, 0x4f, 0x51, 0x6c, 0x00, 0x4b, 0xe7, 0x7e, 0x9e, 0x44, 0xc9, 0x68, 0xd0,
+	0x26, 0x81, 0x26, 0x71, 0x45, 0xc8, 0x85, 0x1f, 0xc5, 0x83, 0xce, 0x6d, 0x6b, 0x63, 0xd9, 0x53,
+	0x94, 0xfb, 0xb9, 0x05, 0xb0, 0x33, 0x19, 0x67, 0xca, 0xcd, 0xdb, 0xd0, 0x27, 0x0f, 0x0e, 0xfd,
+	0xa3, 0x98, 0x17, 0xe4, 0x6b, 0xd3, 0x33, 0x59, 0x6c, 0x03, 0xae, 0x04, 0xe9, 0x38, 0x8b, 0xb9,
+	0xe0, 0xa1, 0xd2, 0x42, 0xd7, 0x2d, 0x6f, 0x9a, 0xcd, 0xde, 0x80, 0x95, 0xe3, 0x28, 0x89, 0x8a,
+	0x13, 0x1e, 0xde, 0xbf, 0x10, 0x5c, 0x86, 0xdc, 0xf2, 0xea, 0x4c, 0xe6, 0xc2, 0xb2, 0x66, 0x78,
+	0xe9, 0x79, 0x41, 0x07, 0xb2, 0xbc, 0x1a, 0x8f, 0xbd, 0x05, 0x57, 0x79, 0x21, 0xa2, 0xb1, 0x2f,
+	0xf8, 0x21, 0xba, 0x42, 0x8a, 0x6d, 0x52, 0x9c, 0x15, 0xb8, 0x7f, 0xb5, 0x00, 0xf6, 0x52, 0x3f,
+	0x54, 0x47, 0x9a, 0x71, 0x43, 0x1e, 0x6a, 0xca, 0x8d, 0x21, 0x00, 0x9d, 0x52, 0xaa, 0xd8, 0xa4,
+	0x62, 0x70, 0xd8, 0x3a, 0x74, 0xb3, 0x3c, 0x1d, 0xe5, 0xbc, 0x28, 0x14, 0x64, 0x4b, 0x1a, 0xd7,
+	0x8e, 0xb9, 0xf0, 0xef, 0x47, 0x49, 0x9c, 0x8e, 0x14, 0x70, 0x0d, 0x0e, 0xbb, 0x0b, 0xab, 0x15,
+	0xb5, 0x7b, 0xf8, 0x68, 0x87, 0x7c, 0xef, 0x79, 0x53, 0x5c, 0xf7, 0x17, 0x16, 0xac, 0x1c, 0x9c,
+	0xf8, 0x79, 0x18, 0x25, 0xa3, 0xdd, 0x3c, 0x9d, 0x64, 0x78, 0x6b, 0xc2, 0xcf, 0x47, 0x5c, 0xa8,
+	0xf4, 0x53, 0x14, 0x26, 0xe5, 0xce, 0xce, 0x1e, 0xfa, 0xd9, 0xc4, 0xa4, 0xc4, 0x6f, 0x79, 0xce,
+	0xbc, 0x10, 0x7b, 0x69, 0xe0, 0x8b, 0x28, 0x4d, 0x94, 0x9b, 0x75, 0x26, 0x25, 0xde, 0x45, 0x12,
+	0x10, 0x72, 0x9a, 0x94, 0x78, 0x44, 0xe1, 0xf9, 0x26, 0x89, 0x92, 0xb4, 0x49, 0x52, 0xd2, 0xee,
+	0x6f, 0x5b, 0x00, 0x07, 0x17, 0x49, 0x30, 0x85, 0x91, 0x07, 0x67, 0x3c, 0x11, 0x75, 0x8c, 0x48,
+	0x16, 0x1a, 0x93, 0x90, 0xc9, 0x74, 0x28, 0x4b, 0x9a, 0xdd, 0x84, 0x5e, 0xce, 0x03, 0x9e, 0x08,
+	0x14, 0x36, 0x49, 0x58, 0x31, 0x10, 0x0d, 0x63, 0xbf, 0x10, 0x3c, 0xaf, 0x05, 0xb3, 0xc6, 0x63,
+	0x9b, 0xe0, 0x98, 0xf4, 0xae, 0x88, 0x42, 0x15, 0xd0, 0x19, 0x3e, 0xda, 0xa3, 0x43, 0x68, 0x7b,
+	0x1d, 0x69, 0xcf, 0xe4, 0xa1, 0x3d, 0x93, 0x26, 0x7b, 0x4b, 0xd2, 0xde, 0x34, 0x1f, 0xed, 0x1d,
+	0xc5, 0x69, 0x70, 0x1a, 0x25, 0x23, 0xba, 0x80, 0x2e, 0x85, 0xaa, 0xc6, 0x63, 0xdf, 0x01, 0x67,
+	0x92, 0xe4, 0xbc, 0x48, 0xe3, 0x33, 0x1e, 0xd2, 0x3d, 0x16, 0x83, 0x9e, 0x51, 0x36, 0xcc, 0x1b,
+	0xf6, 0x66, 0x54, 0x8d, 0x1b, 0x02, 0x59, 0x29, 0xd4, 0x0d, 0x0d, 0x01, 0x8e, 0xc8, 0x91, 0xc3,
+	0x8b, 0x8c, 0x0f, 0xfa, 0x12, 0x65, 0x15, 0x87, 0xbd, 0x0d, 0xd7, 0x0a, 0x1e, 0xa4, 0x49, 0x58,
+	0xdc, 0xe7, 0x27, 0x51, 0x12, 0x3e, 0xa6, 0x58, 0x0c, 0x96, 0x29, 0xc4, 0xf3, 0x44, 0x88, 0x18,
+	0x72, 0x7c, 0x67, 0x67, 0x6f, 0xff, 0x3c, 0xe1, 0xf9, 0x60, 0x45, 0x22, 0xa6, 0xc6, 0xc4, 0xeb,
+	0x0e, 0xd2, 0xe4, 0x38, 0x8e, 0x02, 0xf1, 0xb8, 0x18, 0x0d, 0x56, 0x49, 0xc7, 0x64, 0xb9, 0xbf,
+	0xb1, 0x60, 0xd9, 0xac, 0xa1, 0x46, 0x75, 0xb7, 0x16, 0x54, 0x77, 0xdb, 0xac, 0xee, 0xec, 0xcd,
+	0xb2, 0x8a, 0xcb, 0xaa, 0x4c, 0x71, 0x7a, 0x92, 0xa7, 0x58, 0xee, 0x3c, 0x12, 0x94, 0x85, 0xfd,
+	0x1d, 0xe8, 0xe7, 0x3c, 0xf6, 0x2f, 0xca, 0x72, 0x8c, 0xfa, 0x57, 0x50, 0xdf, 0xab, 0xd8, 0x9e,
+	0xa9, 0xe3, 0xfe, 0xc3, 0x86, 0xbe, 0x21, 0x9c, 0xc1, 0x98, 0xf5, 0x15, 0x31, 0x66, 0x2f, 0xc0,
+	0xd8, 0x6d, 0xed, 0xd2, 0xe4, 0x68, 0x27, 0xca, 0x55, 0xda, 0x99, 0xac, 0x52, 0xa3, 0x06, 0x6a,
+	0x93, 0x85, 0x55, 0xd5, 0x20, 0x0d, 0x48, 0x4f, 0xb3, 0xd9, 0x3d, 0x60, 0xc4, 0xda, 0xf6, 0x45,
+	0x70, 0xf2, 0x59, 0xa6, 0x6e, 0xb9, 0x43, 0x50, 0x99, 0x23, 0x61, 0xaf, 0x41, 0xbb, 0x10, 0xfe,
+	0x88, 0x13, 0xa4, 0x57, 0xb7, 0x7a, 0x04, 0x41, 0x64, 0x78, 0x92, 0x6f, 0x04, 0xbf, 0xfb, 0x82,
+	0xe0, 0xbb, 0x7f, 0x69, 0xc2, 0x4a, 0xed, 0xd5, 0x9b, 0xd7, 0x1d, 0x54, 0x3b, 0xda, 0x0b, 0x76,
+	0xbc, 0x0d, 0xad, 0x49, 0x12, 0xc9, 0xcb, 0x5e, 0xdd, 0x5a, 0x46, 0xf9, 0x67, 0x49, 0x24, 0x10,
+	0xc5, 0x1e, 0x49, 0x0c, 0x9f, 0x5a, 0x2f, 0x02, 0xc4, 0xdb, 0x70, 0xad, 0x4a, 0xa1, 0x9d, 0x9d,
+	0xbd, 0xbd, 0x34, 0x38, 0x2d, 0x2b, 0xec, 0x3c, 0x11, 0x63, 0xb2, 0x37, 0xa0, 0x52, 0xf0, 0xb0,
+	0x21, 0xbb, 0x83, 0xaf, 0x43, 0x3b, 0xc0, 0xd7, 0x9a, 0xa2, 0xa4, 0x00, 0x65, 0x3c, 0xdf, 0x0f,
+	0x1b, 0x9e, 0x94, 0xb3, 0x37, 0xa0, 0x15, 0x4e, 0xc6, 0x99, 0x8a, 0xd5, 0x2a, 0xea, 0x55, 0xcf,
+	0xe7, 0xc3, 0x86, 0x47, 0x52, 0xd4, 0x8a, 0x53, 0x3f, 0x1c, 0xf4, 0x2a, 0xad, 0xea, 0x45, 0x42,
+	0x2d, 0x94, 0xa2, 0x16, 0xe6, 0x36, 0xe5, 0xb9, 0xd2, 0xaa, 0xca, 0x2c, 0x6a, 0xa1, 0x94, 0xbd,
+	0x0b, 0x70, 0xe6, 0xc7, 0x51, 0x28, 0x8b, 0x7a, 0x9f, 0x74, 0xd7, 0x50, 0xf7, 0x59, 0xc9, 0x55,
+	0xa8, 0x37, 0xf4, 0xee, 0x77, 0xa1, 0x53, 0x48, 0xf8, 0x7f, 0x17, 0xae, 0xd6, 0xee, 0x6c, 0x2f,
+	0x2a, 0x28, 0xc0, 0x52, 0x3c, 0xb0, 0x16, 0x35, 0x34, 0x7a, 0xfd, 0x10, 0x80, 0x22, 0xf1, 0x20,
+	0xcf, 0xd3, 0x5c, 0x37, 0x56, 0x56, 0xd9, 0x58, 0xb9, 0xb7, 0xa0, 0x87, 0x11, 0xb8, 0x44, 0x8c,
+	0x47, 0x5f, 0x24, 0xce, 0x60, 0x99, 0xce, 0xfc, 0x74, 0x6f, 0x81, 0x06, 0xdb, 0x82, 0x35, 0xd9,
+	0xdd, 0xc8, 0x24, 0x78, 0x92, 0x16, 0x11, 0x45, 0x42, 0xa6, 0xe3, 0x5c, 0x19, 0x3e, 0x40, 0x1c,
+	0xcd, 0x1d, 0x3c, 0xdd, 0xd3, 0xaf, 0xb5, 0xa6, 0xdd, 0x6f, 0x41, 0x0f, 0x77, 0x94, 0xdb, 0x6d,
+	0x40, 0x87, 0x04, 0x3a, 0x0e, 0x4e, 0x79, 0x09, 0xca, 0x21, 0x4f, 0xc9, 0xdd, 0x9f, 0x59, 0xd0,
+	0x97, 0x45, 0x4e, 0xae, 0x7c, 0xd9, 0x1a, 0x77, 0xbb, 0xb6, 0x5c, 0x57, 0x09, 0xd3, 0xe2, 0x3d,
+	0x00, 0x2a, 0x53, 0x52, 0xa1, 0x55, 0x81, 0xa2, 0xe2, 0x7a, 0x86, 0x06, 0x5e, 0x4c, 0x45, 0xcd,
+	0x09, 0xed, 0xaf, 0x6c, 0x58, 0x56, 0x57, 0x2a, 0x55, 0xfe, 0x4f, 0xc9, 0xaa, 0xf2, 0xa9, 0x65,
+	0xe6, 0xd3, 0x5d, 0x9d, 0x4f, 0xed, 0xea, 0x18, 0x15, 0x8a, 0xaa, 0x74, 0xba, 0xa3, 0xd2, 0xa9,
+	0x43, 0x6a, 0x2b, 0x3a, 0x9d, 0xb4, 0x96, 0xcc, 0xa6, 0x3b, 0x2a, 0x9b, 0x96, 0x2a, 0xa5, 0x12,
+	0x52, 0x65, 0x32, 0xdd, 0x51, 0xc9, 0xd4, 0xad, 0x94, 0xca, 0x6b, 0xd6, 0xb9, 0x74, 0x7f, 0x09,
+	0xda, 0x74, 0x9d, 0xee, 0xfb, 0xe0, 0x98, 0xa1, 0xa1, 0x9c, 0xb8, 0xab, 0x84, 0x35, 0x28, 0x18,
+	0x4a, 0x9e, 0x5a, 0xfb, 0x1c, 0x56, 0x6a, 0xa5, 0x08, 0x5f, 0xe6, 0xa8, 0xd8, 0xf6, 0x93, 0x80,
+	0xc7, 0x65, 0x7f, 0x6f, 0x70, 0x0c, 0x90, 0xd9, 0x95, 0x65, 0x65, 0xa2, 0x06, 0x32, 0xa3, 0x4b,
+	0x6f, 0xd6, 0xba, 0xf4, 0x7f, 0x59, 0xb0, 0x6c, 0x2e, 0xc0, 0x46, 0xff, 0x41, 0x9e, 0x6f, 0xa7,
+	0xa1, 0xbc, 0xcd, 0xb6, 0xa7, 0x49, 0x84, 0x3e, 0x7e, 0xc6, 0x7e, 0x51, 0x28, 0x04, 0x96, 0xb4,
+	0x92, 0x1d, 0x04, 0x69, 0xa6, 0xe7, 0xae, 0x92, 0x56, 0xb2, 0x3d, 0x7e, 0xc6, 0x63, 0xf5, 0x40,
+	0x95, 0x34, 0xee, 0xf6, 0x98, 0x17, 0x05, 0xc2, 0x44, 0xd6, 0x55, 0x4d, 0xe2, 0x2a, 0xcf, 0x3f,
+	0xdf, 0xf6, 0x27, 0x05, 0x57, 0xbd, 0x55, 0x49, 0x63, 0x58, 0x70, 0x3e, 0xf4, 0xf3, 0x74, 0x92,
+	0xe8, 0x8e, 0xca, 0xe0, 0xb8, 0xe7, 0x70, 0xf5, 0xc9, 0x24, 0x1f, 0x71, 0x02, 0xb1, 0x1e, 0x37,
+	0xd7, 0xa1, 0x1b, 0x25, 0x7e, 0x20, 0xa2, 0x33, 0xae, 0x22, 0x59, 0xd2, 0x88, 0x5f, 0x11, 0x8d,
+	0xb9, 0x6a, 0x29, 0xe9, 0x1b, 0xf5, 0x8f, 0xa3, 0x98, 0x13, 0xae, 0xd5, 0x91, 0x34, 0x4d, 0x29,
+	0x2a, 0xdf, 0x64, 0x35, 0x4c, 0x4a, 0xca, 0xfd, 0xb5, 0x0d, 0xeb, 0xfb, 0x19, 0xcf, 0x7d, 0xc1,
+	0xe5, 0x00, 0x7b, 0x10, 0x9c, 0xf0, 0xb1, 0xaf, 0x5d, 0xb8, 0x09, 0x76, 0x9a, 0xd1, 0xe6, 0x0a,
+	0xef, 0x52, 0xbc, 0x9f, 0x79, 0x76, 0x9a, 0x91, 0x13, 0x7e, 0x71, 0xaa, 0x62, 0x4b, 0xdf, 0x0b,
+	0xa7, 0xd9, 0x75, 0xe8, 0x86, 0xbe, 0xf0, 0x8f, 0xfc, 0x82, 0xeb, 0x98, 0x6a, 0x9a, 0x06, 0x3f,
+	0x9c, 0x93, 0x54, 0x44, 0x25, 0x41, 0x96, 0x68, 0x37, 0x15, 0x4d, 0x45, 0xa1, 0xf6, 0x71, 0x3c,
+	0x29, 0x4e, 0x28, 0x8c, 0x5d, 0x4f, 0x12, 0xe8, 0x4b, 0x89, 0xf9, 0xae, 0x7a, 0x2e, 0x86, 0x00,
+	0xc7, 0x79, 0x3a, 0x96, 0x85, 0x85, 0x1e, 0xa0, 0xae, 0x67, 0x70, 0xb4, 0xfc, 0x50, 0x8e, 0x15,
+	0x50, 0xc9, 0x25, 0xc7, 0x15, 0xb0, 0xf2, 0xec, 0x1d, 0x05, 0xfb, 0xc7, 0x5c, 0xf8, 0x6c, 0xdd,
+	0x08, 0x07, 0x60, 0x38, 0x50, 0xa2, 0x82, 0xf1, 0xc2, 0xea, 0xa1, 0x4b, 0x4e, 0xd3, 0x28, 0x39,
+	0x3a, 0x82, 0x2d, 0x82, 0x38, 0x7d, 0xbb, 0xef, 0xc2, 0x9a, 0xba, 0x91, 0x67, 0xef, 0xe0, 0xae,
+	0x0b, 0xef, 0x42, 0x8a, 0xe5, 0xf6, 0xee, 0xdf, 0x2d, 0xb8, 0x3e, 0xb5, 0xec, 0xa5, 0x7f, 0x17,
+	0x78, 0x0f, 0x5a, 0x38, 0x86, 0x0d, 0x9a, 0x94, 0x9a, 0x77, 0x70, 0x8f, 0xb9, 0x26, 0xef, 0x21,
+	0xf1, 0x20, 0x11, 0xf9, 0x85, 0x47, 0x0b, 0xd6, 0x3f, 0x81, 0x5e, 0xc9, 0x42, 0xbb, 0xa7, 0xfc,
+	0x42, 0x57, 0xdf, 0x53, 0x7e, 0x81, 0x1d, 0xc5, 0x99, 0x1f, 0x4f, 0x64, 0x68, 0xd4, 0x03, 0x5b,
+	0x0b, 0xac, 0x27, 0xe5, 0xef, 0xdb, 0xdf, 0xb6, 0xdc, 0x9f, 0xd8, 0x30, 0x78, 0xe8, 0x27, 0x61,
+	0xac, 0x00, 0x29, 0xab, 0x82, 0x8a, 0xc1, 0xab, 0x46, 0x0c, 0xfa, 0x68, 0x86, 0xa4, 0x97, 0xc0,
+	0xf1, 0x26, 0xf4, 0x8e, 0xf4, 0x7b, 0xa8, 0x22, 0x5f, 0x31, 0x08, 0x34, 0xcf, 0xe3, 0x42, 0xcd,
+	0x7f, 0xf4, 0x8d, 0x09, 0x3e, 0x12, 0x51, 0x78, 0xc0, 0x85, 0x4e, 0x70, 0x45, 0xa2, 0xad, 0x42,
+	0xf8, 0xb9, 0x38, 0xc4, 0xc4, 0x93, 0x98, 0xac, 0x18, 0xb8, 0x8e, 0x27, 0x21, 0xc9, 0x64, 0x7e,
+	0x6b, 0x12, 0x61, 0x16, 0x86, 0xf1, 0x13, 0x5f, 0x08, 0x9e, 0x27, 0x04, 0xd0, 0x9e, 0x67, 0x70,
+	0x10, 0xd0, 0x71, 0x34, 0x8e, 0x04, 0x21, 0xb4, 0xed, 0x49, 0xc2, 0xbd, 0x0e, 0xd7, 0x76, 0xb9,
+	0x90, 0x31, 0xd8, 0x3e, 0x1e, 0xa9, 0x08, 0xb8, 0x1b, 0xb0, 0x56, 0x67, 0xab, 0x5b, 0x76, 0xa0,
+	0x19, 0x1c, 0x97, 0x6f, 0x5e, 0x70, 0x3c, 0x72, 0x0f, 0xe0, 0x96, 0x6c, 0xdb, 0x26, 0x47, 0x18,
+	0x0a, 0xac, 0xc1, 0x9f, 0x65, 0xa1, 0x2f, 0xb8, 0x0e, 0xe6, 0x16, 0xac, 0x15, 0x52, 0xb6, 0x7d,
+	0x3c, 0x3a, 0x4c, 0xc7, 0xf1, 0x81, 0xc8, 0xa3, 0x44, 0xdb, 0x98, 0x2b, 0x73, 0xf7, 0x60, 0xb8,
+	0xc8, 0xa8, 0x72, 0x64, 0x00, 0x4b, 0xea, 0xd7, 0x19, 0x85, 0x37, 0x4d, 0xce, 0x02, 0xce, 0x1d,
+	0xc1, 0xfa, 0x2e, 0x17, 0x33, 0xcd, 0x5b, 0x55, 0xff, 0x70, 0x8f, 0x4f, 0xab, 0x77, 0xba, 0xa4,
+	0xd9, 0x37, 0x60, 0xf9, 0x38, 0x8a, 0x05, 0xcf, 0xd5, 0xf0, 0x33, 0x93, 0x74, 0x35, 0xb1, 0xfb,
+	0xa7, 0x26, 0x38, 0xd3, 0xdb, 0x94, 0x78, 0xb1, 0xe6, 0x96, 0x2f, 0xbb, 0x56, 0xbe, 0x18, 0xb4,
+	0xc6, 0xf8, 0xc2, 0xa8, 0xe4, 0xc5, 0xef, 0x2a, 0xe3, 0x5b, 0x0b, 0x32, 0x7e, 0x03, 0xae, 0xa8,
+	0x36, 0x34, 0xd5, 0x03, 0x96, 0x9a, 0x64, 0xa6, 0xd8, 0xd8, 0xb9, 0x4f, 0xb1, 0x68, 0xee, 0x91,
+	0x20, 0x9b, 0x27, 0x32, 0xc6, 0x82, 0xa5, 0xaf, 0x30, 0x16, 0x64, 0x52, 0x20, 0x7f, 0x43, 0x52,
+	0x21, 0x93, 0x40, 0x9c, 0x27, 0x62, 0x6f, 0xc1, 0xd5, 0x8c, 0x27, 0x38, 0x99, 0x1b, 0xfa, 0x3d,
+	0xd2, 0x9f, 0x15, 0xe0, 0x31, 0xe9, 0xcd, 0x36, 0x74, 0x41, 0x1e, 0x73, 0x8a, 0xcd, 0xee, 0xc2,
+	0x6a, 0x91, 0xf8, 0x59, 0x71, 0x92, 0x0a, 0xa5, 0x28, 0x67, 0xf7, 0x29, 0xae, 0xfb, 0x7b, 0x0b,
+	0xae, 0x57, 0xd7, 0x45, 0xbf, 0xa1, 0xbd, 0x60, 0x9c, 0x5e, 0x87, 0x6e, 0x91, 0x07, 0xa4, 0xa9,
+	0x9f, 0x7a, 0x4d, 0xd3, 0xd3, 0x53, 0x08, 0x29, 0x53, 0xef, 0xa2, 0xa6, 0x5f, 0x7c, 0x87, 0x03,
+	0x58, 0x1a, 0xd7, 0xdf, 0x7b, 0x45, 0xba, 0x7f, 0xb3, 0xe0, 0xd5, 0xb9, 0xe8, 0xfd, 0x1f, 0x7e,
+	0x8f, 0x85, 0xf2, 0x8a, 0x0b, 0x55, 0x7d, 0x2f, 0x1f, 0x6b, 0xb0, 0x41, 0xfa, 0x00, 0x56, 0x44,
+	0x15, 0x19, 0xae, 0x7f, 0x8f, 0x7d, 0xa5, 0xbe, 0xd0, 0x08, 0x9e, 0x57, 0xd7, 0x77, 0x4f, 0xe1,
+	0x95, 0x9a, 0xff, 0xb5, 0x4a, 0xbb, 0x45, 0x63, 0x03, 0xea, 0x72, 0x55, 0x6f, 0x6f, 0x18, 0x86,
+	0x65, 0x9b, 0x4e, 0x52, 0xaf, 0xd4, 0xab, 0x25, 0xac, 0x5d, 0x4f, 0x58, 0xf7, 0x77, 0x36, 0x5c,
+	0x99, 0xda, 0x8a, 0xad, 0x82, 0x1d, 0x85, 0xea, 0x22, 0xed, 0x28, 0x5c, 0x98, 0x7c, 0xe6, 0xe5,
+	0x36, 0xa7, 0x2e, 0x17, 0xcb, 0x4d, 0x1e, 0xec, 0xf8, 0xc2, 0x57, 0x6d, 0x85, 0x26, 0x6b, 0xd7,
+	0xde, 0x9e, 0xba, 0xf6, 0x01, 0x2c, 0x85, 0x85, 0xa0, 0x55, 0x32, 0xc7, 0x34, 0x89, 0x45, 0x9e,
+	0x50, 0x4b, 0xbf, 0x2c, 0xc9, 0x42, 0x5e, 0x31, 0xd8, 0xbd, 0x72, 0x56, 0xec, 0x5e, 0x1a, 0x13,
+	0xa5, 0x55, 0xb6, 0x69, 0x3d, 0x55, 0x62, 0xd4, 0x43, 0xa1, 0x11, 0x05, 0x75, 0x44, 0x3d, 0x9f,
+	0x2a, 0x87, 0xea, 0x42, 0x5e, 0x1a, 0x4f, 0x6f, 0xea, 0xee, 0x5d, 0x42, 0xe9, 0x5a, 0x1d, 0x11,
+	0xb5, 0x06, 0xfe, 0x97, 0x16, 0xdc, 0xd2, 0x6f, 0xfc, 0x7c, 0x20, 0xdc, 0x31, 0x9e, 0xdc, 0x59,
+	0x4b, 0xea, 0xe9, 0xa5, 0xb6, 0xff, 0xa3, 0x38, 0x96, 0xf3, 0x9a, 0xad, 0xdb, 0x7e, 0xcd, 0xa9,
+	0x21, 0xa3, 0x39, 0x55, 0xca, 0xd7, 0xc8, 0xdb, 0x47, 0xf2, 0xf7, 0xfb, 0x96, 0x27, 0x09, 0xf7,
+	0x13, 0x18, 0x2e, 0xf2, 0xeb, 0x65, 0xe3, 0xb1, 0x79, 0x0a, 0x1d, 0xd9, 0xa8, 0xb1, 0x15, 0xe8,
+	0x3d, 0x4a, 0x28, 0x87, 0xf6, 0x33, 0xa7, 0xc1, 0xba, 0xd0, 0x3a, 0x10, 0x69, 0xe6, 0x58, 0xac,
+	0x07, 0xed, 0x27, 0xd8, 0xa9, 0x3b, 0x36, 0x03, 0xe8, 0x60, 0x01, 0x1d, 0x73, 0xa7, 0x89, 0xec,
+	0x03, 0x7c, 0xe1, 0x9d, 0x16, 0xb2, 0xe5, 0x4b, 0xe7, 0xb4, 0xd9, 0x2a, 0xc0, 0x47, 0x13, 0x91,
+	0x2a, 0xb5, 0x0e, 0xca, 0x76, 0x78, 0xcc, 0x05, 0x77, 0x96, 0x36, 0x7f, 0x4c, 0x4b, 0x46, 0xf8,
+	0x22, 0x2f, 0xab, 0xbd, 0x88, 0x76, 0x1a, 0x6c, 0x09, 0x9a, 0x9f, 0xf2, 0x73, 0xc7, 0x62, 0x7d,
+	0x58, 0xf2, 0x26, 0x49, 0x12, 0x25, 0x23, 0xb9, 0x1f, 0x6d, 0x1d, 0x3a, 0x4d, 0x14, 0xa0, 0x43,
+	0x19, 0x0f, 0x9d, 0x16, 0x5b, 0x86, 0xee, 0xc7, 0xea, 0x87, 0x79, 0xa7, 0x8d, 0x22, 0x54, 0xc3,
+	0x35, 0x1d, 0x14, 0xd1, 0xe6, 0x48, 0x2d, 0x21, 0x45, 0xab, 0x90, 0xea, 0x6e, 0xee, 0x43, 0x57,
+	0x4f, 0xa5, 0xec, 0x0a, 0xf4, 0x95, 0x0f, 0xc8, 0x72, 0x1a, 0x78, 0x20, 0x7a, 0xbf, 0x1d, 0x0b,
+	0x0f, 0x8f, 0xf3, 0xa5, 0x63, 0xe3, 0x17, 0x0e, 0x91, 0x4e, 0x93, 0x02, 0x72, 0x91, 0x04, 0x4e,
+	0x0b, 0x15, 0x69, 0x18, 0x71, 0xc2, 0xcd, 0xc7, 0xb0, 0x44, 0x9f, 0xfb, 0xd8, 0x62, 0xad, 0x2a,
+	0x7b, 0x8a, 0xe3, 0x34, 0x30, 0xa6, 0xb8, 0xbb, 0xd4, 0xb6, 0x30, 0x36, 0x74, 0x1c, 0x49, 0xdb,
+	0xe8, 0x82, 0x8c, 0x93, 0x64, 0x34, 0x37, 0x7f, 0x6a, 0x41, 0x57, 0x8f, 0x11, 0xec, 0x1a, 0x5c,
+	0xd1, 0x41, 0x52, 0x2c, 0x69, 0x71, 0x97, 0x0b, 0xc9, 0x70, 0x2c, 0xda, 0xa0, 0x24, 0x6d, 0x8c,
+	0xab, 0xc7, 0xc7, 0xe9, 0x19, 0x57, 0x9c, 0x26, 0x6e, 0x89, 0x53, 0xab, 0xa2, 0x5b, 0xb8, 0x00,
+	0x69, 0x4a, 0x75, 0xa7, 0xcd, 0x6e, 0x00, 0x43, 0xf2, 0x71, 0x34, 0x42, 0x38, 0xc9, 0xde, 0xbe,
+	0x70, 0x3a, 0x9b, 0x1f, 0x42, 0x57, 0xb7, 0xd0, 0x86, 0x1f, 0x9a, 0x55, 0xfa, 0x21, 0x19, 0x8e,
+	0x55, 0x6d, 0xac, 0x38, 0xf6, 0xe6, 0x31, 0x8d, 0x9e, 0xd8, 0x80, 0x1a, 0x91, 0x51, 0x1c, 0x05,
+	0xaf, 0xd3, 0x28, 0x53, 0x17, 0xce, 0xb3, 0xd8, 0x0f, 0x4a, 0x80, 0x9d, 0xf1, 0x5c, 0x38, 0x4d,
+	0xfc, 0x7e, 0x94, 0xfc, 0x88, 0x07, 0x88, 0x30, 0xbc, 0x86, 0xa8, 0x10, 0x12, 0x5f, 0xf8, 0x25,
+	0xff, 0x84, 0xe0, 0x74, 0x36, 0xf7, 0xa0, 0xff, 0x4c, 0x17, 0xfe, 0xfd, 0x0c, 0x0f, 0xa4, 0x9d,
+	0xad, 0xb8, 0x4e, 0x03, 0x7d, 0x20, 0xb4, 0x96, 0x5c, 0xc7, 0x62, 0x57, 0x61, 0x05, 0x6f, 0xa7,
+	0x62, 0xd9, 0x9b, 0x4f, 0x81, 0xcd, 0x96, 0x2c, 0xdc, 0xb3, 0x3a, 0x80, 0xd3, 0x40, 0xcf, 0x3e,
+	0xe5, 0xe7, 0xf8, 0x4d, 0x77, 0xfa, 0x68, 0x94, 0xa4, 0x39, 0x27, 0x99, 0xbe, 0x53, 0xfa, 0x2d,
+	0x11, 0x19, 0xcd, 0xcd, 0xd1, 0x54, 0x71, 0xdf, 0xcf, 0x0c, 0xf8, 0x13, 0xed, 0x34, 0x08, 0x8c,
+	0x64, 0x45, 0x32, 0x54, 0x40, 0xc9, 0x8c, 0xe4, 0xd8, 0xb8, 0xd1, 0x76, 0xcc, 0xfd, 0x5c, 0xd2,
+	0x4d, 0xb9, 0x51, 0xe6, 0x47, 0x8a, 0xd1, 0xda, 0xfa, 0x63, 0x07, 0x3a, 0xb2, 0xf9, 0x65, 0x1f,
+	0x42, 0xdf, 0xf8, 0x33, 0x28, 0xa3, 0x52, 0x3c, 0xfb, 0x47, 0xdb, 0xf5, 0xaf, 0xcd, 0xf0, 0x65,
+	0xfd, 0x70, 0x1b, 0xec, 0x03, 0x80, 0x6a, 0xea, 0x66, 0xd7, 0xa9, 0x83, 0x9a, 0x9e, 0xc2, 0xd7,
+	0x07, 0xf4, 0x7b, 0xcd, 0x9c, 0x3f, 0xf1, 0xba, 0x0d, 0xf6, 0x3d, 0x58, 0x51, 0x45, 0x4a, 0x62,
+	0x8f, 0x0d, 0x8d, 0x99, 0x69, 0xce, 0x3c, 0x7d, 0xa9, 0xb1, 0x8f, 0x4b, 0x63, 0x12, 0x5f, 0x6c,
+	0x30, 0x67, 0x00, 0x93, 0x66, 0x5e, 0x59, 0x38, 0x9a, 0xb9, 0x0d, 0xb6, 0x0b, 0x7d, 0x39, 0x3f,
+	0xc9, 0xd2, 0x7b, 0x13, 0x75, 0x17, 0x0d, 0x54, 0x97, 0x3a, 0xb4, 0x0d, 0xcb, 0xe6, 0xa8, 0xc1,
+	0x28, 0x92, 0x73, 0x66, 0x12, 0x69, 0x64, 0xde, 0x54, 0xe2, 0x36, 0x98, 0x0f, 0x37, 0xe6, 0x0f,
+	0x0c, 0xec, 0xf5, 0xea, 0x87, 0xe5, 0x05, 0x13, 0xca, 0xba, 0x7b, 0x99, 0x4a, 0xb9, 0xc5, 0x0f,
+	0x60, 0x50, 0x6e, 0x5e, 0xe2, 0x5c, 0xa1, 0x62, 0xa8, 0x5c, 0x5b, 0x30, 0x63, 0xac, 0xbf, 0xb6,
+	0x50, 0x5e, 0x9a, 0x3f, 0x84, 0xab, 0x95, 0x42, 0x2a, 0xc3, 0xc7, 0x6e, 0xcd, 0xac, 0xab, 0x85,
+	0x75, 0xb8, 0x48, 0x5c, 0x5a, 0xfd, 0x61, 0x35, 0xae, 0xd7, 0x2d, 0xbf, 0x6e, 0xde, 0xed, 0x7c,
+	0xeb, 0xee, 0x65, 0x2a, 0x7a, 0x87, 0xfb, 0x83, 0x7f, 0x7e, 0x31, 0xb4, 0x3e, 0xff, 0x62, 0x68,
+	0xfd, 0xe7, 0x8b, 0xa1, 0xf5, 0xf3, 0x2f, 0x87, 0x8d, 0xcf, 0xbf, 0x1c, 0x36, 0xfe, 0xfd, 0xe5,
+	0xb0, 0x71, 0xd4, 0xa1, 0x7f, 0x74, 0xf8, 0xe6, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xed, 0x98,
+	0xba, 0xb9, 0xfa, 0x20, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -5835,6 +5896,39 @@ func (m *HandleWorkerErrorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	if m.Limit != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.DdlPattern) > 0 {
+		i -= len(m.DdlPattern)
+		copy(dAtA[i:], m.DdlPattern)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.DdlPattern)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.EndTime) > 0 {
+		i -= len(m.EndTime)
+		copy(dAtA[i:], m.EndTime)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.EndTime)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.StartTime) > 0 {
+		i -= len(m.StartTime)
+		copy(dAtA[i:], m.StartTime)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.StartTime)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.GtidSet) > 0 {
+		i -= len(m.GtidSet)
+		copy(dAtA[i:], m.GtidSet)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.GtidSet)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.Sqls) > 0 {
 		for iNdEx := len(m.Sqls) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Sqls[iNdEx])
@@ -6044,6 +6138,13 @@ func (m *ValidationStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SnapshotStatus) > 0 {
+		i -= len(m.SnapshotStatus)
+		copy(dAtA[i:], m.SnapshotStatus)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.SnapshotStatus)))
+		i--
+		dAtA[i] = 0x5a
+	}
 	if len(m.ErrorRowsStatus) > 0 {
 		i -= len(m.ErrorRowsStatus)
 		copy(dAtA[i:], m.ErrorRowsStatus)
@@ -7357,6 +7458,25 @@ func (m *HandleWorkerErrorRequest) Size() (n int) {
 			n += 1 + l + sovDmworker(uint64(l))
 		}
 	}
+	l = len(m.GtidSet)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	l = len(m.StartTime)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	l = len(m.EndTime)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	l = len(m.DdlPattern)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovDmworker(uint64(m.Limit))
+	}
 	return n
 }
 
@@ -7472,6 +7592,10 @@ func (m *ValidationStatus) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovDmworker(uint64(l))
 	}
+	l = len(m.SnapshotStatus)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
 	return n
 }
 
@@ -12730,6 +12854,153 @@ func (m *HandleWorkerErrorRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Sqls = append(m.Sqls, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GtidSet", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GtidSet = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartTime = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EndTime = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DdlPattern", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DdlPattern = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDmworker(dAtA[iNdEx:])
@@ -13508,6 +13779,38 @@ func (m *ValidationStatus) Unmarshal(dAtA []byte) error {
 			}
 			m.ErrorRowsStatus = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotStatus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SnapshotStatus = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDmworker(dAtA[iNdEx:])
@@ -436,6 +436,26 @@ func (mr *MockMasterClientMockRecorder) ShowDDLLocks(arg0, arg1 interface{}, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowDDLLocks", reflect.TypeOf((*MockMasterClient)(nil).ShowDDLLocks), varargs...)
 }
 
+// SimulateDDLLock mocks base method.
+func (m *MockMasterClient) SimulateDDLLock(arg0 context.Context, arg1 *pb.SimulateDDLLockRequest, arg2 ...grpc.CallOption) (*pb.SimulateDDLLockResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SimulateDDLLock", varargs...)
+	ret0, _ := ret[0].(*pb.SimulateDDLLockResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SimulateDDLLock indicates an expected call of SimulateDDLLock.
+func (mr *MockMasterClientMockRecorder) SimulateDDLLock(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SimulateDDLLock", reflect.TypeOf((*MockMasterClient)(nil).SimulateDDLLock), varargs...)
+}
+
 // StartTask mocks base method.
 func (m *MockMasterClient) StartTask(arg0 context.Context, arg1 *pb.StartTaskRequest, arg2 ...grpc.CallOption) (*pb.StartTaskResponse, error) {
 	m.ctrl.T.Helper()
@@ -879,6 +899,21 @@ func (mr *MockMasterServerMockRecorder) ShowDDLLocks(arg0, arg1 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowDDLLocks", reflect.TypeOf((*MockMasterServer)(nil).ShowDDLLocks), arg0, arg1)
 }
 
+// SimulateDDLLock mocks base method.
+func (m *MockMasterServer) SimulateDDLLock(arg0 context.Context, arg1 *pb.SimulateDDLLockRequest) (*pb.SimulateDDLLockResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SimulateDDLLock", arg0, arg1)
+	ret0, _ := ret[0].(*pb.SimulateDDLLockResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SimulateDDLLock indicates an expected call of SimulateDDLLock.
+func (mr *MockMasterServerMockRecorder) SimulateDDLLock(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SimulateDDLLock", reflect.TypeOf((*MockMasterServer)(nil).SimulateDDLLock), arg0, arg1)
+}
+
 // StartTask mocks base method.
 func (m *MockMasterServer) StartTask(arg0 context.Context, arg1 *pb.StartTaskRequest) (*pb.StartTaskResponse, error) {
 	m.ctrl.T.Helper()
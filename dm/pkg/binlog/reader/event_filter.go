@@ -0,0 +1,228 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reader
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	gmysql "github.com/go-mysql-org/go-mysql/mysql"
+	"github.com/go-mysql-org/go-mysql/replication"
+	"go.uber.org/zap"
+
+	"github.com/pingcap/tiflow/dm/pkg/binlog/event"
+	"github.com/pingcap/tiflow/dm/pkg/gtid"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/parser"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/pingcap/tiflow/dm/pkg/utils"
+)
+
+// EventFilter selects DDL events by the GTID of their transactions, the timestamp range
+// and a pattern of the DDL statement. Conditions are combined with AND, and an empty
+// condition matches everything. A filter without DDL pattern also selects the DML of
+// the transactions in its GTID set, see Match.
+type EventFilter struct {
+	flavor string
+
+	gSet       gmysql.GTIDSet
+	startTime  time.Time // inclusive
+	endTime    time.Time // inclusive
+	ddlPattern *regexp.Regexp
+}
+
+// NewEventFilter creates an EventFilter. startTime and endTime are in the format of
+// `utils.StartTimeFormat` and parsed in loc.
+func NewEventFilter(flavor, gSetStr, startTime, endTime, ddlPattern string, loc *time.Location) (*EventFilter, error) {
+	f := &EventFilter{flavor: flavor}
+	var err error
+	if gSetStr != "" {
+		if f.gSet, err = gtid.ParserGTID(flavor, gSetStr); err != nil {
+			return nil, terror.ErrVerifyHandleErrorArgs.Generatef("invalid --gtid-set %s: %s", gSetStr, terror.Message(err))
+		}
+	}
+	if startTime != "" {
+		if f.startTime, err = utils.ParseStartTimeInLoc(startTime, loc); err != nil {
+			return nil, terror.ErrVerifyHandleErrorArgs.Generatef("invalid --start-time %s, should be in the format like '2006-01-02 15:04:05' or '2006-01-02T15:04:05'", startTime)
+		}
+	}
+	if endTime != "" {
+		if f.endTime, err = utils.ParseStartTimeInLoc(endTime, loc); err != nil {
+			return nil, terror.ErrVerifyHandleErrorArgs.Generatef("invalid --end-time %s, should be in the format like '2006-01-02 15:04:05' or '2006-01-02T15:04:05'", endTime)
+		}
+	}
+	if !f.startTime.IsZero() && !f.endTime.IsZero() && f.startTime.After(f.endTime) {
+		return nil, terror.ErrVerifyHandleErrorArgs.Generatef("--start-time %s is after --end-time %s", startTime, endTime)
+	}
+	if ddlPattern != "" {
+		if f.ddlPattern, err = regexp.Compile(ddlPattern); err != nil {
+			return nil, terror.ErrVerifyHandleErrorArgs.Generatef("invalid --ddl-pattern %s: %s", ddlPattern, err.Error())
+		}
+	}
+	return f, nil
+}
+
+// IsEmpty returns whether no condition is set in the filter.
+func (f *EventFilter) IsEmpty() bool {
+	return f.gSet == nil && f.startTime.IsZero() && f.endTime.IsZero() && f.ddlPattern == nil
+}
+
+// Match returns whether the event matches all the conditions.
+// gtidStr is the GTID of the transaction which the event belongs to, it's empty if GTID is not enabled.
+// DDLs are matched by all the conditions. When the filter only selects events by a GTID set and
+// the time range, rows events and XID events of the transactions in the set are matched too, so
+// the DML of the set can be skipped.
+func (f *EventFilter) Match(gtidStr string, e *replication.BinlogEvent) bool {
+	var query string
+	switch ev := e.Event.(type) {
+	case *replication.QueryEvent:
+		query = string(ev.Query)
+		p, err := event.GetParserForStatusVars(ev.StatusVars)
+		if err != nil {
+			log.L().Warn("found error when get sql_mode from binlog status_vars", zap.Error(err))
+		}
+		if !parser.CheckIsDDL(query, p) {
+			return false
+		}
+	case *replication.RowsEvent, *replication.XIDEvent:
+		if f.gSet == nil || f.ddlPattern != nil {
+			return false
+		}
+	default:
+		return false
+	}
+
+	if f.gSet != nil {
+		if gtidStr == "" {
+			return false
+		}
+		gs, err2 := gtid.ParserGTID(f.flavor, gtidStr)
+		if err2 != nil || !f.gSet.Contain(gs) {
+			return false
+		}
+	}
+	ts := time.Unix(int64(e.Header.Timestamp), 0)
+	if !f.startTime.IsZero() && ts.Before(f.startTime) {
+		return false
+	}
+	if !f.endTime.IsZero() && ts.After(f.endTime) {
+		return false
+	}
+	if f.ddlPattern != nil && !f.ddlPattern.MatchString(query) {
+		return false
+	}
+	return true
+}
+
+// GTIDSet returns the GTID set condition of the filter, it's nil if not set.
+func (f *EventFilter) GTIDSet() gmysql.GTIDSet {
+	return f.gSet
+}
+
+// PassedEnd returns whether the event is later than the end time, so no following events will be matched.
+func (f *EventFilter) PassedEnd(e *replication.BinlogEvent) bool {
+	// fake events and some events of the binlog file header have zero timestamp.
+	if f.endTime.IsZero() || e.Header.Timestamp == 0 {
+		return false
+	}
+	return time.Unix(int64(e.Header.Timestamp), 0).After(f.endTime)
+}
+
+// String implements fmt.Stringer.
+func (f *EventFilter) String() string {
+	conds := make([]string, 0, 4)
+	if f.gSet != nil {
+		conds = append(conds, "gtid-set: "+f.gSet.String())
+	}
+	if !f.startTime.IsZero() {
+		conds = append(conds, "start-time: "+f.startTime.Format(utils.StartTimeFormat))
+	}
+	if !f.endTime.IsZero() {
+		conds = append(conds, "end-time: "+f.endTime.Format(utils.StartTimeFormat))
+	}
+	if f.ddlPattern != nil {
+		conds = append(conds, "ddl-pattern: "+f.ddlPattern.String())
+	}
+	return strings.Join(conds, ", ")
+}
+
+// EventSummary is a brief description of a binlog event.
+type EventSummary struct {
+	Position  string `json:"position"`
+	GTID      string `json:"gtid,omitempty"`
+	Timestamp string `json:"timestamp"`
+	Schema    string `json:"schema"`
+	Query     string `json:"query"`
+}
+
+// ListMatchedEvents reads events from the streamer and returns at most limit events matched by the filter.
+// The reading is stopped when no event is received in idleTimeout, which means the streamer has caught up
+// with the end of the binlog.
+func ListMatchedEvents(
+	ctx context.Context,
+	r Streamer,
+	f *EventFilter,
+	limit int,
+	idleTimeout time.Duration,
+	loc *time.Location,
+) ([]*EventSummary, error) {
+	var (
+		result   = make([]*EventSummary, 0)
+		fileName string
+		gtidStr  string
+	)
+	for len(result) < limit {
+		readCtx, cancel := context.WithTimeout(ctx, idleTimeout)
+		e, err := r.GetEvent(readCtx)
+		cancel()
+		if err != nil {
+			if ctx.Err() == nil && readCtx.Err() == context.DeadlineExceeded {
+				break
+			}
+			if terror.ErrReaderReachEndOfFile.Equal(err) {
+				break
+			}
+			return nil, err
+		}
+
+		switch ev := e.Event.(type) {
+		case *replication.RotateEvent:
+			fileName = string(ev.NextLogName)
+			continue
+		case *replication.GTIDEvent, *replication.MariadbGTIDEvent:
+			if gtidStr, err = event.GetGTIDStr(e); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if f.PassedEnd(e) {
+			break
+		}
+		ev, ok := e.Event.(*replication.QueryEvent)
+		if !ok || !f.Match(gtidStr, e) {
+			continue
+		}
+		result = append(result, &EventSummary{
+			Position:  fmt.Sprintf("%s:%d", fileName, e.Header.LogPos-e.Header.EventSize),
+			GTID:      gtidStr,
+			Timestamp: time.Unix(int64(e.Header.Timestamp), 0).In(loc).Format(utils.StartTimeFormat),
+			Schema:    string(ev.Schema),
+			Query:     string(ev.Query),
+		})
+	}
+	return result, nil
+}
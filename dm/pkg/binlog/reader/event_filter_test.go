@@ -0,0 +1,204 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reader
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-mysql-org/go-mysql/mysql"
+	"github.com/go-mysql-org/go-mysql/replication"
+	"github.com/google/uuid"
+	. "github.com/pingcap/check"
+
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+)
+
+var _ = Suite(&testEventFilterSuite{})
+
+type testEventFilterSuite struct{}
+
+func (t *testEventFilterSuite) TestNewEventFilter(c *C) {
+	f, err := NewEventFilter(mysql.MySQLFlavor, "", "", "", "", time.UTC)
+	c.Assert(err, IsNil)
+	c.Assert(f.IsEmpty(), IsTrue)
+	c.Assert(f.String(), Equals, "")
+
+	f, err = NewEventFilter(mysql.MySQLFlavor, "3ccc475b-2343-11e7-be21-6c0b84d59f30:1-14",
+		"2022-01-01 00:00:00", "2022-01-02T00:00:00", "^ALTER", time.UTC)
+	c.Assert(err, IsNil)
+	c.Assert(f.IsEmpty(), IsFalse)
+	c.Assert(f.String(), Equals, "gtid-set: 3ccc475b-2343-11e7-be21-6c0b84d59f30:1-14, start-time: 2022-01-01 00:00:00, end-time: 2022-01-02 00:00:00, ddl-pattern: ^ALTER")
+
+	cases := []struct {
+		gSet, start, end, pattern string
+	}{
+		{gSet: "invalid-gtid"},
+		{start: "2022/01/01"},
+		{end: "2022/01/01"},
+		{start: "2022-01-02 00:00:00", end: "2022-01-01 00:00:00"},
+		{pattern: "(ALTER"},
+	}
+	for _, cs := range cases {
+		_, err = NewEventFilter(mysql.MySQLFlavor, cs.gSet, cs.start, cs.end, cs.pattern, time.UTC)
+		c.Assert(terror.ErrVerifyHandleErrorArgs.Equal(err), IsTrue, Commentf("%+v", cs))
+	}
+}
+
+func (t *testEventFilterSuite) TestMatch(c *C) {
+	ts := uint32(time.Date(2022, 1, 1, 12, 0, 0, 0, time.UTC).Unix())
+	ddl := &replication.BinlogEvent{
+		Header: &replication.EventHeader{Timestamp: ts},
+		Event:  &replication.QueryEvent{Query: []byte("ALTER TABLE tb ADD COLUMN c INT")},
+	}
+	begin := &replication.BinlogEvent{
+		Header: &replication.EventHeader{Timestamp: ts},
+		Event:  &replication.QueryEvent{Query: []byte("BEGIN")},
+	}
+	rows := &replication.BinlogEvent{
+		Header: &replication.EventHeader{Timestamp: ts},
+		Event:  &replication.RowsEvent{},
+	}
+	gtidInSet := "3ccc475b-2343-11e7-be21-6c0b84d59f30:10"
+	gtidNotInSet := "3ccc475b-2343-11e7-be21-6c0b84d59f30:20"
+
+	f, err := NewEventFilter(mysql.MySQLFlavor, "", "", "", "", time.UTC)
+	c.Assert(err, IsNil)
+	c.Assert(f.Match("", ddl), IsTrue)
+	c.Assert(f.Match("", begin), IsFalse)
+	c.Assert(f.Match("", rows), IsFalse)
+
+	f, err = NewEventFilter(mysql.MySQLFlavor, "3ccc475b-2343-11e7-be21-6c0b84d59f30:1-14", "", "", "", time.UTC)
+	c.Assert(err, IsNil)
+	c.Assert(f.Match(gtidInSet, ddl), IsTrue)
+	c.Assert(f.Match(gtidNotInSet, ddl), IsFalse)
+	c.Assert(f.Match("", ddl), IsFalse)
+	// DML of the GTID set is matched too
+	xid := &replication.BinlogEvent{
+		Header: &replication.EventHeader{Timestamp: ts},
+		Event:  &replication.XIDEvent{},
+	}
+	c.Assert(f.Match(gtidInSet, rows), IsTrue)
+	c.Assert(f.Match(gtidInSet, xid), IsTrue)
+	c.Assert(f.Match(gtidInSet, begin), IsFalse)
+	c.Assert(f.Match(gtidNotInSet, rows), IsFalse)
+	c.Assert(f.Match(gtidNotInSet, xid), IsFalse)
+	c.Assert(f.GTIDSet().String(), Equals, "3ccc475b-2343-11e7-be21-6c0b84d59f30:1-14")
+	// but not when a DDL pattern is given
+	f, err = NewEventFilter(mysql.MySQLFlavor, "3ccc475b-2343-11e7-be21-6c0b84d59f30:1-14", "", "", "^ALTER", time.UTC)
+	c.Assert(err, IsNil)
+	c.Assert(f.Match(gtidInSet, ddl), IsTrue)
+	c.Assert(f.Match(gtidInSet, rows), IsFalse)
+	c.Assert(f.Match(gtidInSet, xid), IsFalse)
+
+	f, err = NewEventFilter(mysql.MySQLFlavor, "", "2022-01-01 00:00:00", "2022-01-01 12:00:00", "", time.UTC)
+	c.Assert(err, IsNil)
+	c.Assert(f.Match("", ddl), IsTrue)
+	c.Assert(f.PassedEnd(ddl), IsFalse)
+	f, err = NewEventFilter(mysql.MySQLFlavor, "", "2022-01-01 12:00:01", "", "", time.UTC)
+	c.Assert(err, IsNil)
+	c.Assert(f.Match("", ddl), IsFalse)
+	f, err = NewEventFilter(mysql.MySQLFlavor, "", "", "2022-01-01 11:59:59", "", time.UTC)
+	c.Assert(err, IsNil)
+	c.Assert(f.Match("", ddl), IsFalse)
+	c.Assert(f.PassedEnd(ddl), IsTrue)
+
+	f, err = NewEventFilter(mysql.MySQLFlavor, "", "", "", "(?i)^alter table tb ", time.UTC)
+	c.Assert(err, IsNil)
+	c.Assert(f.Match("", ddl), IsTrue)
+	f, err = NewEventFilter(mysql.MySQLFlavor, "", "", "", "^DROP", time.UTC)
+	c.Assert(err, IsNil)
+	c.Assert(f.Match("", ddl), IsFalse)
+}
+
+func (t *testEventFilterSuite) TestListMatchedEvents(c *C) {
+	sid, err := uuid.Parse("3ccc475b-2343-11e7-be21-6c0b84d59f30")
+	c.Assert(err, IsNil)
+	sidBytes, err := sid.MarshalBinary()
+	c.Assert(err, IsNil)
+	ts := uint32(time.Date(2022, 1, 1, 12, 0, 0, 0, time.UTC).Unix())
+	events := []*replication.BinlogEvent{
+		{
+			Header: &replication.EventHeader{LogPos: 0},
+			Event:  &replication.RotateEvent{NextLogName: []byte("mysql-bin.000001"), Position: 4},
+		},
+		{
+			Header: &replication.EventHeader{LogPos: 100, EventSize: 50, Timestamp: ts},
+			Event:  &replication.GTIDEvent{SID: sidBytes, GNO: 10},
+		},
+		{
+			Header: &replication.EventHeader{LogPos: 200, EventSize: 100, Timestamp: ts},
+			Event:  &replication.QueryEvent{Schema: []byte("db"), Query: []byte("ALTER TABLE tb ADD COLUMN c INT")},
+		},
+		{
+			Header: &replication.EventHeader{LogPos: 250, EventSize: 50, Timestamp: ts},
+			Event:  &replication.GTIDEvent{SID: sidBytes, GNO: 11},
+		},
+		{
+			Header: &replication.EventHeader{LogPos: 350, EventSize: 100, Timestamp: ts},
+			Event:  &replication.QueryEvent{Schema: []byte("db"), Query: []byte("CREATE TABLE tb2 (id INT)")},
+		},
+		{
+			Header: &replication.EventHeader{LogPos: 400, EventSize: 50, Timestamp: ts + 3600},
+			Event:  &replication.GTIDEvent{SID: sidBytes, GNO: 12},
+		},
+		{
+			Header: &replication.EventHeader{LogPos: 500, EventSize: 100, Timestamp: ts + 3600},
+			Event:  &replication.QueryEvent{Schema: []byte("db"), Query: []byte("ALTER TABLE tb2 ADD COLUMN c INT")},
+		},
+	}
+	newReader := func() Reader {
+		r := NewMockReader()
+		go func() {
+			for _, e := range events {
+				_ = r.(*MockReader).PushEvent(context.Background(), e)
+			}
+		}()
+		return r
+	}
+
+	f, err := NewEventFilter(mysql.MySQLFlavor, "", "", "", "^ALTER", time.UTC)
+	c.Assert(err, IsNil)
+	result, err := ListMatchedEvents(context.Background(), newReader(), f, 10, 100*time.Millisecond, time.UTC)
+	c.Assert(err, IsNil)
+	c.Assert(result, DeepEquals, []*EventSummary{
+		{
+			Position:  "mysql-bin.000001:100",
+			GTID:      "3ccc475b-2343-11e7-be21-6c0b84d59f30:10",
+			Timestamp: "2022-01-01 12:00:00",
+			Schema:    "db",
+			Query:     "ALTER TABLE tb ADD COLUMN c INT",
+		},
+		{
+			Position:  "mysql-bin.000001:400",
+			GTID:      "3ccc475b-2343-11e7-be21-6c0b84d59f30:12",
+			Timestamp: "2022-01-01 13:00:00",
+			Schema:    "db",
+			Query:     "ALTER TABLE tb2 ADD COLUMN c INT",
+		},
+	})
+
+	// limit
+	result, err = ListMatchedEvents(context.Background(), newReader(), f, 1, 100*time.Millisecond, time.UTC)
+	c.Assert(err, IsNil)
+	c.Assert(result, HasLen, 1)
+
+	// stop at end time
+	f, err = NewEventFilter(mysql.MySQLFlavor, "3ccc475b-2343-11e7-be21-6c0b84d59f30:11-12", "", "2022-01-01 12:30:00", "", time.UTC)
+	c.Assert(err, IsNil)
+	result, err = ListMatchedEvents(context.Background(), newReader(), f, 10, time.Minute, time.UTC)
+	c.Assert(err, IsNil)
+	c.Assert(result, HasLen, 1)
+	c.Assert(result[0].Position, Equals, "mysql-bin.000001:250")
+}
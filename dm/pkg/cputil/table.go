@@ -53,3 +53,7 @@ func ValidatorErrorChange(task string) string {
 func ValidatorTableStatus(task string) string {
 	return task + "_validator_table_status"
 }
+
+func ValidatorRepairLog(task string) string {
+	return task + "_validator_repair_log"
+}
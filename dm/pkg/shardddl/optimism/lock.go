@@ -105,6 +105,9 @@ type Lock struct {
 	columns map[string]map[string]map[string]map[string]DropColumnStage
 
 	downstreamMeta *DownstreamMeta
+
+	// whether the lock is a copy used by `Simulate`, no operation will be put into etcd for it.
+	simulating bool
 }
 
 // NewLock creates a new Lock instance.
@@ -633,20 +636,24 @@ func (l *Lock) AddDroppedColumns(source, schema, table string, cols []string) er
 	}
 
 	for _, col := range newCols {
-		if _, ok := l.columns[col]; !ok {
-			l.columns[col] = make(map[string]map[string]map[string]DropColumnStage)
-		}
-		if _, ok := l.columns[col][source]; !ok {
-			l.columns[col][source] = make(map[string]map[string]DropColumnStage)
-		}
-		if _, ok := l.columns[col][source][schema]; !ok {
-			l.columns[col][source][schema] = make(map[string]DropColumnStage)
-		}
-		l.columns[col][source][schema][table] = DropNotDone
+		l.addDroppedColumn(col, source, schema, table, DropNotDone)
 	}
 	return nil
 }
 
+func (l *Lock) addDroppedColumn(col, source, upSchema, upTable string, stage DropColumnStage) {
+	if _, ok := l.columns[col]; !ok {
+		l.columns[col] = make(map[string]map[string]map[string]DropColumnStage)
+	}
+	if _, ok := l.columns[col][source]; !ok {
+		l.columns[col][source] = make(map[string]map[string]DropColumnStage)
+	}
+	if _, ok := l.columns[col][source][upSchema]; !ok {
+		l.columns[col][source][upSchema] = make(map[string]DropColumnStage)
+	}
+	l.columns[col][source][upSchema][upTable] = stage
+}
+
 // DeleteColumnsByOp deletes the partially dropped columns that extracted from operation.
 // We can not remove columns from the partially dropped columns map unless:
 // this column is dropped in the downstream database,
@@ -1184,6 +1191,9 @@ func (l *Lock) resolveTables() {
 
 // redirectForConflictTables put redirect Ops for all conflict tables.
 func (l *Lock) redirectForConflictTables(callerSource, callerSchema, callerTable string) error {
+	if l.simulating {
+		return nil
+	}
 	for source, schemaTables := range l.conflictTables {
 		for schema, tables := range schemaTables {
 			for table := range tables {
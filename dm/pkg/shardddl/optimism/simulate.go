@@ -0,0 +1,331 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package optimism
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pingcap/tidb/parser"
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/format"
+	"github.com/pingcap/tidb/util/filter"
+	"github.com/pingcap/tidb/util/schemacmp"
+	"go.uber.org/zap"
+
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	parserpkg "github.com/pingcap/tiflow/dm/pkg/parser"
+	"github.com/pingcap/tiflow/dm/pkg/schema"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/pingcap/tiflow/dm/pkg/utils"
+)
+
+// SimulateTableResult is the simulated result of a DDL for one upstream table of the lock.
+type SimulateTableResult struct {
+	Source string `json:"source"`
+	Schema string `json:"schema"`
+	Table  string `json:"table"`
+
+	ConflictStage ConflictStage `json:"conflict-stage"`
+	ConflictMsg   string        `json:"conflict-message,omitempty"`
+	// DDLs which will be executed in the downstream for this table.
+	DDLs []string `json:"ddls"`
+}
+
+// SimulateResult is the simulated result of a DDL for a shard DDL lock.
+type SimulateResult struct {
+	// whether any table will meet a conflict which can't be resolved automatically.
+	Conflict bool `json:"conflict"`
+	// CREATE TABLE statements of the joined table info before and after the DDL.
+	OldJoined string `json:"old-joined"`
+	NewJoined string `json:"new-joined"`
+
+	Tables []*SimulateTableResult `json:"tables"`
+	// upstream tables (in the format of `source-schema-table`) which will stall after the DDL,
+	// the replication of them is paused by a conflict, or is waiting for other tables to redirect.
+	Stalled []string `json:"stalled"`
+}
+
+// Simulate simulates that the upstream tables selected by match (all tables if match is nil)
+// execute the DDL one by one, and reports what will happen to the lock.
+// The simulation is based on a copy of the current table infos, so the lock itself is not changed.
+func (l *Lock) Simulate(ctx context.Context, ddl string, match func(source, schema, table string) bool) (*SimulateResult, error) {
+	p := parser.New()
+	stmt, err := p.ParseOneStmt(ddl, "", "")
+	if err != nil {
+		return nil, terror.ErrMasterSimulateDDLLock.Generate(ddl, l.ID, err.Error())
+	}
+	switch stmt.(type) {
+	case *ast.AlterTableStmt, *ast.CreateIndexStmt, *ast.DropIndexStmt:
+	default:
+		return nil, terror.ErrMasterSimulateDDLLock.Generate(ddl, l.ID, "only ALTER TABLE, CREATE INDEX and DROP INDEX are supported")
+	}
+
+	tr := schema.NewTracker()
+	if err = tr.Init(ctx, l.Task, int(utils.LCTableNamesSensitive), nil, log.L()); err != nil {
+		return nil, err
+	}
+	defer tr.Close()
+
+	sl := l.cloneForSimulate()
+	res := &SimulateResult{
+		Tables:  make([]*SimulateTableResult, 0),
+		Stalled: make([]string, 0),
+	}
+	if joined, err2 := sl.joinNormalTables(); err2 == nil {
+		res.OldJoined = restoreTable(joined, l.DownTable)
+	}
+
+	for _, tt := range sl.sortedTables() {
+		source, upSchema, upTable := tt[0], tt[1], tt[2]
+		if match != nil && !match(source, upSchema, upTable) {
+			continue
+		}
+		prevTable := sl.finalTables[source][upSchema][upTable]
+		upDDLs, postTables, err2 := l.trackDDL(ctx, tr, p, ddl, prevTable, upSchema, upTable)
+		if err2 != nil {
+			return nil, err2
+		}
+		tblRes := sl.simulateTableDDLs(source, upSchema, upTable, upDDLs, prevTable, postTables)
+		if tblRes.ConflictStage == ConflictDetected {
+			res.Conflict = true
+		}
+		res.Tables = append(res.Tables, tblRes)
+	}
+	if len(res.Tables) == 0 {
+		return nil, terror.ErrMasterSimulateDDLLock.Generate(ddl, l.ID, "no upstream table matched")
+	}
+
+	if joined, err2 := sl.joinNormalTables(); err2 == nil {
+		res.NewJoined = restoreTable(joined, l.DownTable)
+	}
+	for _, tblRes := range res.Tables {
+		_, waiting := sl.conflictTables[tblRes.Source][tblRes.Schema][tblRes.Table]
+		if waiting || tblRes.ConflictStage == ConflictDetected {
+			res.Stalled = append(res.Stalled, utils.GenDDLLockID(tblRes.Source, tblRes.Schema, tblRes.Table))
+		}
+	}
+	return res, nil
+}
+
+// cloneForSimulate copies the table infos of the lock into a new lock used for simulation.
+func (l *Lock) cloneForSimulate() *Lock {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	sl := &Lock{
+		ID:             l.ID,
+		Task:           l.Task,
+		DownSchema:     l.DownSchema,
+		DownTable:      l.DownTable,
+		initTable:      l.initTable,
+		tables:         copyTableInfos(l.tables),
+		conflictTables: copyTableInfos(l.conflictTables),
+		finalTables:    copyTableInfos(l.finalTables),
+		columns:        make(map[string]map[string]map[string]map[string]DropColumnStage),
+		simulating:     true,
+	}
+	for col, sourceTables := range l.columns {
+		for source, schemaTables := range sourceTables {
+			for upSchema, tables := range schemaTables {
+				for upTable, stage := range tables {
+					sl.addDroppedColumn(col, source, upSchema, upTable, stage)
+				}
+			}
+		}
+	}
+	return sl
+}
+
+// sortedTables returns all upstream tables in the lock in the form of [source, schema, table].
+func (l *Lock) sortedTables() [][3]string {
+	tts := make([][3]string, 0)
+	for source, schemaTables := range l.finalTables {
+		for upSchema, tables := range schemaTables {
+			for upTable := range tables {
+				tts = append(tts, [3]string{source, upSchema, upTable})
+			}
+		}
+	}
+	sort.Slice(tts, func(i, j int) bool {
+		for k := range tts[i] {
+			if tts[i][k] != tts[j][k] {
+				return tts[i][k] < tts[j][k]
+			}
+		}
+		return false
+	})
+	return tts
+}
+
+// trackDDL executes the DDL on the table info of an upstream table,
+// and returns the split upstream DDLs and the table info after each of them.
+func (l *Lock) trackDDL(
+	ctx context.Context,
+	tr *schema.Tracker,
+	p *parser.Parser,
+	ddl string,
+	prevTable schemacmp.Table,
+	upSchema, upTable string,
+) ([]string, []schemacmp.Table, error) {
+	tbl := &filter.Table{Schema: upSchema, Name: upTable}
+	if err := tr.CreateSchemaIfNotExists(upSchema); err != nil {
+		return nil, nil, err
+	}
+	// the table may be left by the previous simulated table with the same name.
+	_ = tr.DropTable(tbl)
+
+	createStmt, err := p.ParseOneStmt(restoreTable(prevTable, upTable), "", "")
+	if err != nil {
+		return nil, nil, terror.ErrMasterSimulateDDLLock.Generate(ddl, l.ID, err.Error())
+	}
+	if err = tr.Exec(ctx, upSchema, createStmt); err != nil {
+		return nil, nil, terror.ErrSchemaTrackerCannotCreateTable.Delegate(err, tbl)
+	}
+	if ti, err2 := tr.GetTableInfo(tbl); err2 == nil {
+		if cmp, err3 := prevTable.Compare(schemacmp.Encode(ti)); err3 != nil || cmp != 0 {
+			log.L().Warn("table info is changed after restored in schema tracker, the simulated result may be inaccurate",
+				zap.String("lock", l.ID), zap.Stringer("table", tbl), zap.Stringer("table info", prevTable))
+		}
+	}
+
+	// parse again because the statement is modified when renaming and splitting.
+	stmt, err := p.ParseOneStmt(ddl, "", "")
+	if err != nil {
+		return nil, nil, terror.ErrMasterSimulateDDLLock.Generate(ddl, l.ID, err.Error())
+	}
+	renamed, err := parserpkg.RenameDDLTable(stmt, []*filter.Table{tbl})
+	if err != nil {
+		return nil, nil, err
+	}
+	if stmt, err = p.ParseOneStmt(renamed, "", ""); err != nil {
+		return nil, nil, terror.ErrMasterSimulateDDLLock.Generate(ddl, l.ID, err.Error())
+	}
+	upDDLs, err := parserpkg.SplitDDL(stmt, upSchema)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	postTables := make([]schemacmp.Table, 0, len(upDDLs))
+	for _, upDDL := range upDDLs {
+		splitStmt, err2 := p.ParseOneStmt(upDDL, "", "")
+		if err2 != nil {
+			return nil, nil, terror.ErrMasterSimulateDDLLock.Generate(ddl, l.ID, err2.Error())
+		}
+		if err2 = tr.Exec(ctx, upSchema, splitStmt); err2 != nil {
+			return nil, nil, terror.ErrMasterSimulateDDLLock.Generate(ddl, l.ID, err2.Error())
+		}
+		ti, err2 := tr.GetTableInfo(tbl)
+		if err2 != nil {
+			return nil, nil, err2
+		}
+		postTables = append(postTables, schemacmp.Encode(ti.Clone()))
+	}
+	return upDDLs, postTables, nil
+}
+
+// simulateTableDDLs tries to sync the DDLs of an upstream table like `TrySync`,
+// but no operation is put into etcd.
+func (l *Lock) simulateTableDDLs(
+	source, upSchema, upTable string,
+	upDDLs []string,
+	tableBefore schemacmp.Table,
+	postTables []schemacmp.Table,
+) *SimulateTableResult {
+	res := &SimulateTableResult{
+		Source:        source,
+		Schema:        upSchema,
+		Table:         upTable,
+		ConflictStage: ConflictNone,
+		DDLs:          make([]string, 0),
+	}
+	revert := func() {
+		l.tables[source][upSchema][upTable] = tableBefore
+		l.finalTables[source][upSchema][upTable] = tableBefore
+		l.removeConflictTable(source, upSchema, upTable)
+	}
+
+	cols := make([]string, 0)
+	prevTable := tableBefore
+	for idx, postTable := range postTables {
+		schemaChanged, conflictStage := l.trySyncForOneDDL(source, upSchema, upTable, prevTable, postTable)
+		switch conflictStage {
+		case ConflictDetected:
+			res.ConflictStage = ConflictDetected
+			res.ConflictMsg = fmt.Sprintf("there will be conflicts if DDLs %s are applied to the downstream. old table info: %s, new table info: %s", upDDLs[idx], prevTable, postTable)
+			res.DDLs = res.DDLs[:0]
+			revert()
+			return res
+		case ConflictNone:
+			col, err := l.checkAddDropColumn(source, upSchema, upTable, upDDLs[idx], prevTable, postTable, cols)
+			if err != nil {
+				res.ConflictStage = ConflictDetected
+				res.ConflictMsg = terror.Message(err)
+				res.DDLs = res.DDLs[:0]
+				revert()
+				return res
+			} else if len(col) != 0 {
+				cols = append(cols, col)
+			}
+		case ConflictSkipWaitRedirect:
+			res.ConflictStage = ConflictSkipWaitRedirect
+			return res
+		}
+
+		if schemaChanged {
+			res.DDLs = append(res.DDLs, l.toDownstreamDDL(upDDLs[idx]))
+		}
+		prevTable = postTable
+	}
+	for _, col := range cols {
+		l.addDroppedColumn(col, source, upSchema, upTable, DropNotDone)
+	}
+	return res
+}
+
+// toDownstreamDDL renames the table in the DDL to the downstream table.
+func (l *Lock) toDownstreamDDL(ddl string) string {
+	stmt, err := parser.New().ParseOneStmt(ddl, "", "")
+	if err != nil {
+		return ddl
+	}
+	downDDL, err := parserpkg.RenameDDLTable(stmt, []*filter.Table{{Schema: l.DownSchema, Name: l.DownTable}})
+	if err != nil {
+		return ddl
+	}
+	return downDDL
+}
+
+func copyTableInfos(src map[string]map[string]map[string]schemacmp.Table) map[string]map[string]map[string]schemacmp.Table {
+	dst := make(map[string]map[string]map[string]schemacmp.Table, len(src))
+	for source, schemaTables := range src {
+		dst[source] = make(map[string]map[string]schemacmp.Table, len(schemaTables))
+		for upSchema, tables := range schemaTables {
+			dst[source][upSchema] = make(map[string]schemacmp.Table, len(tables))
+			for upTable, ti := range tables {
+				dst[source][upSchema][upTable] = ti
+			}
+		}
+	}
+	return dst
+}
+
+// restoreTable restores the table info into a CREATE TABLE statement.
+func restoreTable(t schemacmp.Table, tableName string) string {
+	var sb strings.Builder
+	t.Restore(format.NewRestoreCtx(format.DefaultRestoreFlags, &sb), tableName)
+	return sb.String()
+}
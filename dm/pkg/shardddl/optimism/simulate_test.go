@@ -0,0 +1,149 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package optimism
+
+import (
+	"context"
+	"strings"
+
+	. "github.com/pingcap/check"
+	"github.com/pingcap/tidb/parser"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/util/filter"
+	"github.com/pingcap/tidb/util/schemacmp"
+
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/schema"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/pingcap/tiflow/dm/pkg/utils"
+)
+
+func (t *testLock) TestLockSimulate(c *C) {
+	var (
+		ctx        = context.Background()
+		ID         = "test_lock_simulate-`foo`.`bar`"
+		task       = "test_lock_simulate"
+		sources    = []string{"mysql-replica-1", "mysql-replica-2"}
+		downSchema = "foo"
+		downTable  = "bar"
+		db         = "db"
+		tbls       = []string{"bar1", "bar2"}
+		// table infos in the lock are generated by the schema tracker of DM-worker.
+		ti0    = trackedTableInfo(c, `CREATE TABLE bar (id INT PRIMARY KEY, c1 INT)`)
+		ti1    = trackedTableInfo(c, `CREATE TABLE bar (id INT PRIMARY KEY, c1 INT, c2 VARCHAR(10))`)
+		tables = map[string]map[string]struct{}{db: {tbls[0]: struct{}{}, tbls[1]: struct{}{}}}
+		tts    = []TargetTable{
+			newTargetTable(task, sources[0], downSchema, downTable, tables),
+			newTargetTable(task, sources[1], downSchema, downTable, tables),
+		}
+		vers = map[string]map[string]map[string]int64{
+			sources[0]: {db: {tbls[0]: 0, tbls[1]: 0}},
+			sources[1]: {db: {tbls[0]: 0, tbls[1]: 0}},
+		}
+		onlyFirst = func(source, schema, table string) bool {
+			return source == sources[0] && schema == db && table == tbls[0]
+		}
+
+		l = NewLock(etcdTestCli, ID, task, downSchema, downTable, schemacmp.Encode(ti0), tts, nil)
+	)
+
+	checkJoinedNotChanged := func() {
+		joined, err := l.Joined()
+		c.Assert(err, IsNil)
+		cmp, err := joined.Compare(schemacmp.Encode(ti0))
+		c.Assert(err, IsNil)
+		c.Assert(cmp, Equals, 0)
+		t.checkLockSynced(c, l)
+	}
+
+	// CASE: unsupported DDL.
+	_, err := l.Simulate(ctx, "CREATE TABLE bar (id INT PRIMARY KEY)", nil)
+	c.Assert(terror.ErrMasterSimulateDDLLock.Equal(err), IsTrue)
+	_, err = l.Simulate(ctx, "ALTER TABLE bar ADD COLUMN c2 INT", func(string, string, string) bool { return false })
+	c.Assert(terror.ErrMasterSimulateDDLLock.Equal(err), IsTrue)
+
+	// CASE: all tables add a column, no conflict.
+	res, err := l.Simulate(ctx, "ALTER TABLE bar ADD COLUMN c2 INT, ADD COLUMN c3 INT", nil)
+	c.Assert(err, IsNil)
+	c.Assert(res.Conflict, IsFalse)
+	c.Assert(res.Stalled, HasLen, 0)
+	c.Assert(res.Tables, HasLen, 4)
+	c.Assert(res.Tables[0].Source, Equals, sources[0])
+	c.Assert(res.Tables[0].Table, Equals, tbls[0])
+	c.Assert(res.Tables[0].ConflictStage, Equals, ConflictNone)
+	c.Assert(res.Tables[0].DDLs, DeepEquals, []string{
+		"ALTER TABLE `foo`.`bar` ADD COLUMN `c2` INT",
+		"ALTER TABLE `foo`.`bar` ADD COLUMN `c3` INT",
+	})
+	c.Assert(strings.Contains(res.OldJoined, "`c2`"), IsFalse)
+	c.Assert(strings.Contains(res.NewJoined, "`c2`"), IsTrue)
+	c.Assert(strings.Contains(res.NewJoined, "`c3`"), IsTrue)
+	checkJoinedNotChanged()
+
+	// CASE: only one table renames a column, it waits for other tables to redirect.
+	res, err = l.Simulate(ctx, "ALTER TABLE bar RENAME COLUMN c1 TO c4", onlyFirst)
+	c.Assert(err, IsNil)
+	c.Assert(res.Conflict, IsFalse)
+	c.Assert(res.Tables, HasLen, 1)
+	c.Assert(res.Tables[0].ConflictStage, Equals, ConflictSkipWaitRedirect)
+	c.Assert(res.Tables[0].DDLs, HasLen, 0)
+	c.Assert(res.Stalled, DeepEquals, []string{utils.GenDDLLockID(sources[0], db, tbls[0])})
+	c.Assert(res.NewJoined, Equals, res.OldJoined)
+	checkJoinedNotChanged()
+
+	// CASE: all tables rename the column, the conflict is resolved by the last table.
+	res, err = l.Simulate(ctx, "ALTER TABLE bar RENAME COLUMN c1 TO c4", nil)
+	c.Assert(err, IsNil)
+	c.Assert(res.Conflict, IsFalse)
+	c.Assert(res.Stalled, HasLen, 0)
+	c.Assert(res.Tables[3].ConflictStage, Equals, ConflictNone)
+	c.Assert(strings.Contains(res.NewJoined, "`c4`"), IsTrue)
+	c.Assert(strings.Contains(res.NewJoined, "`c1`"), IsFalse)
+	checkJoinedNotChanged()
+
+	// CASE: a table has added a column, add the column with a different length will conflict.
+	info := newInfoWithVersion(task, sources[0], db, tbls[0], downSchema, downTable,
+		[]string{"ALTER TABLE bar ADD COLUMN c2 VARCHAR(10)"}, ti0, []*model.TableInfo{ti1}, vers)
+	_, _, err = l.TrySync(info, tts)
+	c.Assert(err, IsNil)
+	res, err = l.Simulate(ctx, "ALTER TABLE bar ADD COLUMN c2 VARCHAR(20)", func(source, schema, table string) bool {
+		return !onlyFirst(source, schema, table)
+	})
+	c.Assert(err, IsNil)
+	c.Assert(res.Conflict, IsTrue)
+	c.Assert(res.Tables, HasLen, 3)
+	c.Assert(res.Stalled, HasLen, 3)
+	for _, tblRes := range res.Tables {
+		c.Assert(tblRes.ConflictStage, Equals, ConflictDetected)
+		c.Assert(tblRes.ConflictMsg, Not(Equals), "")
+		c.Assert(tblRes.DDLs, HasLen, 0)
+	}
+	c.Assert(res.NewJoined, Equals, res.OldJoined)
+	ready := l.Ready()
+	c.Assert(ready[sources[0]][db][tbls[0]], IsTrue)
+	c.Assert(ready[sources[0]][db][tbls[1]], IsFalse)
+}
+
+func trackedTableInfo(c *C, sql string) *model.TableInfo {
+	tr, err := schema.NewTestTracker(context.Background(), "test", nil, log.L())
+	c.Assert(err, IsNil)
+	defer tr.Close()
+	c.Assert(tr.CreateSchemaIfNotExists("db"), IsNil)
+	stmt, err := parser.New().ParseOneStmt(sql, "", "")
+	c.Assert(err, IsNil)
+	c.Assert(tr.Exec(context.Background(), "db", stmt), IsNil)
+	ti, err := tr.GetTableInfo(&filter.Table{Schema: "db", Name: "bar"})
+	c.Assert(err, IsNil)
+	return ti
+}
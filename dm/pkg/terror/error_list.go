@@ -509,6 +509,7 @@ const (
 	codeMasterOptimisticDownstreamMetaNotFound
 	codeMasterInvalidClusterID
 	codeMasterStartTask
+	codeMasterSimulateDDLLock
 )
 
 // DM-worker error code.
@@ -631,6 +632,7 @@ const (
 	codeValidatorNotFound
 	codeValidatorPanic
 	codeValidatorTooMuchPending
+	codeValidatorRepairFailed
 )
 
 // Schema-tracker error code.
@@ -1197,6 +1199,7 @@ var (
 	ErrMasterOptimisticDownstreamMetaNotFound  = New(codeMasterOptimisticDownstreamMetaNotFound, ClassDMMaster, ScopeInternal, LevelHigh, "downstream database config and meta for task %s not found", "")
 	ErrMasterInvalidClusterID                  = New(codeMasterInvalidClusterID, ClassDMMaster, ScopeInternal, LevelHigh, "invalid cluster id: %v", "")
 	ErrMasterStartTask                         = New(codeMasterStartTask, ClassDMMaster, ScopeInternal, LevelHigh, "can not start task: %s reason: %s", "")
+	ErrMasterSimulateDDLLock                   = New(codeMasterSimulateDDLLock, ClassDMMaster, ScopeInternal, LevelMedium, "fail to simulate DDL %s for shard DDL lock %s: %s", "Please check whether the DDL is a valid table level DDL for the upstream tables.")
 
 	// DM-worker error.
 	ErrWorkerParseFlagSet            = New(codeWorkerParseFlagSet, ClassDMWorker, ScopeInternal, LevelMedium, "parse dm-worker config flag set", "")
@@ -1312,6 +1315,7 @@ var (
 	ErrValidatorNotFound          = New(codeValidatorNotFound, ClassValidator, ScopeNotSet, LevelMedium, "validator not found for task %s with source %s", "")
 	ErrValidatorPanic             = New(codeValidatorPanic, ClassValidator, ScopeInternal, LevelHigh, "panic error: %v", "")
 	ErrValidatorTooMuchPending    = New(codeValidatorTooMuchPending, ClassValidator, ScopeInternal, LevelMedium, "too much pending data, stop validator. row size(curr/max): %d/%d, row count(curr/max): %d/%d", "")
+	ErrValidatorRepairFailed      = New(codeValidatorRepairFailed, ClassValidator, ScopeInternal, LevelMedium, "failed to repair %d of %d validation error rows, last error: %s", "Please check the validator repair log table in meta schema for details.")
 
 	// Schema-tracker error.
 	ErrSchemaTrackerInvalidJSON        = New(codeSchemaTrackerInvalidJSON, ClassSchemaTracker, ScopeDownstream, LevelHigh, "saved schema of `%s`.`%s` is not proper JSON", "")
@@ -14,6 +14,125 @@
 // Package config is the configuration definitions used by the simulator.
 package config
 
+import (
+	"os"
+	"time"
+
+	"github.com/pingcap/errors"
+	"gopkg.in/yaml.v2"
+
+	dmconfig "github.com/pingcap/tiflow/dm/config"
+)
+
+const (
+	defaultQPS      = 100
+	defaultWorkers  = 4
+	defaultDuration = time.Minute
+	defaultMaxRows  = 100000
+)
+
+// Config is the top-level config of a simulator workload.
+type Config struct {
+	DataSource dmconfig.DBConfig `yaml:"data_source"`
+	Tables     []*TableConfig    `yaml:"tables"`
+	Workload   WorkloadConfig    `yaml:"workload"`
+}
+
+// WorkloadConfig is the sub config for describing how the tables are modified.
+type WorkloadConfig struct {
+	// QPS is the total number of DMLs executed per second on all the tables.
+	QPS int `yaml:"qps"`
+	// Duration is how long the workload runs.
+	Duration time.Duration `yaml:"duration"`
+	// Workers is the number of concurrent connections executing DMLs.
+	Workers int `yaml:"workers"`
+	// InsertRatio, UpdateRatio and DeleteRatio are the weights of the DML types.
+	InsertRatio int `yaml:"insert_ratio"`
+	UpdateRatio int `yaml:"update_ratio"`
+	DeleteRatio int `yaml:"delete_ratio"`
+	// MaxRows is the max number of rows kept for a table, INSERT is replaced by UPDATE when it's reached.
+	MaxRows int `yaml:"max_rows"`
+	// Truncate means the tables are truncated before the workload starts,
+	// otherwise the existing unique keys are loaded.
+	Truncate bool `yaml:"truncate"`
+}
+
+// FromFile loads the config from a YAML file.
+func FromFile(path string) (*Config, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Annotatef(err, "read config file %s", path)
+	}
+	cfg := &Config{}
+	if err = yaml.UnmarshalStrict(content, cfg); err != nil {
+		return nil, errors.Annotate(err, "parse config file")
+	}
+	if err = cfg.Adjust(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// Adjust fills the default values and validates the config.
+func (c *Config) Adjust() error {
+	if len(c.Tables) == 0 {
+		return errors.New("no table is configured")
+	}
+	tableIDs := make(map[string]struct{}, len(c.Tables))
+	for _, tbl := range c.Tables {
+		if tbl.DatabaseName == "" || tbl.TableName == "" {
+			return errors.Errorf("database name and table name of table %q should not be empty", tbl.TableID)
+		}
+		if tbl.TableID == "" {
+			tbl.TableID = tbl.DatabaseName + "." + tbl.TableName
+		}
+		if _, ok := tableIDs[tbl.TableID]; ok {
+			return errors.Errorf("duplicate table id %q", tbl.TableID)
+		}
+		tableIDs[tbl.TableID] = struct{}{}
+		if len(tbl.Columns) == 0 {
+			return errors.Errorf("no column is configured for table %q", tbl.TableID)
+		}
+		colNames := make(map[string]struct{}, len(tbl.Columns))
+		for _, col := range tbl.Columns {
+			colNames[col.ColumnName] = struct{}{}
+		}
+		if len(tbl.UniqueKeyColumnNames) == 0 {
+			return errors.Errorf("no unique key is configured for table %q", tbl.TableID)
+		}
+		for _, ukCol := range tbl.UniqueKeyColumnNames {
+			if _, ok := colNames[ukCol]; !ok {
+				return errors.Errorf("unique key column %q is not defined in table %q", ukCol, tbl.TableID)
+			}
+		}
+	}
+
+	w := &c.Workload
+	if w.QPS < 0 || w.Workers < 0 || w.Duration < 0 || w.MaxRows < 0 {
+		return errors.New("qps, duration, workers and max_rows should not be negative")
+	}
+	if w.QPS == 0 {
+		w.QPS = defaultQPS
+	}
+	if w.Workers == 0 {
+		w.Workers = defaultWorkers
+	}
+	if w.Duration == 0 {
+		w.Duration = defaultDuration
+	}
+	if w.MaxRows == 0 {
+		w.MaxRows = defaultMaxRows
+	}
+	if w.InsertRatio < 0 || w.UpdateRatio < 0 || w.DeleteRatio < 0 {
+		return errors.New("ratios of DML types should not be negative")
+	}
+	if w.InsertRatio+w.UpdateRatio+w.DeleteRatio == 0 {
+		// insert more than delete so that the tables grow
+		w.InsertRatio, w.UpdateRatio, w.DeleteRatio = 4, 4, 2
+	}
+	return nil
+}
+
 // TableConfig is the sub config for describing a simulating table in the data source.
 type TableConfig struct {
 	TableID              string              `yaml:"id"`
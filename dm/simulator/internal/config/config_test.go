@@ -0,0 +1,103 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type testConfigSuite struct {
+	suite.Suite
+}
+
+func (s *testConfigSuite) TestFromFile() {
+	cfg, err := FromFile("../../workload.example.yaml")
+	s.Require().Nil(err)
+	s.Equal("127.0.0.1", cfg.DataSource.Host)
+	s.Require().Len(cfg.Tables, 1)
+	s.Equal("members", cfg.Tables[0].TableID)
+	s.Len(cfg.Tables[0].Columns, 4)
+	s.Equal([]string{"id"}, cfg.Tables[0].UniqueKeyColumnNames)
+	s.Equal(time.Minute, cfg.Workload.Duration)
+	s.Equal(100, cfg.Workload.QPS)
+	s.True(cfg.Workload.Truncate)
+
+	// unknown fields are not allowed
+	path := filepath.Join(s.T().TempDir(), "workload.yaml")
+	s.Require().Nil(os.WriteFile(path, []byte("unknown_field: 1\n"), 0o644))
+	_, err = FromFile(path)
+	s.Require().NotNil(err)
+}
+
+func (s *testConfigSuite) TestAdjust() {
+	newConfig := func() *Config {
+		return &Config{
+			Tables: []*TableConfig{
+				{
+					DatabaseName: "games",
+					TableName:    "members",
+					Columns: []*ColumnDefinition{
+						{ColumnName: "id", DataType: "int"},
+						{ColumnName: "name", DataType: "varchar"},
+					},
+					UniqueKeyColumnNames: []string{"id"},
+				},
+			},
+		}
+	}
+	cfg := newConfig()
+	s.Require().Nil(cfg.Adjust())
+	s.Equal("games.members", cfg.Tables[0].TableID)
+	s.Equal(defaultQPS, cfg.Workload.QPS)
+	s.Equal(defaultWorkers, cfg.Workload.Workers)
+	s.Equal(defaultDuration, cfg.Workload.Duration)
+	s.Equal(defaultMaxRows, cfg.Workload.MaxRows)
+	s.Equal(4, cfg.Workload.InsertRatio)
+	s.Equal(4, cfg.Workload.UpdateRatio)
+	s.Equal(2, cfg.Workload.DeleteRatio)
+
+	cfg = newConfig()
+	cfg.Workload.InsertRatio = 1
+	s.Require().Nil(cfg.Adjust())
+	s.Equal(1, cfg.Workload.InsertRatio)
+	s.Equal(0, cfg.Workload.UpdateRatio)
+	s.Equal(0, cfg.Workload.DeleteRatio)
+
+	cfg = newConfig()
+	cfg.Workload.DeleteRatio = -1
+	s.Require().NotNil(cfg.Adjust())
+
+	cfg = newConfig()
+	cfg.Tables[0].UniqueKeyColumnNames = []string{"not_exist"}
+	s.Require().NotNil(cfg.Adjust())
+
+	cfg = newConfig()
+	cfg.Tables[0].UniqueKeyColumnNames = nil
+	s.Require().NotNil(cfg.Adjust())
+
+	cfg = newConfig()
+	cfg.Tables = append(cfg.Tables, cfg.Tables[0])
+	s.Require().NotNil(cfg.Adjust())
+
+	s.Require().NotNil((&Config{}).Adjust())
+}
+
+func TestConfigSuite(t *testing.T) {
+	suite.Run(t, &testConfigSuite{})
+}
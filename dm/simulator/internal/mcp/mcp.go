@@ -62,6 +62,13 @@ func (mcp *ModificationCandidatePool) Len() int {
 	return len(mcp.keyPool)
 }
 
+// Cap gets the capacity of the MCP.
+func (mcp *ModificationCandidatePool) Cap() int {
+	mcp.RLock()
+	defer mcp.RUnlock()
+	return cap(mcp.keyPool)
+}
+
 // AddUK adds the unique key into the MCP.
 // It has side effect: the input UK's row ID will be changed.
 func (mcp *ModificationCandidatePool) AddUK(uk *UniqueKey) error {
@@ -0,0 +1,263 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package workload executes the generated DMLs on a simulating table.
+package workload
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/errno"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/simulator/internal/config"
+	"github.com/pingcap/tiflow/dm/simulator/internal/mcp"
+	"github.com/pingcap/tiflow/dm/simulator/internal/sqlgen"
+)
+
+// DMLType is the type of a DML executed by the workload.
+type DMLType int
+
+// DML types.
+const (
+	DMLInsert DMLType = iota
+	DMLUpdate
+	DMLDelete
+)
+
+// String implements fmt.Stringer.
+func (t DMLType) String() string {
+	switch t {
+	case DMLInsert:
+		return "insert"
+	case DMLUpdate:
+		return "update"
+	case DMLDelete:
+		return "delete"
+	default:
+		return "unknown"
+	}
+}
+
+// Stats is the statistics of the executed DMLs of a table.
+type Stats struct {
+	Inserted int64
+	Updated  int64
+	Deleted  int64
+	// Skipped is the number of DMLs skipped because of a unique key conflict,
+	// or there is no row to modify.
+	Skipped int64
+	// Failed is the number of DMLs failed to execute.
+	Failed int64
+}
+
+// TableWorkload generates and executes DMLs on a single table.
+// Unique keys of the existing rows are kept in an MCP,
+// so that UPDATE and DELETE always hit a row.
+type TableWorkload struct {
+	cfg  *config.TableConfig
+	gen  sqlgen.SQLGenerator
+	pool *mcp.ModificationCandidatePool
+
+	inserted atomic.Int64
+	updated  atomic.Int64
+	deleted  atomic.Int64
+	skipped  atomic.Int64
+	failed   atomic.Int64
+}
+
+// NewTableWorkload creates a workload for the table, at most maxRows unique keys are kept.
+func NewTableWorkload(cfg *config.TableConfig, maxRows int) *TableWorkload {
+	return &TableWorkload{
+		cfg:  cfg,
+		gen:  sqlgen.NewSQLGeneratorImpl(cfg),
+		pool: mcp.NewModificationCandidatePool(maxRows),
+	}
+}
+
+// Config returns the config of the table.
+func (w *TableWorkload) Config() *config.TableConfig {
+	return w.cfg
+}
+
+// RowCount returns the number of rows which are known to exist in the table.
+func (w *TableWorkload) RowCount() int {
+	return w.pool.Len()
+}
+
+// Stats returns the statistics of the executed DMLs.
+func (w *TableWorkload) Stats() Stats {
+	return Stats{
+		Inserted: w.inserted.Load(),
+		Updated:  w.updated.Load(),
+		Deleted:  w.deleted.Load(),
+		Skipped:  w.skipped.Load(),
+		Failed:   w.failed.Load(),
+	}
+}
+
+// Prepare truncates the table if truncate is true,
+// otherwise loads the unique keys of the existing rows into the MCP.
+func (w *TableWorkload) Prepare(ctx context.Context, db *sql.DB, truncate bool) error {
+	w.pool.Reset()
+	if truncate {
+		query, err := w.gen.GenTruncateTable()
+		if err != nil {
+			return errors.Trace(err)
+		}
+		_, err = db.ExecContext(ctx, query)
+		return errors.Annotatef(err, "truncate table %s", w.cfg.TableID)
+	}
+
+	query, cols, err := w.gen.GenLoadUniqueKeySQL()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	rows, err := db.QueryContext(ctx, query)
+	if err != nil {
+		return errors.Annotatef(err, "load unique keys of table %s", w.cfg.TableID)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		values := make([]sql.NullString, len(cols))
+		dest := make([]interface{}, len(cols))
+		for i := range values {
+			dest[i] = &values[i]
+		}
+		if err = rows.Scan(dest...); err != nil {
+			return errors.Trace(err)
+		}
+		ukValue := make(map[string]interface{}, len(cols))
+		for i, col := range cols {
+			if values[i].Valid {
+				ukValue[col.ColumnName] = values[i].String
+			} else {
+				ukValue[col.ColumnName] = nil
+			}
+		}
+		if err = w.pool.AddUK(mcp.NewUniqueKey(-1, ukValue)); err != nil {
+			if errors.Cause(err) == mcp.ErrMCPCapacityFull {
+				log.L().Warn("too many rows in table, only part of them will be modified",
+					zap.String("table", w.cfg.TableID), zap.Int("max rows", w.pool.Len()))
+				break
+			}
+			return errors.Trace(err)
+		}
+	}
+	return errors.Trace(rows.Err())
+}
+
+// Execute generates and executes a DML of the given type.
+// INSERT is turned into UPDATE if the MCP is full,
+// and UPDATE or DELETE is turned into INSERT if the table is empty.
+// Errors of executing the DML are only counted and logged, only the error of context is returned.
+func (w *TableWorkload) Execute(ctx context.Context, db *sql.DB, tp DMLType) error {
+	if tp == DMLInsert && w.pool.Len() >= w.pool.Cap() {
+		tp = DMLUpdate
+	}
+	if tp != DMLInsert && w.pool.Len() == 0 {
+		tp = DMLInsert
+	}
+
+	var err error
+	switch tp {
+	case DMLInsert:
+		err = w.insert(ctx, db)
+	case DMLUpdate:
+		err = w.update(ctx, db)
+	case DMLDelete:
+		err = w.delete(ctx, db)
+	}
+	if err == nil {
+		return nil
+	}
+	if ctx.Err() != nil {
+		return errors.Trace(ctx.Err())
+	}
+	if isDupEntryError(err) {
+		w.skipped.Inc()
+		return nil
+	}
+	w.failed.Inc()
+	log.L().Warn("execute DML failed", zap.String("table", w.cfg.TableID),
+		zap.Stringer("type", tp), zap.Error(err))
+	return nil
+}
+
+func (w *TableWorkload) insert(ctx context.Context, db *sql.DB) error {
+	query, uk, err := w.gen.GenInsertRow()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if _, err = db.ExecContext(ctx, query); err != nil {
+		return errors.Trace(err)
+	}
+	w.inserted.Inc()
+	if err = w.pool.AddUK(uk); err != nil {
+		// the row is inserted, but it won't be modified later.
+		log.L().Debug("add unique key into MCP failed", zap.String("table", w.cfg.TableID), zap.Error(err))
+	}
+	return nil
+}
+
+func (w *TableWorkload) update(ctx context.Context, db *sql.DB) error {
+	uk := w.pool.NextUK()
+	if uk == nil {
+		w.skipped.Inc()
+		return nil
+	}
+	query, err := w.gen.GenUpdateRow(uk)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if _, err = db.ExecContext(ctx, query); err != nil {
+		return errors.Trace(err)
+	}
+	w.updated.Inc()
+	return nil
+}
+
+func (w *TableWorkload) delete(ctx context.Context, db *sql.DB) error {
+	uk := w.pool.NextUK()
+	if uk == nil {
+		w.skipped.Inc()
+		return nil
+	}
+	// remove the unique key first, so that concurrent workers won't delete the same row.
+	if err := w.pool.DeleteUK(uk); err != nil {
+		w.skipped.Inc()
+		return nil
+	}
+	query, err := w.gen.GenDeleteRow(uk)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if _, err = db.ExecContext(ctx, query); err != nil {
+		if addErr := w.pool.AddUK(uk); addErr != nil {
+			log.L().Debug("add unique key back into MCP failed", zap.String("table", w.cfg.TableID), zap.Error(addErr))
+		}
+		return errors.Trace(err)
+	}
+	w.deleted.Inc()
+	return nil
+}
+
+func isDupEntryError(err error) bool {
+	mysqlErr, ok := errors.Cause(err).(*mysql.MySQLError)
+	return ok && mysqlErr.Number == errno.ErrDupEntry
+}
@@ -0,0 +1,110 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package workload
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-sql-driver/mysql"
+	"github.com/pingcap/tidb/errno"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/simulator/internal/config"
+)
+
+type testWorkloadSuite struct {
+	suite.Suite
+	tableConfig *config.TableConfig
+}
+
+func (s *testWorkloadSuite) SetupSuite() {
+	s.Require().Nil(log.InitLogger(&log.Config{}))
+	s.tableConfig = &config.TableConfig{
+		TableID:      "members",
+		DatabaseName: "games",
+		TableName:    "members",
+		Columns: []*config.ColumnDefinition{
+			{ColumnName: "id", DataType: "int", DataLen: 11},
+			{ColumnName: "name", DataType: "varchar", DataLen: 255},
+		},
+		UniqueKeyColumnNames: []string{"id"},
+	}
+}
+
+func (s *testWorkloadSuite) TestPrepare() {
+	ctx := context.Background()
+	db, mock, err := sqlmock.New()
+	s.Require().Nil(err)
+	defer db.Close()
+	w := NewTableWorkload(s.tableConfig, 2)
+
+	mock.ExpectExec("TRUNCATE TABLE `games`.`members`").WillReturnResult(sqlmock.NewResult(0, 0))
+	s.Require().Nil(w.Prepare(ctx, db, true))
+	s.Equal(0, w.RowCount())
+
+	// only max rows of unique keys are loaded
+	mock.ExpectQuery("SELECT .*`id` FROM `games`.`members`").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("1").AddRow("2").AddRow("3"))
+	s.Require().Nil(w.Prepare(ctx, db, false))
+	s.Equal(2, w.RowCount())
+	s.Require().Nil(mock.ExpectationsWereMet())
+}
+
+func (s *testWorkloadSuite) TestExecute() {
+	ctx := context.Background()
+	db, mock, err := sqlmock.New()
+	s.Require().Nil(err)
+	defer db.Close()
+	w := NewTableWorkload(s.tableConfig, 2)
+
+	// the table is empty, UPDATE is turned into INSERT
+	mock.ExpectExec("INSERT INTO `games`.`members`.*").WillReturnResult(sqlmock.NewResult(1, 1))
+	s.Require().Nil(w.Execute(ctx, db, DMLUpdate))
+	s.Equal(1, w.RowCount())
+
+	mock.ExpectExec("UPDATE `games`.`members` SET .* WHERE `id`=.*").WillReturnResult(sqlmock.NewResult(0, 1))
+	s.Require().Nil(w.Execute(ctx, db, DMLUpdate))
+
+	// duplicate entry is skipped
+	mock.ExpectExec("INSERT INTO `games`.`members`.*").WillReturnError(&mysql.MySQLError{Number: errno.ErrDupEntry})
+	s.Require().Nil(w.Execute(ctx, db, DMLInsert))
+	s.Equal(1, w.RowCount())
+
+	mock.ExpectExec("INSERT INTO `games`.`members`.*").WillReturnResult(sqlmock.NewResult(1, 1))
+	s.Require().Nil(w.Execute(ctx, db, DMLInsert))
+	s.Equal(2, w.RowCount())
+
+	// the MCP is full, INSERT is turned into UPDATE
+	mock.ExpectExec("UPDATE `games`.`members` SET .*").WillReturnResult(sqlmock.NewResult(0, 1))
+	s.Require().Nil(w.Execute(ctx, db, DMLInsert))
+
+	mock.ExpectExec("DELETE FROM `games`.`members` WHERE `id`=.*").WillReturnResult(sqlmock.NewResult(0, 1))
+	s.Require().Nil(w.Execute(ctx, db, DMLDelete))
+	s.Equal(1, w.RowCount())
+
+	// failed DELETE puts the unique key back
+	mock.ExpectExec("DELETE FROM `games`.`members` WHERE `id`=.*").WillReturnError(&mysql.MySQLError{Number: errno.ErrLockWaitTimeout})
+	s.Require().Nil(w.Execute(ctx, db, DMLDelete))
+	s.Equal(1, w.RowCount())
+
+	s.Equal(Stats{Inserted: 2, Updated: 2, Deleted: 1, Skipped: 1, Failed: 1}, w.Stats())
+	s.Require().Nil(mock.ExpectationsWereMet())
+}
+
+func TestWorkloadSuite(t *testing.T) {
+	suite.Run(t, &testWorkloadSuite{})
+}
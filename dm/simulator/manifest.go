@@ -0,0 +1,139 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package simulator
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb-tools/pkg/dbutil"
+	"go.uber.org/zap"
+
+	"github.com/pingcap/tiflow/dm/pkg/conn"
+	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/utils"
+	"github.com/pingcap/tiflow/dm/simulator/internal/config"
+)
+
+// Manifest describes the expected state of the simulating tables after the workload.
+// The downstream of DM is consistent with the upstream once it has replicated to the binlog location,
+// and the row count and checksum calculated by ChecksumSQL of each table are the same as the manifest.
+type Manifest struct {
+	StartTime time.Time `json:"start_time"`
+	EndTime   time.Time `json:"end_time"`
+	// binlog location of the data source after the workload.
+	BinlogName string `json:"binlog_name,omitempty"`
+	BinlogPos  uint32 `json:"binlog_pos,omitempty"`
+	GTIDSet    string `json:"gtid_set,omitempty"`
+
+	Tables []*TableManifest `json:"tables"`
+}
+
+// TableManifest is the expected state of a simulating table.
+type TableManifest struct {
+	ID         string   `json:"id"`
+	Schema     string   `json:"schema"`
+	Table      string   `json:"table"`
+	UniqueKeys []string `json:"unique_keys"`
+
+	RowCount    int64  `json:"row_count"`
+	Checksum    uint64 `json:"checksum"`
+	ChecksumSQL string `json:"checksum_sql"`
+
+	Inserted int64 `json:"inserted"`
+	Updated  int64 `json:"updated"`
+	Deleted  int64 `json:"deleted"`
+	Skipped  int64 `json:"skipped"`
+	Failed   int64 `json:"failed"`
+}
+
+// WriteFile writes the manifest into a JSON file, or stdout if path is empty.
+func (m *Manifest) WriteFile(path string) error {
+	content, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if path == "" {
+		fmt.Println(string(content))
+		return nil
+	}
+	return errors.Trace(os.WriteFile(path, content, 0o644))
+}
+
+func (s *Simulator) buildManifest(ctx context.Context, db *conn.BaseDB) (*Manifest, error) {
+	m := &Manifest{
+		StartTime: s.startTime,
+		EndTime:   s.endTime,
+		Tables:    make([]*TableManifest, 0, len(s.tables)),
+	}
+	tctx := tcontext.NewContext(ctx, log.L())
+	flavor, err := utils.GetFlavor(ctx, db.DB)
+	if err == nil {
+		m.BinlogName, m.BinlogPos, _, _, m.GTIDSet, err = conn.GetMasterStatus(tctx, db, flavor)
+	}
+	if err != nil {
+		// binlog location is only a hint for the checker, need REPLICATION CLIENT privilege.
+		log.L().Warn("fail to get binlog location of data source", zap.Error(err))
+	}
+
+	for _, tbl := range s.tables {
+		tblCfg := tbl.Config()
+		tm := &TableManifest{
+			ID:          tblCfg.TableID,
+			Schema:      tblCfg.DatabaseName,
+			Table:       tblCfg.TableName,
+			UniqueKeys:  tblCfg.UniqueKeyColumnNames,
+			ChecksumSQL: buildChecksumSQL(tblCfg),
+		}
+		if tm.RowCount, tm.Checksum, err = queryChecksum(ctx, db.DB, tm.ChecksumSQL); err != nil {
+			return nil, errors.Annotatef(err, "calculate checksum of table %s", tm.ID)
+		}
+		stats := tbl.Stats()
+		tm.Inserted, tm.Updated, tm.Deleted = stats.Inserted, stats.Updated, stats.Deleted
+		tm.Skipped, tm.Failed = stats.Skipped, stats.Failed
+		m.Tables = append(m.Tables, tm)
+	}
+	return m, nil
+}
+
+// buildChecksumSQL builds the SQL calculating row count and checksum of a table,
+// it's the same as the checksum used by snapshot validation of DM.
+func buildChecksumSQL(tblCfg *config.TableConfig) string {
+	columns := make([]string, 0, len(tblCfg.Columns))
+	isNulls := make([]string, 0, len(tblCfg.Columns))
+	for _, col := range tblCfg.Columns {
+		columns = append(columns, dbutil.ColumnName(col.ColumnName))
+		isNulls = append(isNulls, fmt.Sprintf("ISNULL(%s)", dbutil.ColumnName(col.ColumnName)))
+	}
+	return fmt.Sprintf("SELECT COUNT(*) AS cnt, BIT_XOR(CAST(CRC32(CONCAT_WS(',', %s, CONCAT(%s))) AS UNSIGNED)) AS checksum FROM %s",
+		strings.Join(columns, ", "), strings.Join(isNulls, ", "), dbutil.TableName(tblCfg.DatabaseName, tblCfg.TableName))
+}
+
+func queryChecksum(ctx context.Context, db *sql.DB, query string) (int64, uint64, error) {
+	var (
+		cnt      int64
+		checksum sql.NullInt64
+	)
+	if err := db.QueryRowContext(ctx, query).Scan(&cnt, &checksum); err != nil {
+		return 0, 0, errors.Trace(err)
+	}
+	return cnt, uint64(checksum.Int64), nil
+}
@@ -0,0 +1,136 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package simulator drives a configurable DML workload on a MySQL data source,
+// and outputs a manifest describing the expected state of the simulating tables.
+package simulator
+
+import (
+	"context"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/pingcap/errors"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+	"golang.org/x/time/rate"
+
+	"github.com/pingcap/tiflow/dm/pkg/conn"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/simulator/internal/config"
+	"github.com/pingcap/tiflow/dm/simulator/internal/workload"
+)
+
+// Simulator runs the workload described by a config file.
+type Simulator struct {
+	cfg    *config.Config
+	tables []*workload.TableWorkload
+
+	randLock sync.Mutex
+	theRand  *rand.Rand
+
+	startTime time.Time
+	endTime   time.Time
+}
+
+// NewSimulator creates a simulator from the workload config file.
+func NewSimulator(cfgPath string) (*Simulator, error) {
+	cfg, err := config.FromFile(cfgPath)
+	if err != nil {
+		return nil, err
+	}
+	return newSimulator(cfg), nil
+}
+
+func newSimulator(cfg *config.Config) *Simulator {
+	tables := make([]*workload.TableWorkload, 0, len(cfg.Tables))
+	for _, tblCfg := range cfg.Tables {
+		tables = append(tables, workload.NewTableWorkload(tblCfg, cfg.Workload.MaxRows))
+	}
+	return &Simulator{
+		cfg:     cfg,
+		tables:  tables,
+		theRand: rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+}
+
+// Run runs the workload until the configured duration elapses or ctx is canceled,
+// and returns the manifest of the simulating tables.
+func (s *Simulator) Run(ctx context.Context) (*Manifest, error) {
+	db, err := conn.DefaultDBProvider.Apply(&s.cfg.DataSource)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+	db.DB.SetMaxOpenConns(s.cfg.Workload.Workers)
+
+	for _, tbl := range s.tables {
+		if err = tbl.Prepare(ctx, db.DB, s.cfg.Workload.Truncate); err != nil {
+			return nil, err
+		}
+	}
+
+	wCfg := s.cfg.Workload
+	log.L().Info("start to run workload", zap.Int("qps", wCfg.QPS), zap.Duration("duration", wCfg.Duration),
+		zap.Int("workers", wCfg.Workers), zap.Int("tables", len(s.tables)))
+	s.startTime = time.Now()
+	runCtx, cancel := context.WithTimeout(ctx, wCfg.Duration)
+	defer cancel()
+	limiter := rate.NewLimiter(rate.Limit(wCfg.QPS), wCfg.Workers)
+	eg, egCtx := errgroup.WithContext(runCtx)
+	for i := 0; i < wCfg.Workers; i++ {
+		eg.Go(func() error {
+			for {
+				if err2 := limiter.Wait(egCtx); err2 != nil {
+					// the deadline of runCtx is exceeded or will be exceeded before the next token.
+					return nil
+				}
+				tbl, tp := s.nextDML()
+				if err2 := tbl.Execute(egCtx, db.DB, tp); err2 != nil {
+					return nil
+				}
+			}
+		})
+	}
+	_ = eg.Wait()
+	s.endTime = time.Now()
+	if err = ctx.Err(); err != nil {
+		return nil, errors.Trace(err)
+	}
+	log.L().Info("workload finished", zap.Duration("elapsed", s.endTime.Sub(s.startTime)))
+
+	return s.buildManifest(ctx, db)
+}
+
+// nextDML randomly picks a table and a DML type according to the configured ratios.
+func (s *Simulator) nextDML() (*workload.TableWorkload, workload.DMLType) {
+	wCfg := s.cfg.Workload
+	s.randLock.Lock()
+	tbl := s.tables[s.theRand.Intn(len(s.tables))]
+	n := s.theRand.Intn(wCfg.InsertRatio + wCfg.UpdateRatio + wCfg.DeleteRatio)
+	s.randLock.Unlock()
+	return tbl, pickDMLType(n, wCfg.InsertRatio, wCfg.UpdateRatio)
+}
+
+// pickDMLType maps n in [0, insertRatio+updateRatio+deleteRatio) to a DML type.
+func pickDMLType(n, insertRatio, updateRatio int) workload.DMLType {
+	switch {
+	case n < insertRatio:
+		return workload.DMLInsert
+	case n < insertRatio+updateRatio:
+		return workload.DMLUpdate
+	default:
+		return workload.DMLDelete
+	}
+}
@@ -0,0 +1,58 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package simulator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/pingcap/tiflow/dm/simulator/internal/config"
+	"github.com/pingcap/tiflow/dm/simulator/internal/workload"
+)
+
+func TestPickDMLType(t *testing.T) {
+	require.Equal(t, workload.DMLInsert, pickDMLType(0, 4, 4))
+	require.Equal(t, workload.DMLInsert, pickDMLType(3, 4, 4))
+	require.Equal(t, workload.DMLUpdate, pickDMLType(4, 4, 4))
+	require.Equal(t, workload.DMLUpdate, pickDMLType(7, 4, 4))
+	require.Equal(t, workload.DMLDelete, pickDMLType(8, 4, 4))
+	require.Equal(t, workload.DMLDelete, pickDMLType(0, 0, 0))
+}
+
+func TestNewSimulator(t *testing.T) {
+	sim, err := NewSimulator("workload.example.yaml")
+	require.NoError(t, err)
+	require.Len(t, sim.tables, 1)
+	for i := 0; i < 10; i++ {
+		tbl, _ := sim.nextDML()
+		require.Equal(t, "members", tbl.Config().TableID)
+	}
+
+	_, err = NewSimulator("not-exist.yaml")
+	require.Error(t, err)
+}
+
+func TestBuildChecksumSQL(t *testing.T) {
+	tblCfg := &config.TableConfig{
+		DatabaseName: "games",
+		TableName:    "members",
+		Columns: []*config.ColumnDefinition{
+			{ColumnName: "id", DataType: "int"},
+			{ColumnName: "name", DataType: "varchar"},
+		},
+	}
+	require.Equal(t, "SELECT COUNT(*) AS cnt, BIT_XOR(CAST(CRC32(CONCAT_WS(',', `id`, `name`, CONCAT(ISNULL(`id`), ISNULL(`name`)))) AS UNSIGNED)) AS checksum FROM `games`.`members`",
+		buildChecksumSQL(tblCfg))
+}
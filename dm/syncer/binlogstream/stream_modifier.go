@@ -24,6 +24,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/pingcap/tiflow/dm/pb"
 	"github.com/pingcap/tiflow/dm/pkg/binlog"
+	"github.com/pingcap/tiflow/dm/pkg/binlog/reader"
 	"github.com/pingcap/tiflow/dm/pkg/log"
 	"github.com/pingcap/tiflow/dm/pkg/terror"
 	"go.uber.org/zap"
@@ -37,6 +38,8 @@ type operator struct {
 	pos       mysql.Position
 	events    []*replication.BinlogEvent // ddls -> events
 	originReq *pb.HandleWorkerErrorRequest
+	// fromRule means the operator is generated by a ruleOperator for a matched event.
+	fromRule bool
 }
 
 // newOperator creates a new operator with a random UUID.
@@ -66,10 +69,28 @@ func (o *operator) String() string {
 	return fmt.Sprintf("uuid: %s, op: %s, events: %s, originReq: %v", o.uuid, o.op, strings.Join(events, "\n"), o.originReq)
 }
 
+// ruleOperator contains an operation for all the DDLs matched by an EventFilter,
+// used by `binlog` commands with --gtid-set, --start-time, --end-time or --ddl-pattern.
+// An operator is generated when an upstream event is matched. A skip rule with a GTID set
+// also skips the DML of the set, and the rule is removed once the set is consumed.
+type ruleOperator struct {
+	uuid      string
+	op        pb.ErrorOp
+	filter    *reader.EventFilter
+	events    []*replication.BinlogEvent
+	originReq *pb.HandleWorkerErrorRequest
+}
+
+func (r *ruleOperator) String() string {
+	return fmt.Sprintf("uuid: %s, op: %s, filter: %s, originReq: %v", r.uuid, r.op, r.filter, r.originReq)
+}
+
 // streamModifier is not thread-safe.
 type streamModifier struct {
 	ops    []*operator // sorted on operator.Position
 	nextOp int         // next operator whose location is waiting to be matched
+	// rules generate operators in ops for the matched events.
+	rules []*ruleOperator
 
 	// next event in current operator. This field can be
 	// modified by StreamerController.
@@ -109,7 +130,13 @@ func (m *streamModifier) Set(req *pb.HandleWorkerErrorRequest, events []*replica
 		return err
 	}
 
-	toInject := newOperator(req.Op, pos, events, req)
+	m.insert(newOperator(req.Op, pos, events, req))
+	return nil
+}
+
+// insert puts the operator into ops, an existing operator at the same position is overwritten.
+func (m *streamModifier) insert(toInject *operator) {
+	pos := toInject.pos
 	toInsertIndex := m.minIdxLargerOrEqual(pos)
 
 	if toInsertIndex == len(m.ops) {
@@ -117,7 +144,7 @@ func (m *streamModifier) Set(req *pb.HandleWorkerErrorRequest, events []*replica
 		m.logger.Info("set a new operator",
 			zap.Stringer("position", pos),
 			zap.Stringer("new operator", toInject))
-		return nil
+		return
 	}
 
 	pre := m.ops[toInsertIndex]
@@ -126,7 +153,7 @@ func (m *streamModifier) Set(req *pb.HandleWorkerErrorRequest, events []*replica
 		m.logger.Warn("overwrite operator",
 			zap.Stringer("position", pos),
 			zap.Stringer("old operator", pre))
-		return nil
+		return
 	}
 
 	m.ops = append(m.ops, nil)
@@ -138,9 +165,103 @@ func (m *streamModifier) Set(req *pb.HandleWorkerErrorRequest, events []*replica
 	m.logger.Info("set a new operator",
 		zap.Stringer("position", pos),
 		zap.Stringer("new operator", toInject))
+}
+
+// SetRule handles HandleWorkerErrorRequest with ErrorOp_Skip, ErrorOp_Replace, ErrorOp_Inject
+// for all the DDLs matched by the filter. A rule with the same filter is overwritten.
+func (m *streamModifier) SetRule(req *pb.HandleWorkerErrorRequest, filter *reader.EventFilter, events []*replication.BinlogEvent) error {
+	switch req.Op {
+	case pb.ErrorOp_Skip:
+	case pb.ErrorOp_Replace, pb.ErrorOp_Inject:
+		if len(events) == 0 {
+			return terror.ErrSyncerEvent.Generatef("%s op should have non-empty events", req.Op.String())
+		}
+	default:
+		return terror.ErrSyncerEvent.Generatef("invalid error op: %s", req.Op.String())
+	}
+
+	reqClone := *req
+	rule := &ruleOperator{
+		uuid:      uuid.New().String(),
+		op:        req.Op,
+		filter:    filter,
+		events:    events,
+		originReq: &reqClone,
+	}
+	for i, pre := range m.rules {
+		if pre.filter.String() == filter.String() {
+			m.rules[i] = rule
+			m.logger.Warn("overwrite rule operator", zap.Stringer("old rule", pre), zap.Stringer("new rule", rule))
+			return nil
+		}
+	}
+	m.rules = append(m.rules, rule)
+	m.logger.Info("set a new rule operator", zap.Stringer("new rule", rule))
 	return nil
 }
 
+// DeleteRule deletes the rule operator with the same filter. Operators already generated by
+// the rule are not deleted.
+func (m *streamModifier) DeleteRule(filter *reader.EventFilter) error {
+	for i, pre := range m.rules {
+		if pre.filter.String() == filter.String() {
+			m.rules = append(m.rules[:i], m.rules[i+1:]...)
+			return nil
+		}
+	}
+	return terror.ErrSyncerOperatorNotExist.Generate(filter.String())
+}
+
+// matchRules generates an operator at the position for the event, if the event is matched by
+// a rule operator and there's no operator at this position.
+func (m *streamModifier) matchRules(gtidStr string, pos mysql.Position, e *replication.BinlogEvent) {
+	if len(m.rules) == 0 {
+		return
+	}
+	idx := m.minIdxLargerOrEqual(pos)
+	if idx < len(m.ops) && m.ops[idx].pos.Compare(pos) == 0 {
+		return
+	}
+	_, isQuery := e.Event.(*replication.QueryEvent)
+	for _, rule := range m.rules {
+		// only DDLs can be replaced or injected before.
+		if !isQuery && rule.op != pb.ErrorOp_Skip {
+			continue
+		}
+		if !rule.filter.Match(gtidStr, e) {
+			continue
+		}
+		// the header of events will be changed when they're sent, so every operator has its own copy.
+		events := make([]*replication.BinlogEvent, 0, len(rule.events))
+		for _, ev := range rule.events {
+			header := *ev.Header
+			events = append(events, &replication.BinlogEvent{Header: &header, Event: ev.Event})
+		}
+		op := newOperator(rule.op, pos, events, rule.originReq)
+		op.fromRule = true
+		m.insert(op)
+		return
+	}
+}
+
+// removeConsumedRules removes the rules whose GTID set is contained in gset, which is the
+// GTID set of the replicated transactions, so no following event will be matched by them.
+func (m *streamModifier) removeConsumedRules(gset mysql.GTIDSet) {
+	if len(m.rules) == 0 || gset == nil {
+		return
+	}
+	rules := m.rules[:0]
+	for _, rule := range m.rules {
+		ruleSet := rule.filter.GTIDSet()
+		if ruleSet != nil && gset.Contain(ruleSet) {
+			m.logger.Info("remove rule operator whose GTID set is consumed", zap.Stringer("rule", rule))
+			continue
+		}
+		rules = append(rules, rule)
+	}
+	m.rules = rules
+}
+
 // Delete will delete an operator. `posStr` should be in the format of "binlog-file:pos".
 func (m *streamModifier) Delete(posStr string) error {
 	pos, err := binlog.PositionFromPosStr(posStr)
@@ -164,7 +285,7 @@ func (m *streamModifier) Delete(posStr string) error {
 
 // ListEqualAndAfter returns a JSON string of operators equals and after the given
 // position.
-//   - if argument is "", it returns all operators.
+//   - if argument is "", it returns all operators and rule operators.
 //   - Otherwise caller should make sure the argument in format of "binlog-file:pos"
 //     and it returns all operators >= this position.
 func (m *streamModifier) ListEqualAndAfter(posStr string) []*pb.HandleWorkerErrorRequest {
@@ -188,8 +309,16 @@ func (m *streamModifier) ListEqualAndAfter(posStr string) []*pb.HandleWorkerErro
 
 	reqs := make([]*pb.HandleWorkerErrorRequest, 0, len(matchedOps))
 	for _, op := range matchedOps {
+		if op.fromRule {
+			continue
+		}
 		reqs = append(reqs, op.originReq)
 	}
+	if posStr == "" {
+		for _, rule := range m.rules {
+			reqs = append(reqs, rule.originReq)
+		}
+	}
 
 	return reqs
 }
@@ -15,12 +15,15 @@ package binlogstream
 
 import (
 	"testing"
+	"time"
 
 	"github.com/go-mysql-org/go-mysql/mysql"
 	"github.com/go-mysql-org/go-mysql/replication"
 	"github.com/pingcap/tiflow/dm/pb"
 	"github.com/pingcap/tiflow/dm/pkg/binlog"
+	"github.com/pingcap/tiflow/dm/pkg/binlog/reader"
 	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
 	"github.com/stretchr/testify/require"
 )
 
@@ -348,3 +351,121 @@ func TestRemoveOutdated(t *testing.T) {
 	m.RemoveOutdated(mysql.Position{Name: "mysql.000001", Pos: 9999})
 	require.Len(t, m.ops, 0)
 }
+
+func TestSetAndDeleteRule(t *testing.T) {
+	t.Parallel()
+	m := newStreamModifier(log.L())
+
+	filter1, err := reader.NewEventFilter(mysql.MySQLFlavor, "", "2022-01-01 00:00:00", "", "", time.UTC)
+	require.NoError(t, err)
+	filter2, err := reader.NewEventFilter(mysql.MySQLFlavor, "", "", "", "^DROP", time.UTC)
+	require.NoError(t, err)
+
+	req := &pb.HandleWorkerErrorRequest{Op: pb.ErrorOp_Replace, StartTime: "2022-01-01 00:00:00"}
+	err = m.SetRule(req, filter1, nil)
+	require.True(t, terror.ErrSyncerEvent.Equal(err))
+	req.Op = pb.ErrorOp_Revert
+	err = m.SetRule(req, filter1, nil)
+	require.True(t, terror.ErrSyncerEvent.Equal(err))
+
+	req.Op = pb.ErrorOp_Skip
+	require.NoError(t, m.SetRule(req, filter1, nil))
+	require.NoError(t, m.SetRule(&pb.HandleWorkerErrorRequest{Op: pb.ErrorOp_Skip, DdlPattern: "^DROP"}, filter2, nil))
+	// overwrite the rule with same filter
+	injectEvents := []*replication.BinlogEvent{
+		{
+			Header: &replication.EventHeader{},
+			Event:  &replication.QueryEvent{Query: []byte("a DDL")},
+		},
+	}
+	require.NoError(t, m.SetRule(&pb.HandleWorkerErrorRequest{Op: pb.ErrorOp_Inject, StartTime: "2022-01-01 00:00:00"}, filter1, injectEvents))
+	require.Len(t, m.rules, 2)
+	require.Equal(t, pb.ErrorOp_Inject, m.rules[0].op)
+
+	reqs := m.ListEqualAndAfter("")
+	require.Len(t, reqs, 2)
+	require.Equal(t, pb.ErrorOp_Inject, reqs[0].Op)
+	require.Equal(t, pb.ErrorOp_Skip, reqs[1].Op)
+
+	// match the event with time after start-time
+	pos := mysql.Position{Name: "mysql.000001", Pos: 1234}
+	ddl := &replication.BinlogEvent{
+		Header: &replication.EventHeader{Timestamp: uint32(time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC).Unix())},
+		Event:  &replication.QueryEvent{Query: []byte("CREATE TABLE tb (id INT)")},
+	}
+	m.matchRules("", pos, ddl)
+	require.Len(t, m.ops, 1)
+	require.Equal(t, pb.ErrorOp_Inject, m.ops[0].op)
+	require.True(t, m.ops[0].fromRule)
+	// events are copied
+	require.Len(t, m.ops[0].events, 1)
+	require.NotSame(t, injectEvents[0].Header, m.ops[0].events[0].Header)
+	// won't generate operator at the same position twice
+	m.matchRules("", pos, ddl)
+	require.Len(t, m.ops, 1)
+	// DML is never matched
+	m.matchRules("", mysql.Position{Name: "mysql.000001", Pos: 2345}, &replication.BinlogEvent{
+		Header: &replication.EventHeader{Timestamp: ddl.Header.Timestamp},
+		Event:  &replication.QueryEvent{Query: []byte("BEGIN")},
+	})
+	require.Len(t, m.ops, 1)
+
+	require.NoError(t, m.DeleteRule(filter1))
+	require.Len(t, m.rules, 1)
+	err = m.DeleteRule(filter1)
+	require.True(t, terror.ErrSyncerOperatorNotExist.Equal(err))
+	// generated operators are kept
+	require.Len(t, m.ops, 1)
+}
+
+func TestSkipRuleForDMLAndConsumedRule(t *testing.T) {
+	t.Parallel()
+	m := newStreamModifier(log.L())
+
+	skipFilter, err := reader.NewEventFilter(mysql.MySQLFlavor, "3ccc475b-2343-11e7-be21-6c0b84d59f30:10-11", "", "", "", time.UTC)
+	require.NoError(t, err)
+	injectFilter, err := reader.NewEventFilter(mysql.MySQLFlavor, "3ccc475b-2343-11e7-be21-6c0b84d59f30:1-20", "", "", "", time.UTC)
+	require.NoError(t, err)
+	require.NoError(t, m.SetRule(&pb.HandleWorkerErrorRequest{Op: pb.ErrorOp_Skip}, skipFilter, nil))
+	injectEvents := []*replication.BinlogEvent{
+		{
+			Header: &replication.EventHeader{},
+			Event:  &replication.QueryEvent{Query: []byte("a DDL")},
+		},
+	}
+	require.NoError(t, m.SetRule(&pb.HandleWorkerErrorRequest{Op: pb.ErrorOp_Inject}, injectFilter, injectEvents))
+
+	// rows events and XID event of the GTID set are skipped, and only skip rules apply to them
+	rows := &replication.BinlogEvent{Header: &replication.EventHeader{}, Event: &replication.RowsEvent{}}
+	xid := &replication.BinlogEvent{Header: &replication.EventHeader{}, Event: &replication.XIDEvent{}}
+	m.matchRules("3ccc475b-2343-11e7-be21-6c0b84d59f30:10", mysql.Position{Name: "mysql.000001", Pos: 100}, rows)
+	m.matchRules("3ccc475b-2343-11e7-be21-6c0b84d59f30:10", mysql.Position{Name: "mysql.000001", Pos: 200}, xid)
+	require.Len(t, m.ops, 2)
+	require.Equal(t, pb.ErrorOp_Skip, m.ops[0].op)
+	require.Equal(t, pb.ErrorOp_Skip, m.ops[1].op)
+	// DML outside the skip GTID set is not matched by the inject rule
+	m.matchRules("3ccc475b-2343-11e7-be21-6c0b84d59f30:12", mysql.Position{Name: "mysql.000001", Pos: 300}, rows)
+	require.Len(t, m.ops, 2)
+
+	// rules are kept until their GTID sets are all replicated
+	gset, err := mysql.ParseGTIDSet(mysql.MySQLFlavor, "3ccc475b-2343-11e7-be21-6c0b84d59f30:1-10")
+	require.NoError(t, err)
+	m.removeConsumedRules(gset)
+	require.Len(t, m.rules, 2)
+	gset, err = mysql.ParseGTIDSet(mysql.MySQLFlavor, "3ccc475b-2343-11e7-be21-6c0b84d59f30:1-11")
+	require.NoError(t, err)
+	m.removeConsumedRules(gset)
+	require.Len(t, m.rules, 1)
+	require.Equal(t, pb.ErrorOp_Inject, m.rules[0].op)
+	// rules without GTID set are never removed
+	timeFilter, err := reader.NewEventFilter(mysql.MySQLFlavor, "", "2022-01-01 00:00:00", "", "", time.UTC)
+	require.NoError(t, err)
+	require.NoError(t, m.SetRule(&pb.HandleWorkerErrorRequest{Op: pb.ErrorOp_Skip}, timeFilter, nil))
+	gset, err = mysql.ParseGTIDSet(mysql.MySQLFlavor, "3ccc475b-2343-11e7-be21-6c0b84d59f30:1-30")
+	require.NoError(t, err)
+	m.removeConsumedRules(gset)
+	require.Len(t, m.rules, 1)
+	require.Equal(t, pb.ErrorOp_Skip, m.rules[0].op)
+	// generated operators are kept
+	require.Len(t, m.ops, 2)
+}
@@ -31,6 +31,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/pingcap/tiflow/dm/pkg/binlog"
+	"github.com/pingcap/tiflow/dm/pkg/binlog/event"
 	"github.com/pingcap/tiflow/dm/pkg/binlog/reader"
 	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
 	"github.com/pingcap/tiflow/dm/pkg/terror"
@@ -150,6 +151,9 @@ type StreamerController struct {
 	// streamModifier will also modify locations so they'll be different from upstreamLocations.
 	locations *locations
 
+	// currentGTID is the GTID of the transaction which the last upstream event belongs to.
+	currentGTID string
+
 	// lastEventFromUpstream is the last event from upstream, and not sent to caller
 	// yet. It should be set to nil after sent to caller.
 	lastEventFromUpstream *replication.BinlogEvent
@@ -409,13 +413,12 @@ LOOP:
 		suffix = 0
 
 		if c.lastEventFromUpstream == nil {
-			c.lastEventFromUpstream, err = c.upstream.GetEvent(tctx.Context())
-			failpoint.Inject("GetEventError", func() {
-				err = errors.New("go-mysql returned an error")
-			})
+			c.lastEventFromUpstream, err = c.fetchUpstreamEvent(tctx)
 			if err != nil {
 				return
 			}
+			// an operator may be generated for the event by rule operators
+			frontOp = c.streamModifier.front()
 		}
 
 		// fake rotate. binlog recorder should handle it
@@ -526,12 +529,45 @@ LOOP:
 		return
 	}
 
-	event, err = c.upstream.GetEvent(tctx.Context())
+	event, err = c.fetchUpstreamEvent(tctx)
+	if err != nil || c.streamModifier.front() == nil {
+		// nolint:nakedret
+		return
+	}
+	// the event is matched by a rule operator, let the generated operator handle it.
+	c.lastEventFromUpstream = event
+	return c.getEvent(tctx)
+}
+
+// fetchUpstreamEvent gets an event from upstream, and records the GTID of current transaction
+// to let rule operators match the event.
+func (c *StreamerController) fetchUpstreamEvent(tctx *tcontext.Context) (*replication.BinlogEvent, error) {
+	e, err := c.upstream.GetEvent(tctx.Context())
 	failpoint.Inject("GetEventError", func() {
 		err = errors.New("go-mysql returned an error")
 	})
-	// nolint:nakedret
-	return
+	if err != nil {
+		return nil, err
+	}
+	// fake rotate.
+	if e.Header.LogPos == 0 {
+		return e, nil
+	}
+	switch e.Event.(type) {
+	case *replication.GTIDEvent, *replication.MariadbGTIDEvent:
+		if gtidStr, err2 := event.GetGTIDStr(e); err2 == nil {
+			c.currentGTID = gtidStr
+		}
+	case *replication.QueryEvent, *replication.RowsEvent, *replication.XIDEvent:
+		startPos := mysql.Position{
+			Name: c.upstream.curEndLocation.Position.Name,
+			Pos:  e.Header.LogPos - e.Header.EventSize,
+		}
+		c.streamModifier.matchRules(c.currentGTID, startPos, e)
+		// the rules whose GTID set is all replicated won't match any following event.
+		c.streamModifier.removeConsumedRules(c.upstream.txnEndLocation.GetGTID())
+	}
+	return e, nil
 }
 
 // Close closes streamer.
@@ -638,3 +638,61 @@ func TestResetToLastOfInject(t *testing.T) {
 	_, _, err = controller.GetEvent(ctx)
 	require.ErrorIs(t, err, context.DeadlineExceeded)
 }
+
+func TestGetEventWithRule(t *testing.T) {
+	upstream := &mockStream{
+		events: []*replication.BinlogEvent{
+			{
+				Header: &replication.EventHeader{LogPos: 1010, EventSize: 10},
+			},
+			{
+				Header:  &replication.EventHeader{LogPos: 1020, EventSize: 10},
+				Event:   &replication.QueryEvent{Schema: []byte("db"), Query: []byte("ALTER TABLE tb ADD COLUMN c INT")},
+				RawData: []byte("should skip me at 1010"),
+			},
+			{
+				Header:  &replication.EventHeader{LogPos: 1030, EventSize: 10},
+				Event:   &replication.QueryEvent{Schema: []byte("db"), Query: []byte("CREATE TABLE tb2 (id INT)")},
+				RawData: []byte("not matched at 1020"),
+			},
+			{
+				Header:  &replication.EventHeader{LogPos: 1040, EventSize: 10},
+				Event:   &replication.QueryEvent{Schema: []byte("db"), Query: []byte("ALTER TABLE tb2 ADD COLUMN c INT")},
+				RawData: []byte("should skip me at 1030"),
+			},
+		},
+	}
+	producer := &mockStreamProducer{upstream}
+
+	controller := NewStreamerController4Test(producer, upstream)
+
+	skipReq := &pb.HandleWorkerErrorRequest{
+		Op:         pb.ErrorOp_Skip,
+		DdlPattern: "^ALTER TABLE",
+	}
+	filter, err := reader.NewEventFilter(mysql.MySQLFlavor, "", "", "", skipReq.DdlPattern, time.Local)
+	require.NoError(t, err)
+	require.NoError(t, controller.SetRule(skipReq, filter, nil))
+	loc := binlog.Location{Position: mysql.Position{
+		Name: "bin.000001",
+		Pos:  1000,
+	}}
+	controller.streamModifier.reset(loc)
+	controller.upstream.locationRecorder.reset(loc)
+
+	expecteds := []expectedInfo{
+		{1010, 0, nil, pb.ErrorOp_InvalidErrorOp},
+		{1020, 0, []byte("should skip me at 1010"), pb.ErrorOp_Skip},
+		{1030, 0, []byte("not matched at 1020"), pb.ErrorOp_InvalidErrorOp},
+		{1040, 0, []byte("should skip me at 1030"), pb.ErrorOp_Skip},
+	}
+
+	checkGetEvent(t, controller, expecteds)
+
+	// operators generated by the rule are not listed
+	reqs := controller.ListEqualAndAfter("")
+	require.Len(t, reqs, 1)
+	require.Equal(t, "^ALTER TABLE", reqs[0].DdlPattern)
+	require.Len(t, controller.ops, 2)
+	require.True(t, controller.ops[0].fromRule)
+}
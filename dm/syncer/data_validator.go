@@ -213,6 +213,9 @@ type DataValidator struct {
 	location             *binlog.Location
 	loadedPendingChanges map[string]*tableChangeJob
 
+	// progress of snapshot validation, only used in snapshot mode
+	snapshotProgress snapshotProgress
+
 	vmetric *metrics.ValidatorMetrics
 }
 
@@ -252,6 +255,7 @@ func (v *DataValidator) reset() {
 	v.newErrorRowCount.Store(0)
 	v.processedBinlogSize.Store(0)
 	v.pendingRowSize.Store(0)
+	v.snapshotProgress.reset()
 	v.initTableStatus(map[string]*tableValidateStatus{})
 }
 
@@ -356,14 +360,19 @@ func (v *DataValidator) Start(expect pb.Stage) {
 		return
 	}
 
-	v.wg.Add(1)
-	go v.routineWrapper(v.doValidate)
+	if v.cfg.ValidatorCfg.Mode == config.ValidationSnapshot {
+		v.wg.Add(1)
+		go v.routineWrapper(v.doSnapshotValidate)
+	} else {
+		v.wg.Add(1)
+		go v.routineWrapper(v.doValidate)
 
-	v.wg.Add(1)
-	go v.routineWrapper(v.printStatusRoutine)
+		v.wg.Add(1)
+		go v.routineWrapper(v.printStatusRoutine)
 
-	v.wg.Add(1)
-	go utils.GoLogWrapper(v.L, v.markErrorStartedRoutine)
+		v.wg.Add(1)
+		go utils.GoLogWrapper(v.L, v.markErrorStartedRoutine)
+	}
 
 	// routineWrapper relies on errorProcessRoutine to handle panic errors,
 	// so just wrap it using a common wrapper.
@@ -1267,6 +1276,9 @@ func (v *DataValidator) GetValidatorError(errState pb.ValidateErrorState) ([]*pb
 }
 
 func (v *DataValidator) OperateValidatorError(validateOp pb.ValidationErrOp, errID uint64, isAll bool) error {
+	if validateOp == pb.ValidationErrOp_RepairErrOp {
+		return v.RepairValidatorError(errID, isAll)
+	}
 	var (
 		toDB  *conn.BaseDB
 		err   error
@@ -1352,6 +1364,10 @@ func (v *DataValidator) GetValidatorStatus() *pb.ValidationStatus {
 			validatorBinlogGtid = flushedLoc.GetGTID().String()
 		}
 	}
+	var snapshotStatus string
+	if v.cfg.ValidatorCfg.Mode == config.ValidationSnapshot {
+		snapshotStatus = v.getSnapshotStatus()
+	}
 	return &pb.ValidationStatus{
 		Task:                v.cfg.Name,
 		Source:              v.cfg.SourceID,
@@ -1363,5 +1379,6 @@ func (v *DataValidator) GetValidatorStatus() *pb.ValidationStatus {
 		ProcessedRowsStatus: processedRows,
 		PendingRowsStatus:   pendingRows,
 		ErrorRowsStatus:     errorRows,
+		SnapshotStatus:      snapshotStatus,
 	}
 }
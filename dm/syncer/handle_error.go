@@ -17,6 +17,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/pingcap/tidb/parser"
 
@@ -25,12 +26,28 @@ import (
 
 	"github.com/pingcap/tiflow/dm/pb"
 	"github.com/pingcap/tiflow/dm/pkg/binlog"
+	"github.com/pingcap/tiflow/dm/pkg/binlog/reader"
 	parserpkg "github.com/pingcap/tiflow/dm/pkg/parser"
 	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/pingcap/tiflow/dm/pkg/utils"
+	"github.com/pingcap/tiflow/dm/syncer/binlogstream"
+)
+
+const (
+	defaultListEventsLimit = 100
+	// list-events stops when no new event is received in this duration.
+	listEventsIdleTimeout = 3 * time.Second
 )
 
 // HandleError handle error for syncer.
 func (s *Syncer) HandleError(ctx context.Context, req *pb.HandleWorkerErrorRequest) (string, error) {
+	if req.Op == pb.ErrorOp_ListEvents {
+		return s.listBinlogEvents(ctx, req)
+	}
+	if hasEventFilter(req) {
+		return s.handleErrorByFilter(ctx, req)
+	}
+
 	pos := req.BinlogPos
 
 	if len(pos) == 0 {
@@ -84,6 +101,99 @@ func (s *Syncer) HandleError(ctx context.Context, req *pb.HandleWorkerErrorReque
 	return "", s.streamerController.Set(req, events)
 }
 
+func hasEventFilter(req *pb.HandleWorkerErrorRequest) bool {
+	return req.GtidSet != "" || req.StartTime != "" || req.EndTime != "" || req.DdlPattern != ""
+}
+
+func (s *Syncer) newEventFilter(req *pb.HandleWorkerErrorRequest) (*reader.EventFilter, error) {
+	return reader.NewEventFilter(s.cfg.Flavor, req.GtidSet, req.StartTime, req.EndTime, req.DdlPattern, s.timezone)
+}
+
+// handleErrorByFilter handles the request for all the DDLs matched by GTID set, time range or DDL pattern.
+func (s *Syncer) handleErrorByFilter(ctx context.Context, req *pb.HandleWorkerErrorRequest) (string, error) {
+	if len(req.BinlogPos) != 0 {
+		return "", terror.ErrVerifyHandleErrorArgs.Generate("binlog-pos can't be used together with gtid-set, start-time, end-time or ddl-pattern")
+	}
+	filter, err := s.newEventFilter(req)
+	if err != nil {
+		return "", err
+	}
+
+	switch req.Op {
+	case pb.ErrorOp_List:
+		commandsJSON, err2 := json.Marshal(s.streamerController.ListEqualAndAfter(""))
+		return string(commandsJSON), err2
+	case pb.ErrorOp_Revert:
+		return "", s.streamerController.DeleteRule(filter)
+	}
+
+	events := make([]*replication.BinlogEvent, 0)
+	if req.Op == pb.ErrorOp_Replace || req.Op == pb.ErrorOp_Inject {
+		events, err = s.genEvents(ctx, req.Sqls)
+		if err != nil {
+			return "", err
+		}
+	}
+	return "", s.streamerController.SetRule(req, filter, events)
+}
+
+// listBinlogEvents reads binlog from the binlog-pos or the global checkpoint, and returns the DDLs
+// matched by GTID set, time range or DDL pattern, so user can check them before handling them.
+func (s *Syncer) listBinlogEvents(ctx context.Context, req *pb.HandleWorkerErrorRequest) (string, error) {
+	filter, err := s.newEventFilter(req)
+	if err != nil {
+		return "", err
+	}
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultListEventsLimit
+	}
+
+	var location binlog.Location
+	if len(req.BinlogPos) != 0 {
+		pos, err2 := binlog.VerifyBinlogPos(req.BinlogPos)
+		if err2 != nil {
+			return "", err2
+		}
+		location = binlog.NewLocation(*pos, nil)
+	} else {
+		location = s.checkpoint.GlobalPoint()
+		location.ResetSuffix()
+	}
+	if location.Position.Name == "" {
+		return "", terror.ErrVerifyHandleErrorArgs.Generate("binlog position of global checkpoint is unknown, please specify binlog-pos")
+	}
+
+	tctx := s.tctx.WithContext(ctx)
+	// use a random server ID to avoid kicking out the binlog dump connection of syncer.
+	syncCfg := s.syncCfg
+	syncCfg.ServerID, err = utils.GetRandomServerID(ctx, s.fromDB.BaseDB.DB)
+	if err != nil {
+		return "", err
+	}
+	// read by position even if GTID is enabled, same as adjustGlobalPointGTID.
+	streamerController := binlogstream.NewStreamerController(
+		syncCfg,
+		false,
+		s.fromDB,
+		s.cfg.RelayDir,
+		s.timezone,
+		s.relay,
+		s.tctx.L(),
+	)
+	if err = streamerController.Start(tctx, location); err != nil {
+		return "", err
+	}
+	defer streamerController.Close()
+
+	events, err := reader.ListMatchedEvents(ctx, streamerController.GetStreamer(), filter, limit, listEventsIdleTimeout, s.timezone)
+	if err != nil {
+		return "", err
+	}
+	eventsJSON, err := json.Marshal(events)
+	return string(eventsJSON), err
+}
+
 func (s *Syncer) genEvents(ctx context.Context, sqls []string) ([]*replication.BinlogEvent, error) {
 	events := make([]*replication.BinlogEvent, 0)
 
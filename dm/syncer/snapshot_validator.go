@@ -0,0 +1,625 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	gmysql "github.com/go-mysql-org/go-mysql/mysql"
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/util/dbutil"
+	"github.com/pingcap/tidb/util/filter"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+
+	cdcmodel "github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/dm/pb"
+	"github.com/pingcap/tiflow/dm/pkg/binlog"
+	"github.com/pingcap/tiflow/dm/pkg/conn"
+	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
+	"github.com/pingcap/tiflow/dm/pkg/schema"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/pingcap/tiflow/dm/pkg/utils"
+)
+
+const (
+	snapshotPassedMsg       = "snapshot validation passed"
+	snapshotMismatchMsg     = "snapshot validation found %d mismatched rows"
+	snapshotQueryErrMsg     = "snapshot validation failed: %s"
+	snapshotNoConsistentMsg = "cannot lock upstream to get a consistent snapshot, rows changed at the moment of snapshot may be reported as mismatched"
+)
+
+// snapshotBarrier holds binlog replication of syncer once it reaches the location, so that
+// snapshot validator can compare the upstream snapshot and the downstream at the same point.
+type snapshotBarrier struct {
+	location    binlog.Location
+	reached     chan binlog.Location
+	released    chan struct{}
+	releaseOnce sync.Once
+}
+
+func newSnapshotBarrier(location binlog.Location) *snapshotBarrier {
+	return &snapshotBarrier{
+		location: location,
+		reached:  make(chan binlog.Location, 1),
+		released: make(chan struct{}),
+	}
+}
+
+func (b *snapshotBarrier) release() {
+	b.releaseOnce.Do(func() {
+		close(b.released)
+	})
+}
+
+func (s *Syncer) setSnapshotBarrier(b *snapshotBarrier) {
+	s.snapshotBarrier.Lock()
+	defer s.snapshotBarrier.Unlock()
+	s.snapshotBarrier.barrier = b
+	// syncer may be waiting for binlog events of an idle upstream, wake it up to check the barrier.
+	if s.snapshotBarrier.wakeup != nil {
+		s.snapshotBarrier.wakeup()
+	}
+}
+
+// getEventContext returns the context to fetch binlog events, it's canceled when a snapshot barrier is set.
+func (s *Syncer) getEventContext() *tcontext.Context {
+	s.snapshotBarrier.Lock()
+	defer s.snapshotBarrier.Unlock()
+	return s.snapshotBarrier.getEventCtx
+}
+
+// resetGetEventContext creates a new context to fetch binlog events after the previous one is canceled.
+func (s *Syncer) resetGetEventContext() {
+	s.snapshotBarrier.Lock()
+	defer s.snapshotBarrier.Unlock()
+	if s.snapshotBarrier.wakeup != nil {
+		s.snapshotBarrier.wakeup()
+	}
+	ctx, cancel := context.WithCancel(s.runCtx.Ctx)
+	s.snapshotBarrier.getEventCtx = s.runCtx.WithContext(ctx)
+	s.snapshotBarrier.wakeup = cancel
+}
+
+// removeSnapshotBarrier removes the barrier and lets binlog replication continue.
+func (s *Syncer) removeSnapshotBarrier(b *snapshotBarrier) {
+	s.snapshotBarrier.Lock()
+	if s.snapshotBarrier.barrier == b {
+		s.snapshotBarrier.barrier = nil
+	}
+	s.snapshotBarrier.Unlock()
+	b.release()
+}
+
+// waitSnapshotBarrier blocks binlog replication when current location reaches the snapshot barrier,
+// until the barrier is released or syncer is stopped. It should be called between transactions.
+func (s *Syncer) waitSnapshotBarrier(currLoc binlog.Location) error {
+	s.snapshotBarrier.Lock()
+	b := s.snapshotBarrier.barrier
+	s.snapshotBarrier.Unlock()
+	if b == nil || binlog.CompareLocation(currLoc, b.location, s.cfg.EnableGTID) < 0 {
+		return nil
+	}
+
+	// make sure all changes before the barrier are executed in downstream.
+	if err := s.flushJobs(); err != nil {
+		return err
+	}
+	s.tctx.L().Info("binlog replication is held by snapshot validation",
+		zap.Stringer("location", currLoc), zap.Stringer("barrier", b.location))
+	select {
+	case b.reached <- currLoc.Clone():
+	default:
+	}
+	select {
+	case <-b.released:
+		s.tctx.L().Info("binlog replication is released by snapshot validation")
+	case <-s.runCtx.Ctx.Done():
+	}
+	return nil
+}
+
+type snapshotProgress struct {
+	totalTables      atomic.Int64
+	checkedTables    atomic.Int64
+	mismatchedTables atomic.Int64
+	checkedChunks    atomic.Int64
+	mismatchedChunks atomic.Int64
+	mismatchedRows   atomic.Int64
+}
+
+func (p *snapshotProgress) reset() {
+	p.totalTables.Store(0)
+	p.checkedTables.Store(0)
+	p.mismatchedTables.Store(0)
+	p.checkedChunks.Store(0)
+	p.mismatchedChunks.Store(0)
+	p.mismatchedRows.Store(0)
+}
+
+func (p *snapshotProgress) String() string {
+	return fmt.Sprintf("tables checked/mismatched/total: %d/%d/%d, chunks checked/mismatched: %d/%d, mismatched rows: %d",
+		p.checkedTables.Load(), p.mismatchedTables.Load(), p.totalTables.Load(),
+		p.checkedChunks.Load(), p.mismatchedChunks.Load(), p.mismatchedRows.Load())
+}
+
+// upstreamSnapshot is a connection to upstream inside a consistent snapshot transaction,
+// along with the binlog location of the snapshot.
+type upstreamSnapshot struct {
+	conn       *conn.BaseConn
+	location   binlog.Location
+	consistent bool
+}
+
+func (v *DataValidator) createUpstreamSnapshot(tctx *tcontext.Context) (*upstreamSnapshot, error) {
+	baseConn, err := v.fromDB.GetBaseConn(tctx.Context())
+	if err != nil {
+		return nil, err
+	}
+	snapshot := &upstreamSnapshot{conn: baseConn, consistent: true}
+	// same as dumpling with consistency=flush, lock the upstream only when opening the snapshot and
+	// reading the binlog location.
+	if _, err = baseConn.DBConn.ExecContext(tctx.Context(), "FLUSH TABLES WITH READ LOCK"); err != nil {
+		v.L.Warn("failed to lock upstream", zap.Error(err))
+		snapshot.consistent = false
+	}
+	defer func() {
+		if snapshot.consistent {
+			if _, err2 := baseConn.DBConn.ExecContext(tctx.Context(), "UNLOCK TABLES"); err2 != nil {
+				v.L.Warn("failed to unlock upstream", zap.Error(err2))
+			}
+		}
+		if err != nil {
+			_ = v.fromDB.CloseBaseConn(baseConn)
+		}
+	}()
+	if _, err = baseConn.DBConn.ExecContext(tctx.Context(), "START TRANSACTION WITH CONSISTENT SNAPSHOT"); err != nil {
+		return nil, terror.DBErrorAdapt(err, terror.ErrDBDriverError)
+	}
+	var (
+		pos  gmysql.Position
+		gset gmysql.GTIDSet
+	)
+	pos, gset, err = conn.GetPosAndGs(tctx, v.fromDB, v.cfg.Flavor)
+	if err != nil {
+		return nil, err
+	}
+	snapshot.location = binlog.NewLocation(pos, gset)
+	return snapshot, nil
+}
+
+func (v *DataValidator) closeUpstreamSnapshot(snapshot *upstreamSnapshot) {
+	if _, err := snapshot.conn.DBConn.ExecContext(context.Background(), "ROLLBACK"); err != nil {
+		v.L.Warn("failed to rollback snapshot transaction", zap.Error(err))
+	}
+	_ = v.fromDB.CloseBaseConn(snapshot.conn)
+}
+
+// doSnapshotValidate runs in a separate goroutine when validation mode is snapshot.
+// it holds syncer at the binlog location of an upstream snapshot, compares chunk checksums of
+// each routed table between upstream tables and downstream, and compares rows of mismatched chunks.
+func (v *DataValidator) doSnapshotValidate() {
+	defer v.wg.Done()
+
+	if err := v.waitSyncerRunning(); err != nil {
+		// no need to wrapped it in error_list, since err can be context.Canceled only.
+		v.sendError(err)
+		return
+	}
+
+	snapshot, err := v.createUpstreamSnapshot(v.tctx)
+	if err != nil {
+		v.sendError(terror.Annotate(err, "fail to create upstream snapshot"))
+		return
+	}
+	defer v.closeUpstreamSnapshot(snapshot)
+	if !snapshot.consistent {
+		v.L.Warn(snapshotNoConsistentMsg)
+	}
+	v.L.Info("upstream snapshot created", zap.Stringer("location", snapshot.location))
+
+	barrier := newSnapshotBarrier(snapshot.location)
+	v.syncer.setSnapshotBarrier(barrier)
+	defer v.syncer.removeSnapshotBarrier(barrier)
+
+	var location binlog.Location
+	select {
+	case <-v.ctx.Done():
+		v.sendError(v.ctx.Err())
+		return
+	case location = <-barrier.reached:
+	}
+	v.setFlushedLoc(&location)
+	v.L.Info("syncer reached snapshot location", zap.Stringer("location", location))
+
+	tables, err := v.getSnapshotTables()
+	if err != nil {
+		v.sendError(terror.Annotate(err, "fail to get tables for snapshot validation"))
+		return
+	}
+	v.snapshotProgress.totalTables.Store(int64(len(tables)))
+
+	var failedRows []*snapshotFailedRow
+	for _, tbl := range tables {
+		rows, err2 := v.validateSnapshotTable(snapshot, tbl)
+		if err2 != nil {
+			if utils.IsContextCanceledError(err2) {
+				v.sendError(err2)
+				return
+			}
+			v.L.Warn("failed to validate table", zap.Stringer("table", tbl.target), zap.Error(err2))
+			tbl.stopAll(fmt.Sprintf(snapshotQueryErrMsg, err2.Error()))
+			continue
+		}
+		v.snapshotProgress.checkedTables.Inc()
+		if len(rows) > 0 {
+			v.snapshotProgress.mismatchedTables.Inc()
+			v.snapshotProgress.mismatchedRows.Add(int64(len(rows)))
+			tbl.stopAll(fmt.Sprintf(snapshotMismatchMsg, len(rows)))
+			failedRows = append(failedRows, rows...)
+		} else {
+			tbl.stopAll(snapshotPassedMsg)
+		}
+	}
+	// downstream data is no longer needed, let syncer continue as soon as possible.
+	v.syncer.removeSnapshotBarrier(barrier)
+
+	if err = v.persistHelper.persistSnapshotResult(v.tctx, failedRows); err != nil {
+		v.sendError(terror.ErrValidatorPersistData.Delegate(err))
+		return
+	}
+	v.L.Info("snapshot validation finished", zap.Stringer("progress", &v.snapshotProgress))
+
+	// validator stops itself after snapshot validation finished.
+	go v.stopInner()
+}
+
+// snapshotTable is a downstream table and the upstream tables routed to it.
+type snapshotTable struct {
+	target   *filter.Table
+	sources  []*filter.Table
+	statuses []*tableValidateStatus
+	info     *validateTableInfo
+}
+
+func (t *snapshotTable) stopAll(msg string) {
+	for _, status := range t.statuses {
+		status.stopped(msg)
+	}
+}
+
+func (v *DataValidator) getSnapshotTables() ([]*snapshotTable, error) {
+	tctx, cancel := v.tctx.WithTimeout(queryTimeout)
+	defer cancel()
+	allTables, err := utils.FetchAllDoTables(tctx.Context(), v.fromDB.DB, v.syncer.baList)
+	if err != nil {
+		return nil, err
+	}
+
+	tableMap := make(map[string]*snapshotTable)
+	for schemaName, tableNames := range allTables {
+		for _, tableName := range tableNames {
+			sourceTable := &filter.Table{Schema: schemaName, Name: tableName}
+			targetTable := v.syncer.route(sourceTable)
+			status := &tableValidateStatus{source: *sourceTable, target: *targetTable, stage: pb.Stage_Running}
+			v.putTableStatus(sourceTable.String(), status)
+
+			tbl, ok := tableMap[targetTable.String()]
+			if !ok {
+				tbl = &snapshotTable{target: targetTable}
+				tableMap[targetTable.String()] = tbl
+			}
+			tbl.sources = append(tbl.sources, sourceTable)
+			tbl.statuses = append(tbl.statuses, status)
+		}
+	}
+
+	tables := make([]*snapshotTable, 0, len(tableMap))
+	for _, tbl := range tableMap {
+		tables = append(tables, tbl)
+	}
+	sort.Slice(tables, func(i, j int) bool {
+		return tables[i].target.String() < tables[j].target.String()
+	})
+	return tables, nil
+}
+
+type snapshotFailedRow struct {
+	tp          validateFailedType
+	key         string
+	sourceTable *filter.Table
+	targetTable *filter.Table
+	srcData     []*sql.NullString
+	dstData     []*sql.NullString
+}
+
+// validateSnapshotTable returns mismatched rows of the table.
+func (v *DataValidator) validateSnapshotTable(snapshot *upstreamSnapshot, tbl *snapshotTable) ([]*snapshotFailedRow, error) {
+	sourceTable := tbl.sources[0]
+	tableInfo, err := v.syncer.getTrackedTableInfo(sourceTable)
+	if err != nil {
+		if schema.IsTableNotExists(err) {
+			tbl.stopAll(tableNotSyncedOrDropped)
+			return nil, nil
+		}
+		return nil, err
+	}
+	tbl.info, err = v.genValidateTableInfo(sourceTable, len(tableInfo.Columns))
+	if err != nil {
+		return nil, err
+	}
+	if tbl.info.message != "" {
+		tbl.stopAll(tbl.info.message)
+		return nil, nil
+	}
+
+	columns := make([]string, 0, len(tbl.info.srcTableInfo.Columns))
+	for _, col := range tbl.info.srcTableInfo.Columns {
+		columns = append(columns, col.Name.O)
+	}
+	pk := tbl.info.downstreamTableInfo.WhereHandle.UniqueNotNullIdx
+	pkColumns := make([]string, 0, len(pk.Columns))
+	pkOffsets := make([]int, 0, len(pk.Columns))
+	for _, col := range pk.Columns {
+		pkColumns = append(pkColumns, col.Name.O)
+		pkOffsets = append(pkOffsets, col.Offset)
+	}
+
+	var (
+		failedRows []*snapshotFailedRow
+		lower      []string
+	)
+	for {
+		upper, err2 := v.getNextChunkBound(tbl.target, pkColumns, lower)
+		if err2 != nil {
+			return nil, err2
+		}
+		where, args := buildChunkWhere(pkColumns, lower, upper)
+		equal, err2 := v.compareChunkChecksum(snapshot, tbl, columns, where, args)
+		if err2 != nil {
+			return nil, err2
+		}
+		v.snapshotProgress.checkedChunks.Inc()
+		if !equal {
+			v.snapshotProgress.mismatchedChunks.Inc()
+			rows, err3 := v.compareChunkRows(snapshot, tbl, columns, pkOffsets, where, args)
+			if err3 != nil {
+				return nil, err3
+			}
+			failedRows = append(failedRows, rows...)
+		}
+		if upper == nil {
+			break
+		}
+		lower = upper
+	}
+	return failedRows, nil
+}
+
+// getNextChunkBound returns the inclusive upper bound of the chunk starting after lower on downstream,
+// it returns nil when it's the last chunk.
+func (v *DataValidator) getNextChunkBound(table *filter.Table, pkColumns []string, lower []string) ([]string, error) {
+	tctx, cancel := v.tctx.WithTimeout(queryTimeout)
+	defer cancel()
+	where, args := buildChunkWhere(pkColumns, lower, nil)
+	orderBy := quoteColumns(pkColumns)
+	query := fmt.Sprintf("SELECT /*!40001 SQL_NO_CACHE */ %s FROM %s WHERE %s ORDER BY %s LIMIT 1 OFFSET %d",
+		orderBy, dbutil.TableName(table.Schema, table.Name), where, orderBy, v.cfg.ValidatorCfg.SnapshotChunkSize-1)
+	rows, err := v.toDB.QueryContext(tctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	if !rows.Next() {
+		return nil, rows.Err()
+	}
+	data, err := scanRow(rows)
+	if err != nil {
+		return nil, err
+	}
+	bound := make([]string, 0, len(data))
+	for _, d := range data {
+		bound = append(bound, d.String)
+	}
+	return bound, rows.Err()
+}
+
+// compareChunkChecksum compares the checksum of the chunk in downstream with the combined checksum of
+// all upstream tables, since the checksum is an XOR of row checksums, it's independent of row order and
+// can be combined across sharding tables.
+func (v *DataValidator) compareChunkChecksum(
+	snapshot *upstreamSnapshot, tbl *snapshotTable, columns []string, where string, args []interface{},
+) (bool, error) {
+	tctx, cancel := v.tctx.WithTimeout(queryTimeout)
+	defer cancel()
+
+	var srcCount, srcChecksum uint64
+	for _, sourceTable := range tbl.sources {
+		query := buildChecksumSQL(sourceTable, columns, where)
+		count, checksum, err := queryChecksum(snapshot.conn.DBConn.QueryRowContext(tctx.Context(), query, args...))
+		if err != nil {
+			return false, err
+		}
+		srcCount += count
+		srcChecksum ^= checksum
+	}
+	query := buildChecksumSQL(tbl.target, columns, where)
+	dstCount, dstChecksum, err := queryChecksum(v.toDB.DB.QueryRowContext(tctx.Context(), query, args...))
+	if err != nil {
+		return false, err
+	}
+	return srcCount == dstCount && srcChecksum == dstChecksum, nil
+}
+
+func queryChecksum(row *sql.Row) (uint64, uint64, error) {
+	var (
+		count    uint64
+		checksum sql.NullInt64
+	)
+	if err := row.Scan(&count, &checksum); err != nil {
+		return 0, 0, errors.Trace(err)
+	}
+	return count, uint64(checksum.Int64), nil
+}
+
+func (v *DataValidator) compareChunkRows(
+	snapshot *upstreamSnapshot, tbl *snapshotTable, columns []string, pkOffsets []int, where string, args []interface{},
+) ([]*snapshotFailedRow, error) {
+	tctx, cancel := v.tctx.WithTimeout(queryTimeout)
+	defer cancel()
+
+	type sourceRow struct {
+		table *filter.Table
+		data  []*sql.NullString
+	}
+	sourceRows := make(map[string]*sourceRow)
+	for _, sourceTable := range tbl.sources {
+		query := buildChunkRowsSQL(sourceTable, columns, where)
+		rows, err := snapshot.conn.DBConn.QueryContext(tctx.Context(), query, args...)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		err = scanChunkRows(rows, pkOffsets, func(key string, data []*sql.NullString) {
+			sourceRows[key] = &sourceRow{table: sourceTable, data: data}
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	targetRows := make(map[string][]*sql.NullString)
+	rows, err := v.toDB.QueryContext(tctx, buildChunkRowsSQL(tbl.target, columns, where), args...)
+	if err != nil {
+		return nil, err
+	}
+	err = scanChunkRows(rows, pkOffsets, func(key string, data []*sql.NullString) {
+		targetRows[key] = data
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	compareContext := &validateCompareContext{
+		logger:      v.L,
+		sourceTable: &cdcmodel.TableName{Schema: tbl.sources[0].Schema, Table: tbl.sources[0].Name},
+		targetTable: &cdcmodel.TableName{Schema: tbl.target.Schema, Table: tbl.target.Name},
+		columns:     tbl.info.srcTableInfo.Columns,
+	}
+	failedRows := make([]*snapshotFailedRow, 0)
+	for key, src := range sourceRows {
+		dst, ok := targetRows[key]
+		if !ok {
+			failedRows = append(failedRows, &snapshotFailedRow{
+				tp: rowNotExist, key: key, sourceTable: src.table, targetTable: tbl.target, srcData: src.data,
+			})
+			continue
+		}
+		eq, err2 := compareContext.compareData(key, src.data, dst)
+		if err2 != nil {
+			return nil, err2
+		}
+		if !eq {
+			failedRows = append(failedRows, &snapshotFailedRow{
+				tp: rowDifferent, key: key, sourceTable: src.table, targetTable: tbl.target, srcData: src.data, dstData: dst,
+			})
+		}
+	}
+	for key, dst := range targetRows {
+		if _, ok := sourceRows[key]; !ok {
+			// the row doesn't exist in any upstream table, we record it under the first upstream table.
+			failedRows = append(failedRows, &snapshotFailedRow{
+				tp: deletedRowExists, key: key, sourceTable: tbl.sources[0], targetTable: tbl.target, dstData: dst,
+			})
+		}
+	}
+	return failedRows, nil
+}
+
+func scanChunkRows(rows *sql.Rows, pkOffsets []int, fn func(key string, data []*sql.NullString)) error {
+	defer rows.Close()
+	for rows.Next() {
+		data, err := scanRow(rows)
+		if err != nil {
+			return err
+		}
+		pkValues := make([]string, 0, len(pkOffsets))
+		for _, offset := range pkOffsets {
+			pkValues = append(pkValues, data[offset].String)
+		}
+		fn(genRowKeyByString(pkValues), data)
+	}
+	return errors.Trace(rows.Err())
+}
+
+func quoteColumns(columns []string) string {
+	quoted := make([]string, 0, len(columns))
+	for _, col := range columns {
+		quoted = append(quoted, dbutil.ColumnName(col))
+	}
+	return strings.Join(quoted, ", ")
+}
+
+// buildChunkWhere builds the condition of the chunk (lower, upper] on primary key columns,
+// a nil bound means the chunk is unbounded on that side.
+func buildChunkWhere(pkColumns []string, lower, upper []string) (string, []interface{}) {
+	var (
+		conds []string
+		args  []interface{}
+	)
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(pkColumns)), ", ")
+	columns := quoteColumns(pkColumns)
+	if len(pkColumns) > 1 {
+		columns = "(" + columns + ")"
+		placeholders = "(" + placeholders + ")"
+	}
+	if lower != nil {
+		conds = append(conds, fmt.Sprintf("%s > %s", columns, placeholders))
+		for _, val := range lower {
+			args = append(args, val)
+		}
+	}
+	if upper != nil {
+		conds = append(conds, fmt.Sprintf("%s <= %s", columns, placeholders))
+		for _, val := range upper {
+			args = append(args, val)
+		}
+	}
+	if len(conds) == 0 {
+		return "TRUE", nil
+	}
+	return strings.Join(conds, " AND "), args
+}
+
+// buildChecksumSQL builds the same checksum query as sync-diff-inspector does.
+func buildChecksumSQL(table *filter.Table, columns []string, where string) string {
+	isNulls := make([]string, 0, len(columns))
+	for _, col := range columns {
+		isNulls = append(isNulls, fmt.Sprintf("ISNULL(%s)", dbutil.ColumnName(col)))
+	}
+	return fmt.Sprintf("SELECT COUNT(*) AS cnt, BIT_XOR(CAST(CRC32(CONCAT_WS(',', %s, CONCAT(%s))) AS UNSIGNED)) AS checksum FROM %s WHERE %s",
+		quoteColumns(columns), strings.Join(isNulls, ", "), dbutil.TableName(table.Schema, table.Name), where)
+}
+
+func buildChunkRowsSQL(table *filter.Table, columns []string, where string) string {
+	return fmt.Sprintf("SELECT /*!40001 SQL_NO_CACHE */ %s FROM %s WHERE %s",
+		quoteColumns(columns), dbutil.TableName(table.Schema, table.Name), where)
+}
+
+func (v *DataValidator) getSnapshotStatus() string {
+	return v.snapshotProgress.String()
+}
@@ -0,0 +1,152 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-mysql-org/go-mysql/mysql"
+	"github.com/go-mysql-org/go-mysql/replication"
+	"github.com/pingcap/tidb/util/filter"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pingcap/tiflow/dm/config"
+	"github.com/pingcap/tiflow/dm/pkg/binlog"
+	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
+)
+
+func TestSnapshotValidatorBuildChunkWhere(t *testing.T) {
+	where, args := buildChunkWhere([]string{"id"}, nil, nil)
+	require.Equal(t, "TRUE", where)
+	require.Nil(t, args)
+
+	where, args = buildChunkWhere([]string{"id"}, nil, []string{"10"})
+	require.Equal(t, "`id` <= ?", where)
+	require.Equal(t, []interface{}{"10"}, args)
+
+	where, args = buildChunkWhere([]string{"id"}, []string{"10"}, []string{"20"})
+	require.Equal(t, "`id` > ? AND `id` <= ?", where)
+	require.Equal(t, []interface{}{"10", "20"}, args)
+
+	where, args = buildChunkWhere([]string{"a", "b"}, []string{"1", "x"}, nil)
+	require.Equal(t, "(`a`, `b`) > (?, ?)", where)
+	require.Equal(t, []interface{}{"1", "x"}, args)
+}
+
+func TestSnapshotValidatorBuildSQL(t *testing.T) {
+	table := &filter.Table{Schema: "db", Name: "tbl"}
+	columns := []string{"id", "name"}
+	require.Equal(t, "SELECT COUNT(*) AS cnt, BIT_XOR(CAST(CRC32(CONCAT_WS(',', `id`, `name`, CONCAT(ISNULL(`id`), ISNULL(`name`)))) AS UNSIGNED)) AS checksum FROM `db`.`tbl` WHERE `id` > ?",
+		buildChecksumSQL(table, columns, "`id` > ?"))
+	require.Equal(t, "SELECT /*!40001 SQL_NO_CACHE */ `id`, `name` FROM `db`.`tbl` WHERE TRUE",
+		buildChunkRowsSQL(table, columns, "TRUE"))
+}
+
+func TestSnapshotValidatorProgress(t *testing.T) {
+	var p snapshotProgress
+	p.totalTables.Store(3)
+	p.checkedTables.Store(2)
+	p.mismatchedTables.Inc()
+	p.checkedChunks.Store(10)
+	p.mismatchedChunks.Inc()
+	p.mismatchedRows.Store(5)
+	require.Equal(t, "tables checked/mismatched/total: 2/1/3, chunks checked/mismatched: 10/1, mismatched rows: 5", p.String())
+	p.reset()
+	require.Equal(t, "tables checked/mismatched/total: 0/0/0, chunks checked/mismatched: 0/0, mismatched rows: 0", p.String())
+}
+
+func TestSnapshotBarrier(t *testing.T) {
+	syncerObj := &Syncer{cfg: &config.SubTaskConfig{}}
+	// no barrier
+	require.NoError(t, syncerObj.waitSnapshotBarrier(binlog.MustZeroLocation(mysql.MySQLFlavor)))
+
+	barrier := newSnapshotBarrier(binlog.Location{Position: mysql.Position{Name: "mysql-bin.000002", Pos: 100}})
+	syncerObj.setSnapshotBarrier(barrier)
+	// location is before the barrier, won't be held
+	require.NoError(t, syncerObj.waitSnapshotBarrier(binlog.Location{Position: mysql.Position{Name: "mysql-bin.000001", Pos: 200}}))
+	require.Len(t, barrier.reached, 0)
+
+	syncerObj.removeSnapshotBarrier(barrier)
+	require.Nil(t, syncerObj.snapshotBarrier.barrier)
+	select {
+	case <-barrier.released:
+	default:
+		require.FailNow(t, "barrier should be released")
+	}
+	// release twice is ok
+	barrier.release()
+}
+
+// idleStreamer has no binlog event, it blocks until the context is done.
+type idleStreamer struct {
+	waiting chan struct{}
+}
+
+func (m *idleStreamer) GetEvent(ctx context.Context) (*replication.BinlogEvent, error) {
+	m.waiting <- struct{}{}
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func TestSnapshotBarrierIdleUpstream(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	syncerObj := &Syncer{
+		cfg:    &config.SubTaskConfig{},
+		tctx:   tcontext.Background(),
+		runCtx: tcontext.Background().WithContext(ctx),
+	}
+	syncerObj.handleJobFunc = func(*job) (bool, error) { return true, nil }
+	syncerObj.resetGetEventContext()
+
+	// all binlog events are consumed and no new event comes.
+	location := binlog.Location{Position: mysql.Position{Name: "mysql-bin.000001", Pos: 200}}
+	streamer := &idleStreamer{waiting: make(chan struct{}, 1)}
+	errCh := make(chan error, 1)
+	go func() {
+		// same as the binlog event loop of syncer.
+		for {
+			if err := syncerObj.waitSnapshotBarrier(location); err != nil {
+				errCh <- err
+				return
+			}
+			getEventCtx := syncerObj.getEventContext()
+			_, err := streamer.GetEvent(getEventCtx.Ctx)
+			if err == context.Canceled && getEventCtx.Ctx.Err() != nil && ctx.Err() == nil {
+				syncerObj.resetGetEventContext()
+				continue
+			}
+			errCh <- nil
+			return
+		}
+	}()
+
+	// set the barrier when syncer is waiting for binlog events.
+	<-streamer.waiting
+	barrier := newSnapshotBarrier(location)
+	syncerObj.setSnapshotBarrier(barrier)
+	select {
+	case reached := <-barrier.reached:
+		require.Equal(t, location.Position, reached.Position)
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "barrier should be reached when upstream is idle")
+	}
+
+	syncerObj.removeSnapshotBarrier(barrier)
+	<-streamer.waiting
+	cancel()
+	require.NoError(t, <-errCh)
+}
@@ -239,6 +239,16 @@ type Syncer struct {
 	idAndCollationMap          map[int]string
 
 	ddlWorker *DDLWorker
+
+	// snapshotBarrier is set by snapshot validator to hold binlog replication at a consistent location.
+	snapshotBarrier struct {
+		sync.Mutex
+		barrier *snapshotBarrier
+		// getEventCtx is used to fetch binlog events, it's canceled to wake up syncer when a barrier is set,
+		// so the barrier is checked even if upstream is idle.
+		getEventCtx *tcontext.Context
+		wakeup      context.CancelFunc
+	}
 }
 
 // NewSyncer creates a new Syncer.
@@ -1612,6 +1622,7 @@ func (s *Syncer) updateTSOffset(ctx context.Context) error {
 func (s *Syncer) Run(ctx context.Context) (err error) {
 	runCtx, runCancel := context.WithCancel(context.Background())
 	s.runCtx, s.runCancel = tcontext.NewContext(runCtx, s.tctx.L()), runCancel
+	s.resetGetEventContext()
 	syncCtx, syncCancel := context.WithCancel(context.Background())
 	s.syncCtx, s.syncCancel = tcontext.NewContext(syncCtx, s.tctx.L()), syncCancel
 	defer func() {
@@ -1930,6 +1941,13 @@ func (s *Syncer) Run(ctx context.Context) (err error) {
 		s.currentLocationMu.currentLocation = endLocation
 		s.currentLocationMu.Unlock()
 
+		// only hold replication between transactions, and never inside a sharding re-sync
+		if eventIndex == 0 && shardingReSync == nil {
+			if err = s.waitSnapshotBarrier(lastTxnEndLocation); err != nil {
+				return err
+			}
+		}
+
 		failpoint.Inject("FakeRedirect", func(val failpoint.Value) {
 			if len(shardingReSyncCh) == 0 && shardingReSync == nil {
 				if strVal, ok := val.(string); ok {
@@ -2020,7 +2038,8 @@ func (s *Syncer) Run(ctx context.Context) (err error) {
 		}
 
 		startTime := time.Now()
-		e, op, err := s.streamerController.GetEvent(s.runCtx)
+		getEventCtx := s.getEventContext()
+		e, op, err := s.streamerController.GetEvent(getEventCtx)
 
 		failpoint.Inject("SafeModeExit", func(val failpoint.Value) {
 			if intVal, ok := val.(int); ok && intVal == 1 {
@@ -2045,6 +2064,10 @@ func (s *Syncer) Run(ctx context.Context) (err error) {
 			}
 		})
 		switch {
+		case err == context.Canceled && getEventCtx.Ctx.Err() != nil && s.runCtx.Ctx.Err() == nil:
+			// woken up by a snapshot barrier, check it at the beginning of the loop.
+			s.resetGetEventContext()
+			continue
 		case err == context.Canceled:
 			s.tctx.L().Info("binlog replication main routine quit(context canceled)!", zap.Stringer("last location", lastTxnEndLocation))
 			return nil
@@ -2131,7 +2154,16 @@ func (s *Syncer) Run(ctx context.Context) (err error) {
 			// try to handle pessimistic sharding?
 			queryEvent, ok := e.Event.(*replication.QueryEvent)
 			if !ok {
-				s.tctx.L().Warn("can't skip an event which is not DDL", zap.Reflect("header", e.Header))
+				switch e.Event.(type) {
+				case *replication.RowsEvent:
+					// DML of a skipped GTID set, the transaction is still ended by its XID event
+					// to save the checkpoint.
+					eventIndex++
+					continue
+				case *replication.XIDEvent:
+				default:
+					s.tctx.L().Warn("can't skip an event which is not DDL", zap.Reflect("header", e.Header))
+				}
 				break
 			}
 			ec := eventContext{
@@ -38,6 +38,7 @@ import (
 	"github.com/pingcap/tiflow/dm/pkg/gtid"
 	"github.com/pingcap/tiflow/dm/pkg/log"
 	"github.com/pingcap/tiflow/dm/pkg/retry"
+	"github.com/pingcap/tiflow/dm/pkg/utils"
 )
 
 const (
@@ -64,6 +65,7 @@ type validatorPersistHelper struct {
 	pendingChangeTableName string
 	errorChangeTableName   string
 	tableStatusTableName   string
+	repairLogTableName     string
 
 	db                *conn.BaseDB
 	schemaInitialized atomic.Bool
@@ -91,6 +93,7 @@ func newValidatorCheckpointHelper(validator *DataValidator) *validatorPersistHel
 		pendingChangeTableName: dbutil.TableName(cfg.MetaSchema, cputil.ValidatorPendingChange(cfg.Name)),
 		errorChangeTableName:   dbutil.TableName(cfg.MetaSchema, cputil.ValidatorErrorChange(cfg.Name)),
 		tableStatusTableName:   dbutil.TableName(cfg.MetaSchema, cputil.ValidatorTableStatus(cfg.Name)),
+		repairLogTableName:     dbutil.TableName(cfg.MetaSchema, cputil.ValidatorRepairLog(cfg.Name)),
 	}
 
 	return c
@@ -188,6 +191,22 @@ func (c *validatorPersistHelper) createTable(tctx *tcontext.Context) error {
 			UNIQUE KEY uk_source_schema_table_key(source, src_schema_name, src_table_name),
 			INDEX idx_stage(stage)
 		)`,
+		`CREATE TABLE IF NOT EXISTS ` + c.repairLogTableName + ` (
+			id BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+			source VARCHAR(32) NOT NULL,
+			error_id BIGINT NOT NULL,
+			src_schema_name VARCHAR(128) NOT NULL,
+			src_table_name VARCHAR(128) NOT NULL,
+			row_pk VARCHAR(` + maxRowKeyLengthStr + `) NOT NULL,
+			dst_schema_name VARCHAR(128) NOT NULL,
+			dst_table_name VARCHAR(128) NOT NULL,
+			action VARCHAR(16) NOT NULL,
+			data JSON NOT NULL,
+			validated TINYINT(1) NOT NULL,
+			message VARCHAR(512) NOT NULL,
+			create_time timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			INDEX idx_source_error(source, error_id)
+		)`,
 	}
 	tctx.L().Info("create checkpoint and data table", zap.Strings("statements", sqls))
 	for _, q := range sqls {
@@ -250,18 +269,7 @@ func (c *validatorPersistHelper) persistTableStatusAndErrors(tctx *tcontext.Cont
 
 	// upsert table status
 	for _, state := range tableStatus {
-		query := `INSERT INTO ` + c.tableStatusTableName + `
-					(source, src_schema_name, src_table_name, dst_schema_name, dst_table_name, stage, message)
-					VALUES (?, ?, ?, ?, ?, ?, ?) ON DUPLICATE KEY UPDATE
-					source = VALUES(source),
-					src_schema_name = VALUES(src_schema_name),
-					src_table_name = VALUES(src_table_name),
-					dst_schema_name = VALUES(dst_schema_name),
-					dst_table_name = VALUES(dst_table_name),
-					stage = VALUES(stage),
-					message = VALUES(message)
-				`
-		queries = append(queries, query)
+		queries = append(queries, c.tableStatusUpsertQuery())
 		args = append(args, []interface{}{
 			c.cfg.SourceID, state.source.Schema, state.source.Name, state.target.Schema, state.target.Name,
 			int(state.stage), state.message,
@@ -271,34 +279,14 @@ func (c *validatorPersistHelper) persistTableStatusAndErrors(tctx *tcontext.Cont
 	// upsert error rows
 	for _, worker := range c.validator.getWorkers() {
 		for _, r := range worker.getErrorRows() {
-			query := `INSERT INTO ` + c.errorChangeTableName + `
-					(source, src_schema_name, src_table_name, row_pk, dst_schema_name, dst_table_name, data, dst_data, error_type, status)
-					VALUES(?, ?, ?, ?, ?, ?, ?, ?, ?, ?) ON DUPLICATE KEY UPDATE
-					source = VALUES(source),
-					src_schema_name = VALUES(src_schema_name),
-					src_table_name = VALUES(src_table_name),
-					row_pk = VALUES(row_pk),
-					dst_schema_name = VALUES(dst_schema_name),
-					dst_table_name = VALUES(dst_table_name),
-					data = VALUES(data),
-					dst_data = VALUES(dst_data),
-					error_type = VALUES(error_type),
-					status = VALUES(status)
-			`
-			queries = append(queries, query)
+			queries = append(queries, c.errorRowUpsertQuery())
 
 			row := r.srcJob.row
 			srcDataBytes, err := json.Marshal(row.RowValues())
 			if err != nil {
 				return err
 			}
-			dstData := make([]interface{}, len(r.dstData))
-			for i, d := range r.dstData {
-				if d.Valid {
-					dstData[i] = d.String
-				}
-			}
-			dstDataBytes, err := json.Marshal(dstData)
+			dstData, err := nullStringsToJSON(r.dstData)
 			if err != nil {
 				return err
 			}
@@ -307,7 +295,7 @@ func (c *validatorPersistHelper) persistTableStatusAndErrors(tctx *tcontext.Cont
 			args = append(args, []interface{}{
 				c.cfg.SourceID, sourceTable.Schema, sourceTable.Table, r.srcJob.Key,
 				targetTable.Schema, targetTable.Table,
-				string(srcDataBytes), string(dstDataBytes), r.tp, pb.ValidateErrorState_NewErr,
+				string(srcDataBytes), dstData, r.tp, pb.ValidateErrorState_NewErr,
 			})
 		}
 	}
@@ -315,6 +303,79 @@ func (c *validatorPersistHelper) persistTableStatusAndErrors(tctx *tcontext.Cont
 	return c.execQueriesWithRetry(tctx, queries, args)
 }
 
+func (c *validatorPersistHelper) tableStatusUpsertQuery() string {
+	return `INSERT INTO ` + c.tableStatusTableName + `
+				(source, src_schema_name, src_table_name, dst_schema_name, dst_table_name, stage, message)
+				VALUES (?, ?, ?, ?, ?, ?, ?) ON DUPLICATE KEY UPDATE
+				source = VALUES(source),
+				src_schema_name = VALUES(src_schema_name),
+				src_table_name = VALUES(src_table_name),
+				dst_schema_name = VALUES(dst_schema_name),
+				dst_table_name = VALUES(dst_table_name),
+				stage = VALUES(stage),
+				message = VALUES(message)
+			`
+}
+
+func (c *validatorPersistHelper) errorRowUpsertQuery() string {
+	return `INSERT INTO ` + c.errorChangeTableName + `
+				(source, src_schema_name, src_table_name, row_pk, dst_schema_name, dst_table_name, data, dst_data, error_type, status)
+				VALUES(?, ?, ?, ?, ?, ?, ?, ?, ?, ?) ON DUPLICATE KEY UPDATE
+				source = VALUES(source),
+				src_schema_name = VALUES(src_schema_name),
+				src_table_name = VALUES(src_table_name),
+				row_pk = VALUES(row_pk),
+				dst_schema_name = VALUES(dst_schema_name),
+				dst_table_name = VALUES(dst_table_name),
+				data = VALUES(data),
+				dst_data = VALUES(dst_data),
+				error_type = VALUES(error_type),
+				status = VALUES(status)
+			`
+}
+
+func nullStringsToJSON(data []*sql.NullString) (string, error) {
+	bytes, err := json.Marshal(nullStringsToValues(data))
+	if err != nil {
+		return "", err
+	}
+	return string(bytes), nil
+}
+
+// persistSnapshotResult persists table status and mismatched rows found by snapshot validation.
+func (c *validatorPersistHelper) persistSnapshotResult(tctx *tcontext.Context, failedRows []*snapshotFailedRow) error {
+	tableStatus := c.validator.getTableStatusMap()
+	count := len(tableStatus) + len(failedRows)
+	queries := make([]string, 0, count)
+	args := make([][]interface{}, 0, count)
+
+	for _, state := range tableStatus {
+		queries = append(queries, c.tableStatusUpsertQuery())
+		args = append(args, []interface{}{
+			c.cfg.SourceID, state.source.Schema, state.source.Name, state.target.Schema, state.target.Name,
+			int(state.stage), state.message,
+		})
+	}
+	for _, r := range failedRows {
+		srcData, err := nullStringsToJSON(r.srcData)
+		if err != nil {
+			return err
+		}
+		dstData, err := nullStringsToJSON(r.dstData)
+		if err != nil {
+			return err
+		}
+		queries = append(queries, c.errorRowUpsertQuery())
+		args = append(args, []interface{}{
+			c.cfg.SourceID, r.sourceTable.Schema, r.sourceTable.Name, r.key,
+			r.targetTable.Schema, r.targetTable.Name,
+			srcData, dstData, r.tp, pb.ValidateErrorState_NewErr,
+		})
+	}
+
+	return c.execQueriesWithRetry(tctx, queries, args)
+}
+
 func (c *validatorPersistHelper) persistPendingRows(tctx *tcontext.Context, rev int64) error {
 	count := int(c.validator.getAllPendingRowCount()) + 1
 	queries := make([]string, 0, count)
@@ -722,3 +783,68 @@ func (c *validatorPersistHelper) deleteError(tctx *tcontext.Context, db *conn.Ba
 	_, err := db.ExecContext(tctx, query, args...)
 	return err
 }
+
+// loadErrorForRepair loads error rows to repair, if isAll is true, all unprocessed error rows of current source are loaded.
+func (c *validatorPersistHelper) loadErrorForRepair(tctx *tcontext.Context, db *conn.BaseDB, errID uint64, isAll bool) ([]*validatorRepairRow, error) {
+	query := "SELECT id, src_schema_name, src_table_name, dst_schema_name, dst_table_name, row_pk FROM " +
+		c.errorChangeTableName + " WHERE source = ?"
+	args := []interface{}{c.cfg.SourceID}
+	if isAll {
+		query += " AND status = ?"
+		args = append(args, int(pb.ValidateErrorState_NewErr))
+	} else {
+		query += " AND id = ?"
+		args = append(args, errID)
+	}
+	rows, err := db.QueryContext(tctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := make([]*validatorRepairRow, 0)
+	for rows.Next() {
+		var (
+			id                                                               uint64
+			srcSchemaName, srcTableName, dstSchemaName, dstTableName, rowKey string
+		)
+		if err = rows.Scan(&id, &srcSchemaName, &srcTableName, &dstSchemaName, &dstTableName, &rowKey); err != nil {
+			return nil, err
+		}
+		res = append(res, &validatorRepairRow{
+			id:          id,
+			sourceTable: &filter.Table{Schema: srcSchemaName, Name: srcTableName},
+			targetTable: &filter.Table{Schema: dstSchemaName, Name: dstTableName},
+			rowKey:      rowKey,
+		})
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	c.L.Info("load validator errors for repair", zap.Int("count", len(res)))
+	return res, nil
+}
+
+// persistRepairResult records the repair action, and marks the error row as resolved if the row is validated after repair.
+func (c *validatorPersistHelper) persistRepairResult(tctx *tcontext.Context, db *conn.BaseDB, r *validatorRepairRow) error {
+	data, err := nullStringsToJSON(r.data)
+	if err != nil {
+		return err
+	}
+	queries := []string{
+		`INSERT INTO ` + c.repairLogTableName + `
+			(source, error_id, src_schema_name, src_table_name, row_pk, dst_schema_name, dst_table_name, action, data, validated, message)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+	}
+	args := [][]interface{}{
+		{
+			c.cfg.SourceID, r.id, r.sourceTable.Schema, r.sourceTable.Name, r.rowKey,
+			r.targetTable.Schema, r.targetTable.Name, r.action, data, r.validated, utils.TruncateString(r.message, 500),
+		},
+	}
+	if r.validated {
+		queries = append(queries, "UPDATE "+c.errorChangeTableName+" SET status=? WHERE source=? AND id=?")
+		args = append(args, []interface{}{int(pb.ValidateErrorState_ResolvedErr), c.cfg.SourceID, r.id})
+	}
+	return db.DoTxWithRetry(tctx, queries, args, c.retryer)
+}
@@ -0,0 +1,262 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/util/filter"
+	"go.uber.org/zap"
+
+	cdcmodel "github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/dm/config"
+	"github.com/pingcap/tiflow/dm/pkg/conn"
+	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
+	"github.com/pingcap/tiflow/dm/pkg/schema"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/pingcap/tiflow/dm/pkg/utils"
+	"github.com/pingcap/tiflow/dm/syncer/dbconn"
+	"github.com/pingcap/tiflow/pkg/sqlmodel"
+)
+
+const (
+	// repairing is executed row by row, so it may take a long time when repairing all errors.
+	// dm-master waits for the same time besides its RPC timeout, keep them consistent.
+	validatorRepairTimeout = 10 * time.Minute
+
+	repairActionReplace = "replace"
+	repairActionDelete  = "delete"
+	repairActionNone    = "none"
+
+	repairNotConsistentMsg = "row is still inconsistent after repair"
+)
+
+// validatorRepairRow is a validation error row to repair, along with the result of repairing.
+type validatorRepairRow struct {
+	id          uint64
+	sourceTable *filter.Table
+	targetTable *filter.Table
+	rowKey      string
+
+	action string
+	// row written to downstream for replace, or row removed from downstream for delete
+	data      []*sql.NullString
+	validated bool
+	message   string
+}
+
+// RepairValidatorError re-reads upstream rows of validation errors and writes them to downstream in safe mode.
+func (v *DataValidator) RepairValidatorError(errID uint64, isAll bool) error {
+	ctx, cancel := context.WithTimeout(context.Background(), validatorRepairTimeout)
+	defer cancel()
+	tctx := tcontext.NewContext(ctx, v.L)
+
+	dbCfg := v.cfg.From
+	dbCfg.RawDBCfg = config.DefaultRawDBConfig().SetMaxIdleConns(1)
+	fromDB, err := dbconn.CreateBaseDB(&dbCfg)
+	if err != nil {
+		return err
+	}
+	defer dbconn.CloseBaseDB(tctx, fromDB)
+	dbCfg = v.cfg.To
+	dbCfg.RawDBCfg = config.DefaultRawDBConfig().SetMaxIdleConns(1)
+	toDB, err := dbconn.CreateBaseDB(&dbCfg)
+	if err != nil {
+		return err
+	}
+	defer dbconn.CloseBaseDB(tctx, toDB)
+	return v.repairErrors(tctx, fromDB, toDB, errID, isAll)
+}
+
+// repairErrors writes the upstream row into downstream using REPLACE, or DELETE the downstream row if it
+// doesn't exist in upstream. the row is validated again after repairing, and the error is marked as
+// resolved if it's consistent. the action of each row is recorded into the repair log table.
+func (v *DataValidator) repairErrors(tctx *tcontext.Context, fromDB, toDB *conn.BaseDB, errID uint64, isAll bool) error {
+	rows, err := v.persistHelper.loadErrorForRepair(tctx, toDB, errID, isAll)
+	if err != nil {
+		return err
+	}
+	var (
+		failedCount int
+		lastMsg     string
+	)
+	for _, r := range rows {
+		if err = v.repairRow(tctx, fromDB, toDB, r); err != nil {
+			if utils.IsContextCanceledError(err) {
+				return err
+			}
+			r.message = err.Error()
+		}
+		if err = v.persistHelper.persistRepairResult(tctx, toDB, r); err != nil {
+			return err
+		}
+		v.L.Info("validation error row repaired", zap.Uint64("id", r.id), zap.Stringer("table", r.targetTable),
+			zap.String("action", r.action), zap.Bool("validated", r.validated), zap.String("message", r.message))
+		if !r.validated {
+			failedCount++
+			lastMsg = fmt.Sprintf("error %d: %s", r.id, r.message)
+		}
+	}
+	if failedCount > 0 {
+		return terror.ErrValidatorRepairFailed.Generate(failedCount, len(rows), lastMsg)
+	}
+	return nil
+}
+
+func (v *DataValidator) repairRow(tctx *tcontext.Context, fromDB, toDB *conn.BaseDB, r *validatorRepairRow) error {
+	r.action = repairActionNone
+	tableInfo, downstreamTableInfo, err := v.getRepairTableInfo(tctx, r.sourceTable, r.targetTable)
+	if err != nil {
+		return err
+	}
+	pk := downstreamTableInfo.WhereHandle.UniqueNotNullIdx
+	pkValues := strings.Split(r.rowKey, "\t")
+	if len(pkValues) != len(pk.Columns) {
+		// the key is hashed since it's too long, or some values of primary key contain tab.
+		return errors.Errorf("cannot extract primary key values from row key %s", r.rowKey)
+	}
+	cond := &Cond{
+		TargetTbl: r.sourceTable.String(),
+		Columns:   tableInfo.Columns,
+		PK:        pk,
+		PkValues:  [][]string{pkValues},
+	}
+	srcRow, err := queryRowByCond(tctx, fromDB, cond)
+	if err != nil {
+		return err
+	}
+	cond.TargetTbl = r.targetTable.String()
+	dstRow, err := queryRowByCond(tctx, toDB, cond)
+	if err != nil {
+		return err
+	}
+
+	sourceTable := &cdcmodel.TableName{Schema: r.sourceTable.Schema, Table: r.sourceTable.Name}
+	targetTable := &cdcmodel.TableName{Schema: r.targetTable.Schema, Table: r.targetTable.Name}
+	var rowChange *sqlmodel.RowChange
+	switch {
+	case srcRow != nil:
+		rowChange = sqlmodel.NewRowChange(sourceTable, targetTable, nil, nullStringsToValues(srcRow),
+			tableInfo, downstreamTableInfo.TableInfo, nil)
+		r.action, r.data = repairActionReplace, srcRow
+	case dstRow != nil:
+		rowChange = sqlmodel.NewRowChange(sourceTable, targetTable, nullStringsToValues(dstRow), nil,
+			tableInfo, downstreamTableInfo.TableInfo, nil)
+		r.action, r.data = repairActionDelete, dstRow
+	}
+	if rowChange != nil {
+		rowChange.SetWhereHandle(downstreamTableInfo.WhereHandle)
+		var (
+			query string
+			args  []interface{}
+		)
+		if r.action == repairActionReplace {
+			query, args = rowChange.GenSQL(sqlmodel.DMLReplace)
+		} else {
+			query, args = rowChange.GenSQL(sqlmodel.DMLDelete)
+		}
+		if _, err = toDB.ExecContext(tctx, query, args...); err != nil {
+			return errors.Trace(err)
+		}
+	}
+
+	// validate the row again
+	dstRow, err = queryRowByCond(tctx, toDB, cond)
+	if err != nil {
+		return err
+	}
+	switch {
+	case srcRow == nil:
+		r.validated = dstRow == nil
+	case dstRow == nil:
+		r.validated = false
+	default:
+		compareContext := &validateCompareContext{
+			logger:      v.L,
+			sourceTable: sourceTable,
+			targetTable: targetTable,
+			columns:     tableInfo.Columns,
+		}
+		if r.validated, err = compareContext.compareData(r.rowKey, srcRow, dstRow); err != nil {
+			return err
+		}
+	}
+	if !r.validated {
+		r.message = repairNotConsistentMsg
+	}
+	return nil
+}
+
+func (v *DataValidator) getRepairTableInfo(
+	tctx *tcontext.Context, sourceTable, targetTable *filter.Table,
+) (*model.TableInfo, *schema.DownstreamTableInfo, error) {
+	tableInfo, err := v.syncer.getTrackedTableInfo(sourceTable)
+	if err != nil {
+		if !terror.ErrSchemaTrackerIsClosed.Equal(err) {
+			return nil, nil, err
+		}
+		if tableInfo = v.syncer.getTableInfoFromCheckpoint(sourceTable); tableInfo == nil {
+			return nil, nil, errors.Annotate(err, "fail to get table info from checkpoint")
+		}
+	}
+	downstreamTableInfo, err := v.syncer.getDownStreamTableInfo(tctx, utils.GenTableID(targetTable), tableInfo)
+	if err != nil {
+		return nil, nil, err
+	}
+	if downstreamTableInfo.WhereHandle.UniqueNotNullIdx == nil {
+		return nil, nil, errors.New(tableWithoutPrimaryKeyMsg)
+	}
+	return tableInfo, downstreamTableInfo, nil
+}
+
+// queryRowByCond returns the row matching the condition, or nil if not exists.
+func queryRowByCond(tctx *tcontext.Context, db *conn.BaseDB, cond *Cond) ([]*sql.NullString, error) {
+	ctx, cancel := tctx.WithTimeout(queryTimeout)
+	defer cancel()
+	columnNames := make([]string, 0, len(cond.Columns))
+	for _, col := range cond.Columns {
+		columnNames = append(columnNames, col.Name.O)
+	}
+	query := fmt.Sprintf("SELECT /*!40001 SQL_NO_CACHE */ %s FROM %s WHERE %s",
+		quoteColumns(columnNames), cond.TargetTbl, cond.GetWhere())
+	rows, err := db.QueryContext(ctx, query, cond.GetArgs()...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	if !rows.Next() {
+		return nil, errors.Trace(rows.Err())
+	}
+	row, err := scanRow(rows)
+	if err != nil {
+		return nil, err
+	}
+	return row, errors.Trace(rows.Err())
+}
+
+func nullStringsToValues(data []*sql.NullString) []interface{} {
+	values := make([]interface{}, len(data))
+	for i, d := range data {
+		if d.Valid {
+			values[i] = d.String
+		}
+	}
+	return values
+}
@@ -0,0 +1,150 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pingcap/tiflow/dm/pb"
+	"github.com/pingcap/tiflow/dm/pkg/conn"
+	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/retry"
+	"github.com/pingcap/tiflow/dm/pkg/schema"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/pingcap/tiflow/dm/syncer/dbconn"
+)
+
+func TestValidatorRepairErrors(t *testing.T) {
+	var (
+		schemaName     = "test"
+		tableName      = "tbl"
+		createTableSQL = "CREATE TABLE `" + tableName + "`(id int primary key, v varchar(100))"
+	)
+	createAST, err := parseSQL(createTableSQL)
+	require.NoError(t, err)
+
+	cfg := genSubtaskConfig(t)
+	syncerObj := NewSyncer(cfg, nil, nil)
+	trackerDB, trackerMock, err := sqlmock.New()
+	require.NoError(t, err)
+	trackerMock.MatchExpectationsInOrder(false)
+	trackerMock.ExpectQuery("SHOW VARIABLES LIKE 'sql_mode'").WillReturnRows(
+		trackerMock.NewRows([]string{"Variable_name", "Value"}).AddRow("sql_mode", ""),
+	)
+	trackerMock.ExpectBegin()
+	trackerMock.ExpectExec("SET SESSION SQL_MODE.*").WillReturnResult(sqlmock.NewResult(1, 1))
+	trackerMock.ExpectCommit()
+	trackerMock.ExpectQuery("SHOW CREATE TABLE .*").WillReturnRows(
+		trackerMock.NewRows([]string{"Table", "Create Table"}).AddRow(tableName, createTableSQL),
+	)
+	trackerConn, err := trackerDB.Conn(context.Background())
+	require.NoError(t, err)
+	syncerObj.downstreamTrackConn = dbconn.NewDBConn(cfg, conn.NewBaseConn(trackerConn, &retry.FiniteRetryStrategy{}))
+	syncerObj.schemaTracker, err = schema.NewTestTracker(context.Background(), cfg.Name, syncerObj.downstreamTrackConn, log.L())
+	require.NoError(t, err)
+	defer syncerObj.schemaTracker.Close()
+	require.NoError(t, syncerObj.schemaTracker.CreateSchemaIfNotExists(schemaName))
+	require.NoError(t, syncerObj.schemaTracker.Exec(context.Background(), schemaName, createAST))
+
+	validator := NewContinuousDataValidator(cfg, syncerObj, false)
+	tctx := tcontext.Background()
+	sourceID := cfg.SourceID
+	fromDB, fromMock, err := sqlmock.New()
+	require.NoError(t, err)
+	toDB, toMock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	// error 1: row differs, repaired by replace
+	// error 2: row not exists in upstream, repaired by delete
+	// error 3: row still differs after repair
+	toMock.ExpectQuery("SELECT id, src_schema_name, src_table_name, dst_schema_name, dst_table_name, row_pk FROM .* WHERE source = \\? AND status = \\?").
+		WithArgs(sourceID, int(pb.ValidateErrorState_NewErr)).
+		WillReturnRows(toMock.NewRows([]string{"", "", "", "", "", ""}).
+			AddRow(1, schemaName, tableName, schemaName, tableName, "1").
+			AddRow(2, schemaName, tableName, schemaName, tableName, "2").
+			AddRow(3, schemaName, tableName, schemaName, tableName, "3"))
+
+	fromMock.ExpectQuery("SELECT .* FROM `test`.`tbl` WHERE id in \\(\\?\\)").WithArgs("1").
+		WillReturnRows(fromMock.NewRows([]string{"id", "v"}).AddRow("1", "a"))
+	toMock.ExpectQuery("SELECT .* FROM `test`.`tbl` WHERE id in \\(\\?\\)").WithArgs("1").
+		WillReturnRows(toMock.NewRows([]string{"id", "v"}).AddRow("1", "b"))
+	toMock.ExpectExec("REPLACE INTO `test`.`tbl` \\(`id`,`v`\\) VALUES \\(\\?,\\?\\)").WithArgs("1", "a").
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	toMock.ExpectQuery("SELECT .* FROM `test`.`tbl` WHERE id in \\(\\?\\)").WithArgs("1").
+		WillReturnRows(toMock.NewRows([]string{"id", "v"}).AddRow("1", "a"))
+	toMock.ExpectBegin()
+	toMock.ExpectExec("INSERT INTO .*_validator_repair_log.*").
+		WithArgs(sourceID, 1, schemaName, tableName, "1", schemaName, tableName, repairActionReplace, `["1","a"]`, true, "").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	toMock.ExpectExec("UPDATE .*_validator_error_change` SET status=\\? WHERE source=\\? AND id=\\?").
+		WithArgs(int(pb.ValidateErrorState_ResolvedErr), sourceID, 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	toMock.ExpectCommit()
+
+	fromMock.ExpectQuery("SELECT .* FROM `test`.`tbl` WHERE id in \\(\\?\\)").WithArgs("2").
+		WillReturnRows(fromMock.NewRows([]string{"id", "v"}))
+	toMock.ExpectQuery("SELECT .* FROM `test`.`tbl` WHERE id in \\(\\?\\)").WithArgs("2").
+		WillReturnRows(toMock.NewRows([]string{"id", "v"}).AddRow("2", "b"))
+	toMock.ExpectExec("DELETE FROM `test`.`tbl` WHERE `id` = \\? LIMIT 1").WithArgs("2").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	toMock.ExpectQuery("SELECT .* FROM `test`.`tbl` WHERE id in \\(\\?\\)").WithArgs("2").
+		WillReturnRows(toMock.NewRows([]string{"id", "v"}))
+	toMock.ExpectBegin()
+	toMock.ExpectExec("INSERT INTO .*_validator_repair_log.*").
+		WithArgs(sourceID, 2, schemaName, tableName, "2", schemaName, tableName, repairActionDelete, `["2","b"]`, true, "").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	toMock.ExpectExec("UPDATE .*_validator_error_change` SET status=\\? WHERE source=\\? AND id=\\?").
+		WithArgs(int(pb.ValidateErrorState_ResolvedErr), sourceID, 2).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	toMock.ExpectCommit()
+
+	fromMock.ExpectQuery("SELECT .* FROM `test`.`tbl` WHERE id in \\(\\?\\)").WithArgs("3").
+		WillReturnRows(fromMock.NewRows([]string{"id", "v"}).AddRow("3", "a"))
+	toMock.ExpectQuery("SELECT .* FROM `test`.`tbl` WHERE id in \\(\\?\\)").WithArgs("3").
+		WillReturnRows(toMock.NewRows([]string{"id", "v"}))
+	toMock.ExpectExec("REPLACE INTO `test`.`tbl` \\(`id`,`v`\\) VALUES \\(\\?,\\?\\)").WithArgs("3", "a").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	toMock.ExpectQuery("SELECT .* FROM `test`.`tbl` WHERE id in \\(\\?\\)").WithArgs("3").
+		WillReturnRows(toMock.NewRows([]string{"id", "v"}).AddRow("3", "c"))
+	toMock.ExpectBegin()
+	toMock.ExpectExec("INSERT INTO .*_validator_repair_log.*").
+		WithArgs(sourceID, 3, schemaName, tableName, "3", schemaName, tableName, repairActionReplace, `["3","a"]`, false, repairNotConsistentMsg).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	toMock.ExpectCommit()
+
+	err = validator.repairErrors(tctx, conn.NewBaseDB(fromDB, func() {}), conn.NewBaseDB(toDB, func() {}), 0, true)
+	require.True(t, terror.ErrValidatorRepairFailed.Equal(err))
+	require.Contains(t, err.Error(), "failed to repair 1 of 3 validation error rows")
+	require.NoError(t, fromMock.ExpectationsWereMet())
+	require.NoError(t, toMock.ExpectationsWereMet())
+
+	// row key which cannot be split into primary key values is recorded as failed
+	toMock.ExpectQuery("SELECT id, src_schema_name, src_table_name, dst_schema_name, dst_table_name, row_pk FROM .* WHERE source = \\? AND id = \\?").
+		WithArgs(sourceID, 4).
+		WillReturnRows(toMock.NewRows([]string{"", "", "", "", "", ""}).
+			AddRow(4, schemaName, tableName, schemaName, tableName, "4\t4"))
+	toMock.ExpectBegin()
+	toMock.ExpectExec("INSERT INTO .*_validator_repair_log.*").
+		WithArgs(sourceID, 4, schemaName, tableName, "4\t4", schemaName, tableName, repairActionNone, `[]`, false, sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	toMock.ExpectCommit()
+	err = validator.repairErrors(tctx, conn.NewBaseDB(fromDB, func() {}), conn.NewBaseDB(toDB, func() {}), 4, false)
+	require.True(t, terror.ErrValidatorRepairFailed.Equal(err))
+	require.NoError(t, toMock.ExpectationsWereMet())
+}
@@ -250,7 +250,7 @@ function run_validation_start_stop_cmd {
 	echo "--> (fail) validation start: invalid mode"
 	run_dm_ctl $WORK_DIR "127.0.0.1:$MASTER_PORT" \
 		"validation start --mode xxx" \
-		"Error: mode should be either \`full\` or \`fast\`" 1
+		"Error: mode should be one of \`full\`, \`fast\` or \`snapshot\`" 1
 
 	echo "--> (fail) validation start: missing start-time value"
 	run_dm_ctl $WORK_DIR "127.0.0.1:$MASTER_PORT" \
@@ -268,7 +268,8 @@ func (st *SubTask) StartValidator(expect pb.Stage, startWithSubtask bool) {
 	st.Lock()
 	defer st.Unlock()
 
-	if st.cfg.ValidatorCfg.Mode != config.ValidationFast && st.cfg.ValidatorCfg.Mode != config.ValidationFull {
+	if st.cfg.ValidatorCfg.Mode != config.ValidationFast && st.cfg.ValidatorCfg.Mode != config.ValidationFull &&
+		st.cfg.ValidatorCfg.Mode != config.ValidationSnapshot {
 		return
 	}
 	var syncerObj *syncer.Syncer
@@ -852,7 +853,7 @@ func (st *SubTask) HandleError(ctx context.Context, req *pb.HandleWorkerErrorReq
 		return "", err
 	}
 
-	if st.Stage() == pb.Stage_Paused && req.Op != pb.ErrorOp_List {
+	if st.Stage() == pb.Stage_Paused && req.Op != pb.ErrorOp_List && req.Op != pb.ErrorOp_ListEvents {
 		err = st.Resume(relay)
 	}
 	return msg, err
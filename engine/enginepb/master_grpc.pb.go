@@ -26,6 +26,12 @@ type DiscoveryClient interface {
 	// If the number of executors becomes very large in the future,
 	// we can consider a mechanism to watch the changes of the executors.
 	ListExecutors(ctx context.Context, in *ListExecutorsRequest, opts ...grpc.CallOption) (*ListExecutorsResponse, error)
+	// DrainExecutor marks an executor unschedulable and asks the job masters
+	// to migrate the workers running on it to other executors.
+	// It returns the drain progress of the executor.
+	DrainExecutor(ctx context.Context, in *DrainExecutorRequest, opts ...grpc.CallOption) (*ExecutorDrainStatus, error)
+	// GetExecutorDrainStatus returns the drain progress of an executor.
+	GetExecutorDrainStatus(ctx context.Context, in *GetExecutorDrainStatusRequest, opts ...grpc.CallOption) (*ExecutorDrainStatus, error)
 	ListMasters(ctx context.Context, in *ListMastersRequest, opts ...grpc.CallOption) (*ListMastersResponse, error)
 	Heartbeat(ctx context.Context, in *HeartbeatRequest, opts ...grpc.CallOption) (*HeartbeatResponse, error)
 	// RegisterMetaStore is called from backend metastore and
@@ -64,6 +70,24 @@ func (c *discoveryClient) ListExecutors(ctx context.Context, in *ListExecutorsRe
 	return out, nil
 }
 
+func (c *discoveryClient) DrainExecutor(ctx context.Context, in *DrainExecutorRequest, opts ...grpc.CallOption) (*ExecutorDrainStatus, error) {
+	out := new(ExecutorDrainStatus)
+	err := c.cc.Invoke(ctx, "/enginepb.Discovery/DrainExecutor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *discoveryClient) GetExecutorDrainStatus(ctx context.Context, in *GetExecutorDrainStatusRequest, opts ...grpc.CallOption) (*ExecutorDrainStatus, error) {
+	out := new(ExecutorDrainStatus)
+	err := c.cc.Invoke(ctx, "/enginepb.Discovery/GetExecutorDrainStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *discoveryClient) ListMasters(ctx context.Context, in *ListMastersRequest, opts ...grpc.CallOption) (*ListMastersResponse, error) {
 	out := new(ListMastersResponse)
 	err := c.cc.Invoke(ctx, "/enginepb.Discovery/ListMasters", in, out, opts...)
@@ -129,6 +153,12 @@ type DiscoveryServer interface {
 	// If the number of executors becomes very large in the future,
 	// we can consider a mechanism to watch the changes of the executors.
 	ListExecutors(context.Context, *ListExecutorsRequest) (*ListExecutorsResponse, error)
+	// DrainExecutor marks an executor unschedulable and asks the job masters
+	// to migrate the workers running on it to other executors.
+	// It returns the drain progress of the executor.
+	DrainExecutor(context.Context, *DrainExecutorRequest) (*ExecutorDrainStatus, error)
+	// GetExecutorDrainStatus returns the drain progress of an executor.
+	GetExecutorDrainStatus(context.Context, *GetExecutorDrainStatusRequest) (*ExecutorDrainStatus, error)
 	ListMasters(context.Context, *ListMastersRequest) (*ListMastersResponse, error)
 	Heartbeat(context.Context, *HeartbeatRequest) (*HeartbeatResponse, error)
 	// RegisterMetaStore is called from backend metastore and
@@ -151,6 +181,12 @@ func (UnimplementedDiscoveryServer) RegisterExecutor(context.Context, *RegisterE
 func (UnimplementedDiscoveryServer) ListExecutors(context.Context, *ListExecutorsRequest) (*ListExecutorsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListExecutors not implemented")
 }
+func (UnimplementedDiscoveryServer) DrainExecutor(context.Context, *DrainExecutorRequest) (*ExecutorDrainStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DrainExecutor not implemented")
+}
+func (UnimplementedDiscoveryServer) GetExecutorDrainStatus(context.Context, *GetExecutorDrainStatusRequest) (*ExecutorDrainStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExecutorDrainStatus not implemented")
+}
 func (UnimplementedDiscoveryServer) ListMasters(context.Context, *ListMastersRequest) (*ListMastersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListMasters not implemented")
 }
@@ -217,6 +253,42 @@ func _Discovery_ListExecutors_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Discovery_DrainExecutor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DrainExecutorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiscoveryServer).DrainExecutor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/enginepb.Discovery/DrainExecutor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiscoveryServer).DrainExecutor(ctx, req.(*DrainExecutorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Discovery_GetExecutorDrainStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExecutorDrainStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiscoveryServer).GetExecutorDrainStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/enginepb.Discovery/GetExecutorDrainStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiscoveryServer).GetExecutorDrainStatus(ctx, req.(*GetExecutorDrainStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Discovery_ListMasters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListMastersRequest)
 	if err := dec(in); err != nil {
@@ -340,6 +412,14 @@ var Discovery_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListExecutors",
 			Handler:    _Discovery_ListExecutors_Handler,
 		},
+		{
+			MethodName: "DrainExecutor",
+			Handler:    _Discovery_DrainExecutor_Handler,
+		},
+		{
+			MethodName: "GetExecutorDrainStatus",
+			Handler:    _Discovery_GetExecutorDrainStatus_Handler,
+		},
 		{
 			MethodName: "ListMasters",
 			Handler:    _Discovery_ListMasters_Handler,
@@ -502,6 +582,12 @@ type JobManagerClient interface {
 	ListJobs(ctx context.Context, in *ListJobsRequest, opts ...grpc.CallOption) (*ListJobsResponse, error)
 	CancelJob(ctx context.Context, in *CancelJobRequest, opts ...grpc.CallOption) (*Job, error)
 	DeleteJob(ctx context.Context, in *DeleteJobRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	PauseJob(ctx context.Context, in *PauseJobRequest, opts ...grpc.CallOption) (*Job, error)
+	ResumeJob(ctx context.Context, in *ResumeJobRequest, opts ...grpc.CallOption) (*Job, error)
+	UpdateJobConfig(ctx context.Context, in *UpdateJobConfigRequest, opts ...grpc.CallOption) (*Job, error)
+	ListJobEvents(ctx context.Context, in *ListJobEventsRequest, opts ...grpc.CallOption) (*ListJobEventsResponse, error)
+	GetQuota(ctx context.Context, in *GetQuotaRequest, opts ...grpc.CallOption) (*Quota, error)
+	SetQuota(ctx context.Context, in *SetQuotaRequest, opts ...grpc.CallOption) (*Quota, error)
 }
 
 type jobManagerClient struct {
@@ -557,6 +643,60 @@ func (c *jobManagerClient) DeleteJob(ctx context.Context, in *DeleteJobRequest,
 	return out, nil
 }
 
+func (c *jobManagerClient) PauseJob(ctx context.Context, in *PauseJobRequest, opts ...grpc.CallOption) (*Job, error) {
+	out := new(Job)
+	err := c.cc.Invoke(ctx, "/enginepb.JobManager/PauseJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobManagerClient) ResumeJob(ctx context.Context, in *ResumeJobRequest, opts ...grpc.CallOption) (*Job, error) {
+	out := new(Job)
+	err := c.cc.Invoke(ctx, "/enginepb.JobManager/ResumeJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobManagerClient) UpdateJobConfig(ctx context.Context, in *UpdateJobConfigRequest, opts ...grpc.CallOption) (*Job, error) {
+	out := new(Job)
+	err := c.cc.Invoke(ctx, "/enginepb.JobManager/UpdateJobConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobManagerClient) ListJobEvents(ctx context.Context, in *ListJobEventsRequest, opts ...grpc.CallOption) (*ListJobEventsResponse, error) {
+	out := new(ListJobEventsResponse)
+	err := c.cc.Invoke(ctx, "/enginepb.JobManager/ListJobEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobManagerClient) GetQuota(ctx context.Context, in *GetQuotaRequest, opts ...grpc.CallOption) (*Quota, error) {
+	out := new(Quota)
+	err := c.cc.Invoke(ctx, "/enginepb.JobManager/GetQuota", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobManagerClient) SetQuota(ctx context.Context, in *SetQuotaRequest, opts ...grpc.CallOption) (*Quota, error) {
+	out := new(Quota)
+	err := c.cc.Invoke(ctx, "/enginepb.JobManager/SetQuota", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // JobManagerServer is the server API for JobManager service.
 // All implementations should embed UnimplementedJobManagerServer
 // for forward compatibility
@@ -566,6 +706,12 @@ type JobManagerServer interface {
 	ListJobs(context.Context, *ListJobsRequest) (*ListJobsResponse, error)
 	CancelJob(context.Context, *CancelJobRequest) (*Job, error)
 	DeleteJob(context.Context, *DeleteJobRequest) (*emptypb.Empty, error)
+	PauseJob(context.Context, *PauseJobRequest) (*Job, error)
+	ResumeJob(context.Context, *ResumeJobRequest) (*Job, error)
+	UpdateJobConfig(context.Context, *UpdateJobConfigRequest) (*Job, error)
+	ListJobEvents(context.Context, *ListJobEventsRequest) (*ListJobEventsResponse, error)
+	GetQuota(context.Context, *GetQuotaRequest) (*Quota, error)
+	SetQuota(context.Context, *SetQuotaRequest) (*Quota, error)
 }
 
 // UnimplementedJobManagerServer should be embedded to have forward compatible implementations.
@@ -587,6 +733,24 @@ func (UnimplementedJobManagerServer) CancelJob(context.Context, *CancelJobReques
 func (UnimplementedJobManagerServer) DeleteJob(context.Context, *DeleteJobRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteJob not implemented")
 }
+func (UnimplementedJobManagerServer) PauseJob(context.Context, *PauseJobRequest) (*Job, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseJob not implemented")
+}
+func (UnimplementedJobManagerServer) ResumeJob(context.Context, *ResumeJobRequest) (*Job, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeJob not implemented")
+}
+func (UnimplementedJobManagerServer) UpdateJobConfig(context.Context, *UpdateJobConfigRequest) (*Job, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateJobConfig not implemented")
+}
+func (UnimplementedJobManagerServer) ListJobEvents(context.Context, *ListJobEventsRequest) (*ListJobEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListJobEvents not implemented")
+}
+func (UnimplementedJobManagerServer) GetQuota(context.Context, *GetQuotaRequest) (*Quota, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQuota not implemented")
+}
+func (UnimplementedJobManagerServer) SetQuota(context.Context, *SetQuotaRequest) (*Quota, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetQuota not implemented")
+}
 
 // UnsafeJobManagerServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to JobManagerServer will
@@ -689,6 +853,114 @@ func _JobManager_DeleteJob_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _JobManager_PauseJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobManagerServer).PauseJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/enginepb.JobManager/PauseJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobManagerServer).PauseJob(ctx, req.(*PauseJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobManager_ResumeJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobManagerServer).ResumeJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/enginepb.JobManager/ResumeJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobManagerServer).ResumeJob(ctx, req.(*ResumeJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobManager_UpdateJobConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateJobConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobManagerServer).UpdateJobConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/enginepb.JobManager/UpdateJobConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobManagerServer).UpdateJobConfig(ctx, req.(*UpdateJobConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobManager_ListJobEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListJobEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobManagerServer).ListJobEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/enginepb.JobManager/ListJobEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobManagerServer).ListJobEvents(ctx, req.(*ListJobEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobManager_GetQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobManagerServer).GetQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/enginepb.JobManager/GetQuota",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobManagerServer).GetQuota(ctx, req.(*GetQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobManager_SetQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobManagerServer).SetQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/enginepb.JobManager/SetQuota",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobManagerServer).SetQuota(ctx, req.(*SetQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // JobManager_ServiceDesc is the grpc.ServiceDesc for JobManager service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -716,6 +988,30 @@ var JobManager_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteJob",
 			Handler:    _JobManager_DeleteJob_Handler,
 		},
+		{
+			MethodName: "PauseJob",
+			Handler:    _JobManager_PauseJob_Handler,
+		},
+		{
+			MethodName: "ResumeJob",
+			Handler:    _JobManager_ResumeJob_Handler,
+		},
+		{
+			MethodName: "UpdateJobConfig",
+			Handler:    _JobManager_UpdateJobConfig_Handler,
+		},
+		{
+			MethodName: "ListJobEvents",
+			Handler:    _JobManager_ListJobEvents_Handler,
+		},
+		{
+			MethodName: "GetQuota",
+			Handler:    _JobManager_GetQuota_Handler,
+		},
+		{
+			MethodName: "SetQuota",
+			Handler:    _JobManager_SetQuota_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "engine/proto/master.proto",
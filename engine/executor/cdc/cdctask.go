@@ -0,0 +1,282 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	perrors "github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+
+	"github.com/pingcap/tiflow/engine/framework"
+	frameModel "github.com/pingcap/tiflow/engine/framework/model"
+	"github.com/pingcap/tiflow/engine/framework/registry"
+	"github.com/pingcap/tiflow/engine/model"
+	dcontext "github.com/pingcap/tiflow/engine/pkg/context"
+	"github.com/pingcap/tiflow/engine/pkg/p2p"
+	"github.com/pingcap/tiflow/pkg/config"
+	"github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/security"
+)
+
+// UpstreamConfig is the config of the upstream TiDB cluster.
+type UpstreamConfig struct {
+	PDAddrs  []string             `toml:"pd-addrs" json:"pd-addrs"`
+	Security *security.Credential `toml:"security" json:"security"`
+}
+
+// TableRange is a range of physical table IDs, [Start, End).
+// An End of 0 means the range is unbounded.
+type TableRange struct {
+	Start int64 `json:"start"`
+	End   int64 `json:"end"`
+}
+
+// Contains returns whether the table belongs to the range.
+func (r TableRange) Contains(tableID int64) bool {
+	return tableID >= r.Start && (r.End == 0 || tableID < r.End)
+}
+
+// Config is the config of a cdc task, it replicates the tables in Range
+// from CheckpointTs to TargetTs.
+type Config struct {
+	Index         int                   `json:"index"`
+	Range         TableRange            `json:"range"`
+	CheckpointTs  uint64                `json:"checkpoint-ts"`
+	TargetTs      uint64                `json:"target-ts"`
+	SinkURI       string                `json:"sink-uri"`
+	Upstream      UpstreamConfig        `json:"upstream"`
+	ReplicaConfig *config.ReplicaConfig `json:"replica-config"`
+}
+
+// Status is the business status of a cdc task.
+type Status struct {
+	Index        int    `json:"index"`
+	CheckpointTs uint64 `json:"checkpoint-ts"`
+}
+
+// Processor replicates the changes of the tables in a task to the downstream.
+type Processor interface {
+	// Run replicates the changes until ctx is canceled or the target ts is
+	// reached, it returns nil in the latter case.
+	Run(ctx context.Context) error
+	// Checkpoint returns the ts before which all changes have been written
+	// to the downstream.
+	Checkpoint() uint64
+	// Close releases the resources of the processor.
+	Close() error
+}
+
+// newProcessor creates the Processor of a task, id is used as the changefeed
+// ID of the processor. It's a variable so that it can be replaced in tests.
+var newProcessor = newProcessorImpl
+
+type cdcTask struct {
+	framework.BaseWorker
+
+	cfg          Config
+	processor    Processor
+	checkpointTs *atomic.Uint64
+	cancelFn     func()
+	wg           sync.WaitGroup
+
+	statusCode struct {
+		sync.RWMutex
+		code frameModel.WorkerStatusCode
+	}
+	runError struct {
+		sync.RWMutex
+		err error
+	}
+
+	statusRateLimiter *rate.Limiter
+}
+
+// RegisterWorker is used to register cdc task worker into global registry
+func RegisterWorker() {
+	factory := registry.NewSimpleWorkerFactory(newCdcTask)
+	registry.GlobalWorkerRegistry().MustRegisterWorkerType(framework.CdcTask, factory)
+}
+
+func newCdcTask(ctx *dcontext.Context, _workerID frameModel.WorkerID, masterID frameModel.MasterID, conf *Config) *cdcTask {
+	return &cdcTask{
+		cfg:               *conf,
+		checkpointTs:      atomic.NewUint64(conf.CheckpointTs),
+		statusRateLimiter: rate.NewLimiter(rate.Every(time.Second), 1),
+	}
+}
+
+// InitImpl implements WorkerImpl.InitImpl
+func (t *cdcTask) InitImpl(ctx context.Context) error {
+	log.Info("init cdc task", zap.String("id", t.ID()), zap.Int("index", t.cfg.Index),
+		zap.Any("range", t.cfg.Range), zap.Uint64("checkpoint-ts", t.cfg.CheckpointTs))
+	processor, err := newProcessor(ctx, t.ID(), &t.cfg)
+	if err != nil {
+		// Report the error to the master by exiting in the next tick.
+		log.Warn("create cdc processor failed", zap.String("id", t.ID()), zap.Error(err))
+		t.setRunError(err)
+		t.setStatusCode(frameModel.WorkerStatusError)
+		return nil
+	}
+	t.processor = processor
+	t.setStatusCode(frameModel.WorkerStatusNormal)
+
+	// Don't use the ctx from the caller. Caller may cancel the ctx after InitImpl returns.
+	ctx, t.cancelFn = context.WithCancel(context.Background())
+	t.wg.Add(1)
+	go func() {
+		defer t.wg.Done()
+		err := processor.Run(ctx)
+		t.updateCheckpoint()
+		switch {
+		case err == nil:
+			t.setStatusCode(frameModel.WorkerStatusFinished)
+		case perrors.Cause(err) == context.Canceled:
+		default:
+			log.Error("cdc processor exited with error", zap.String("id", t.ID()), zap.Error(err))
+			t.setRunError(err)
+			t.setStatusCode(frameModel.WorkerStatusError)
+		}
+	}()
+	return nil
+}
+
+// Tick implements WorkerImpl.Tick
+func (t *cdcTask) Tick(ctx context.Context) error {
+	t.updateCheckpoint()
+	if t.statusRateLimiter.Allow() {
+		err := t.BaseWorker.UpdateStatus(ctx, t.Status())
+		if errors.ErrWorkerUpdateStatusTryAgain.Equal(err) {
+			log.Warn("update status try again later", zap.String("id", t.ID()), zap.Error(err))
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	exitReason := framework.ExitReasonUnknown
+	switch t.getStatusCode() {
+	case frameModel.WorkerStatusFinished:
+		exitReason = framework.ExitReasonFinished
+	case frameModel.WorkerStatusError:
+		exitReason = framework.ExitReasonFailed
+	case frameModel.WorkerStatusStopped:
+		exitReason = framework.ExitReasonCanceled
+	default:
+	}
+	if exitReason == framework.ExitReasonUnknown {
+		return nil
+	}
+	return t.BaseWorker.Exit(ctx, exitReason, t.getRunError(), t.Status().ExtBytes)
+}
+
+// Status implements WorkerImpl.Status
+func (t *cdcTask) Status() frameModel.WorkerStatus {
+	status := &Status{
+		Index:        t.cfg.Index,
+		CheckpointTs: t.checkpointTs.Load(),
+	}
+	statusBytes, err := json.Marshal(status)
+	if err != nil {
+		log.Panic("get status failed", zap.String("id", t.ID()), zap.Error(err))
+	}
+	var errMsg string
+	if err := t.getRunError(); err != nil {
+		errMsg = err.Error()
+	}
+	return frameModel.WorkerStatus{
+		Code:     t.getStatusCode(),
+		ErrorMsg: errMsg,
+		ExtBytes: statusBytes,
+	}
+}
+
+// Workload implements WorkerImpl.Workload
+func (t *cdcTask) Workload() model.RescUnit {
+	return 1
+}
+
+// OnMasterMessage implements WorkerImpl.OnMasterMessage
+func (t *cdcTask) OnMasterMessage(topic p2p.Topic, message p2p.MessageValue) error {
+	switch msg := message.(type) {
+	case *frameModel.StatusChangeRequest:
+		switch msg.ExpectState {
+		case frameModel.WorkerStatusStopped:
+			t.setStatusCode(frameModel.WorkerStatusStopped)
+		default:
+			log.Info("cdc task: ignore status change state", zap.Int32("state", int32(msg.ExpectState)))
+		}
+	default:
+		log.Info("unsupported message", zap.Any("message", message))
+	}
+	return nil
+}
+
+// CloseImpl implements WorkerImpl.CloseImpl
+func (t *cdcTask) CloseImpl(ctx context.Context) error {
+	if t.cancelFn != nil {
+		t.cancelFn()
+	}
+	t.wg.Wait()
+	if t.processor != nil {
+		return t.processor.Close()
+	}
+	return nil
+}
+
+// updateCheckpoint advances the checkpoint of the task to the one of the
+// processor, the checkpoint never goes backward.
+func (t *cdcTask) updateCheckpoint() {
+	if t.processor == nil {
+		return
+	}
+	checkpointTs := t.processor.Checkpoint()
+	for {
+		old := t.checkpointTs.Load()
+		if checkpointTs <= old || t.checkpointTs.CAS(old, checkpointTs) {
+			return
+		}
+	}
+}
+
+func (t *cdcTask) getStatusCode() frameModel.WorkerStatusCode {
+	t.statusCode.RLock()
+	defer t.statusCode.RUnlock()
+	return t.statusCode.code
+}
+
+func (t *cdcTask) setStatusCode(code frameModel.WorkerStatusCode) {
+	t.statusCode.Lock()
+	defer t.statusCode.Unlock()
+	t.statusCode.code = code
+}
+
+func (t *cdcTask) getRunError() error {
+	t.runError.RLock()
+	defer t.runError.RUnlock()
+	return t.runError.err
+}
+
+func (t *cdcTask) setRunError(err error) {
+	t.runError.Lock()
+	defer t.runError.Unlock()
+	t.runError.err = err
+}
@@ -0,0 +1,225 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
+
+	"github.com/pingcap/tiflow/engine/framework"
+	frameModel "github.com/pingcap/tiflow/engine/framework/model"
+	dcontext "github.com/pingcap/tiflow/engine/pkg/context"
+)
+
+type mockProcessor struct {
+	checkpointTs atomic.Uint64
+	runErrCh     chan error
+	closed       atomic.Bool
+}
+
+func newMockProcessor() *mockProcessor {
+	return &mockProcessor{runErrCh: make(chan error, 1)}
+}
+
+func (p *mockProcessor) Run(ctx context.Context) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case err := <-p.runErrCh:
+		return err
+	}
+}
+
+func (p *mockProcessor) Checkpoint() uint64 {
+	return p.checkpointTs.Load()
+}
+
+func (p *mockProcessor) Close() error {
+	p.closed.Store(true)
+	return nil
+}
+
+// mockBaseWorker records the status updates and the exit of a task.
+type mockBaseWorker struct {
+	framework.BaseWorker
+
+	mu         sync.Mutex
+	statuses   []frameModel.WorkerStatus
+	exitReason framework.ExitReason
+	exitErr    error
+	exited     bool
+}
+
+func (w *mockBaseWorker) UpdateStatus(ctx context.Context, status frameModel.WorkerStatus) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.statuses = append(w.statuses, status)
+	return nil
+}
+
+func (w *mockBaseWorker) Exit(
+	ctx context.Context, exitReason framework.ExitReason, err error, extBytes []byte,
+) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.exited = true
+	w.exitReason = exitReason
+	w.exitErr = err
+	return nil
+}
+
+func (w *mockBaseWorker) getExit() (bool, framework.ExitReason, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.exited, w.exitReason, w.exitErr
+}
+
+func newTaskForTest(t *testing.T, processor *mockProcessor, newErr error) (*cdcTask, *mockBaseWorker) {
+	backup := newProcessor
+	newProcessor = func(ctx context.Context, id string, cfg *Config) (Processor, error) {
+		if newErr != nil {
+			return nil, newErr
+		}
+		return processor, nil
+	}
+	t.Cleanup(func() {
+		newProcessor = backup
+	})
+
+	task := newCdcTask(dcontext.Background(), "worker-id", "master-id", &Config{
+		Index:        1,
+		CheckpointTs: 10,
+	})
+	baseWorker := &mockBaseWorker{
+		BaseWorker: framework.MockBaseWorker("worker-id", "master-id", task),
+	}
+	task.BaseWorker = baseWorker
+	return task, baseWorker
+}
+
+func checkpointOfStatus(t *testing.T, status frameModel.WorkerStatus) uint64 {
+	var s Status
+	require.NoError(t, json.Unmarshal(status.ExtBytes, &s))
+	return s.CheckpointTs
+}
+
+func TestTaskCheckpoint(t *testing.T) {
+	processor := newMockProcessor()
+	task, _ := newTaskForTest(t, processor, nil)
+	ctx := context.Background()
+	require.NoError(t, task.InitImpl(ctx))
+	defer func() {
+		require.NoError(t, task.CloseImpl(ctx))
+	}()
+
+	// The checkpoint of the config is kept before the processor advances.
+	require.NoError(t, task.Tick(ctx))
+	require.Equal(t, uint64(10), checkpointOfStatus(t, task.Status()))
+
+	processor.checkpointTs.Store(20)
+	require.NoError(t, task.Tick(ctx))
+	require.Equal(t, uint64(20), checkpointOfStatus(t, task.Status()))
+
+	// The checkpoint never goes backward.
+	processor.checkpointTs.Store(15)
+	require.NoError(t, task.Tick(ctx))
+	require.Equal(t, uint64(20), checkpointOfStatus(t, task.Status()))
+	require.Equal(t, frameModel.WorkerStatusNormal, task.Status().Code)
+}
+
+func TestTaskExit(t *testing.T) {
+	ctx := context.Background()
+
+	// The processor reaches the target ts.
+	processor := newMockProcessor()
+	task, baseWorker := newTaskForTest(t, processor, nil)
+	require.NoError(t, task.InitImpl(ctx))
+	processor.checkpointTs.Store(100)
+	processor.runErrCh <- nil
+	require.Eventually(t, func() bool {
+		require.NoError(t, task.Tick(ctx))
+		exited, _, _ := baseWorker.getExit()
+		return exited
+	}, 5*time.Second, 10*time.Millisecond)
+	_, reason, err := baseWorker.getExit()
+	require.Equal(t, framework.ExitReasonFinished, reason)
+	require.NoError(t, err)
+	require.Equal(t, uint64(100), checkpointOfStatus(t, task.Status()))
+	require.NoError(t, task.CloseImpl(ctx))
+	require.True(t, processor.closed.Load())
+
+	// The processor fails.
+	processor = newMockProcessor()
+	task, baseWorker = newTaskForTest(t, processor, nil)
+	require.NoError(t, task.InitImpl(ctx))
+	runErr := errors.New("processor failed")
+	processor.runErrCh <- runErr
+	require.Eventually(t, func() bool {
+		require.NoError(t, task.Tick(ctx))
+		exited, _, _ := baseWorker.getExit()
+		return exited
+	}, 5*time.Second, 10*time.Millisecond)
+	_, reason, err = baseWorker.getExit()
+	require.Equal(t, framework.ExitReasonFailed, reason)
+	require.Equal(t, runErr, err)
+	require.Equal(t, runErr.Error(), task.Status().ErrorMsg)
+	require.NoError(t, task.CloseImpl(ctx))
+
+	// The processor can't be created.
+	createErr := errors.New("create processor failed")
+	task, baseWorker = newTaskForTest(t, nil, createErr)
+	require.NoError(t, task.InitImpl(ctx))
+	require.NoError(t, task.Tick(ctx))
+	exited, reason, err := baseWorker.getExit()
+	require.True(t, exited)
+	require.Equal(t, framework.ExitReasonFailed, reason)
+	require.Equal(t, createErr, err)
+	require.NoError(t, task.CloseImpl(ctx))
+}
+
+func TestTaskStop(t *testing.T) {
+	processor := newMockProcessor()
+	task, baseWorker := newTaskForTest(t, processor, nil)
+	ctx := context.Background()
+	require.NoError(t, task.InitImpl(ctx))
+
+	// Other state changes are ignored.
+	require.NoError(t, task.OnMasterMessage("", &frameModel.StatusChangeRequest{
+		ExpectState: frameModel.WorkerStatusFinished,
+	}))
+	require.NoError(t, task.Tick(ctx))
+	exited, _, _ := baseWorker.getExit()
+	require.False(t, exited)
+
+	require.NoError(t, task.OnMasterMessage("", &frameModel.StatusChangeRequest{
+		ExpectState: frameModel.WorkerStatusStopped,
+	}))
+	require.NoError(t, task.Tick(ctx))
+	exited, reason, err := baseWorker.getExit()
+	require.True(t, exited)
+	require.Equal(t, framework.ExitReasonCanceled, reason)
+	require.NoError(t, err)
+
+	// The running processor is canceled and closed.
+	require.NoError(t, task.CloseImpl(ctx))
+	require.True(t, processor.closed.Load())
+	require.Equal(t, frameModel.WorkerStatusStopped, task.Status().Code)
+}
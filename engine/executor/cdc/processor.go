@@ -0,0 +1,458 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+
+	"github.com/pingcap/tiflow/cdc/contextutil"
+	"github.com/pingcap/tiflow/cdc/entry"
+	"github.com/pingcap/tiflow/cdc/entry/schema"
+	"github.com/pingcap/tiflow/cdc/kv"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/processor/pipeline"
+	"github.com/pingcap/tiflow/cdc/puller"
+	"github.com/pingcap/tiflow/cdc/redo"
+	sinkv1 "github.com/pingcap/tiflow/cdc/sink"
+	sinkmetric "github.com/pingcap/tiflow/cdc/sink/metrics"
+	"github.com/pingcap/tiflow/cdc/sinkv2/eventsink/factory"
+	"github.com/pingcap/tiflow/pkg/config"
+	cdcContext "github.com/pingcap/tiflow/pkg/context"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/filter"
+	"github.com/pingcap/tiflow/pkg/security"
+	"github.com/pingcap/tiflow/pkg/upstream"
+	"github.com/pingcap/tiflow/pkg/util"
+	"github.com/pingcap/tiflow/pkg/version"
+)
+
+const (
+	processorTickInterval = 100 * time.Millisecond
+	// processorGCServiceID is the GC service ID of the upstreams of the
+	// processors, the processors never set GC safepoints, the job master does.
+	processorGCServiceID = "tiflow-engine-cdc-processor"
+)
+
+// processor replicates the row changes of the tables in the range of a task
+// with the table pipelines of TiCDC. There is no owner, so the processor
+// picks the tables from the schema of the upstream by itself and doesn't
+// replicate DDLs: the tables must be created in the downstream, and altered
+// compatibly, before their rows are replicated.
+type processor struct {
+	changefeedID model.ChangeFeedID
+	cfg          *Config
+	info         *model.ChangeFeedInfo
+
+	rt        *runtime
+	upManager *upstream.Manager
+	upstream  *upstream.Upstream
+
+	filter        filter.Filter
+	schemaStorage entry.SchemaStorage
+	mounter       entry.Mounter
+	sinkV1        sinkv1.Sink
+	sinkV2Factory *factory.SinkFactory
+	redoManager   redo.LogManager
+
+	tables map[model.TableID]pipeline.TablePipeline
+	// droppedTables maps the tables not in the schema any more to a ts after
+	// they are dropped, they are removed once their checkpoint reaches it.
+	droppedTables map[model.TableID]model.Ts
+	// schemaTs is the ts of the schema the tables were picked from.
+	schemaTs     model.Ts
+	checkpointTs *atomic.Uint64
+
+	errCh  chan error
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func newProcessorImpl(ctx context.Context, id string, cfg *Config) (Processor, error) {
+	replicaConfig := cfg.ReplicaConfig
+	if replicaConfig == nil {
+		replicaConfig = config.GetDefaultReplicaConfig()
+	}
+	changefeedID := model.DefaultChangeFeedID(id)
+	return &processor{
+		changefeedID: changefeedID,
+		cfg:          cfg,
+		info: &model.ChangeFeedInfo{
+			Namespace: changefeedID.Namespace,
+			ID:        changefeedID.ID,
+			SinkURI:   cfg.SinkURI,
+			StartTs:   cfg.CheckpointTs,
+			TargetTs:  cfg.TargetTs,
+			Engine:    model.SortUnified,
+			Config:    replicaConfig,
+			State:     model.StateNormal,
+		},
+		tables:        make(map[model.TableID]pipeline.TablePipeline),
+		droppedTables: make(map[model.TableID]model.Ts),
+		checkpointTs:  atomic.NewUint64(cfg.CheckpointTs),
+		errCh:         make(chan error, 16),
+		cancel:        func() {},
+	}, nil
+}
+
+// Run implements Processor.Run
+func (p *processor) Run(ctx context.Context) error {
+	ctx, p.cancel = context.WithCancel(ctx)
+	cdcCtx, err := p.init(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	ticker := time.NewTicker(processorTickInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return errors.Trace(ctx.Err())
+		case err := <-p.errCh:
+			return errors.Trace(err)
+		case <-ticker.C:
+		}
+		if err := p.tick(cdcCtx); err != nil {
+			return errors.Trace(err)
+		}
+		if p.cfg.TargetTs != 0 && p.checkpointTs.Load() >= p.cfg.TargetTs {
+			log.Info("cdc processor reached the target ts",
+				zap.String("changefeed", p.changefeedID.ID),
+				zap.Uint64("target-ts", p.cfg.TargetTs))
+			return nil
+		}
+	}
+}
+
+// Checkpoint implements Processor.Checkpoint
+func (p *processor) Checkpoint() uint64 {
+	return p.checkpointTs.Load()
+}
+
+// Close implements Processor.Close
+func (p *processor) Close() error {
+	for _, table := range p.tables {
+		table.Cancel()
+	}
+	for _, table := range p.tables {
+		table.Wait()
+	}
+	p.cancel()
+	p.wg.Wait()
+
+	var err error
+	if p.sinkV1 != nil {
+		// pass a canceled context is ok here, since we don't need to wait Close
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		if err = p.sinkV1.Close(ctx); errors.Cause(err) == context.Canceled {
+			err = nil
+		}
+	} else if p.sinkV2Factory != nil {
+		if err = p.sinkV2Factory.Close(); errors.Cause(err) == context.Canceled {
+			err = nil
+		}
+	}
+	if p.upManager != nil {
+		p.upManager.Close()
+	}
+	if p.rt != nil {
+		releaseRuntime()
+		p.rt = nil
+	}
+	sinkmetric.TableSinkTotalRowsCountCounter.
+		DeleteLabelValues(p.changefeedID.Namespace, p.changefeedID.ID)
+	log.Info("cdc processor closed", zap.String("changefeed", p.changefeedID.ID), zap.Error(err))
+	return errors.Trace(err)
+}
+
+// init creates the upstream, schema storage, mounter and sink, like the
+// lazy initialization of the processor of TiCDC.
+func (p *processor) init(ctx context.Context) (cdcContext.Context, error) {
+	conf := config.GetGlobalServerConfig()
+	tz, err := util.GetTimezone(conf.TZ)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	ctx = contextutil.PutTimezoneInCtx(ctx, tz)
+
+	rt, err := acquireRuntime()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	p.rt = rt
+
+	credential := p.cfg.Upstream.Security
+	if credential == nil {
+		credential = &security.Credential{}
+	}
+	p.upManager = upstream.NewManager(ctx, processorGCServiceID)
+	p.upstream, err = p.upManager.AddDefaultUpstream(p.cfg.Upstream.PDAddrs, credential)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	p.info.UpstreamID = p.upstream.ID
+
+	p.filter, err = filter.NewFilter(p.info.Config, util.GetTimeZoneName(tz))
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	p.schemaStorage, err = p.createAndDriveSchemaStorage(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	p.mounter = entry.NewMounter(p.schemaStorage, p.changefeedID, tz,
+		p.filter, p.info.Config.EnableOldValue)
+
+	stdCtx := contextutil.PutChangefeedIDInCtx(ctx, p.changefeedID)
+	stdCtx = contextutil.PutRoleInCtx(stdCtx, util.RoleProcessor)
+	if !conf.Debug.EnableNewSink {
+		p.sinkV1, err = sinkv1.New(stdCtx, p.changefeedID, p.info.SinkURI, p.info.Config, p.errCh)
+	} else {
+		p.sinkV2Factory, err = factory.New(stdCtx, p.info.SinkURI, p.info.Config, p.errCh)
+	}
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	p.redoManager = redo.NewDisabledManager()
+
+	cdcCtx := cdcContext.NewContext(ctx, &cdcContext.GlobalVars{
+		CaptureInfo: &model.CaptureInfo{
+			ID:      p.changefeedID.ID,
+			Version: version.ReleaseVersion,
+		},
+		TableActorSystem: rt.tableActorSystem,
+		SorterSystem:     rt.sorterSystem,
+	})
+	cdcCtx = cdcContext.WithChangefeedVars(cdcCtx, &cdcContext.ChangefeedVars{
+		ID:   p.changefeedID,
+		Info: p.info,
+	})
+	cdcCtx = cdcContext.WithErrorHandler(cdcCtx, func(err error) error {
+		if cerror.ErrTableProcessorStoppedSafely.Equal(err) ||
+			errors.Cause(errors.Cause(err)) == context.Canceled {
+			return nil
+		}
+		p.sendError(err)
+		return nil
+	})
+	log.Info("cdc processor initialized", zap.String("changefeed", p.changefeedID.ID),
+		zap.Any("range", p.cfg.Range), zap.Uint64("checkpoint-ts", p.cfg.CheckpointTs))
+	return cdcCtx, nil
+}
+
+func (p *processor) createAndDriveSchemaStorage(ctx context.Context) (entry.SchemaStorage, error) {
+	checkpointTs := p.cfg.CheckpointTs
+	stdCtx := contextutil.PutTableInfoInCtx(ctx, -1, puller.DDLPullerTableName)
+	stdCtx = contextutil.PutChangefeedIDInCtx(stdCtx, p.changefeedID)
+	stdCtx = contextutil.PutRoleInCtx(stdCtx, util.RoleProcessor)
+	ddlPuller, err := puller.NewDDLJobPuller(
+		stdCtx,
+		p.upstream.PDClient,
+		p.upstream.GrpcPool,
+		p.upstream.RegionCache,
+		p.upstream.KVStorage,
+		p.upstream.PDClock,
+		checkpointTs,
+		config.GetGlobalServerConfig().KVClient,
+		p.changefeedID,
+	)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	meta, err := kv.GetSnapshotMeta(p.upstream.KVStorage, checkpointTs)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	schemaStorage, err := entry.NewSchemaStorage(meta, checkpointTs, p.filter,
+		p.info.Config.ForceReplicate, p.changefeedID)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	p.wg.Add(2)
+	go func() {
+		defer p.wg.Done()
+		p.sendError(ddlPuller.Run(stdCtx))
+	}()
+	go func() {
+		defer p.wg.Done()
+		var jobEntry *model.DDLJobEntry
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case jobEntry = <-ddlPuller.Output():
+			}
+			if jobEntry.OpType == model.OpTypeResolved {
+				schemaStorage.AdvanceResolvedTs(jobEntry.CRTs)
+			}
+			job, err := jobEntry.Job, jobEntry.Err
+			if err != nil {
+				p.sendError(errors.Trace(err))
+				return
+			}
+			if job == nil {
+				continue
+			}
+			if err := schemaStorage.HandleDDLJob(job); err != nil {
+				p.sendError(errors.Trace(err))
+				return
+			}
+		}
+	}()
+	return schemaStorage, nil
+}
+
+func (p *processor) sendError(err error) {
+	if err == nil || errors.Cause(err) == context.Canceled {
+		return
+	}
+	select {
+	case p.errCh <- err:
+	default:
+		log.Error("cdc processor receives redundant error", zap.Error(err))
+	}
+}
+
+func (p *processor) tick(ctx cdcContext.Context) error {
+	if schemaTs := p.schemaStorage.ResolvedTs(); schemaTs > p.schemaTs {
+		snap, err := p.schemaStorage.GetSnapshot(ctx, schemaTs)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if err := p.updateTables(ctx, snap, schemaTs); err != nil {
+			return errors.Trace(err)
+		}
+		p.schemaTs = schemaTs
+	}
+
+	checkpointTs := p.schemaTs
+	for tableID, table := range p.tables {
+		table.UpdateBarrierTs(p.schemaTs)
+		tableCheckpointTs := table.CheckpointTs()
+		if dropTs, ok := p.droppedTables[tableID]; ok && tableCheckpointTs >= dropTs {
+			log.Info("remove dropped table", zap.String("changefeed", p.changefeedID.ID),
+				zap.Int64("table-id", tableID), zap.Uint64("checkpoint-ts", tableCheckpointTs))
+			table.Cancel()
+			table.Wait()
+			delete(p.tables, tableID)
+			delete(p.droppedTables, tableID)
+			continue
+		}
+		if tableCheckpointTs < checkpointTs {
+			checkpointTs = tableCheckpointTs
+		}
+	}
+	if p.cfg.TargetTs != 0 && checkpointTs > p.cfg.TargetTs {
+		checkpointTs = p.cfg.TargetTs
+	}
+	if checkpointTs > p.checkpointTs.Load() {
+		p.checkpointTs.Store(checkpointTs)
+		// Please refer to `unmarshalAndMountRowChanged` in cdc/entry/mounter.go
+		// for why we need -1.
+		p.schemaStorage.DoGC(checkpointTs - 1)
+	}
+	return nil
+}
+
+// updateTables starts the pipelines of the tables in the range which are
+// created before schemaTs, and marks the tables dropped before it.
+func (p *processor) updateTables(ctx cdcContext.Context, snap *schema.Snapshot, schemaTs model.Ts) error {
+	tables := make(map[model.TableID]*model.TableInfo)
+	forceReplicate := p.info.Config.ForceReplicate
+	snap.IterTables(true, func(tbl *model.TableInfo) {
+		if p.filter.ShouldIgnoreTable(tbl.TableName.Schema, tbl.TableName.Table) ||
+			!tbl.IsEligible(forceReplicate) {
+			return
+		}
+		if pi := tbl.GetPartitionInfo(); pi != nil {
+			for _, partition := range pi.Definitions {
+				if p.cfg.Range.Contains(partition.ID) {
+					tables[partition.ID] = tbl
+				}
+			}
+		} else if p.cfg.Range.Contains(tbl.ID) {
+			tables[tbl.ID] = tbl
+		}
+	})
+
+	// The tables not in the last schema are created after it, so they
+	// have no rows before its ts.
+	startTs := p.schemaTs
+	if startTs < p.cfg.CheckpointTs {
+		startTs = p.cfg.CheckpointTs
+	}
+	for tableID, tbl := range tables {
+		delete(p.droppedTables, tableID)
+		if _, ok := p.tables[tableID]; ok {
+			continue
+		}
+		if p.cfg.TargetTs != 0 && startTs >= p.cfg.TargetTs {
+			continue
+		}
+		table, err := p.createTablePipeline(ctx, tableID, tbl.TableName.QuoteString(), startTs)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		p.tables[tableID] = table
+	}
+	// The rows of the dropped tables are committed before schemaTs.
+	for tableID := range p.tables {
+		if _, ok := tables[tableID]; ok {
+			continue
+		}
+		if _, ok := p.droppedTables[tableID]; !ok {
+			p.droppedTables[tableID] = schemaTs
+		}
+	}
+	return nil
+}
+
+func (p *processor) createTablePipeline(
+	ctx cdcContext.Context, tableID model.TableID, tableName string, startTs model.Ts,
+) (table pipeline.TablePipeline, err error) {
+	replicaInfo := &model.TableReplicaInfo{StartTs: startTs}
+	if p.sinkV1 != nil {
+		s, err := sinkv1.NewTableSink(p.sinkV1, tableID,
+			sinkmetric.TableSinkTotalRowsCountCounter.
+				WithLabelValues(p.changefeedID.Namespace, p.changefeedID.ID))
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		table, err = pipeline.NewTableActor(ctx, p.upstream, p.mounter, tableID, tableName,
+			nil, replicaInfo, s, nil, p.redoManager, p.info.GetTargetTs())
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+	} else {
+		s := p.sinkV2Factory.CreateTableSink(tableID)
+		table, err = pipeline.NewTableActor(ctx, p.upstream, p.mounter, tableID, tableName,
+			nil, replicaInfo, nil, s, p.redoManager, p.info.GetTargetTs())
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+	}
+	table.Start(startTs)
+	log.Info("add table pipeline", zap.String("changefeed", p.changefeedID.ID),
+		zap.Int64("table-id", tableID), zap.String("name", tableName),
+		zap.Uint64("start-ts", startTs))
+	return table, nil
+}
@@ -0,0 +1,117 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/pingcap/tiflow/cdc/entry"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/processor/pipeline"
+	cdcContext "github.com/pingcap/tiflow/pkg/context"
+)
+
+type mockTablePipeline struct {
+	pipeline.TablePipeline
+
+	checkpointTs model.Ts
+	barrierTs    model.Ts
+	canceled     bool
+}
+
+func (t *mockTablePipeline) CheckpointTs() model.Ts {
+	return t.checkpointTs
+}
+
+func (t *mockTablePipeline) UpdateBarrierTs(ts model.Ts) {
+	t.barrierTs = ts
+}
+
+func (t *mockTablePipeline) Cancel() {
+	t.canceled = true
+}
+
+func (t *mockTablePipeline) Wait() {}
+
+// mockSchemaStorage never resolves a newer schema, so the tables of the
+// processor are not changed by tick.
+type mockSchemaStorage struct {
+	entry.SchemaStorage
+
+	resolvedTs model.Ts
+	gcTs       model.Ts
+}
+
+func (s *mockSchemaStorage) ResolvedTs() uint64 {
+	return s.resolvedTs
+}
+
+func (s *mockSchemaStorage) DoGC(ts uint64) uint64 {
+	s.gcTs = ts
+	return ts
+}
+
+func TestProcessorTick(t *testing.T) {
+	t.Parallel()
+
+	p, err := newProcessorImpl(context.Background(), "task", &Config{
+		CheckpointTs: 10,
+		TargetTs:     100,
+	})
+	require.NoError(t, err)
+	proc := p.(*processor)
+	schemaStorage := &mockSchemaStorage{resolvedTs: 50}
+	proc.schemaStorage = schemaStorage
+	proc.schemaTs = 50
+	table1 := &mockTablePipeline{checkpointTs: 20}
+	table2 := &mockTablePipeline{checkpointTs: 30}
+	proc.tables[1] = table1
+	proc.tables[2] = table2
+	ctx := cdcContext.NewBackendContext4Test(false)
+
+	// The checkpoint is the minimum of the tables and the barrier is schemaTs.
+	require.NoError(t, proc.tick(ctx))
+	require.Equal(t, uint64(20), proc.Checkpoint())
+	require.Equal(t, uint64(19), schemaStorage.gcTs)
+	require.Equal(t, uint64(50), table1.barrierTs)
+	require.Equal(t, uint64(50), table2.barrierTs)
+
+	// A dropped table is kept until its checkpoint reaches the drop ts.
+	proc.droppedTables[1] = 40
+	table1.checkpointTs = 35
+	require.NoError(t, proc.tick(ctx))
+	require.Equal(t, uint64(30), proc.Checkpoint())
+	require.False(t, table1.canceled)
+
+	table1.checkpointTs = 40
+	require.NoError(t, proc.tick(ctx))
+	require.True(t, table1.canceled)
+	require.NotContains(t, proc.tables, model.TableID(1))
+	require.NotContains(t, proc.droppedTables, model.TableID(1))
+
+	// The checkpoint never goes backward.
+	table2.checkpointTs = 25
+	require.NoError(t, proc.tick(ctx))
+	require.Equal(t, uint64(30), proc.Checkpoint())
+
+	// The checkpoint is capped at the target ts.
+	proc.schemaTs = 200
+	schemaStorage.resolvedTs = 200
+	table2.checkpointTs = 150
+	require.NoError(t, proc.tick(ctx))
+	require.Equal(t, uint64(100), proc.Checkpoint())
+}
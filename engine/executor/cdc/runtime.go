@@ -0,0 +1,132 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"context"
+	"os"
+	"sync"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+
+	"github.com/pingcap/tiflow/cdc/kv"
+	"github.com/pingcap/tiflow/cdc/processor/pipeline/system"
+	ssystem "github.com/pingcap/tiflow/cdc/sorter/db/system"
+	"github.com/pingcap/tiflow/cdc/sorter/unified"
+	"github.com/pingcap/tiflow/pkg/config"
+)
+
+// runtime holds the resources shared by all processors of an executor, they
+// are the ones a TiCDC capture creates when it starts.
+type runtime struct {
+	tableActorSystem *system.System
+	// sorterSystem is nil if the db sorter is disabled.
+	sorterSystem *ssystem.System
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+var sharedRuntime struct {
+	sync.Mutex
+	rt       *runtime
+	refCount int
+}
+
+// acquireRuntime returns the shared runtime, it's started by the first caller.
+// Each successful call must be paired with a releaseRuntime.
+func acquireRuntime() (*runtime, error) {
+	sharedRuntime.Lock()
+	defer sharedRuntime.Unlock()
+	if sharedRuntime.rt == nil {
+		rt, err := startRuntime()
+		if err != nil {
+			return nil, err
+		}
+		sharedRuntime.rt = rt
+	}
+	sharedRuntime.refCount++
+	return sharedRuntime.rt, nil
+}
+
+// releaseRuntime stops the shared runtime when no processor uses it.
+func releaseRuntime() {
+	sharedRuntime.Lock()
+	defer sharedRuntime.Unlock()
+	sharedRuntime.refCount--
+	if sharedRuntime.refCount > 0 {
+		return
+	}
+	sharedRuntime.rt.stop()
+	sharedRuntime.rt = nil
+}
+
+func startRuntime() (*runtime, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	rt := &runtime{cancel: cancel}
+
+	kv.InitWorkerPool()
+	rt.wg.Add(2)
+	go func() {
+		defer rt.wg.Done()
+		if err := kv.RunWorkerPool(ctx); err != nil && errors.Cause(err) != context.Canceled {
+			log.Warn("kv worker pool exited with error", zap.Error(err))
+		}
+	}()
+	go func() {
+		defer rt.wg.Done()
+		if err := unified.RunWorkerPool(ctx); err != nil && errors.Cause(err) != context.Canceled {
+			log.Warn("sorter worker pool exited with error", zap.Error(err))
+		}
+	}()
+
+	rt.tableActorSystem = system.NewSystem()
+	if err := rt.tableActorSystem.Start(ctx); err != nil {
+		rt.stop()
+		return nil, errors.Trace(err)
+	}
+
+	conf := config.GetGlobalServerConfig()
+	if err := os.MkdirAll(conf.Sorter.SortDir, 0o755); err != nil {
+		rt.stop()
+		return nil, errors.Trace(err)
+	}
+	if conf.Debug.EnableDBSorter {
+		memPercentage := float64(conf.Sorter.MaxMemoryPercentage) / 100
+		sorterSystem := ssystem.NewSystem(conf.Sorter.SortDir, memPercentage, conf.Debug.DB)
+		if err := sorterSystem.Start(ctx); err != nil {
+			rt.stop()
+			return nil, errors.Trace(err)
+		}
+		rt.sorterSystem = sorterSystem
+	}
+	log.Info("cdc runtime started")
+	return rt, nil
+}
+
+func (rt *runtime) stop() {
+	if rt.sorterSystem != nil {
+		if err := rt.sorterSystem.Stop(); err != nil {
+			log.Warn("stop sorter system failed", zap.Error(err))
+		}
+	}
+	if rt.tableActorSystem != nil && rt.tableActorSystem.System() != nil {
+		rt.tableActorSystem.Stop()
+	}
+	rt.cancel()
+	rt.wg.Wait()
+	log.Info("cdc runtime stopped")
+}
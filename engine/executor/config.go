@@ -74,7 +74,9 @@ type Config struct {
 
 // String implements fmt.Stringer
 func (c *Config) String() string {
-	cfg, err := json.Marshal(c)
+	redacted := *c
+	redacted.Storage = c.Storage.Redacted()
+	cfg, err := json.Marshal(&redacted)
 	if err != nil {
 		log.Error("fail to marshal config to json", logutil.ShortError(err))
 	}
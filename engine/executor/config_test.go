@@ -71,6 +71,8 @@ join = "127.0.0.1:10240"
 
 [storage]
 local.base-dir = "/tmp/my-base-dir"
+s3.uri = "s3://my-bucket/prefix"
+s3.endpoint = "http://127.0.0.1:9000"
 `
 	fileName := mustWriteToTempFile(t, testToml)
 	cfg := GetDefaultExecutorConfig()
@@ -80,6 +82,25 @@ local.base-dir = "/tmp/my-base-dir"
 	require.NoError(t, err)
 
 	require.Equal(t, "/tmp/my-base-dir", cfg.Storage.Local.BaseDir)
+	require.True(t, cfg.Storage.S3.IsEnabled())
+	require.Equal(t, "s3://my-bucket/prefix", cfg.Storage.S3.URI)
+	require.Equal(t, "http://127.0.0.1:9000", cfg.Storage.S3.Endpoint)
+}
+
+func TestConfigStringRedactsS3Credentials(t *testing.T) {
+	t.Parallel()
+
+	cfg := GetDefaultExecutorConfig()
+	cfg.Storage.S3.URI = "s3://my-bucket/prefix"
+	cfg.Storage.S3.AccessKey = "my-access-key"
+	cfg.Storage.S3.SecretAccessKey = "my-secret-access-key"
+
+	s := cfg.String()
+	require.Contains(t, s, "s3://my-bucket/prefix")
+	require.NotContains(t, s, "my-access-key")
+	require.NotContains(t, s, "my-secret-access-key")
+	// The config itself is not changed.
+	require.Equal(t, "my-secret-access-key", cfg.Storage.S3.SecretAccessKey)
 }
 
 func mustWriteToTempFile(t *testing.T, content string) (filePath string) {
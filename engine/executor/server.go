@@ -594,6 +594,11 @@ func (s *Server) keepHeartbeat(ctx context.Context) error {
 				// executor actually wait for a timeout when ttl is nearly up.
 				Ttl: uint64(s.cfg.KeepAliveTTL.Milliseconds() + s.cfg.RPCTimeout.Milliseconds()),
 			}
+			if s.taskRunner != nil {
+				// The worker count is used by the server master to report
+				// the progress of draining the executor.
+				req.WorkerCount = s.taskRunner.WorkerCount()
+			}
 			resp, err := s.masterClient.Heartbeat(ctx, req)
 			if err != nil {
 				log.L().Error("heartbeat rpc meet error", zap.Error(err))
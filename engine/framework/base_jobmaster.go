@@ -60,6 +60,10 @@ type BaseJobMaster interface {
 	// their ID's must be passed by `resources`.
 	CreateWorker(workerType WorkerType, config WorkerConfig, cost model.RescUnit, resources ...resourcemeta.ResourceID) (frameModel.WorkerID, error)
 
+	// CreateWorkerWithOpts is the same as CreateWorker, except that
+	// the resources and scheduling constraints are passed by options.
+	CreateWorkerWithOpts(workerType WorkerType, config WorkerConfig, cost model.RescUnit, opts ...CreateWorkerOpt) (frameModel.WorkerID, error)
+
 	// UpdateJobStatus updates jobmaster(worker of jobmanager) status and
 	// sends a 'status updated' message to jobmanager
 	UpdateJobStatus(ctx context.Context, status frameModel.WorkerStatus) error
@@ -108,6 +112,9 @@ type DefaultBaseJobMaster struct {
 	impl      JobMasterImpl
 	errCenter *errctx.ErrCenter
 	closeOnce sync.Once
+
+	// retry applies the retry policy of the job to the failed workers.
+	retry *workerRetryTracker
 }
 
 // JobMasterImpl is the implementation of a job master of dataflow engine.
@@ -147,10 +154,17 @@ func NewBaseJobMaster(
 	// master-worker pair: job master(`baseMaster` following) <-> real workers
 	// `masterID` here is the ID of `JobManager`
 	// `workerID` here is the ID of Job. It remains unchanged in the job lifecycle.
-	baseMaster := NewBaseMaster(
-		ctx, &jobMasterImplAsMasterImpl{jobMasterImpl}, workerID, tp)
+	masterImpl := &jobMasterImplAsMasterImpl{
+		inner: jobMasterImpl,
+		retry: newWorkerRetryTracker(),
+	}
+	baseMaster := NewBaseMaster(ctx, masterImpl, workerID, tp)
+	masterImpl.master = baseMaster.(*DefaultBaseMaster)
 	baseWorker := NewBaseWorker(
-		ctx, &jobMasterImplAsWorkerImpl{jobMasterImpl}, workerID, masterID, tp, workerEpoch)
+		ctx, &jobMasterImplAsWorkerImpl{
+			inner:  jobMasterImpl,
+			master: baseMaster.(*DefaultBaseMaster),
+		}, workerID, masterID, tp, workerEpoch)
 	errCenter := errctx.NewErrCenter()
 	baseMaster.(*DefaultBaseMaster).errCenter = errCenter
 	baseWorker.(*DefaultBaseWorker).errCenter = errCenter
@@ -159,6 +173,7 @@ func NewBaseJobMaster(
 		worker:    baseWorker.(*DefaultBaseWorker),
 		impl:      jobMasterImpl,
 		errCenter: errCenter,
+		retry:     masterImpl.retry,
 	}
 }
 
@@ -186,10 +201,15 @@ func (d *DefaultBaseJobMaster) Init(ctx context.Context) error {
 		return errors.Trace(err)
 	}
 
+	if err := d.initMessageHandlers(ctx); err != nil {
+		return errors.Trace(err)
+	}
+
 	isFirstStartUp, err := d.master.doInit(ctx)
 	if err != nil {
 		return errors.Trace(err)
 	}
+	d.retry.reset(&d.master.MasterMeta().Ext)
 
 	if isFirstStartUp {
 		if err := d.impl.InitImpl(ctx); err != nil {
@@ -211,6 +231,31 @@ func (d *DefaultBaseJobMaster) Init(ctx context.Context) error {
 	return nil
 }
 
+// initMessageHandlers registers the handlers of the messages sent from
+// the job manager that are handled by the framework.
+func (d *DefaultBaseJobMaster) initMessageHandlers(ctx context.Context) error {
+	topic := frameModel.DrainExecutorRequestTopic(d.worker.masterID, d.worker.id)
+	ok, err := d.worker.messageHandlerManager.RegisterHandler(
+		ctx,
+		topic,
+		&frameModel.DrainExecutorRequest{},
+		func(sender p2p.NodeID, value p2p.MessageValue) error {
+			msg, ok := value.(*frameModel.DrainExecutorRequest)
+			if !ok {
+				return derror.ErrInvalidMasterMessage.GenWithStackByArgs(value)
+			}
+			d.worker.messageRouter.AppendMessage(topic, msg)
+			return nil
+		})
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if !ok {
+		d.Logger().Panic("duplicate handler", zap.String("topic", topic))
+	}
+	return nil
+}
+
 // Poll implements BaseJobMaster.Poll
 func (d *DefaultBaseJobMaster) Poll(ctx context.Context) error {
 	ctx, cancel := d.errCenter.WithCancelOnFirstError(ctx)
@@ -219,6 +264,9 @@ func (d *DefaultBaseJobMaster) Poll(ctx context.Context) error {
 	if err := d.master.doPoll(ctx); err != nil {
 		return errors.Trace(err)
 	}
+	if err := d.pollRetry(ctx); err != nil {
+		return errors.Trace(err)
+	}
 	if err := d.worker.doPoll(ctx); err != nil {
 		if derror.ErrWorkerHalfExit.NotEqual(err) {
 			return errors.Trace(err)
@@ -231,6 +279,51 @@ func (d *DefaultBaseJobMaster) Poll(ctx context.Context) error {
 	return nil
 }
 
+// pollRetry persists the retry stats, fails the job if the retry policy
+// says so, and delivers the workerOffline events whose backoff has elapsed.
+func (d *DefaultBaseJobMaster) pollRetry(ctx context.Context) error {
+	// The job events are best-effort, failing to persist them doesn't
+	// fail the job.
+	for _, event := range d.retry.popEvents() {
+		event.JobID = d.master.id
+		if err := d.master.frameMetaClient.CreateJobEvent(ctx, event); err != nil {
+			d.Logger().Warn("failed to record job event",
+				zap.String("type", string(event.Type)), zap.Error(err))
+		}
+	}
+
+	if d.retry.statsDirty {
+		if err := d.persistRetryStats(ctx); err != nil {
+			return errors.Trace(err)
+		}
+		d.retry.statsDirty = false
+	}
+
+	if d.retry.err != nil {
+		d.Logger().Warn("job fails due to its retry policy", zap.Error(d.retry.err))
+		if err := d.Exit(ctx, ExitReasonFailed, d.retry.err, ""); err != nil {
+			return errors.Trace(err)
+		}
+		return d.retry.err
+	}
+
+	for _, event := range d.retry.popDue(d.master.clock.Now()) {
+		if err := d.impl.OnWorkerOffline(event.worker, event.reason); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return nil
+}
+
+// persistRetryStats updates only the retry stats in the master meta, the
+// target status may be changed by the job manager concurrently.
+func (d *DefaultBaseJobMaster) persistRetryStats(ctx context.Context) error {
+	return errors.Trace(d.master.frameMetaClient.UpdateJobExt(ctx, d.master.id, map[string]interface{}{
+		frameModel.MasterMetaExtRestartCount: d.retry.restartCount,
+		frameModel.MasterMetaExtLastError:    d.retry.lastError,
+	}))
+}
+
 // GetWorkers implements BaseJobMaster.GetWorkers
 func (d *DefaultBaseJobMaster) GetWorkers() map[frameModel.WorkerID]WorkerHandle {
 	return d.master.GetWorkers()
@@ -277,6 +370,11 @@ func (d *DefaultBaseJobMaster) CreateWorker(workerType WorkerType, config Worker
 	return d.master.CreateWorker(workerType, config, cost, resources...)
 }
 
+// CreateWorkerWithOpts implements BaseJobMaster.CreateWorkerWithOpts
+func (d *DefaultBaseJobMaster) CreateWorkerWithOpts(workerType WorkerType, config WorkerConfig, cost model.RescUnit, opts ...CreateWorkerOpt) (frameModel.WorkerID, error) {
+	return d.master.CreateWorkerWithOpts(workerType, config, cost, opts...)
+}
+
 // UpdateStatus delegates the UpdateStatus of inner worker
 func (d *DefaultBaseJobMaster) UpdateStatus(ctx context.Context, status frameModel.WorkerStatus) error {
 	ctx, cancel := d.errCenter.WithCancelOnFirstError(ctx)
@@ -350,7 +448,8 @@ func (d *DefaultBaseJobMaster) TriggerOpenAPIInitialize(apiGroup *gin.RouterGrou
 func (d *DefaultBaseJobMaster) IsBaseJobMasterExt() {}
 
 type jobMasterImplAsWorkerImpl struct {
-	inner JobMasterImpl
+	inner  JobMasterImpl
+	master *DefaultBaseMaster
 }
 
 func (j *jobMasterImplAsWorkerImpl) InitImpl(ctx context.Context) error {
@@ -368,6 +467,18 @@ func (j *jobMasterImplAsWorkerImpl) Workload() model.RescUnit {
 }
 
 func (j *jobMasterImplAsWorkerImpl) OnMasterMessage(topic p2p.Topic, message interface{}) error {
+	if msg, ok := message.(*frameModel.DrainExecutorRequest); ok {
+		// The migrated workers go offline and are recreated by the
+		// failover logic of the JobMasterImpl, so the JobMasterImpl
+		// doesn't need to be aware of the draining.
+		ctx, cancel := context.WithTimeout(context.Background(), drainExecutorTimeout)
+		defer cancel()
+		if _, err := j.master.MigrateWorkers(ctx, msg.ExecutorID, msg.PinnedWorkers); err != nil {
+			j.master.Logger().Warn("failed to migrate workers",
+				zap.String("executor-id", string(msg.ExecutorID)), zap.Error(err))
+		}
+		return nil
+	}
 	return j.inner.OnJobManagerMessage(topic, message)
 }
 
@@ -377,7 +488,9 @@ func (j *jobMasterImplAsWorkerImpl) CloseImpl(ctx context.Context) error {
 }
 
 type jobMasterImplAsMasterImpl struct {
-	inner JobMasterImpl
+	inner  JobMasterImpl
+	master *DefaultBaseMaster
+	retry  *workerRetryTracker
 }
 
 func (j *jobMasterImplAsMasterImpl) OnWorkerStatusUpdated(worker WorkerHandle, newStatus *frameModel.WorkerStatus) error {
@@ -407,7 +520,27 @@ func (j *jobMasterImplAsMasterImpl) OnWorkerOnline(worker WorkerHandle) error {
 }
 
 func (j *jobMasterImplAsMasterImpl) OnWorkerOffline(worker WorkerHandle, reason error) error {
-	return j.inner.OnWorkerOffline(worker, reason)
+	restartKey := j.master.takeRestartKey(worker.ID())
+	if j.master.takeMigrating(worker.ID()) || !isWorkerFailure(reason) {
+		return j.inner.OnWorkerOffline(worker, reason)
+	}
+
+	errMsg := workerErrorMessage(worker, reason)
+	backoff, ok := j.retry.onWorkerFailed(worker.ID(), restartKey, errMsg, j.master.clock.Now())
+	if !ok {
+		// The job fails in the next poll.
+		return nil
+	}
+	if backoff <= 0 {
+		return j.inner.OnWorkerOffline(worker, reason)
+	}
+	j.master.Logger().Info("worker failed, delay restarting it",
+		zap.String("worker-id", worker.ID()),
+		zap.String("restart-key", restartKey),
+		zap.Duration("backoff", backoff),
+		zap.String("error", errMsg))
+	j.retry.delay(worker, reason, j.master.clock.Now().Add(backoff))
+	return nil
 }
 
 func (j *jobMasterImplAsMasterImpl) OnWorkerMessage(worker WorkerHandle, topic p2p.Topic, message interface{}) error {
@@ -27,14 +27,17 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/pingcap/tiflow/engine/framework/internal/master"
 	frameModel "github.com/pingcap/tiflow/engine/framework/model"
 	"github.com/pingcap/tiflow/engine/model"
 	"github.com/pingcap/tiflow/engine/pkg/client"
+	"github.com/pingcap/tiflow/engine/pkg/clock"
 	dcontext "github.com/pingcap/tiflow/engine/pkg/context"
 	"github.com/pingcap/tiflow/engine/pkg/deps"
 	metaMock "github.com/pingcap/tiflow/engine/pkg/meta/mock"
 	pkgOrm "github.com/pingcap/tiflow/engine/pkg/orm"
 	"github.com/pingcap/tiflow/engine/pkg/p2p"
+	derror "github.com/pingcap/tiflow/pkg/errors"
 )
 
 const (
@@ -251,6 +254,46 @@ func TestBaseJobMasterBasics(t *testing.T) {
 	jobMaster.mu.Unlock()
 }
 
+func TestBaseJobMasterDrainExecutor(t *testing.T) {
+	t.Parallel()
+
+	jobMaster := &testJobMasterImpl{}
+	base := newBaseJobMasterForTests(t, jobMaster)
+	jobMaster.base = base
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	jobMaster.mu.Lock()
+	jobMaster.On("InitImpl", mock.Anything).Return(nil)
+	jobMaster.On("Tick", mock.Anything).Return(nil)
+	jobMaster.mu.Unlock()
+
+	err := base.Init(ctx)
+	require.NoError(t, err)
+
+	// The drain request is handled by the framework, instead of being
+	// passed to OnJobManagerMessage.
+	err = base.worker.messageHandlerManager.(*p2p.MockMessageHandlerManager).InvokeHandler(t,
+		frameModel.DrainExecutorRequestTopic(jobManagerID, jobMasterID),
+		"executor-0",
+		&frameModel.DrainExecutorRequest{
+			FromMasterID: jobManagerID,
+			ExecutorID:   "executor-1",
+		})
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		err := base.Poll(ctx)
+		require.NoError(t, err)
+		return len(base.worker.messageRouter.buffer) == 0
+	}, time.Second, 10*time.Millisecond)
+
+	jobMaster.mu.Lock()
+	jobMaster.AssertNotCalled(t, "OnJobManagerMessage", mock.Anything, mock.Anything)
+	jobMaster.mu.Unlock()
+}
+
 func TestOnOpenAPIInitialized(t *testing.T) {
 	t.Parallel()
 
@@ -276,6 +319,7 @@ func TestJobMasterExit(t *testing.T) {
 		exitReason       ExitReason
 		err              error
 		extMsg           string
+		targetStatus     frameModel.MasterStatusCode
 		expectedStatus   frameModel.MasterStatusCode
 		expectedErrorMsg string
 		expectedExtMsg   string
@@ -312,6 +356,15 @@ func TestJobMasterExit(t *testing.T) {
 			expectedErrorMsg: "test canceled with error",
 			expectedExtMsg:   "test canceled",
 		},
+		{
+			exitReason:       ExitReasonCanceled,
+			err:              nil,
+			extMsg:           "test paused",
+			targetStatus:     frameModel.MasterStatusPaused,
+			expectedStatus:   frameModel.MasterStatusPaused,
+			expectedErrorMsg: "",
+			expectedExtMsg:   "test paused",
+		},
 		{
 			exitReason:       ExitReasonFailed,
 			err:              nil,
@@ -376,6 +429,14 @@ func TestJobMasterExit(t *testing.T) {
 		jobMaster.On("CloseImpl", mock.Anything).Return(nil)
 		jobMaster.mu.Unlock()
 
+		// the target status is set by job manager after the master is initialized
+		if cs.targetStatus != frameModel.MasterStatusUninit {
+			meta, err := jobMaster.base.master.frameMetaClient.GetJobByID(ctx, jobMaster.base.ID())
+			require.NoError(t, err)
+			meta.Ext.TargetStatus = cs.targetStatus
+			require.NoError(t, jobMaster.base.master.frameMetaClient.UpdateJob(ctx, meta))
+		}
+
 		// test exit status
 		err = jobMaster.base.Exit(ctx, cs.exitReason, cs.err, cs.extMsg)
 		require.NoError(t, err)
@@ -383,6 +444,7 @@ func TestJobMasterExit(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, cs.expectedStatus, meta.StatusCode)
 		require.Equal(t, cs.expectedExtMsg, meta.ExtMsg)
+		require.Equal(t, cs.targetStatus, meta.Ext.TargetStatus)
 
 		err = jobMaster.base.Close(ctx)
 		require.NoError(t, err)
@@ -392,3 +454,100 @@ func TestJobMasterExit(t *testing.T) {
 		jobMaster.mu.Unlock()
 	}
 }
+
+func TestBaseJobMasterRetryPolicy(t *testing.T) {
+	t.Parallel()
+
+	jobMaster := &testJobMasterImpl{}
+	base := newBaseJobMasterForTests(t, jobMaster)
+	jobMaster.base = base
+	mockClock := clock.NewMock()
+	base.master.clock = mockClock
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	err := base.master.frameMetaClient.UpsertJob(ctx, &frameModel.MasterMetaKVData{
+		ID:         jobMasterID,
+		StatusCode: frameModel.MasterStatusUninit,
+		Ext: frameModel.MasterMetaExt{
+			RetryPolicy: &frameModel.RetryPolicy{
+				MaxRestarts:    1,
+				InitialBackoff: time.Second,
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	jobMaster.mu.Lock()
+	jobMaster.On("InitImpl", mock.Anything).Return(nil)
+	jobMaster.On("Tick", mock.Anything).Return(nil)
+	jobMaster.mu.Unlock()
+	require.NoError(t, base.Init(ctx))
+
+	masterImpl := base.master.Impl.(*jobMasterImplAsMasterImpl)
+	newFailedWorker := func(workerID frameModel.WorkerID) WorkerHandle {
+		base.master.restartKeys.Store(workerID, "key-1")
+		return &master.MockHandle{
+			WorkerID: workerID,
+			WorkerStatus: &frameModel.WorkerStatus{
+				Code:     frameModel.WorkerStatusError,
+				ErrorMsg: "failed",
+			},
+			IsTombstone: true,
+		}
+	}
+
+	// The offline event of a finished worker is delivered immediately.
+	finished := &master.MockHandle{WorkerID: "worker-0", IsTombstone: true}
+	reason := derror.ErrWorkerFinish.FastGenByArgs()
+	jobMaster.mu.Lock()
+	jobMaster.On("OnWorkerOffline", finished, reason).Return(nil)
+	jobMaster.mu.Unlock()
+	require.NoError(t, masterImpl.OnWorkerOffline(finished, reason))
+
+	// The job is being paused by the job manager.
+	require.NoError(t, base.master.frameMetaClient.UpdateJobExt(ctx, jobMasterID, map[string]interface{}{
+		frameModel.MasterMetaExtTargetStatus: frameModel.MasterStatusPaused,
+	}))
+
+	// The offline event of a failed worker is delayed by the backoff.
+	worker1 := newFailedWorker("worker-1")
+	reason = derror.ErrWorkerOffline.FastGenByArgs("worker-1")
+	require.NoError(t, masterImpl.OnWorkerOffline(worker1, reason))
+	require.NoError(t, base.Poll(ctx))
+	jobMaster.mu.Lock()
+	jobMaster.AssertNotCalled(t, "OnWorkerOffline", worker1, reason)
+	jobMaster.On("OnWorkerOffline", worker1, reason).Return(nil)
+	jobMaster.mu.Unlock()
+
+	meta, err := base.master.frameMetaClient.GetJobByID(ctx, jobMasterID)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), meta.Ext.RestartCount)
+	require.Equal(t, "failed", meta.Ext.LastError)
+	// The target status set by the job manager is kept.
+	require.Equal(t, frameModel.MasterStatusPaused, meta.Ext.TargetStatus)
+
+	mockClock.Add(time.Second)
+	require.NoError(t, base.Poll(ctx))
+	jobMaster.mu.Lock()
+	jobMaster.AssertCalled(t, "OnWorkerOffline", worker1, reason)
+	jobMaster.mu.Unlock()
+
+	// The job fails when the restarts are exhausted.
+	worker2 := newFailedWorker("worker-2")
+	require.NoError(t, masterImpl.OnWorkerOffline(worker2, reason))
+	jobMaster.mu.Lock()
+	jobMaster.On("CloseImpl", mock.Anything).Return(nil)
+	jobMaster.mu.Unlock()
+	err = base.Poll(ctx)
+	require.True(t, derror.ErrWorkerRestartExhausted.Equal(err))
+
+	meta, err = base.master.frameMetaClient.GetJobByID(ctx, jobMasterID)
+	require.NoError(t, err)
+	require.Equal(t, frameModel.MasterStatusFailed, meta.StatusCode)
+	require.Contains(t, meta.ErrorMsg, "ErrWorkerRestartExhausted")
+	require.Equal(t, int64(1), meta.Ext.RestartCount)
+
+	require.NoError(t, base.Close(ctx))
+}
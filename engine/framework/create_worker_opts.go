@@ -0,0 +1,85 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package framework
+
+import (
+	pb "github.com/pingcap/tiflow/engine/enginepb"
+	resModel "github.com/pingcap/tiflow/engine/pkg/externalresource/resourcemeta/model"
+	schedModel "github.com/pingcap/tiflow/engine/servermaster/scheduler/model"
+	"github.com/pingcap/tiflow/pkg/label"
+)
+
+// CreateWorkerOpt specifies an option for creating a worker.
+type CreateWorkerOpt func(opts *createWorkerOpts)
+
+type createWorkerOpts struct {
+	Resources    []resModel.ResourceID
+	Selectors    []*pb.Selector
+	Preferences  []*pb.SchedulePreference
+	AntiAffinity *antiAffinityOpt
+	RestartKey   string
+}
+
+type antiAffinityOpt struct {
+	Required bool
+}
+
+// CreateWorkerWithResourceRequirements specifies the file system resources
+// that the worker needs to access.
+func CreateWorkerWithResourceRequirements(resources ...resModel.ResourceID) CreateWorkerOpt {
+	return func(opts *createWorkerOpts) {
+		opts.Resources = append(opts.Resources, resources...)
+	}
+}
+
+// CreateWorkerWithSelectors specifies the hard affinity of the worker, it can
+// only be scheduled to executors whose labels match all the selectors.
+func CreateWorkerWithSelectors(selectors ...*label.Selector) CreateWorkerOpt {
+	return func(opts *createWorkerOpts) {
+		for _, selector := range selectors {
+			opts.Selectors = append(opts.Selectors, schedModel.SelectorToPB(selector))
+		}
+	}
+}
+
+// CreateWorkerWithPreference specifies a soft affinity of the worker,
+// executors matching preferences with a higher total weight are preferred.
+// The weight must be in [schedModel.MinPreferenceWeight, schedModel.MaxPreferenceWeight].
+func CreateWorkerWithPreference(selector *label.Selector, weight int64) CreateWorkerOpt {
+	return func(opts *createWorkerOpts) {
+		opts.Preferences = append(opts.Preferences, &pb.SchedulePreference{
+			Selector: schedModel.SelectorToPB(selector),
+			Weight:   weight,
+		})
+	}
+}
+
+// CreateWorkerWithAntiAffinity spreads the workers of the same master across
+// executors. If required is true, the worker is never scheduled to an executor
+// running another worker of the master, otherwise such executors are avoided
+// if possible.
+func CreateWorkerWithAntiAffinity(required bool) CreateWorkerOpt {
+	return func(opts *createWorkerOpts) {
+		opts.AntiAffinity = &antiAffinityOpt{Required: required}
+	}
+}
+
+// CreateWorkerWithRestartKey specifies the key identifying the worker across
+// restarts. The failures of workers with the same key are counted together
+// by the retry policy of the job. The worker ID is used by default.
+func CreateWorkerWithRestartKey(key string) CreateWorkerOpt {
+	return func(opts *createWorkerOpts) {
+		opts.RestartKey = key
+	}
+}
@@ -16,6 +16,7 @@ package fake
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"strings"
 	"sync"
@@ -204,7 +205,8 @@ func (m *Master) InitImpl(ctx context.Context) error {
 
 // This function is not thread safe, it must be called with m.workerListMu locked
 func (m *Master) createWorker(wcfg *WorkerConfig) error {
-	workerID, err := m.CreateWorker(framework.FakeTask, wcfg, 1)
+	workerID, err := m.CreateWorkerWithOpts(framework.FakeTask, wcfg, 1,
+		framework.CreateWorkerWithRestartKey(fmt.Sprintf("business-%d", wcfg.ID)))
 	if err != nil {
 		return errors.Trace(err)
 	}
@@ -15,6 +15,7 @@ package master
 
 import (
 	"context"
+	"sort"
 	"sync"
 	"time"
 
@@ -464,6 +465,50 @@ func (m *WorkerManager) GetWorkers() map[frameModel.WorkerID]WorkerHandle {
 	return ret
 }
 
+// GetWorkersOnExecutor gets the online workers running on the given executor.
+func (m *WorkerManager) GetWorkersOnExecutor(executorID model.ExecutorID) []RunningHandle {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var ret []RunningHandle
+	for workerID, entry := range m.workerEntries {
+		if entry.State() != workerEntryNormal || entry.executorID != executorID {
+			continue
+		}
+		ret = append(ret, &runningHandleImpl{
+			workerID:   workerID,
+			executorID: entry.executorID,
+			manager:    m,
+		})
+	}
+	// for determinism
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].ID() < ret[j].ID()
+	})
+	return ret
+}
+
+// GetWorkerExecutors returns the executor of every worker that is alive or
+// being started, so an executor appears once per worker on it.
+func (m *WorkerManager) GetWorkerExecutors() map[frameModel.WorkerID]model.ExecutorID {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	ret := make(map[frameModel.WorkerID]model.ExecutorID)
+	for workerID, entry := range m.workerEntries {
+		switch entry.State() {
+		case workerEntryWait, workerEntryCreated, workerEntryNormal:
+		default:
+			continue
+		}
+		if entry.executorID == "" {
+			continue
+		}
+		ret[workerID] = entry.executorID
+	}
+	return ret
+}
+
 // IsInitialized returns true after the worker manager has checked all tombstone
 // workers are online or dead.
 func (m *WorkerManager) IsInitialized() bool {
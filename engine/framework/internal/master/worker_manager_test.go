@@ -29,6 +29,7 @@ import (
 	"github.com/pingcap/tiflow/engine/framework/metadata"
 	frameModel "github.com/pingcap/tiflow/engine/framework/model"
 	"github.com/pingcap/tiflow/engine/framework/statusutil"
+	"github.com/pingcap/tiflow/engine/model"
 	"github.com/pingcap/tiflow/engine/pkg/clock"
 	pkgOrm "github.com/pingcap/tiflow/engine/pkg/orm"
 	"github.com/pingcap/tiflow/engine/pkg/p2p"
@@ -251,6 +252,64 @@ func TestCreateWorkerAndWorkerOnline(t *testing.T) {
 	suite.Close()
 }
 
+func TestGetWorkersOnExecutor(t *testing.T) {
+	t.Parallel()
+
+	suite := NewWorkerManageTestSuite(true)
+	wEpoch := int64(2)
+	suite.manager.BeforeStartingWorker("worker-1", "executor-1", wEpoch)
+	suite.manager.BeforeStartingWorker("worker-2", "executor-2", wEpoch)
+	suite.manager.BeforeStartingWorker("worker-3", "executor-1", wEpoch)
+
+	suite.SimulateHeartbeat("worker-1", 1, wEpoch, "executor-1", false)
+	suite.SimulateHeartbeat("worker-2", 1, wEpoch, "executor-2", false)
+	event := suite.WaitForEvent(t, "worker-1")
+	require.Equal(t, workerOnlineEvent, event.Tp)
+	event = suite.WaitForEvent(t, "worker-2")
+	require.Equal(t, workerOnlineEvent, event.Tp)
+
+	// worker-3 is not online yet, so it is not returned.
+	handles := suite.manager.GetWorkersOnExecutor("executor-1")
+	require.Len(t, handles, 1)
+	require.Equal(t, "worker-1", handles[0].ID())
+
+	suite.SimulateHeartbeat("worker-3", 1, wEpoch, "executor-1", false)
+	event = suite.WaitForEvent(t, "worker-3")
+	require.Equal(t, workerOnlineEvent, event.Tp)
+	handles = suite.manager.GetWorkersOnExecutor("executor-1")
+	require.Len(t, handles, 2)
+	require.Equal(t, "worker-1", handles[0].ID())
+	require.Equal(t, "worker-3", handles[1].ID())
+
+	require.Empty(t, suite.manager.GetWorkersOnExecutor("executor-3"))
+	suite.Close()
+}
+
+func TestGetWorkerExecutors(t *testing.T) {
+	t.Parallel()
+
+	suite := NewWorkerManageTestSuite(true)
+	wEpoch := int64(2)
+	require.Empty(t, suite.manager.GetWorkerExecutors())
+
+	suite.manager.BeforeStartingWorker("worker-1", "executor-1", wEpoch)
+	suite.manager.BeforeStartingWorker("worker-2", "executor-2", wEpoch)
+	suite.manager.BeforeStartingWorker("worker-3", "executor-1", wEpoch)
+	// workers being started are counted as well.
+	expected := map[frameModel.WorkerID]model.ExecutorID{
+		"worker-1": "executor-1",
+		"worker-2": "executor-2",
+		"worker-3": "executor-1",
+	}
+	require.Equal(t, expected, suite.manager.GetWorkerExecutors())
+
+	suite.SimulateHeartbeat("worker-2", 1, wEpoch, "executor-2", false)
+	event := suite.WaitForEvent(t, "worker-2")
+	require.Equal(t, workerOnlineEvent, event.Tp)
+	require.Equal(t, expected, suite.manager.GetWorkerExecutors())
+	suite.Close()
+}
+
 func TestCreateWorkerAndWorkerTimesOut(t *testing.T) {
 	t.Parallel()
 
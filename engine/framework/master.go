@@ -27,6 +27,7 @@ import (
 	"go.uber.org/atomic"
 	"go.uber.org/dig"
 	"go.uber.org/zap"
+	"golang.org/x/exp/slices"
 
 	pb "github.com/pingcap/tiflow/engine/enginepb"
 	"github.com/pingcap/tiflow/engine/framework/config"
@@ -98,6 +99,7 @@ type MasterImpl interface {
 const (
 	createWorkerWaitQuotaTimeout = 5 * time.Second
 	createWorkerTimeout          = 10 * time.Second
+	drainExecutorTimeout         = 10 * time.Second
 	genEpochTimeout              = 5 * time.Second
 	maxCreateWorkerConcurrency   = 100
 )
@@ -142,6 +144,26 @@ type BaseMaster interface {
 		cost model.RescUnit,
 		resources ...resModel.ResourceID,
 	) (frameModel.WorkerID, error)
+
+	// CreateWorkerWithOpts is the same as CreateWorker, except that
+	// the resources and scheduling constraints are passed by options.
+	CreateWorkerWithOpts(
+		workerType WorkerType,
+		config WorkerConfig,
+		cost model.RescUnit,
+		opts ...CreateWorkerOpt,
+	) (frameModel.WorkerID, error)
+
+	// MigrateWorkers asks the workers running on the given executor to exit,
+	// so that they are recreated on other executors as in a failover.
+	// The workers in pinnedWorkers are skipped, because they own local file
+	// resources on the executor and can't be moved.
+	// It returns the IDs of the workers that are asked to migrate.
+	MigrateWorkers(
+		ctx context.Context,
+		executorID model.ExecutorID,
+		pinnedWorkers []frameModel.WorkerID,
+	) ([]frameModel.WorkerID, error)
 }
 
 // DefaultBaseMaster implements BaseMaster interface
@@ -183,6 +205,13 @@ type DefaultBaseMaster struct {
 	// masterProjectInfo is the projectInfo of itself
 	masterProjectInfo tenant.ProjectInfo
 
+	// restartKeys keeps the <WorkerID, restart key> map of the workers
+	// created with CreateWorkerWithRestartKey.
+	restartKeys sync.Map
+	// migratingWorkers keeps the workers asked to migrate by MigrateWorkers,
+	// whose next offline event is not a failure.
+	migratingWorkers sync.Map
+
 	// business kvclient with namespace
 	businessMetaKVClient metaModel.KVClient
 
@@ -474,6 +503,77 @@ func (m *DefaultBaseMaster) GetWorkers() map[frameModel.WorkerID]WorkerHandle {
 	return m.workerManager.GetWorkers()
 }
 
+// antiAffinityOf returns the anti-affinity in the ScheduleTaskRequest, the
+// workers of the master form the anti-affinity group.
+func (m *DefaultBaseMaster) antiAffinityOf(options *createWorkerOpts) *pb.AntiAffinity {
+	if options.AntiAffinity == nil {
+		return nil
+	}
+	ret := &pb.AntiAffinity{
+		Group:    m.id,
+		Required: options.AntiAffinity.Required,
+	}
+	for workerID, executorID := range m.workerManager.GetWorkerExecutors() {
+		if ret.TaskExecutors == nil {
+			ret.TaskExecutors = make(map[string]string)
+		}
+		ret.TaskExecutors[workerID] = string(executorID)
+	}
+	return ret
+}
+
+// MigrateWorkers implements BaseMaster.MigrateWorkers
+func (m *DefaultBaseMaster) MigrateWorkers(
+	ctx context.Context,
+	executorID model.ExecutorID,
+	pinnedWorkers []frameModel.WorkerID,
+) ([]frameModel.WorkerID, error) {
+	var migrated []frameModel.WorkerID
+	for _, handle := range m.workerManager.GetWorkersOnExecutor(executorID) {
+		if slices.Contains(pinnedWorkers, handle.ID()) {
+			m.Logger().Info("worker owns local files on the executor, skip migrating it",
+				zap.String("worker-id", handle.ID()),
+				zap.String("executor-id", string(executorID)))
+			continue
+		}
+		topic := frameModel.MigrateWorkerRequestTopic(m.id, handle.ID())
+		msg := &frameModel.MigrateWorkerRequest{
+			SendTime:     m.clock.Mono(),
+			FromMasterID: m.id,
+			Epoch:        m.currentEpoch.Load(),
+			ExecutorID:   executorID,
+		}
+		m.migratingWorkers.Store(handle.ID(), struct{}{})
+		if err := handle.SendMessage(ctx, topic, msg, true /*nonblocking*/); err != nil {
+			m.migratingWorkers.Delete(handle.ID())
+			return migrated, errors.Trace(err)
+		}
+		migrated = append(migrated, handle.ID())
+	}
+	if len(migrated) > 0 {
+		m.Logger().Info("workers are asked to migrate",
+			zap.String("executor-id", string(executorID)),
+			zap.Strings("worker-ids", migrated))
+	}
+	return migrated, nil
+}
+
+// takeRestartKey returns the restart key of the worker and forgets it.
+// The worker ID is used if the worker is not created with a restart key.
+func (m *DefaultBaseMaster) takeRestartKey(workerID frameModel.WorkerID) string {
+	if key, ok := m.restartKeys.LoadAndDelete(workerID); ok {
+		return key.(string)
+	}
+	return workerID
+}
+
+// takeMigrating returns whether the worker has been asked to migrate,
+// and forgets it.
+func (m *DefaultBaseMaster) takeMigrating(workerID frameModel.WorkerID) bool {
+	_, ok := m.migratingWorkers.LoadAndDelete(workerID)
+	return ok
+}
+
 func (m *DefaultBaseMaster) doClose() {
 	closeCtx, cancel := context.WithTimeout(context.Background(), time.Second*3)
 	defer cancel()
@@ -555,7 +655,7 @@ func (m *DefaultBaseMaster) prepareWorkerConfig(
 	workerType frameModel.WorkerType, config WorkerConfig,
 ) (rawConfig []byte, workerID frameModel.WorkerID, err error) {
 	switch workerType {
-	case CvsJobMaster, FakeJobMaster, DMJobMaster:
+	case CvsJobMaster, FakeJobMaster, DMJobMaster, CdcJobMaster:
 		masterMeta, ok := config.(*frameModel.MasterMetaKVData)
 		if !ok {
 			err = derror.ErrMasterInvalidMeta.GenWithStackByArgs(config)
@@ -588,11 +688,27 @@ func (m *DefaultBaseMaster) CreateWorker(
 	cost model.RescUnit,
 	resources ...resModel.ResourceID,
 ) (frameModel.WorkerID, error) {
+	return m.CreateWorkerWithOpts(workerType, config, cost,
+		CreateWorkerWithResourceRequirements(resources...))
+}
+
+// CreateWorkerWithOpts implements BaseMaster.CreateWorkerWithOpts
+func (m *DefaultBaseMaster) CreateWorkerWithOpts(
+	workerType frameModel.WorkerType,
+	config WorkerConfig,
+	cost model.RescUnit,
+	opts ...CreateWorkerOpt,
+) (frameModel.WorkerID, error) {
+	options := &createWorkerOpts{}
+	for _, opt := range opts {
+		opt(options)
+	}
+
 	m.Logger().Info("CreateWorker",
 		zap.Int64("worker-type", int64(workerType)),
 		zap.Any("worker-config", config),
 		zap.Int("cost", int(cost)),
-		zap.Any("resources", resources),
+		zap.Any("options", options),
 		zap.String("master-id", m.id))
 
 	errCtx, cancel := m.errCenter.WithCancelOnFirstError(context.Background())
@@ -607,6 +723,9 @@ func (m *DefaultBaseMaster) CreateWorker(
 	if err != nil {
 		return "", err
 	}
+	if options.RestartKey != "" {
+		m.restartKeys.Store(workerID, options.RestartKey)
+	}
 
 	go func() {
 		defer func() {
@@ -618,10 +737,16 @@ func (m *DefaultBaseMaster) CreateWorker(
 		requestCtx, cancel := context.WithTimeout(errCtx, createWorkerTimeout)
 		defer cancel()
 
+		projectInfo := m.GetProjectInfo(workerID)
 		resp, err := m.serverMasterClient.ScheduleTask(requestCtx, &pb.ScheduleTaskRequest{
 			TaskId:               workerID,
 			Cost:                 int64(cost),
-			ResourceRequirements: resModel.ToResourceRequirement(m.id, resources...),
+			ResourceRequirements: resModel.ToResourceRequirement(m.id, options.Resources...),
+			TenantId:             projectInfo.TenantID(),
+			ProjectId:            projectInfo.ProjectID(),
+			Selectors:            options.Selectors,
+			Preferences:          options.Preferences,
+			AntiAffinity:         m.antiAffinityOf(options),
 		})
 		if err != nil {
 			// TODO log the gRPC errors from a lower level such as by an interceptor.
@@ -699,12 +824,27 @@ func (m *DefaultBaseMaster) Exit(ctx context.Context, exitReason ExitReason, err
 }
 
 func (m *DefaultBaseMaster) exitWithoutSetErrCenter(ctx context.Context, exitReason ExitReason, err error, extMsg string) (errRet error) {
+	metaClient := metadata.NewMasterMetadataClient(m.id, m.frameMetaClient)
+	// The config and the target status of the job could be changed by the
+	// job manager after the master is initialized, reload them to avoid
+	// overwriting them with the stale ones.
+	if latest, loadErr := metaClient.Load(ctx); loadErr == nil {
+		m.masterMeta.Config = latest.Config
+		m.masterMeta.Ext = latest.Ext
+	} else {
+		m.Logger().Warn("failed to load the latest master meta before exit",
+			zap.Error(loadErr))
+	}
+
 	switch exitReason {
 	case ExitReasonFinished:
 		m.masterMeta.StatusCode = frameModel.MasterStatusFinished
 	case ExitReasonCanceled:
 		// TODO: replace stop with cancel
 		m.masterMeta.StatusCode = frameModel.MasterStatusStopped
+		if m.masterMeta.Ext.TargetStatus == frameModel.MasterStatusPaused {
+			m.masterMeta.StatusCode = frameModel.MasterStatusPaused
+		}
 	case ExitReasonFailed:
 		m.masterMeta.StatusCode = frameModel.MasterStatusFailed
 	default:
@@ -716,7 +856,6 @@ func (m *DefaultBaseMaster) exitWithoutSetErrCenter(ctx context.Context, exitRea
 	}
 	m.masterMeta.ExtMsg = extMsg
 
-	metaClient := metadata.NewMasterMetadataClient(m.id, m.frameMetaClient)
 	return metaClient.Update(ctx, m.masterMeta)
 }
 
@@ -750,7 +889,6 @@ func (m *DefaultBaseMaster) GetProjectInfo(masterID frameModel.MasterID) tenant.
 // NOTICE: Only used by JobMananger when failover
 func (m *DefaultBaseMaster) InitProjectInfosAfterRecover(jobs []*frameModel.MasterMetaKVData) {
 	for _, meta := range jobs {
-		// TODO: fix the TenantID
-		m.workerProjectMap.Store(meta.ID, tenant.NewProjectInfo("", meta.ProjectID))
+		m.workerProjectMap.Store(meta.ID, tenant.NewProjectInfo(meta.Ext.TenantID, meta.ProjectID))
 	}
 }
@@ -19,14 +19,20 @@ import (
 	"testing"
 	"time"
 
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	pb "github.com/pingcap/tiflow/engine/enginepb"
 	"github.com/pingcap/tiflow/engine/framework/metadata"
 	frameModel "github.com/pingcap/tiflow/engine/framework/model"
 	"github.com/pingcap/tiflow/engine/framework/statusutil"
+	"github.com/pingcap/tiflow/engine/model"
 	resourcemeta "github.com/pingcap/tiflow/engine/pkg/externalresource/resourcemeta/model"
+	"github.com/pingcap/tiflow/engine/pkg/p2p"
+	"github.com/pingcap/tiflow/engine/pkg/tenant"
 	"github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/label"
 	"github.com/pingcap/tiflow/pkg/uuid"
 )
 
@@ -234,6 +240,76 @@ func TestMasterCreateWorker(t *testing.T) {
 	}, 1*time.Second, 10*time.Millisecond)
 }
 
+func TestMasterMigrateWorkers(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	master := NewMockMasterImpl(t, "", masterName)
+	master.timeoutConfig.WorkerTimeoutDuration = time.Second * 1000
+	master.timeoutConfig.MasterHeartbeatCheckLoopInterval = time.Millisecond * 10
+	master.uuidGen = uuid.NewMock()
+	MockMasterPrepareMeta(ctx, t, master)
+
+	epoch, err := master.MetaKVClient().GenEpoch(ctx)
+	require.NoError(t, err)
+
+	master.On("InitImpl", mock.Anything).Return(nil)
+	err = master.Init(ctx)
+	require.NoError(t, err)
+
+	MockBaseMasterCreateWorker(
+		t,
+		master.DefaultBaseMaster,
+		workerTypePlaceholder,
+		&dummyConfig{param: 1},
+		100,
+		masterName,
+		workerID1,
+		executorNodeID1,
+		nil,
+		epoch+3,
+	)
+	_, err = master.CreateWorker(workerTypePlaceholder, &dummyConfig{param: 1}, 100)
+	require.NoError(t, err)
+
+	master.On("OnWorkerDispatched", mock.AnythingOfType("*master.runningHandleImpl"), nil).Return(nil)
+	master.On("OnWorkerOnline", mock.AnythingOfType("*master.runningHandleImpl")).Return(nil)
+	master.On("Tick", mock.Anything).Return(nil)
+	require.Eventually(t, func() bool {
+		if err = MockBaseMasterWorkerHeartbeat(t, master.DefaultBaseMaster,
+			masterName, workerID1, executorNodeID1); err != nil {
+			return false
+		}
+		err = master.Poll(ctx)
+		require.NoError(t, err)
+		return master.onlineWorkerCount.Load() == 1
+	}, time.Second*10, time.Millisecond*10)
+
+	sender := master.messageSender.(*p2p.MockMessageSender)
+	topic := frameModel.MigrateWorkerRequestTopic(masterName, workerID1)
+
+	// No worker runs on executorNodeID3.
+	migrated, err := master.MigrateWorkers(ctx, executorNodeID3, nil)
+	require.NoError(t, err)
+	require.Empty(t, migrated)
+
+	// The pinned worker is not migrated.
+	migrated, err = master.MigrateWorkers(ctx, executorNodeID1, []frameModel.WorkerID{workerID1})
+	require.NoError(t, err)
+	require.Empty(t, migrated)
+	_, ok := sender.TryPop(executorNodeID1, topic)
+	require.False(t, ok)
+
+	migrated, err = master.MigrateWorkers(ctx, executorNodeID1, nil)
+	require.NoError(t, err)
+	require.Equal(t, []frameModel.WorkerID{workerID1}, migrated)
+	msg, ok := sender.TryPop(executorNodeID1, topic)
+	require.True(t, ok)
+	require.Equal(t, model.ExecutorID(executorNodeID1), msg.(*frameModel.MigrateWorkerRequest).ExecutorID)
+}
+
 func TestMasterCreateWorkerMetError(t *testing.T) {
 	t.Parallel()
 
@@ -282,6 +358,73 @@ func TestMasterCreateWorkerMetError(t *testing.T) {
 	}
 }
 
+func TestMasterCreateWorkerWithOpts(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	master := NewMockMasterImpl(t, "", masterName)
+	master.timeoutConfig.MasterHeartbeatCheckLoopInterval = time.Millisecond * 10
+	master.uuidGen = uuid.NewMock()
+	MockMasterPrepareMeta(ctx, t, master)
+
+	master.On("InitImpl", mock.Anything).Return(nil)
+	err := master.Init(ctx)
+	require.NoError(t, err)
+
+	// another worker of the master is being started on executorNodeID3.
+	master.workerManager.BeforeStartingWorker("worker-0", executorNodeID3, 1)
+
+	expectedSchedulerReq := &pb.ScheduleTaskRequest{
+		TaskId:    workerID1,
+		Cost:      100,
+		TenantId:  tenant.TestProjectInfo.TenantID(),
+		ProjectId: tenant.TestProjectInfo.ProjectID(),
+		Selectors: []*pb.Selector{
+			{Label: "zone", Target: "z1", Op: pb.Selector_Eq},
+		},
+		Preferences: []*pb.SchedulePreference{{
+			Selector: &pb.Selector{Label: "disk", Target: "ssd", Op: pb.Selector_Eq},
+			Weight:   10,
+		}},
+		AntiAffinity: &pb.AntiAffinity{
+			Group:         masterName,
+			TaskExecutors: map[string]string{"worker-0": executorNodeID3},
+		},
+	}
+	master.serverMasterClient.EXPECT().
+		ScheduleTask(gomock.Any(), gomock.Eq(expectedSchedulerReq)).
+		Return(nil, errors.ErrClusterResourceNotEnough.FastGenByArgs()).
+		Times(1)
+	master.uuidGen.(*uuid.MockGenerator).Push(workerID1)
+
+	done := make(chan struct{})
+	master.On("Tick", mock.Anything).Return(nil)
+	master.On("OnWorkerDispatched", mock.Anything, mock.Anything).
+		Return(nil).
+		Run(func(args mock.Arguments) {
+			err := args.Error(1)
+			require.Regexp(t, ".*ErrClusterResourceNotEnough.*", err)
+			close(done)
+		})
+
+	_, err = master.CreateWorkerWithOpts(workerTypePlaceholder, &dummyConfig{param: 1}, 100,
+		CreateWorkerWithSelectors(&label.Selector{Key: "zone", Target: "z1", Op: label.OpEq}),
+		CreateWorkerWithPreference(&label.Selector{Key: "disk", Target: "ssd", Op: label.OpEq}, 10),
+		CreateWorkerWithAntiAffinity(false))
+	require.NoError(t, err)
+
+	for {
+		require.NoError(t, master.Poll(ctx))
+		select {
+		case <-done:
+			return
+		default:
+		}
+	}
+}
+
 func TestPrepareWorkerConfig(t *testing.T) {
 	t.Parallel()
 
@@ -114,6 +114,8 @@ func MockBaseMasterCreateWorker(
 		TaskId:               workerID,
 		Cost:                 int64(cost),
 		ResourceRequirements: resourcemeta.ToResourceRequirement(masterID, resources...),
+		TenantId:             tenant.TestProjectInfo.TenantID(),
+		ProjectId:            tenant.TestProjectInfo.ProjectID(),
 	}
 	master.serverMasterClient.(*client.MockServerMasterClient).EXPECT().
 		ScheduleTask(gomock.Any(), gomock.Eq(expectedSchedulerReq)).
@@ -160,8 +162,10 @@ func MockBaseMasterCreateWorkerMetScheduleTaskError(
 ) {
 	master.uuidGen = uuid.NewMock()
 	expectedSchedulerReq := &pb.ScheduleTaskRequest{
-		TaskId: workerID,
-		Cost:   int64(cost),
+		TaskId:    workerID,
+		Cost:      int64(cost),
+		TenantId:  tenant.TestProjectInfo.TenantID(),
+		ProjectId: tenant.TestProjectInfo.ProjectID(),
 	}
 	master.serverMasterClient.(*client.MockServerMasterClient).EXPECT().
 		ScheduleTask(gomock.Any(), gomock.Eq(expectedSchedulerReq)).
@@ -40,13 +40,36 @@ const (
 	MasterStatusFinished = MasterStatusCode(3)
 	MasterStatusStopped  = MasterStatusCode(4)
 	MasterStatusFailed   = MasterStatusCode(5)
+	MasterStatusPaused   = MasterStatusCode(6)
 	// extend the status code here
 )
 
+// The json names of the MasterMetaExt fields that are updated separately
+// by orm.Client.UpdateJobExt.
+const (
+	MasterMetaExtTargetStatus = "target-status"
+	MasterMetaExtRestartCount = "restart-count"
+	MasterMetaExtLastError    = "last-error"
+)
+
 // MasterMetaExt stores some attributes of job masters that do not need
 // to be indexed.
 type MasterMetaExt struct {
 	Selectors []*label.Selector `json:"selectors"`
+	// TargetStatus is the status that the job is asked to reach, it's only
+	// set to MasterStatusPaused when the job is paused or being paused.
+	TargetStatus MasterStatusCode `json:"target-status,omitempty"`
+	// TenantID is the tenant that the job belongs to. ProjectID is stored
+	// in the indexed column, but the tenant is needed to enforce quotas.
+	TenantID string `json:"tenant-id,omitempty"`
+	// RetryPolicy controls how the failed workers of the job are restarted.
+	RetryPolicy *RetryPolicy `json:"retry-policy,omitempty"`
+	// RestartCount and LastError record the restarts of the failed workers
+	// of the job, they are updated by the job master.
+	RestartCount int64  `json:"restart-count,omitempty"`
+	LastError    string `json:"last-error,omitempty"`
+	// Labels are the user defined labels of the job, used to filter jobs.
+	Labels map[string]string `json:"labels,omitempty"`
 }
 
 // Value implements driver.Valuer.
@@ -102,7 +125,7 @@ type MasterMetaKVData struct {
 	ProjectID  tenant.ProjectID `json:"project-id" gorm:"column:project_id;type:varchar(128) not null;index:idx_mst,priority:1"`
 	ID         MasterID         `json:"id" gorm:"column:id;type:varchar(128) not null;uniqueIndex:uidx_mid"`
 	Tp         WorkerType       `json:"type" gorm:"column:type;type:smallint not null;comment:JobManager(1),CvsJobMaster(2),FakeJobMaster(3),DMJobMaster(4),CDCJobMaster(5)"`
-	StatusCode MasterStatusCode `json:"status" gorm:"column:status;type:tinyint not null;index:idx_mst,priority:2;comment:Uninit(1),Init(2),Finished(3),Stopped(4)"`
+	StatusCode MasterStatusCode `json:"status" gorm:"column:status;type:tinyint not null;index:idx_mst,priority:2;comment:Uninit(1),Init(2),Finished(3),Stopped(4),Failed(5),Paused(6)"`
 	NodeID     p2p.NodeID       `json:"node-id" gorm:"column:node_id;type:varchar(128) not null"`
 	Addr       string           `json:"addr" gorm:"column:address;type:varchar(256) not null"`
 	Epoch      Epoch            `json:"epoch" gorm:"column:epoch;type:bigint not null"`
@@ -17,6 +17,7 @@ import (
 	"fmt"
 	"time"
 
+	engineModel "github.com/pingcap/tiflow/engine/model"
 	"github.com/pingcap/tiflow/engine/pkg/clock"
 	"github.com/pingcap/tiflow/engine/pkg/p2p"
 )
@@ -37,6 +38,18 @@ func WorkerStatusChangeRequestTopic(masterID MasterID, workerID WorkerID) p2p.To
 	return fmt.Sprintf("worker-status-change-req-%s-%s", masterID, workerID)
 }
 
+// DrainExecutorRequestTopic message topic used when the job manager asks a job
+// master to migrate its workers away from a draining executor.
+func DrainExecutorRequestTopic(masterID MasterID, workerID WorkerID) p2p.Topic {
+	return fmt.Sprintf("drain-executor-req-%s-%s", masterID, workerID)
+}
+
+// MigrateWorkerRequestTopic message topic used when a master asks a worker
+// to exit, so that the worker can be recreated on another executor.
+func MigrateWorkerRequestTopic(masterID MasterID, workerID WorkerID) p2p.Topic {
+	return fmt.Sprintf("migrate-worker-req-%s-%s", masterID, workerID)
+}
+
 // HeartbeatPingMessage ships information in heartbeat ping
 type HeartbeatPingMessage struct {
 	SendTime     clock.MonotonicTime `json:"send-time"`
@@ -62,3 +75,22 @@ type StatusChangeRequest struct {
 	Epoch        Epoch               `json:"epoch"`
 	ExpectState  WorkerStatusCode    `json:"expect-state"`
 }
+
+// DrainExecutorRequest ships information when draining an executor
+type DrainExecutorRequest struct {
+	SendTime     clock.MonotonicTime    `json:"send-time"`
+	FromMasterID MasterID               `json:"from-master-id"`
+	Epoch        Epoch                  `json:"epoch"`
+	ExecutorID   engineModel.ExecutorID `json:"executor-id"`
+	// PinnedWorkers are the workers that own local file resources on the
+	// executor, which can't be migrated.
+	PinnedWorkers []WorkerID `json:"pinned-workers"`
+}
+
+// MigrateWorkerRequest ships information when migrating a worker
+type MigrateWorkerRequest struct {
+	SendTime     clock.MonotonicTime    `json:"send-time"`
+	FromMasterID MasterID               `json:"from-master-id"`
+	Epoch        Epoch                  `json:"epoch"`
+	ExecutorID   engineModel.ExecutorID `json:"executor-id"`
+}
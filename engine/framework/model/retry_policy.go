@@ -0,0 +1,87 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"math"
+	"strings"
+	"time"
+)
+
+// defaultBackoffMultiplier is used if RetryPolicy.BackoffMultiplier is not set.
+const defaultBackoffMultiplier = 2.0
+
+// RetryPolicy controls how the failed workers of a job are restarted.
+type RetryPolicy struct {
+	// MaxRestarts is the max number of restarts of a worker within the
+	// failure window. 0 means unlimited.
+	MaxRestarts int `json:"max-restarts,omitempty"`
+	// InitialBackoff is the delay before the first restart of a worker. It is
+	// multiplied by BackoffMultiplier for each of the following restarts in
+	// the failure window, up to MaxBackoff.
+	InitialBackoff    time.Duration `json:"initial-backoff,omitempty"`
+	MaxBackoff        time.Duration `json:"max-backoff,omitempty"`
+	BackoffMultiplier float64       `json:"backoff-multiplier,omitempty"`
+	// FailureWindow is the period in which the failures of a worker are
+	// counted. 0 means the failures are never forgotten.
+	FailureWindow time.Duration `json:"failure-window,omitempty"`
+	// TerminalErrorCodes are the RFC codes of errors defined in pkg/errors,
+	// such as "DFLOW:ErrWorkerFailed". A worker failing with one of them
+	// fails the job instead of being restarted.
+	TerminalErrorCodes []string `json:"terminal-error-codes,omitempty"`
+}
+
+// Backoff returns the delay before restarting a worker that has failed
+// the given times in the failure window, including the current failure.
+func (p *RetryPolicy) Backoff(failures int) time.Duration {
+	if p == nil || p.InitialBackoff <= 0 || failures <= 0 {
+		return 0
+	}
+	multiplier := p.BackoffMultiplier
+	if multiplier <= 0 {
+		multiplier = defaultBackoffMultiplier
+	}
+	backoff := float64(p.InitialBackoff) * math.Pow(multiplier, float64(failures-1))
+	if p.MaxBackoff > 0 && backoff > float64(p.MaxBackoff) {
+		return p.MaxBackoff
+	}
+	if backoff > math.MaxInt64 {
+		return time.Duration(math.MaxInt64)
+	}
+	return time.Duration(backoff)
+}
+
+// Exhausted returns true if a worker that has failed the given times in
+// the failure window, including the current failure, can't be restarted.
+func (p *RetryPolicy) Exhausted(failures int) bool {
+	if p == nil || p.MaxRestarts <= 0 {
+		return false
+	}
+	return failures > p.MaxRestarts
+}
+
+// TerminalErrorCode returns the terminal error code found in the error
+// message of a failed worker, or "" if there is none. The errors defined in
+// pkg/errors are formatted as "[code]message".
+func (p *RetryPolicy) TerminalErrorCode(errMsg string) string {
+	if p == nil {
+		return ""
+	}
+	for _, code := range p.TerminalErrorCodes {
+		if strings.Contains(errMsg, "["+code+"]") {
+			return code
+		}
+	}
+	return ""
+}
@@ -0,0 +1,65 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pingcap/tiflow/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	t.Parallel()
+
+	var nilPolicy *RetryPolicy
+	require.Equal(t, time.Duration(0), nilPolicy.Backoff(3))
+	require.False(t, nilPolicy.Exhausted(100))
+	require.Equal(t, "", nilPolicy.TerminalErrorCode("[DFLOW:ErrWorkerFailed]"))
+
+	policy := &RetryPolicy{
+		MaxRestarts:    3,
+		InitialBackoff: time.Second,
+		MaxBackoff:     5 * time.Second,
+	}
+	require.Equal(t, time.Duration(0), policy.Backoff(0))
+	require.Equal(t, time.Second, policy.Backoff(1))
+	require.Equal(t, 2*time.Second, policy.Backoff(2))
+	require.Equal(t, 4*time.Second, policy.Backoff(3))
+	require.Equal(t, 5*time.Second, policy.Backoff(4))
+	require.Equal(t, 5*time.Second, policy.Backoff(1000))
+
+	policy.BackoffMultiplier = 3
+	require.Equal(t, 3*time.Second, policy.Backoff(2))
+	policy.MaxBackoff = 0
+	require.Equal(t, 27*time.Second, policy.Backoff(4))
+
+	require.False(t, policy.Exhausted(3))
+	require.True(t, policy.Exhausted(4))
+	policy.MaxRestarts = 0
+	require.False(t, policy.Exhausted(1000))
+}
+
+func TestRetryPolicyTerminalErrorCode(t *testing.T) {
+	t.Parallel()
+
+	policy := &RetryPolicy{
+		TerminalErrorCodes: []string{"DFLOW:ErrWorkerFailed", "DFLOW:ErrWorkerTypeNotFound"},
+	}
+	err := errors.ErrWorkerTypeNotFound.GenWithStackByArgs(1)
+	require.Equal(t, "DFLOW:ErrWorkerTypeNotFound", policy.TerminalErrorCode(err.Error()))
+	require.Equal(t, "", policy.TerminalErrorCode(errors.ErrWorkerOffline.GenWithStackByArgs("w").Error()))
+	require.Equal(t, "", policy.TerminalErrorCode("DFLOW:ErrWorkerFailed without brackets"))
+}
@@ -635,6 +635,32 @@ func (w *DefaultBaseWorker) initMessageHandlers(ctx context.Context) (retErr err
 		w.Logger().Panic("duplicate handler", zap.String("topic", topic))
 	}
 
+	topic = frameModel.MigrateWorkerRequestTopic(w.masterID, w.id)
+	ok, err = w.messageHandlerManager.RegisterHandler(
+		ctx,
+		topic,
+		&frameModel.MigrateWorkerRequest{},
+		func(sender p2p.NodeID, value p2p.MessageValue) error {
+			msg, ok := value.(*frameModel.MigrateWorkerRequest)
+			if !ok {
+				return derror.ErrInvalidMasterMessage.GenWithStackByArgs(value)
+			}
+			w.Logger().Info("worker is asked to migrate",
+				zap.String("master-id", w.masterID),
+				zap.Any("msg", msg))
+			// Exiting without a final status makes the master treat the
+			// worker as offline, so the master recreates it as it does
+			// in a failover, and the scheduler avoids the draining executor.
+			w.onError(derror.ErrWorkerMigrated.GenWithStackByArgs(msg.ExecutorID))
+			return nil
+		})
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if !ok {
+		w.Logger().Panic("duplicate handler", zap.String("topic", topic))
+	}
+
 	return nil
 }
 
@@ -0,0 +1,159 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package framework
+
+import (
+	"fmt"
+	"time"
+
+	frameModel "github.com/pingcap/tiflow/engine/framework/model"
+	ormModel "github.com/pingcap/tiflow/engine/pkg/orm/model"
+	derror "github.com/pingcap/tiflow/pkg/errors"
+)
+
+// delayedOffline is a workerOffline event held back by the backoff
+// of the retry policy.
+type delayedOffline struct {
+	worker    WorkerHandle
+	reason    error
+	deliverAt time.Time
+}
+
+// workerRetryTracker applies the retry policy of a job to the failed
+// workers of its job master. It is only accessed in the poll goroutine.
+//
+// The failure history is kept in memory, so it is reset after the job
+// master fails over, while the restart count and the last error are
+// persisted in the job meta.
+type workerRetryTracker struct {
+	policy *frameModel.RetryPolicy
+
+	// failures maps a restart key to the times of its failures in the
+	// failure window.
+	failures map[string][]time.Time
+	delayed  []*delayedOffline
+
+	restartCount int64
+	lastError    string
+	// statsDirty is true if restartCount or lastError needs persisting.
+	statsDirty bool
+
+	// err is the error failing the job, it is set if a worker fails
+	// with a terminal error or has used up its restarts.
+	err error
+
+	// events are the job events waiting to be persisted.
+	events []*ormModel.JobEvent
+}
+
+func newWorkerRetryTracker() *workerRetryTracker {
+	return &workerRetryTracker{
+		failures: make(map[string][]time.Time),
+	}
+}
+
+// reset loads the retry policy and the persisted stats from the job meta.
+func (t *workerRetryTracker) reset(ext *frameModel.MasterMetaExt) {
+	t.policy = ext.RetryPolicy
+	t.restartCount = ext.RestartCount
+	t.lastError = ext.LastError
+}
+
+// onWorkerFailed records a failure of the worker identified by restartKey
+// and returns the delay before its workerOffline event can be delivered.
+// If the job should fail, ok is false and t.err is set.
+func (t *workerRetryTracker) onWorkerFailed(
+	workerID frameModel.WorkerID, restartKey string, errMsg string, now time.Time,
+) (backoff time.Duration, ok bool) {
+	if code := t.policy.TerminalErrorCode(errMsg); code != "" {
+		t.err = derror.ErrWorkerTerminalError.GenWithStackByArgs(workerID, code, errMsg)
+		t.addEvent(ormModel.JobEventError, t.err.Error())
+		return 0, false
+	}
+
+	history := append(t.failures[restartKey], now)
+	if t.policy != nil && t.policy.FailureWindow > 0 {
+		start := now.Add(-t.policy.FailureWindow)
+		for len(history) > 0 && history[0].Before(start) {
+			history = history[1:]
+		}
+	}
+	t.failures[restartKey] = history
+
+	if t.policy.Exhausted(len(history)) {
+		t.err = derror.ErrWorkerRestartExhausted.GenWithStackByArgs(workerID, len(history), errMsg)
+		t.addEvent(ormModel.JobEventError, t.err.Error())
+		return 0, false
+	}
+
+	t.restartCount++
+	t.lastError = errMsg
+	t.statsDirty = true
+	backoff = t.policy.Backoff(len(history))
+	t.addEvent(ormModel.JobEventWorkerFailed, fmt.Sprintf(
+		"worker %s failed, restart it after %s: %s", workerID, backoff, errMsg))
+	return backoff, true
+}
+
+func (t *workerRetryTracker) addEvent(tp ormModel.JobEventType, message string) {
+	t.events = append(t.events, &ormModel.JobEvent{Type: tp, Message: message})
+}
+
+// popEvents removes and returns the job events waiting to be persisted.
+func (t *workerRetryTracker) popEvents() []*ormModel.JobEvent {
+	events := t.events
+	t.events = nil
+	return events
+}
+
+// delay holds back a workerOffline event until deliverAt.
+func (t *workerRetryTracker) delay(worker WorkerHandle, reason error, deliverAt time.Time) {
+	t.delayed = append(t.delayed, &delayedOffline{
+		worker:    worker,
+		reason:    reason,
+		deliverAt: deliverAt,
+	})
+}
+
+// popDue removes and returns the delayed events which are due at now.
+func (t *workerRetryTracker) popDue(now time.Time) []*delayedOffline {
+	var due []*delayedOffline
+	remaining := t.delayed[:0]
+	for _, event := range t.delayed {
+		if event.deliverAt.After(now) {
+			remaining = append(remaining, event)
+			continue
+		}
+		due = append(due, event)
+	}
+	t.delayed = remaining
+	return due
+}
+
+// isWorkerFailure returns whether a worker going offline for the reason
+// is a failure which the retry policy applies to.
+func isWorkerFailure(reason error) bool {
+	return !derror.ErrWorkerFinish.Equal(reason) && !derror.ErrWorkerStop.Equal(reason)
+}
+
+// workerErrorMessage returns the error message of a failed worker.
+func workerErrorMessage(worker WorkerHandle, reason error) string {
+	if status := worker.Status(); status != nil && status.ErrorMsg != "" {
+		return status.ErrorMsg
+	}
+	if reason != nil {
+		return reason.Error()
+	}
+	return "worker is offline"
+}
@@ -0,0 +1,140 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package framework
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/pingcap/tiflow/engine/framework/internal/master"
+	frameModel "github.com/pingcap/tiflow/engine/framework/model"
+	ormModel "github.com/pingcap/tiflow/engine/pkg/orm/model"
+	derror "github.com/pingcap/tiflow/pkg/errors"
+)
+
+func TestWorkerRetryTrackerWithoutPolicy(t *testing.T) {
+	t.Parallel()
+
+	tracker := newWorkerRetryTracker()
+	tracker.reset(&frameModel.MasterMetaExt{RestartCount: 3, LastError: "error-0"})
+
+	now := time.Now()
+	for i := 0; i < 10; i++ {
+		backoff, ok := tracker.onWorkerFailed("worker-1", "key-1", "error-1", now)
+		require.True(t, ok)
+		require.Zero(t, backoff)
+	}
+	require.NoError(t, tracker.err)
+	require.Equal(t, int64(13), tracker.restartCount)
+	require.Equal(t, "error-1", tracker.lastError)
+	require.True(t, tracker.statsDirty)
+}
+
+func TestWorkerRetryTrackerBackoffAndExhaustion(t *testing.T) {
+	t.Parallel()
+
+	tracker := newWorkerRetryTracker()
+	tracker.reset(&frameModel.MasterMetaExt{
+		RetryPolicy: &frameModel.RetryPolicy{
+			MaxRestarts:    2,
+			InitialBackoff: time.Second,
+			FailureWindow:  time.Minute,
+		},
+	})
+
+	now := time.Now()
+	backoff, ok := tracker.onWorkerFailed("worker-1", "key-1", "error", now)
+	require.True(t, ok)
+	require.Equal(t, time.Second, backoff)
+	backoff, ok = tracker.onWorkerFailed("worker-2", "key-1", "error", now)
+	require.True(t, ok)
+	require.Equal(t, 2*time.Second, backoff)
+
+	// The failures of other workers are counted separately.
+	backoff, ok = tracker.onWorkerFailed("worker-3", "key-2", "error", now)
+	require.True(t, ok)
+	require.Equal(t, time.Second, backoff)
+
+	// The failures out of the window are forgotten.
+	now = now.Add(2 * time.Minute)
+	backoff, ok = tracker.onWorkerFailed("worker-4", "key-1", "error", now)
+	require.True(t, ok)
+	require.Equal(t, time.Second, backoff)
+	_, ok = tracker.onWorkerFailed("worker-5", "key-1", "error", now)
+	require.True(t, ok)
+	require.NoError(t, tracker.err)
+
+	_, ok = tracker.onWorkerFailed("worker-6", "key-1", "error", now)
+	require.False(t, ok)
+	require.True(t, derror.ErrWorkerRestartExhausted.Equal(tracker.err))
+	require.Equal(t, int64(5), tracker.restartCount)
+
+	events := tracker.popEvents()
+	require.Len(t, events, 6)
+	for _, event := range events[:5] {
+		require.Equal(t, ormModel.JobEventWorkerFailed, event.Type)
+	}
+	require.Equal(t, ormModel.JobEventError, events[5].Type)
+	require.Empty(t, tracker.popEvents())
+}
+
+func TestWorkerRetryTrackerTerminalError(t *testing.T) {
+	t.Parallel()
+
+	tracker := newWorkerRetryTracker()
+	tracker.reset(&frameModel.MasterMetaExt{
+		RetryPolicy: &frameModel.RetryPolicy{
+			TerminalErrorCodes: []string{"DFLOW:ErrWorkerFailed"},
+		},
+	})
+
+	now := time.Now()
+	_, ok := tracker.onWorkerFailed("worker-1", "key-1", "[DFLOW:ErrWorkerOffline]offline", now)
+	require.True(t, ok)
+	_, ok = tracker.onWorkerFailed("worker-1", "key-1", "[DFLOW:ErrWorkerFailed]failed", now)
+	require.False(t, ok)
+	require.True(t, derror.ErrWorkerTerminalError.Equal(tracker.err))
+	require.Equal(t, int64(1), tracker.restartCount)
+}
+
+func TestWorkerRetryTrackerPopDue(t *testing.T) {
+	t.Parallel()
+
+	tracker := newWorkerRetryTracker()
+	now := time.Now()
+	worker1 := &master.MockHandle{WorkerID: "worker-1", IsTombstone: true}
+	worker2 := &master.MockHandle{WorkerID: "worker-2", IsTombstone: true}
+	tracker.delay(worker1, nil, now.Add(time.Second))
+	tracker.delay(worker2, nil, now.Add(2*time.Second))
+
+	require.Empty(t, tracker.popDue(now))
+	due := tracker.popDue(now.Add(time.Second))
+	require.Len(t, due, 1)
+	require.Equal(t, "worker-1", due[0].worker.ID())
+	due = tracker.popDue(now.Add(time.Hour))
+	require.Len(t, due, 1)
+	require.Equal(t, "worker-2", due[0].worker.ID())
+	require.Empty(t, tracker.delayed)
+}
+
+func TestIsWorkerFailure(t *testing.T) {
+	t.Parallel()
+
+	require.False(t, isWorkerFailure(derror.ErrWorkerFinish.FastGenByArgs()))
+	require.False(t, isWorkerFailure(derror.ErrWorkerStop.FastGenByArgs()))
+	require.True(t, isWorkerFailure(derror.ErrWorkerOffline.FastGenByArgs("worker-1")))
+	require.True(t, isWorkerFailure(nil))
+}
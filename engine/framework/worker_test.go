@@ -31,6 +31,7 @@ import (
 	"github.com/pingcap/tiflow/engine/framework/statusutil"
 	"github.com/pingcap/tiflow/engine/pkg/clock"
 	pkgOrm "github.com/pingcap/tiflow/engine/pkg/orm"
+	derrors "github.com/pingcap/tiflow/pkg/errors"
 )
 
 var _ Worker = (*DefaultBaseWorker)(nil) // _ runtime.Runnable = (Worker)(nil)
@@ -552,6 +553,49 @@ func TestExitWithoutReturn(t *testing.T) {
 	require.Regexp(t, "Exit error", err)
 }
 
+func TestWorkerMigrate(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	worker := newMockWorkerImpl(workerID1, masterName)
+	worker.clock = clock.NewMock()
+	worker.clock.(*clock.Mock).Set(time.Now())
+	putMasterMeta(ctx, t, worker.metaClient, &frameModel.MasterMetaKVData{
+		ID:         masterName,
+		NodeID:     masterNodeName,
+		Epoch:      1,
+		StatusCode: frameModel.MasterStatusInit,
+	})
+
+	worker.On("InitImpl", mock.Anything).Return(nil)
+	worker.On("Status").Return(frameModel.WorkerStatus{
+		Code: frameModel.WorkerStatusNormal,
+	}, nil)
+
+	err := worker.Init(ctx)
+	require.NoError(t, err)
+
+	worker.On("Tick", mock.Anything).Return(nil)
+	err = worker.Poll(ctx)
+	require.NoError(t, err)
+
+	err = worker.messageHandlerManager.InvokeHandler(t,
+		frameModel.MigrateWorkerRequestTopic(masterName, workerID1),
+		masterNodeName,
+		&frameModel.MigrateWorkerRequest{
+			FromMasterID: masterName,
+			Epoch:        1,
+			ExecutorID:   "executor-1",
+		})
+	require.NoError(t, err)
+
+	err = worker.Poll(ctx)
+	require.Error(t, err)
+	require.True(t, derrors.ErrWorkerMigrated.Equal(err))
+}
+
 func checkWorkerStatusMsg(t *testing.T, expect, msg *statusutil.WorkerStatusMessage) {
 	require.Equal(t, expect.Worker, msg.Worker)
 	require.Equal(t, expect.MasterEpoch, msg.MasterEpoch)
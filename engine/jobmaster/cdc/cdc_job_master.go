@@ -0,0 +1,586 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+
+	cdcModel "github.com/pingcap/tiflow/cdc/model"
+	cdcTask "github.com/pingcap/tiflow/engine/executor/cdc"
+	"github.com/pingcap/tiflow/engine/framework"
+	frameModel "github.com/pingcap/tiflow/engine/framework/model"
+	"github.com/pingcap/tiflow/engine/framework/registry"
+	"github.com/pingcap/tiflow/engine/model"
+	"github.com/pingcap/tiflow/engine/pkg/clock"
+	dcontext "github.com/pingcap/tiflow/engine/pkg/context"
+	"github.com/pingcap/tiflow/engine/pkg/p2p"
+	"github.com/pingcap/tiflow/pkg/config"
+	derrors "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/security"
+	"github.com/pingcap/tiflow/pkg/txnutil/gc"
+	"github.com/pingcap/tiflow/pkg/upstream"
+)
+
+const (
+	taskCost = 1
+	// gcServiceIDPrefix is the prefix of the GC service ID of a cdc job.
+	gcServiceIDPrefix = "tiflow-engine-cdc-"
+)
+
+// Status is the status of a cdc job, it's persisted in the metastore so that
+// the tasks can be recreated from their checkpoints after failover.
+type Status struct {
+	Config *JobConfig `json:"config"`
+	// CheckpointTs is the minimum checkpoint of all tasks.
+	CheckpointTs uint64              `json:"checkpoint-ts"`
+	Tasks        map[int]*TaskStatus `json:"tasks"`
+}
+
+// TaskStatus is the status of a task of a cdc job.
+type TaskStatus struct {
+	Range        cdcTask.TableRange `json:"range"`
+	CheckpointTs uint64             `json:"checkpoint-ts"`
+	Finished     bool               `json:"finished"`
+}
+
+type taskInfo struct {
+	handle     framework.WorkerHandle
+	needCreate bool
+}
+
+// JobMaster defines cdc job master, it splits the tables of a changefeed into
+// ranges and runs a cdc task for each of them.
+type JobMaster struct {
+	framework.BaseJobMaster
+
+	mu        sync.Mutex
+	workerID  frameModel.WorkerID
+	jobStatus *Status
+	tasks     map[int]*taskInfo
+	// launchedWorkers maps worker ID to task index
+	launchedWorkers map[frameModel.WorkerID]int
+	// stopped is set when the job manager asks the job to stop
+	stopped bool
+	// failedErr is set when a task fails
+	failedErr error
+
+	statusRateLimiter *rate.Limiter
+	statusCode        struct {
+		sync.RWMutex
+		code frameModel.WorkerStatusCode
+	}
+	ctx     context.Context
+	clocker clock.Clock
+
+	// upManager and upstream are used to list the tables and keep the GC
+	// safepoint of the job, they are nil in tests.
+	upManager *upstream.Manager
+	upstream  *upstream.Upstream
+}
+
+var _ framework.JobMasterImpl = (*JobMaster)(nil)
+
+type cdcJobMasterFactory struct{}
+
+// RegisterWorker is used to register cdc job master into global registry
+func RegisterWorker() {
+	registry.GlobalWorkerRegistry().MustRegisterWorkerType(framework.CdcJobMaster, cdcJobMasterFactory{})
+}
+
+// DeserializeConfig implements WorkerFactory.DeserializeConfig
+func (f cdcJobMasterFactory) DeserializeConfig(configBytes []byte) (registry.WorkerConfig, error) {
+	cfg := &JobConfig{}
+	err := cfg.Decode(configBytes)
+	return cfg, err
+}
+
+// NewWorkerImpl implements WorkerFactory.NewWorkerImpl
+func (f cdcJobMasterFactory) NewWorkerImpl(
+	ctx *dcontext.Context, workerID frameModel.WorkerID, masterID frameModel.MasterID, conf framework.WorkerConfig,
+) (framework.WorkerImpl, error) {
+	return newCDCJobMaster(ctx.Context, workerID, conf.(*JobConfig)), nil
+}
+
+func newCDCJobMaster(ctx context.Context, workerID frameModel.WorkerID, conf *JobConfig) *JobMaster {
+	log.Info("new cdc jobmaster", zap.String("id", workerID))
+	return &JobMaster{
+		workerID: workerID,
+		jobStatus: &Status{
+			Config: conf,
+			Tasks:  make(map[int]*TaskStatus),
+		},
+		tasks:             make(map[int]*taskInfo),
+		launchedWorkers:   make(map[frameModel.WorkerID]int),
+		statusRateLimiter: rate.NewLimiter(rate.Every(time.Second*2), 1),
+		ctx:               ctx,
+		clocker:           clock.New(),
+	}
+}
+
+// InitImpl implements JobMasterImpl.InitImpl
+func (jm *JobMaster) InitImpl(ctx context.Context) error {
+	log.Info("initializing the cdc jobmaster", zap.String("id", jm.workerID))
+	jm.setStatusCode(frameModel.WorkerStatusInit)
+
+	cfg := jm.jobStatus.Config
+	if err := jm.initUpstream(); err != nil {
+		return err
+	}
+	startTs, tableIDs, err := listUpstreamTables(ctx, jm.upstream.KVStorage, cfg, cfg.StartTs)
+	if err != nil {
+		return err
+	}
+	cfg.StartTs = startTs
+	if cfg.TargetTs != 0 && cfg.TargetTs <= cfg.StartTs {
+		return derrors.ErrCDCJobConfigInvalid.GenWithStackByArgs(
+			"target-ts must be larger than start-ts")
+	}
+	// Keep the changes after the start ts from GC before the tasks start.
+	minServiceGCTs, err := gc.SetServiceGCSafepoint(ctx, jm.upstream.PDClient,
+		jm.gcServiceID(), config.GetGlobalServerConfig().GcTTL, startTs)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if startTs < minServiceGCTs+1 {
+		return derrors.ErrStartTsBeforeGC.GenWithStackByArgs(startTs, minServiceGCTs)
+	}
+	ranges := planTableRanges(tableIDs, cfg.TaskCount)
+	log.Info("cdc jobmaster plans tasks", zap.String("id", jm.workerID),
+		zap.Uint64("start-ts", startTs), zap.Int("table-count", len(tableIDs)),
+		zap.Any("ranges", ranges))
+
+	jm.mu.Lock()
+	for idx, r := range ranges {
+		jm.jobStatus.Tasks[idx] = &TaskStatus{Range: r, CheckpointTs: startTs}
+		jm.tasks[idx] = &taskInfo{needCreate: true}
+	}
+	jm.jobStatus.CheckpointTs = startTs
+	jm.mu.Unlock()
+
+	// This persistence has to succeed before we set this master to normal status.
+	if err := jm.persistStatus(ctx); err != nil {
+		return err
+	}
+	jm.setStatusCode(frameModel.WorkerStatusNormal)
+	return nil
+}
+
+// Tick implements JobMasterImpl.Tick
+func (jm *JobMaster) Tick(ctx context.Context) error {
+	if !jm.IsMasterReady() {
+		if jm.statusRateLimiter.Allow() {
+			log.Info("cdc jobmaster is not ready", zap.String("id", jm.workerID))
+		}
+		return nil
+	}
+
+	jm.mu.Lock()
+	stopped, failedErr, finished := jm.stopped, jm.failedErr, jm.allTasksFinishedLocked()
+	checkpointTs := jm.jobStatus.CheckpointTs
+	if !stopped && failedErr == nil && !finished {
+		jm.createTasksLocked()
+	}
+	jm.mu.Unlock()
+
+	if failedErr == nil && !finished {
+		failedErr = jm.updateGCSafepoint(ctx, checkpointTs)
+	}
+
+	switch {
+	case failedErr != nil:
+		log.Warn("cdc jobmaster failed", zap.String("id", jm.workerID), zap.Error(failedErr))
+		return jm.exit(ctx, frameModel.WorkerStatusError, framework.ExitReasonFailed, failedErr)
+	case finished:
+		log.Info("cdc jobmaster finished", zap.String("id", jm.workerID))
+		jm.removeGCSafepoint(ctx)
+		return jm.exit(ctx, frameModel.WorkerStatusFinished, framework.ExitReasonFinished, nil)
+	case stopped:
+		log.Info("cdc jobmaster stopped", zap.String("id", jm.workerID))
+		return jm.exit(ctx, frameModel.WorkerStatusStopped, framework.ExitReasonCanceled, nil)
+	}
+
+	if jm.statusRateLimiter.Allow() {
+		if err := jm.persistStatus(ctx); err != nil {
+			log.Warn("update job status failed, try next time", zap.String("id", jm.workerID), zap.Error(err))
+		}
+	}
+	return nil
+}
+
+func (jm *JobMaster) exit(
+	ctx context.Context, code frameModel.WorkerStatusCode, reason framework.ExitReason, err error,
+) error {
+	jm.setStatusCode(code)
+	if err := jm.persistStatus(ctx); err != nil {
+		log.Warn("update job status failed", zap.String("id", jm.workerID), zap.Error(err))
+	}
+	status := jm.Status()
+	return jm.BaseJobMaster.Exit(ctx, reason, err, string(status.ExtBytes))
+}
+
+func (jm *JobMaster) gcServiceID() string {
+	return gcServiceIDPrefix + jm.workerID
+}
+
+func (jm *JobMaster) initUpstream() error {
+	cfg := jm.jobStatus.Config
+	credential := cfg.Upstream.Security
+	if credential == nil {
+		credential = &security.Credential{}
+	}
+	// The upstream lives until the job master is closed, so the ctx of the
+	// caller is not used.
+	jm.upManager = upstream.NewManager(context.Background(), jm.gcServiceID())
+	up, err := jm.upManager.AddDefaultUpstream(cfg.Upstream.PDAddrs, credential)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	jm.upstream = up
+	return nil
+}
+
+// updateGCSafepoint keeps the changes after the checkpoint of the job from
+// GC, like the owner of TiCDC does for a changefeed. The GC safepoint is kept
+// after the job is stopped, it expires after the GC TTL if the job is not
+// resumed, and is removed when the job finishes.
+func (jm *JobMaster) updateGCSafepoint(ctx context.Context, checkpointTs uint64) error {
+	if jm.upstream == nil {
+		return nil
+	}
+	gcManager := jm.upstream.GCManager
+	if err := gcManager.TryUpdateGCSafePoint(ctx, checkpointTs-1, false); err != nil {
+		return errors.Trace(err)
+	}
+	return errors.Trace(gcManager.CheckStaleCheckpointTs(
+		ctx, cdcModel.DefaultChangeFeedID(jm.workerID), checkpointTs))
+}
+
+func (jm *JobMaster) removeGCSafepoint(ctx context.Context) {
+	if jm.upstream == nil {
+		return
+	}
+	if err := gc.RemoveServiceGCSafepoint(ctx, jm.upstream.PDClient, jm.gcServiceID()); err != nil {
+		log.Warn("remove gc safepoint failed", zap.String("id", jm.workerID), zap.Error(err))
+	}
+}
+
+func (jm *JobMaster) createTasksLocked() {
+	for idx, info := range jm.tasks {
+		if !info.needCreate || jm.jobStatus.Tasks[idx].Finished {
+			continue
+		}
+		workerID, err := jm.CreateWorkerWithOpts(framework.CdcTask, jm.getTaskConfigLocked(idx), taskCost,
+			framework.CreateWorkerWithRestartKey(fmt.Sprintf("task-%d", idx)))
+		if err != nil {
+			log.Warn("create worker failed, try next time", zap.String("id", jm.workerID), zap.Error(err))
+			continue
+		}
+		jm.launchedWorkers[workerID] = idx
+		info.needCreate = false
+	}
+}
+
+func (jm *JobMaster) getTaskConfigLocked(idx int) *cdcTask.Config {
+	cfg := jm.jobStatus.Config
+	task := jm.jobStatus.Tasks[idx]
+	return &cdcTask.Config{
+		Index:         idx,
+		Range:         task.Range,
+		CheckpointTs:  task.CheckpointTs,
+		TargetTs:      cfg.TargetTs,
+		SinkURI:       cfg.SinkURI,
+		Upstream:      cfg.Upstream,
+		ReplicaConfig: cfg.ReplicaConfig,
+	}
+}
+
+func (jm *JobMaster) allTasksFinishedLocked() bool {
+	for _, task := range jm.jobStatus.Tasks {
+		if !task.Finished {
+			return false
+		}
+	}
+	return true
+}
+
+// updateCheckpointLocked updates the checkpoint of the task from the status
+// reported by its worker and recalculates the checkpoint of the job.
+func (jm *JobMaster) updateCheckpointLocked(idx int, extBytes []byte) {
+	if len(extBytes) == 0 {
+		return
+	}
+	status := &cdcTask.Status{}
+	if err := json.Unmarshal(extBytes, status); err != nil {
+		log.Warn("failed to parse task status", zap.String("id", jm.workerID), zap.Error(err))
+		return
+	}
+	task, ok := jm.jobStatus.Tasks[idx]
+	if !ok || status.CheckpointTs <= task.CheckpointTs {
+		return
+	}
+	task.CheckpointTs = status.CheckpointTs
+
+	var checkpointTs uint64
+	for _, task := range jm.jobStatus.Tasks {
+		if task.Finished {
+			continue
+		}
+		if checkpointTs == 0 || task.CheckpointTs < checkpointTs {
+			checkpointTs = task.CheckpointTs
+		}
+	}
+	if checkpointTs > jm.jobStatus.CheckpointTs {
+		jm.jobStatus.CheckpointTs = checkpointTs
+	}
+}
+
+func (jm *JobMaster) persistStatus(ctx context.Context) error {
+	jm.mu.Lock()
+	statusBytes, err := json.Marshal(jm.jobStatus)
+	jm.mu.Unlock()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	_, err = jm.MetaKVClient().Put(ctx, jm.workerID, string(statusBytes))
+	return errors.Trace(err)
+}
+
+// OnMasterRecovered implements JobMasterImpl.OnMasterRecovered
+func (jm *JobMaster) OnMasterRecovered(ctx context.Context) error {
+	log.Info("recovering cdc jobmaster", zap.String("id", jm.workerID))
+	resp, err := jm.MetaKVClient().Get(ctx, jm.workerID)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if len(resp.Kvs) != 1 {
+		return errors.Errorf("unexpected status count %d of cdc job %s", len(resp.Kvs), jm.workerID)
+	}
+
+	jm.mu.Lock()
+	defer jm.mu.Unlock()
+	status := &Status{}
+	if err := json.Unmarshal(resp.Kvs[0].Value, status); err != nil {
+		return errors.Trace(err)
+	}
+	jm.jobStatus = status
+	for idx := range status.Tasks {
+		if _, ok := jm.tasks[idx]; !ok {
+			jm.tasks[idx] = &taskInfo{needCreate: true}
+		}
+	}
+	if err := jm.initUpstream(); err != nil {
+		return err
+	}
+	log.Info("cdc jobmaster recovered", zap.String("id", jm.workerID),
+		zap.Uint64("checkpoint-ts", status.CheckpointTs))
+	return nil
+}
+
+// OnWorkerDispatched implements JobMasterImpl.OnWorkerDispatched
+func (jm *JobMaster) OnWorkerDispatched(worker framework.WorkerHandle, result error) error {
+	if result == nil {
+		return nil
+	}
+	log.Warn("dispatch cdc task failed", zap.String("id", jm.workerID),
+		zap.String("worker-id", worker.ID()), zap.Error(result))
+	jm.mu.Lock()
+	defer jm.mu.Unlock()
+	idx, ok := jm.launchedWorkers[worker.ID()]
+	if !ok {
+		return nil
+	}
+	delete(jm.launchedWorkers, worker.ID())
+	jm.tasks[idx].handle = nil
+	jm.tasks[idx].needCreate = true
+	return nil
+}
+
+// OnWorkerOnline implements JobMasterImpl.OnWorkerOnline
+func (jm *JobMaster) OnWorkerOnline(worker framework.WorkerHandle) error {
+	jm.mu.Lock()
+	defer jm.mu.Unlock()
+	idx, ok := jm.launchedWorkers[worker.ID()]
+	if !ok {
+		// The worker was created before failover, find its task from its status.
+		status := &cdcTask.Status{}
+		if err := json.Unmarshal(worker.Status().ExtBytes, status); err != nil {
+			return errors.Trace(err)
+		}
+		idx = status.Index
+	}
+	info, ok := jm.tasks[idx]
+	if !ok {
+		return errors.Errorf("unknown task %d of worker %s", idx, worker.ID())
+	}
+	log.Info("cdc task online", zap.String("id", jm.workerID),
+		zap.String("worker-id", worker.ID()), zap.Int("index", idx))
+	info.handle = worker
+	info.needCreate = false
+	jm.launchedWorkers[worker.ID()] = idx
+	return nil
+}
+
+// OnWorkerOffline implements JobMasterImpl.OnWorkerOffline
+func (jm *JobMaster) OnWorkerOffline(worker framework.WorkerHandle, reason error) error {
+	jm.mu.Lock()
+	defer jm.mu.Unlock()
+	idx, ok := jm.launchedWorkers[worker.ID()]
+	if !ok {
+		log.Warn("unknown cdc task offline", zap.String("id", jm.workerID), zap.String("worker-id", worker.ID()))
+		return nil
+	}
+	delete(jm.launchedWorkers, worker.ID())
+	status := worker.Status()
+	jm.updateCheckpointLocked(idx, status.ExtBytes)
+	jm.tasks[idx].handle = nil
+
+	switch {
+	case derrors.ErrWorkerFinish.Equal(reason):
+		log.Info("cdc task finished", zap.String("id", jm.workerID), zap.Int("index", idx))
+		jm.jobStatus.Tasks[idx].Finished = true
+	case status.Code == frameModel.WorkerStatusError:
+		jm.failedErr = errors.Errorf("cdc task %d failed: %s", idx, status.ErrorMsg)
+	case jm.stopped:
+	default:
+		// The worker is lost, recreate it from the checkpoint.
+		log.Info("cdc task offline, recreate it", zap.String("id", jm.workerID),
+			zap.Int("index", idx), zap.Error(reason))
+		jm.tasks[idx].needCreate = true
+	}
+	return nil
+}
+
+// OnWorkerStatusUpdated implements JobMasterImpl.OnWorkerStatusUpdated
+func (jm *JobMaster) OnWorkerStatusUpdated(worker framework.WorkerHandle, newStatus *frameModel.WorkerStatus) error {
+	jm.mu.Lock()
+	defer jm.mu.Unlock()
+	if idx, ok := jm.launchedWorkers[worker.ID()]; ok {
+		jm.updateCheckpointLocked(idx, newStatus.ExtBytes)
+	}
+	return nil
+}
+
+// OnWorkerMessage implements JobMasterImpl.OnWorkerMessage
+func (jm *JobMaster) OnWorkerMessage(worker framework.WorkerHandle, topic p2p.Topic, message p2p.MessageValue) error {
+	return nil
+}
+
+// OnMasterMessage implements JobMasterImpl.OnMasterMessage
+func (jm *JobMaster) OnMasterMessage(topic p2p.Topic, message p2p.MessageValue) error {
+	return nil
+}
+
+// OnJobManagerMessage implements JobMasterImpl.OnJobManagerMessage
+func (jm *JobMaster) OnJobManagerMessage(topic p2p.Topic, message p2p.MessageValue) error {
+	msg, ok := message.(*frameModel.StatusChangeRequest)
+	if !ok {
+		log.Info("unsupported message", zap.Any("message", message))
+		return nil
+	}
+	if msg.ExpectState != frameModel.WorkerStatusStopped {
+		log.Info("cdc jobmaster: ignore status change state", zap.Int32("state", int32(msg.ExpectState)))
+		return nil
+	}
+
+	jm.mu.Lock()
+	defer jm.mu.Unlock()
+	jm.stopped = true
+	for _, info := range jm.tasks {
+		if info.handle == nil {
+			continue
+		}
+		handle := info.handle.Unwrap()
+		if handle == nil {
+			continue
+		}
+		wTopic := frameModel.WorkerStatusChangeRequestTopic(jm.BaseJobMaster.ID(), info.handle.ID())
+		wMessage := &frameModel.StatusChangeRequest{
+			SendTime:     jm.clocker.Mono(),
+			FromMasterID: jm.BaseJobMaster.ID(),
+			Epoch:        jm.BaseJobMaster.CurrentEpoch(),
+			ExpectState:  frameModel.WorkerStatusStopped,
+		}
+		ctx, cancel := context.WithTimeout(jm.ctx, time.Second*2)
+		err := handle.SendMessage(ctx, wTopic, wMessage, false /*nonblocking*/)
+		cancel()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// OnOpenAPIInitialized implements JobMasterImpl.OnOpenAPIInitialized.
+func (jm *JobMaster) OnOpenAPIInitialized(router *gin.RouterGroup) {
+	router.GET("/status", func(c *gin.Context) {
+		jm.mu.Lock()
+		defer jm.mu.Unlock()
+		c.IndentedJSON(http.StatusOK, jm.jobStatus)
+	})
+}
+
+// CloseImpl implements JobMasterImpl.CloseImpl
+func (jm *JobMaster) CloseImpl(ctx context.Context) error {
+	if jm.upManager != nil {
+		jm.upManager.Close()
+	}
+	return nil
+}
+
+// Workload implements JobMasterImpl.Workload
+func (jm *JobMaster) Workload() model.RescUnit {
+	return 2
+}
+
+// Status implements JobMasterImpl.Status
+func (jm *JobMaster) Status() frameModel.WorkerStatus {
+	jm.mu.Lock()
+	status, err := json.Marshal(jm.jobStatus)
+	jm.mu.Unlock()
+	if err != nil {
+		log.Panic("get status failed", zap.String("id", jm.workerID), zap.Error(err))
+	}
+	return frameModel.WorkerStatus{
+		Code:     jm.getStatusCode(),
+		ExtBytes: status,
+	}
+}
+
+// IsJobMasterImpl implements JobMasterImpl.IsJobMasterImpl
+func (jm *JobMaster) IsJobMasterImpl() {
+	panic("unreachable")
+}
+
+func (jm *JobMaster) setStatusCode(code frameModel.WorkerStatusCode) {
+	jm.statusCode.Lock()
+	defer jm.statusCode.Unlock()
+	jm.statusCode.code = code
+}
+
+func (jm *JobMaster) getStatusCode() frameModel.WorkerStatusCode {
+	jm.statusCode.RLock()
+	defer jm.statusCode.RUnlock()
+	return jm.statusCode.code
+}
@@ -0,0 +1,136 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+
+	cdcTask "github.com/pingcap/tiflow/engine/executor/cdc"
+	"github.com/pingcap/tiflow/engine/framework"
+	frameModel "github.com/pingcap/tiflow/engine/framework/model"
+	"github.com/pingcap/tiflow/pkg/errors"
+)
+
+func newTestJobMaster(taskCount int) *JobMaster {
+	jm := newCDCJobMaster(context.Background(), "cdc-job", &JobConfig{StartTs: 100, TargetTs: 1000})
+	ranges := planTableRanges([]int64{1, 2, 3, 4}, taskCount)
+	for idx, r := range ranges {
+		jm.jobStatus.Tasks[idx] = &TaskStatus{Range: r, CheckpointTs: 100}
+		jm.tasks[idx] = &taskInfo{needCreate: true}
+	}
+	jm.jobStatus.CheckpointTs = 100
+	return jm
+}
+
+func newTestWorker(id frameModel.WorkerID, status *frameModel.WorkerStatus) *framework.MockWorkerHandler {
+	worker := &framework.MockWorkerHandler{WorkerID: id}
+	worker.On("Status").Return(status)
+	return worker
+}
+
+func taskStatusBytes(t *testing.T, idx int, checkpointTs uint64) []byte {
+	data, err := json.Marshal(&cdcTask.Status{Index: idx, CheckpointTs: checkpointTs})
+	require.NoError(t, err)
+	return data
+}
+
+func TestJobMasterTrackCheckpoint(t *testing.T) {
+	t.Parallel()
+
+	jm := newTestJobMaster(2)
+	jm.launchedWorkers["w0"] = 0
+	// w1 is created before failover, its task is found from its status
+	w0 := newTestWorker("w0", &frameModel.WorkerStatus{})
+	w1 := newTestWorker("w1", &frameModel.WorkerStatus{ExtBytes: taskStatusBytes(t, 1, 150)})
+	require.NoError(t, jm.OnWorkerOnline(w0))
+	require.NoError(t, jm.OnWorkerOnline(w1))
+	require.False(t, jm.tasks[0].needCreate)
+	require.False(t, jm.tasks[1].needCreate)
+	require.Equal(t, 1, jm.launchedWorkers["w1"])
+
+	require.NoError(t, jm.OnWorkerStatusUpdated(w0, &frameModel.WorkerStatus{
+		ExtBytes: taskStatusBytes(t, 0, 200),
+	}))
+	require.NoError(t, jm.OnWorkerStatusUpdated(w1, &frameModel.WorkerStatus{
+		ExtBytes: taskStatusBytes(t, 1, 300),
+	}))
+	require.Equal(t, uint64(200), jm.jobStatus.Tasks[0].CheckpointTs)
+	require.Equal(t, uint64(300), jm.jobStatus.Tasks[1].CheckpointTs)
+	require.Equal(t, uint64(200), jm.jobStatus.CheckpointTs)
+
+	// checkpoint never goes backward
+	require.NoError(t, jm.OnWorkerStatusUpdated(w0, &frameModel.WorkerStatus{
+		ExtBytes: taskStatusBytes(t, 0, 120),
+	}))
+	require.Equal(t, uint64(200), jm.jobStatus.Tasks[0].CheckpointTs)
+
+	// a finished task doesn't hold back the checkpoint of the job
+	w0Finished := newTestWorker("w0", &frameModel.WorkerStatus{
+		Code:     frameModel.WorkerStatusFinished,
+		ExtBytes: taskStatusBytes(t, 0, 1000),
+	})
+	require.NoError(t, jm.OnWorkerOffline(w0Finished, errors.ErrWorkerFinish.FastGenByArgs()))
+	require.True(t, jm.jobStatus.Tasks[0].Finished)
+	require.Equal(t, uint64(300), jm.jobStatus.CheckpointTs)
+	require.False(t, jm.allTasksFinishedLocked())
+
+	// the lost task is recreated from its checkpoint
+	require.NoError(t, jm.OnWorkerOffline(w1, errors.ErrWorkerOffline.FastGenByArgs("w1")))
+	require.True(t, jm.tasks[1].needCreate)
+	require.Nil(t, jm.failedErr)
+	cfg := jm.getTaskConfigLocked(1)
+	require.Equal(t, uint64(300), cfg.CheckpointTs)
+	require.Equal(t, jm.jobStatus.Tasks[1].Range, cfg.Range)
+	require.Equal(t, uint64(1000), cfg.TargetTs)
+}
+
+func TestJobMasterTaskFailed(t *testing.T) {
+	t.Parallel()
+
+	jm := newTestJobMaster(1)
+	jm.launchedWorkers["w0"] = 0
+	w0 := newTestWorker("w0", &frameModel.WorkerStatus{
+		Code:     frameModel.WorkerStatusError,
+		ErrorMsg: "sink is down",
+	})
+	require.NoError(t, jm.OnWorkerOnline(w0))
+	require.NoError(t, jm.OnWorkerOffline(w0, errors.ErrWorkerOffline.FastGenByArgs("w0")))
+	require.False(t, jm.tasks[0].needCreate)
+	require.ErrorContains(t, jm.failedErr, "sink is down")
+}
+
+func TestJobMasterOpenAPI(t *testing.T) {
+	t.Parallel()
+
+	jm := newTestJobMaster(2)
+	engine := gin.New()
+	jm.OnOpenAPIInitialized(engine.Group("/api/v1/jobs/cdc-job"))
+
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodGet, "/api/v1/jobs/cdc-job/status", nil)
+	engine.ServeHTTP(w, r)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	status := &Status{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), status))
+	require.Equal(t, uint64(100), status.CheckpointTs)
+	require.Len(t, status.Tasks, 2)
+}
@@ -0,0 +1,85 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"encoding/json"
+	"net/url"
+
+	"github.com/pingcap/tiflow/cdc/redo"
+	cdcTask "github.com/pingcap/tiflow/engine/executor/cdc"
+	"github.com/pingcap/tiflow/pkg/config"
+	"github.com/pingcap/tiflow/pkg/errors"
+)
+
+const defaultTaskCount = 1
+
+// JobConfig is the config of a cdc job, it describes a changefeed. A cdc job
+// replicates row changes only, DDLs are not replicated, so the tables must be
+// created in the downstream before they are replicated.
+type JobConfig struct {
+	SinkURI  string                 `toml:"sink-uri" json:"sink-uri"`
+	StartTs  uint64                 `toml:"start-ts" json:"start-ts"`
+	TargetTs uint64                 `toml:"target-ts" json:"target-ts"`
+	Upstream cdcTask.UpstreamConfig `toml:"upstream" json:"upstream"`
+	// TaskCount is the number of tasks the tables are split into.
+	TaskCount     int                   `toml:"task-count" json:"task-count"`
+	ReplicaConfig *config.ReplicaConfig `toml:"replica-config" json:"replica-config"`
+}
+
+// Decode decodes the json encoded config, adjusts and validates it.
+func (c *JobConfig) Decode(data []byte) error {
+	if err := json.Unmarshal(data, c); err != nil {
+		return errors.ErrCDCJobConfigInvalid.Wrap(err).GenWithStackByArgs(err.Error())
+	}
+	return c.ValidateAndAdjust()
+}
+
+// ValidateAndAdjust validates the config and fills the default values.
+func (c *JobConfig) ValidateAndAdjust() error {
+	if c.SinkURI == "" {
+		return errors.ErrCDCJobConfigInvalid.GenWithStackByArgs("sink-uri is empty")
+	}
+	sinkURI, err := url.Parse(c.SinkURI)
+	if err != nil {
+		return errors.ErrCDCJobConfigInvalid.Wrap(err).GenWithStackByArgs(err.Error())
+	}
+	if len(c.Upstream.PDAddrs) == 0 {
+		return errors.ErrCDCJobConfigInvalid.GenWithStackByArgs("upstream pd-addrs is empty")
+	}
+	if c.TargetTs != 0 && c.TargetTs <= c.StartTs {
+		return errors.ErrCDCJobConfigInvalid.GenWithStackByArgs(
+			"target-ts must be larger than start-ts")
+	}
+	if c.TaskCount < 0 {
+		return errors.ErrCDCJobConfigInvalid.GenWithStackByArgs("task-count is negative")
+	}
+	if c.TaskCount == 0 {
+		c.TaskCount = defaultTaskCount
+	}
+	if c.ReplicaConfig == nil {
+		c.ReplicaConfig = config.GetDefaultReplicaConfig()
+	}
+	if err := c.ReplicaConfig.ValidateAndAdjust(sinkURI); err != nil {
+		return errors.ErrCDCJobConfigInvalid.Wrap(err).GenWithStackByArgs(err.Error())
+	}
+	// Both of them need an owner to coordinate the tasks.
+	if c.ReplicaConfig.Consistent != nil && redo.IsConsistentEnabled(c.ReplicaConfig.Consistent.Level) {
+		return errors.ErrCDCJobConfigInvalid.GenWithStackByArgs("redo log is not supported")
+	}
+	if c.ReplicaConfig.Bidirectional.IsEnabled() {
+		return errors.ErrCDCJobConfigInvalid.GenWithStackByArgs("bidirectional replication is not supported")
+	}
+	return nil
+}
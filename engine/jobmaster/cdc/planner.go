@@ -0,0 +1,108 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pingcap/errors"
+	tidbkv "github.com/pingcap/tidb/kv"
+	"github.com/tikv/client-go/v2/oracle"
+
+	"github.com/pingcap/tiflow/cdc/entry/schema"
+	"github.com/pingcap/tiflow/cdc/kv"
+	"github.com/pingcap/tiflow/cdc/model"
+	cdcTask "github.com/pingcap/tiflow/engine/executor/cdc"
+	"github.com/pingcap/tiflow/pkg/filter"
+)
+
+// planTableRanges splits the tables into at most taskCount contiguous ranges
+// holding about the same number of tables. The ranges cover all table IDs,
+// the first one starts from 0 and the last one is unbounded, so the tables
+// created after the job starts always belong to one of them.
+func planTableRanges(tableIDs []int64, taskCount int) []cdcTask.TableRange {
+	ids := make([]int64, len(tableIDs))
+	copy(ids, tableIDs)
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	// remove duplicated IDs
+	n := 0
+	for i, id := range ids {
+		if i == 0 || id != ids[n-1] {
+			ids[n] = id
+			n++
+		}
+	}
+	ids = ids[:n]
+
+	if taskCount > len(ids) {
+		taskCount = len(ids)
+	}
+	if taskCount <= 1 {
+		return []cdcTask.TableRange{{}}
+	}
+
+	ranges := make([]cdcTask.TableRange, 0, taskCount)
+	start := int64(0)
+	for i := 1; i < taskCount; i++ {
+		end := ids[i*len(ids)/taskCount]
+		ranges = append(ranges, cdcTask.TableRange{Start: start, End: end})
+		start = end
+	}
+	return append(ranges, cdcTask.TableRange{Start: start})
+}
+
+// listUpstreamTables returns the IDs of the physical tables to be replicated
+// at ts, a ts of 0 means the current ts of the upstream, which is returned
+// as well. It's a variable so that it can be replaced in tests.
+var listUpstreamTables = func(
+	ctx context.Context, store tidbkv.Storage, cfg *JobConfig, ts uint64,
+) (uint64, []int64, error) {
+	if ts == 0 {
+		ver, err := store.CurrentVersion(oracle.GlobalTxnScope)
+		if err != nil {
+			return 0, nil, errors.Trace(err)
+		}
+		ts = ver.Ver
+	}
+	meta, err := kv.GetSnapshotMeta(store, ts)
+	if err != nil {
+		return 0, nil, errors.Trace(err)
+	}
+	forceReplicate := cfg.ReplicaConfig.ForceReplicate
+	snap, err := schema.NewSingleSnapshotFromMeta(meta, ts, forceReplicate)
+	if err != nil {
+		return 0, nil, errors.Trace(err)
+	}
+	f, err := filter.NewFilter(cfg.ReplicaConfig, "")
+	if err != nil {
+		return 0, nil, errors.Trace(err)
+	}
+
+	var tableIDs []int64
+	snap.IterTables(true, func(tbl *model.TableInfo) {
+		if f.ShouldIgnoreTable(tbl.TableName.Schema, tbl.TableName.Table) ||
+			!tbl.IsEligible(forceReplicate) {
+			return
+		}
+		if pi := tbl.GetPartitionInfo(); pi != nil {
+			for _, partition := range pi.Definitions {
+				tableIDs = append(tableIDs, partition.ID)
+			}
+		} else {
+			tableIDs = append(tableIDs, tbl.ID)
+		}
+	})
+	return ts, tableIDs, nil
+}
@@ -0,0 +1,101 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	cdcTask "github.com/pingcap/tiflow/engine/executor/cdc"
+)
+
+func TestPlanTableRanges(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		tableIDs  []int64
+		taskCount int
+		expected  []cdcTask.TableRange
+	}{
+		{nil, 3, []cdcTask.TableRange{{}}},
+		{[]int64{10, 11}, 1, []cdcTask.TableRange{{}}},
+		{[]int64{12, 10, 11}, 5, []cdcTask.TableRange{
+			{Start: 0, End: 11}, {Start: 11, End: 12}, {Start: 12},
+		}},
+		{[]int64{10, 11, 12, 13, 14, 15, 16}, 3, []cdcTask.TableRange{
+			{Start: 0, End: 12}, {Start: 12, End: 14}, {Start: 14},
+		}},
+		{[]int64{10, 10, 11, 11}, 2, []cdcTask.TableRange{
+			{Start: 0, End: 11}, {Start: 11},
+		}},
+	}
+	for i, c := range cases {
+		ranges := planTableRanges(c.tableIDs, c.taskCount)
+		require.Equal(t, c.expected, ranges, i)
+		// every table belongs to exactly one range
+		for _, id := range c.tableIDs {
+			count := 0
+			for _, r := range ranges {
+				if r.Contains(id) {
+					count++
+				}
+			}
+			require.Equal(t, 1, count, "case %d, table %d", i, id)
+		}
+	}
+}
+
+func TestJobConfigDecode(t *testing.T) {
+	t.Parallel()
+
+	cfg := &JobConfig{}
+	err := cfg.Decode([]byte(`{
+		"sink-uri": "blackhole://",
+		"start-ts": 100,
+		"target-ts": 200,
+		"upstream": {"pd-addrs": ["http://127.0.0.1:2379"]}
+	}`))
+	require.NoError(t, err)
+	require.Equal(t, defaultTaskCount, cfg.TaskCount)
+	require.NotNil(t, cfg.ReplicaConfig)
+	require.Equal(t, uint64(100), cfg.StartTs)
+
+	invalid := []string{
+		`{"sink-uri": "", "upstream": {"pd-addrs": ["http://127.0.0.1:2379"]}}`,
+		`{"sink-uri": "blackhole://"}`,
+		`{"sink-uri": "blackhole://", "start-ts": 200, "target-ts": 100,
+			"upstream": {"pd-addrs": ["http://127.0.0.1:2379"]}}`,
+		`{"sink-uri": "blackhole://", "task-count": -1,
+			"upstream": {"pd-addrs": ["http://127.0.0.1:2379"]}}`,
+		`{"sink-uri": 1}`,
+	}
+	for _, data := range invalid {
+		err := (&JobConfig{}).Decode([]byte(data))
+		require.Error(t, err, data)
+		require.Contains(t, err.Error(), "ErrCDCJobConfigInvalid", data)
+	}
+
+	// The features that need an owner are not supported.
+	unsupported := []string{
+		`{"sink-uri": "blackhole://", "upstream": {"pd-addrs": ["http://127.0.0.1:2379"]},
+			"replica-config": {"consistent": {"level": "eventual", "storage": "local:///tmp/redo"}}}`,
+		`{"sink-uri": "mysql://127.0.0.1:3306/", "upstream": {"pd-addrs": ["http://127.0.0.1:2379"]},
+			"replica-config": {"bidirectional": {"enable": true, "replica-id": 1, "filter-replica-ids": [2]}}}`,
+	}
+	for _, data := range unsupported {
+		err := (&JobConfig{}).Decode([]byte(data))
+		require.ErrorContains(t, err, "is not supported", data)
+	}
+}
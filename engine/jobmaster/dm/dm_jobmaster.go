@@ -22,6 +22,7 @@ import (
 	"github.com/coreos/go-semver/semver"
 	"github.com/gin-gonic/gin"
 	"github.com/pingcap/errors"
+	"go.uber.org/atomic"
 	"go.uber.org/zap"
 
 	"github.com/pingcap/log"
@@ -40,6 +41,7 @@ import (
 	"github.com/pingcap/tiflow/engine/model"
 	dcontext "github.com/pingcap/tiflow/engine/pkg/context"
 	dmpkg "github.com/pingcap/tiflow/engine/pkg/dm"
+	resourcemeta "github.com/pingcap/tiflow/engine/pkg/externalresource/resourcemeta/model"
 	"github.com/pingcap/tiflow/engine/pkg/p2p"
 )
 
@@ -61,6 +63,8 @@ type JobMaster struct {
 	messageAgent          dmpkg.MessageAgent
 	checkpointAgent       checkpoint.Agent
 	messageHandlerManager p2p.MessageHandlerManager
+	// stopping is set when the job manager asks the job master to stop.
+	stopping atomic.Bool
 }
 
 var (
@@ -111,6 +115,26 @@ func (jm *JobMaster) initComponents(ctx context.Context) error {
 	return err
 }
 
+// CreateWorker implements WorkerAgent.CreateWorker.
+// The subtasks of a job are spread across executors if possible, so that
+// they don't share the failure of an executor. The failures of the workers
+// of a subtask are counted together by the retry policy of the job.
+func (jm *JobMaster) CreateWorker(
+	workerType framework.WorkerType,
+	cfg framework.WorkerConfig,
+	cost model.RescUnit,
+	resources ...resourcemeta.ResourceID,
+) (frameModel.WorkerID, error) {
+	opts := []framework.CreateWorkerOpt{
+		framework.CreateWorkerWithResourceRequirements(resources...),
+		framework.CreateWorkerWithAntiAffinity(false /* required */),
+	}
+	if taskCfg, ok := cfg.(*config.TaskCfg); ok && len(taskCfg.Upstreams) > 0 {
+		opts = append(opts, framework.CreateWorkerWithRestartKey(taskCfg.Upstreams[0].SourceID))
+	}
+	return jm.BaseJobMaster.CreateWorkerWithOpts(workerType, cfg, cost, opts...)
+}
+
 // InitImpl implements JobMasterImpl.InitImpl
 func (jm *JobMaster) InitImpl(ctx context.Context) error {
 	jm.Logger().Info("initializing the dm jobmaster")
@@ -132,6 +156,15 @@ func (jm *JobMaster) InitImpl(ctx context.Context) error {
 // Tick implements JobMasterImpl.Tick
 func (jm *JobMaster) Tick(ctx context.Context) error {
 	jm.workerManager.Tick(ctx)
+	if jm.stopping.Load() {
+		if err := jm.messageAgent.Tick(ctx); err != nil {
+			return err
+		}
+		if jm.workerManager.allTombStone() {
+			return jm.stop(ctx)
+		}
+		return nil
+	}
 	jm.taskManager.Tick(ctx)
 	if err := jm.messageAgent.Tick(ctx); err != nil {
 		return err
@@ -235,8 +268,16 @@ func (jm *JobMaster) OnWorkerStatusUpdated(worker framework.WorkerHandle, newSta
 }
 
 // OnJobManagerMessage implements JobMasterImpl.OnJobManagerMessage
+// The job master stops all workers when the job is paused or canceled.
 func (jm *JobMaster) OnJobManagerMessage(topic p2p.Topic, message interface{}) error {
-	// TODO: receive user request
+	msg, ok := message.(*frameModel.StatusChangeRequest)
+	if !ok || msg.ExpectState != frameModel.WorkerStatusStopped {
+		jm.Logger().Info("ignore job manager message", zap.Any("message", message))
+		return nil
+	}
+	jm.Logger().Info("stop the dm jobmaster", zap.Any("message", message))
+	jm.stopping.Store(true)
+	jm.workerManager.StopAllWorkers()
 	return nil
 }
 
@@ -395,6 +436,19 @@ func (jm *JobMaster) cancel(ctx context.Context, code frameModel.WorkerStatusCod
 	}
 }
 
+// stop exits the job master after all workers are stopped. Different from
+// cancel, the job is kept in metadata so that it can be resumed.
+func (jm *JobMaster) stop(ctx context.Context) error {
+	var extMsg string
+	status, err := jm.status(ctx, frameModel.WorkerStatusStopped)
+	if err != nil {
+		jm.Logger().Error("failed to get status", zap.Error(err))
+	} else {
+		extMsg = string(status.ExtBytes)
+	}
+	return jm.Exit(ctx, framework.ExitReasonCanceled, nil, extMsg)
+}
+
 func (jm *JobMaster) removeCheckpoint(ctx context.Context) error {
 	state, err := jm.metadata.JobStore().Get(ctx)
 	if err != nil {
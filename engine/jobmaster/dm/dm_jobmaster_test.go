@@ -426,6 +426,13 @@ func (m *MockBaseJobmaster) CreateWorker(workerType framework.WorkerType, config
 	return args.Get(0).(frameModel.WorkerID), args.Error(1)
 }
 
+func (m *MockBaseJobmaster) CreateWorkerWithOpts(workerType framework.WorkerType, config framework.WorkerConfig, cost model.RescUnit, opts ...framework.CreateWorkerOpt) (frameModel.WorkerID, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	args := m.MethodCalled("CreateWorker")
+	return args.Get(0).(frameModel.WorkerID), args.Error(1)
+}
+
 func (m *MockBaseJobmaster) CurrentEpoch() int64 {
 	return 0
 }
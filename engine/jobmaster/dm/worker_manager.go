@@ -18,6 +18,8 @@ import (
 	"sync"
 	"time"
 
+	"go.uber.org/atomic"
+
 	dmconfig "github.com/pingcap/tiflow/dm/config"
 	"github.com/pingcap/tiflow/engine/model"
 	resourcemeta "github.com/pingcap/tiflow/engine/pkg/externalresource/resourcemeta/model"
@@ -70,6 +72,9 @@ type WorkerManager struct {
 	// workerStatusMap record the runtime worker status
 	// taskID -> WorkerStatus
 	workerStatusMap sync.Map
+	// stopping is set when the job is being paused or canceled, all workers
+	// will be stopped and no worker will be created.
+	stopping atomic.Bool
 }
 
 // NewWorkerManager creates a new WorkerManager instance
@@ -114,6 +119,11 @@ func (wm *WorkerManager) TickImpl(ctx context.Context) error {
 	wm.logger.Info("start to schedule workers")
 	wm.removeOfflineWorkers()
 
+	if wm.stopping.Load() {
+		wm.logger.Info("on job stopping")
+		return wm.onJobDel(ctx)
+	}
+
 	state, err := wm.jobStore.Get(ctx)
 	if err != nil || state.(*metadata.Job).Deleting {
 		wm.logger.Info("on job deleting", zap.Error(err))
@@ -137,6 +147,13 @@ func (wm *WorkerManager) TickImpl(ctx context.Context) error {
 	return recordError
 }
 
+// StopAllWorkers stops all workers without removing the job from metadata,
+// the workers will be created again after the job master is recovered.
+func (wm *WorkerManager) StopAllWorkers() {
+	wm.stopping.Store(true)
+	wm.SetNextCheckTime(time.Now())
+}
+
 // remove offline worker status, usually happened when worker is offline.
 func (wm *WorkerManager) removeOfflineWorkers() {
 	wm.workerStatusMap.Range(func(key, value interface{}) bool {
@@ -219,6 +219,29 @@ func (t *testDMJobmasterSuite) TestClearWorkerStatus() {
 	}, 10*time.Second, 200*time.Millisecond)
 }
 
+func (t *testDMJobmasterSuite) TestStopAllWorkers() {
+	messageAgent := &dmpkg.MockMessageAgent{}
+	workerAgent := &MockWorkerAgent{}
+	workerStatus1 := runtime.InitWorkerStatus("source1", framework.WorkerDMDump, "worker-id-1")
+	workerStatus1.Stage = runtime.WorkerOnline
+	workerStatus2 := runtime.InitWorkerStatus("source2", framework.WorkerDMDump, "worker-id-2")
+	workerStatus2.Stage = runtime.WorkerFinished
+
+	workerManager := NewWorkerManager("job_id", []runtime.WorkerStatus{workerStatus1, workerStatus2}, nil, workerAgent, messageAgent, nil, log.L())
+	require.False(t.T(), workerManager.allTombStone())
+
+	// only the running worker is stopped and no worker is created
+	workerManager.StopAllWorkers()
+	messageAgent.On("SendMessage").Return(nil).Once()
+	require.NoError(t.T(), workerManager.TickImpl(context.Background()))
+	messageAgent.AssertNumberOfCalls(t.T(), "SendMessage", 1)
+	workerAgent.AssertNotCalled(t.T(), "CreateWorker")
+
+	workerStatus1.Stage = runtime.WorkerOffline
+	workerManager.UpdateWorkerStatus(workerStatus1)
+	require.True(t.T(), workerManager.allTombStone())
+}
+
 func (t *testDMJobmasterSuite) TestCreateWorker() {
 	mockAgent := &MockWorkerAgent{}
 	ctx, cancel := context.WithCancel(context.Background())
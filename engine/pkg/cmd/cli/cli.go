@@ -74,6 +74,7 @@ func NewCmdCli() *cobra.Command {
 
 	// Add subcommands.
 	cmds.AddCommand(newCmdJob())
+	cmds.AddCommand(newCmdExecutor())
 
 	return cmds
 }
@@ -0,0 +1,85 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+	"time"
+
+	perrors "github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/engine/enginepb"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// executorGeneralOptions defines some general options of executor management
+type executorGeneralOptions struct {
+	masterAddrs []string
+	rpcTimeout  time.Duration
+	// TODO: add tls support
+
+	// Following fields are generated from options
+	discoveryCli enginepb.DiscoveryClient
+}
+
+func newExecutorGeneralOptions() *executorGeneralOptions {
+	return &executorGeneralOptions{}
+}
+
+// addFlags receives a *cobra.Command reference and binds
+// flags related to template printing to it.
+func (o *executorGeneralOptions) addFlags(cmd *cobra.Command) {
+	if o == nil {
+		return
+	}
+
+	cmd.PersistentFlags().StringSliceVar(&o.masterAddrs, "master-addrs", nil, "server master addresses")
+	cmd.PersistentFlags().DurationVar(&o.rpcTimeout, "rpc-timeout", time.Second*30, "default rpc timeout")
+}
+
+// validate checks that the provided executor options are valid.
+func (o *executorGeneralOptions) validate(ctx context.Context, cmd *cobra.Command) error {
+	if len(o.masterAddrs) == 0 {
+		o.masterAddrs = []string{defaultMasterAddr}
+		log.Warn("the master-addrs are not assigned, use default addr: " + defaultMasterAddr)
+	}
+
+	// TODO support https.
+	dialURL := o.masterAddrs[0]
+	grpcConn, err := grpc.Dial(dialURL, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return perrors.Trace(err)
+	}
+	o.discoveryCli = enginepb.NewDiscoveryClient(grpcConn)
+
+	return nil
+}
+
+// newCmdExecutor creates the `cli executor` command.
+func newCmdExecutor() *cobra.Command {
+	o := newExecutorGeneralOptions()
+
+	cmds := &cobra.Command{
+		Use:   "executor",
+		Short: "Manage executor",
+		Args:  cobra.NoArgs,
+	}
+
+	o.addFlags(cmds)
+	cmds.AddCommand(newCmdExecutorDrain(o))
+
+	return cmds
+}
@@ -0,0 +1,139 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	perrors "github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/engine/enginepb"
+	cmdcontext "github.com/pingcap/tiflow/pkg/cmd/context"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+)
+
+// executorDrainOptions defines flags for executor drain.
+type executorDrainOptions struct {
+	generalOpts *executorGeneralOptions
+
+	executorID   string
+	wait         bool
+	pollInterval time.Duration
+}
+
+// newExecutorDrainOptions creates new drain executor options.
+func newExecutorDrainOptions(generalOpts *executorGeneralOptions) *executorDrainOptions {
+	return &executorDrainOptions{generalOpts: generalOpts}
+}
+
+// addFlags receives a *cobra.Command reference and binds
+// flags related to template printing to it.
+func (o *executorDrainOptions) addFlags(cmd *cobra.Command) {
+	if o == nil {
+		return
+	}
+
+	cmd.Flags().StringVar(&o.executorID, "executor-id", "", "executor id")
+	cmd.Flags().BoolVar(&o.wait, "wait", false, "wait until all the migratable workers leave the executor")
+	cmd.Flags().DurationVar(&o.pollInterval, "poll-interval", time.Second*5, "interval to poll the drain status when --wait is set")
+}
+
+func (o *executorDrainOptions) validate(ctx context.Context, cmd *cobra.Command) error {
+	if o.executorID == "" {
+		return fmt.Errorf("executor-id must be specified")
+	}
+	if o.pollInterval <= 0 {
+		return fmt.Errorf("poll-interval must be positive")
+	}
+	return o.generalOpts.validate(ctx, cmd)
+}
+
+// run the `cli executor drain` command.
+func (o *executorDrainOptions) run(ctx context.Context, cmd *cobra.Command) error {
+	status, err := o.drain(ctx)
+	if err != nil {
+		return err
+	}
+	log.Info("drain executor request is sent", zap.Any("status", status))
+	if !o.wait {
+		return nil
+	}
+
+	ticker := time.NewTicker(o.pollInterval)
+	defer ticker.Stop()
+	for !isDrained(status) {
+		select {
+		case <-ctx.Done():
+			return perrors.Trace(ctx.Err())
+		case <-ticker.C:
+		}
+		status, err = o.getDrainStatus(ctx)
+		if err != nil {
+			return err
+		}
+		log.Info("waiting for executor to be drained",
+			zap.String("executor-id", o.executorID),
+			zap.Int64("worker-count", status.WorkerCount),
+			zap.Strings("pinned-worker-ids", status.PinnedWorkerIds))
+	}
+	log.Info("executor is drained", zap.Any("status", status))
+	return nil
+}
+
+func (o *executorDrainOptions) drain(ctx context.Context) (*enginepb.ExecutorDrainStatus, error) {
+	ctx, cancel := context.WithTimeout(ctx, o.generalOpts.rpcTimeout)
+	defer cancel()
+	return o.generalOpts.discoveryCli.DrainExecutor(ctx, &enginepb.DrainExecutorRequest{
+		ExecutorId: o.executorID,
+	})
+}
+
+func (o *executorDrainOptions) getDrainStatus(ctx context.Context) (*enginepb.ExecutorDrainStatus, error) {
+	ctx, cancel := context.WithTimeout(ctx, o.generalOpts.rpcTimeout)
+	defer cancel()
+	return o.generalOpts.discoveryCli.GetExecutorDrainStatus(ctx, &enginepb.GetExecutorDrainStatusRequest{
+		ExecutorId: o.executorID,
+	})
+}
+
+// isDrained returns true if only pinned workers, which can not be migrated
+// because of their local resources, are left on the executor.
+func isDrained(status *enginepb.ExecutorDrainStatus) bool {
+	return status.Drained || status.WorkerCount <= int64(len(status.PinnedWorkerIds))
+}
+
+// newCmdExecutorDrain creates the `cli executor drain` command.
+func newCmdExecutorDrain(generalOpts *executorGeneralOptions) *cobra.Command {
+	o := newExecutorDrainOptions(generalOpts)
+
+	command := &cobra.Command{
+		Use:   "drain",
+		Short: "Drain an executor, migrating its workers to other executors",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmdcontext.GetDefaultContext()
+			if err := o.validate(ctx, cmd); err != nil {
+				return err
+			}
+			return o.run(ctx, cmd)
+		},
+	}
+
+	o.addFlags(command)
+
+	return command
+}
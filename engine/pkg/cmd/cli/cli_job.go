@@ -110,7 +110,12 @@ func newCmdJob() *cobra.Command {
 	o.addFlags(cmds)
 	cmds.AddCommand(newCmdJobCreate(o))
 	cmds.AddCommand(newCmdJobQuery(o))
+	cmds.AddCommand(newCmdJobList(o))
+	cmds.AddCommand(newCmdJobEvents(o))
 	cmds.AddCommand(newCmdJobCancel(o))
+	cmds.AddCommand(newCmdJobPause(o))
+	cmds.AddCommand(newCmdJobResume(o))
+	cmds.AddCommand(newCmdJobUpdateConfig(o))
 
 	return cmds
 }
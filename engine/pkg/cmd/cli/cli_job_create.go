@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"time"
 
 	"github.com/pingcap/log"
 	"github.com/pingcap/tiflow/engine/enginepb"
@@ -37,6 +38,17 @@ type jobCreateOptions struct {
 	jobID     string
 	jobType   enginepb.Job_Type
 	jobConfig []byte
+
+	maxRestarts        int32
+	initialBackoff     time.Duration
+	maxBackoff         time.Duration
+	backoffMultiplier  float64
+	failureWindow      time.Duration
+	terminalErrorCodes []string
+
+	labels map[string]string
+
+	retryPolicy *enginepb.RetryPolicy
 }
 
 // newJobCreateOptions creates new job options.
@@ -51,9 +63,20 @@ func (o *jobCreateOptions) addFlags(cmd *cobra.Command) {
 		return
 	}
 
-	cmd.Flags().Var(newJobTypeValue(enginepb.Job_TypeUnknown, &o.jobType), "job-type", "job type, one of [FakeJob, CVSDemo, DM]")
+	cmd.Flags().Var(newJobTypeValue(enginepb.Job_TypeUnknown, &o.jobType), "job-type", "job type, one of [FakeJob, CVSDemo, DM, CDC]")
 	cmd.Flags().StringVar(&o.jobConfigStr, "job-config", "", "path of config file for the job")
 	cmd.Flags().StringVar(&o.jobID, "job-id", "", "job id")
+	cmd.Flags().Int32Var(&o.maxRestarts, "max-restarts", 0,
+		"max restarts of a failed worker within the failure window, 0 means unlimited")
+	cmd.Flags().DurationVar(&o.initialBackoff, "initial-backoff", 0, "delay before the first restart of a failed worker")
+	cmd.Flags().DurationVar(&o.maxBackoff, "max-backoff", 0, "max delay before restarting a failed worker")
+	cmd.Flags().Float64Var(&o.backoffMultiplier, "backoff-multiplier", 0,
+		"multiplier of the delay for each restart of a failed worker, 2 if not set")
+	cmd.Flags().DurationVar(&o.failureWindow, "failure-window", 0,
+		"period in which the failures of a worker are counted, 0 means forever")
+	cmd.Flags().StringSliceVar(&o.terminalErrorCodes, "terminal-error-codes", nil,
+		"error codes failing the job instead of restarting the worker, e.g. DFLOW:ErrWorkerFailed")
+	cmd.Flags().StringToStringVar(&o.labels, "labels", nil, "labels of the job, e.g. env=prod,team=dm")
 
 	_ = cmd.MarkFlagRequired("job-type")
 }
@@ -80,8 +103,10 @@ func (v *jobTypeValue) Set(val string) error {
 		*v = jobTypeValue(enginepb.Job_CVSDemo)
 	case "DM":
 		*v = jobTypeValue(enginepb.Job_DM)
+	case "CDC":
+		*v = jobTypeValue(enginepb.Job_CDC)
 	default:
-		return fmt.Errorf("job type must be one of [FakeJob, CVSDemo, DM]")
+		return fmt.Errorf("job type must be one of [FakeJob, CVSDemo, DM, CDC]")
 	}
 	return nil
 }
@@ -102,6 +127,24 @@ func (o *jobCreateOptions) validate(ctx context.Context, cmd *cobra.Command) err
 	}
 	o.jobConfig = jobConfig
 
+	retryFlags := []string{
+		"max-restarts", "initial-backoff", "max-backoff",
+		"backoff-multiplier", "failure-window", "terminal-error-codes",
+	}
+	for _, name := range retryFlags {
+		if cmd.Flags().Changed(name) {
+			o.retryPolicy = &enginepb.RetryPolicy{
+				MaxRestarts:        o.maxRestarts,
+				InitialBackoffMs:   o.initialBackoff.Milliseconds(),
+				MaxBackoffMs:       o.maxBackoff.Milliseconds(),
+				BackoffMultiplier:  o.backoffMultiplier,
+				FailureWindowMs:    o.failureWindow.Milliseconds(),
+				TerminalErrorCodes: o.terminalErrorCodes,
+			}
+			break
+		}
+	}
+
 	return nil
 }
 
@@ -122,8 +165,10 @@ func openFileAndReadString(path string) (content []byte, err error) {
 func (o *jobCreateOptions) run(ctx context.Context, cmd *cobra.Command) error {
 	job, err := o.generalOpts.jobManagerCli.CreateJob(ctx, &enginepb.CreateJobRequest{
 		Job: &enginepb.Job{
-			Type:   o.jobType,
-			Config: o.jobConfig,
+			Type:        o.jobType,
+			Config:      o.jobConfig,
+			RetryPolicy: o.retryPolicy,
+			Labels:      o.labels,
 		},
 		TenantId:  o.generalOpts.tenant.TenantID(),
 		ProjectId: o.generalOpts.tenant.ProjectID(),
@@ -0,0 +1,102 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/engine/enginepb"
+	cmdcontext "github.com/pingcap/tiflow/pkg/cmd/context"
+	"github.com/pingcap/tiflow/pkg/errors"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+)
+
+// jobEventsOptions defines flags for job events.
+type jobEventsOptions struct {
+	generalOpts *jobGeneralOptions
+
+	jobID     string
+	pageSize  int32
+	pageToken string
+}
+
+// newJobEventsOptions creates new job events options.
+func newJobEventsOptions(generalOpts *jobGeneralOptions) *jobEventsOptions {
+	return &jobEventsOptions{generalOpts: generalOpts}
+}
+
+// addFlags receives a *cobra.Command reference and binds
+// flags related to template printing to it.
+func (o *jobEventsOptions) addFlags(cmd *cobra.Command) {
+	if o == nil {
+		return
+	}
+
+	cmd.Flags().StringVar(&o.jobID, "job-id", "", "job id")
+	cmd.Flags().Int32Var(&o.pageSize, "page-size", 0, "max number of events to list, 0 means unlimited")
+	cmd.Flags().StringVar(&o.pageToken, "page-token", "", "the page token returned by the previous list")
+}
+
+func (o *jobEventsOptions) validate(ctx context.Context, cmd *cobra.Command) error {
+	if err := o.generalOpts.validate(ctx, cmd); err != nil {
+		return errors.WrapError(errors.ErrInvalidCliParameter, err)
+	}
+	if o.jobID == "" {
+		return errors.ErrInvalidCliParameter.GenWithStack("job-id must be specified")
+	}
+	if o.pageSize < 0 {
+		return errors.ErrInvalidCliParameter.GenWithStack("page size must not be negative")
+	}
+	return nil
+}
+
+// run the `cli job events` command.
+func (o *jobEventsOptions) run(ctx context.Context, cmd *cobra.Command) error {
+	resp, err := o.generalOpts.jobManagerCli.ListJobEvents(ctx, &enginepb.ListJobEventsRequest{
+		Id:        o.jobID,
+		TenantId:  o.generalOpts.tenant.TenantID(),
+		ProjectId: o.generalOpts.tenant.ProjectID(),
+		PageSize:  o.pageSize,
+		PageToken: o.pageToken,
+	})
+	if err != nil {
+		return err
+	}
+	log.Info("list job events successfully", zap.Any("resp", resp))
+	return nil
+}
+
+// newCmdJobEvents creates the `cli job events` command.
+func newCmdJobEvents(generalOpts *jobGeneralOptions) *cobra.Command {
+	o := newJobEventsOptions(generalOpts)
+
+	command := &cobra.Command{
+		Use:   "events",
+		Short: "List the events of a job",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmdcontext.GetDefaultContext()
+			if err := o.validate(ctx, cmd); err != nil {
+				return err
+			}
+			return o.run(ctx, cmd)
+		},
+	}
+
+	o.addFlags(command)
+
+	return command
+}
@@ -0,0 +1,138 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+	"time"
+
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/engine/enginepb"
+	cmdcontext "github.com/pingcap/tiflow/pkg/cmd/context"
+	"github.com/pingcap/tiflow/pkg/errors"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+)
+
+// jobListOptions defines flags for job list.
+type jobListOptions struct {
+	generalOpts *jobGeneralOptions
+
+	jobType        enginepb.Job_Type
+	statuses       []string
+	labelSelectors []string
+	createdAfter   string
+	createdBefore  string
+	pageSize       int32
+	pageToken      string
+
+	jobStatuses     []enginepb.Job_Status
+	createdAfterMs  int64
+	createdBeforeMs int64
+}
+
+// newJobListOptions creates new list job options.
+func newJobListOptions(generalOpts *jobGeneralOptions) *jobListOptions {
+	return &jobListOptions{generalOpts: generalOpts}
+}
+
+// addFlags receives a *cobra.Command reference and binds
+// flags related to template printing to it.
+func (o *jobListOptions) addFlags(cmd *cobra.Command) {
+	if o == nil {
+		return
+	}
+
+	cmd.Flags().Var(newJobTypeValue(enginepb.Job_TypeUnknown, &o.jobType), "job-type", "job type, one of [FakeJob, CVSDemo, DM, CDC]")
+	cmd.Flags().StringSliceVar(&o.statuses, "status", nil, "job statuses, e.g. Running,Paused")
+	cmd.Flags().StringSliceVar(&o.labelSelectors, "label", nil, "label selectors, e.g. env=prod,team!=dm")
+	cmd.Flags().StringVar(&o.createdAfter, "created-after", "", "only list the jobs created at or after the time, in RFC3339 format")
+	cmd.Flags().StringVar(&o.createdBefore, "created-before", "", "only list the jobs created before the time, in RFC3339 format")
+	cmd.Flags().Int32Var(&o.pageSize, "page-size", 0, "max number of jobs to list, 0 means unlimited")
+	cmd.Flags().StringVar(&o.pageToken, "page-token", "", "the page token returned by the previous list")
+}
+
+func (o *jobListOptions) validate(ctx context.Context, cmd *cobra.Command) error {
+	if err := o.generalOpts.validate(ctx, cmd); err != nil {
+		return errors.WrapError(errors.ErrInvalidCliParameter, err)
+	}
+	for _, s := range o.statuses {
+		status, ok := enginepb.Job_Status_value[s]
+		if !ok {
+			return errors.ErrInvalidCliParameter.GenWithStack("unknown job status %s", s)
+		}
+		o.jobStatuses = append(o.jobStatuses, enginepb.Job_Status(status))
+	}
+	if o.createdAfter != "" {
+		t, err := time.Parse(time.RFC3339, o.createdAfter)
+		if err != nil {
+			return errors.WrapError(errors.ErrInvalidCliParameter, err)
+		}
+		o.createdAfterMs = t.UnixMilli()
+	}
+	if o.createdBefore != "" {
+		t, err := time.Parse(time.RFC3339, o.createdBefore)
+		if err != nil {
+			return errors.WrapError(errors.ErrInvalidCliParameter, err)
+		}
+		o.createdBeforeMs = t.UnixMilli()
+	}
+	if o.pageSize < 0 {
+		return errors.ErrInvalidCliParameter.GenWithStack("page size must not be negative")
+	}
+	return nil
+}
+
+// run the `cli job list` command.
+func (o *jobListOptions) run(ctx context.Context, cmd *cobra.Command) error {
+	req := &enginepb.ListJobsRequest{
+		TenantId:        o.generalOpts.tenant.TenantID(),
+		ProjectId:       o.generalOpts.tenant.ProjectID(),
+		PageSize:        o.pageSize,
+		PageToken:       o.pageToken,
+		Type:            o.jobType,
+		Statuses:        o.jobStatuses,
+		LabelSelectors:  o.labelSelectors,
+		CreatedAfterMs:  o.createdAfterMs,
+		CreatedBeforeMs: o.createdBeforeMs,
+	}
+	resp, err := o.generalOpts.jobManagerCli.ListJobs(ctx, req)
+	if err != nil {
+		return err
+	}
+	log.Info("list jobs successfully", zap.Any("resp", resp))
+	return nil
+}
+
+// newCmdJobList creates the `cli job list` command.
+func newCmdJobList(generalOpts *jobGeneralOptions) *cobra.Command {
+	o := newJobListOptions(generalOpts)
+
+	command := &cobra.Command{
+		Use:   "list",
+		Short: "List jobs",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmdcontext.GetDefaultContext()
+			if err := o.validate(ctx, cmd); err != nil {
+				return err
+			}
+			return o.run(ctx, cmd)
+		},
+	}
+
+	o.addFlags(command)
+
+	return command
+}
@@ -0,0 +1,104 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/engine/enginepb"
+	cmdcontext "github.com/pingcap/tiflow/pkg/cmd/context"
+	"github.com/pingcap/tiflow/pkg/errors"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+)
+
+// jobUpdateConfigOptions defines flags for job update-config.
+type jobUpdateConfigOptions struct {
+	generalOpts *jobGeneralOptions
+
+	jobID        string
+	jobConfigStr string
+
+	jobConfig []byte
+}
+
+// newJobUpdateConfigOptions creates new update-config job options.
+func newJobUpdateConfigOptions(generalOpts *jobGeneralOptions) *jobUpdateConfigOptions {
+	return &jobUpdateConfigOptions{generalOpts: generalOpts}
+}
+
+// addFlags receives a *cobra.Command reference and binds
+// flags related to template printing to it.
+func (o *jobUpdateConfigOptions) addFlags(cmd *cobra.Command) {
+	if o == nil {
+		return
+	}
+
+	cmd.Flags().StringVar(&o.jobID, "job-id", "", "job id")
+	cmd.Flags().StringVar(&o.jobConfigStr, "job-config", "", "path of the new config file for the job")
+
+	_ = cmd.MarkFlagRequired("job-id")
+	_ = cmd.MarkFlagRequired("job-config")
+}
+
+func (o *jobUpdateConfigOptions) validate(ctx context.Context, cmd *cobra.Command) error {
+	if err := o.generalOpts.validate(ctx, cmd); err != nil {
+		return errors.WrapError(errors.ErrInvalidCliParameter, err)
+	}
+
+	jobConfig, err := openFileAndReadString(o.jobConfigStr)
+	if err != nil {
+		return errors.WrapError(errors.ErrInvalidCliParameter, err)
+	}
+	o.jobConfig = jobConfig
+	return nil
+}
+
+// run the `cli job update-config` command.
+func (o *jobUpdateConfigOptions) run(ctx context.Context, cmd *cobra.Command) error {
+	resp, err := o.generalOpts.jobManagerCli.UpdateJobConfig(ctx, &enginepb.UpdateJobConfigRequest{
+		Id:        o.jobID,
+		Config:    o.jobConfig,
+		TenantId:  o.generalOpts.tenant.TenantID(),
+		ProjectId: o.generalOpts.tenant.ProjectID(),
+	})
+	if err != nil {
+		return err
+	}
+	log.Info("update job config request is sent", zap.Any("resp", resp))
+	return nil
+}
+
+// newCmdJobUpdateConfig creates the `cli job update-config` command.
+func newCmdJobUpdateConfig(generalOpts *jobGeneralOptions) *cobra.Command {
+	o := newJobUpdateConfigOptions(generalOpts)
+
+	command := &cobra.Command{
+		Use:   "update-config",
+		Short: "Update the config of a job",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmdcontext.GetDefaultContext()
+			if err := o.validate(ctx, cmd); err != nil {
+				return err
+			}
+			return o.run(ctx, cmd)
+		},
+	}
+
+	o.addFlags(command)
+
+	return command
+}
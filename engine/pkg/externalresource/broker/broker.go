@@ -16,12 +16,14 @@ package broker
 import (
 	"context"
 	"fmt"
+	"sync"
 
 	"github.com/pingcap/errors"
 	"github.com/pingcap/log"
 	pb "github.com/pingcap/tiflow/engine/enginepb"
 	"github.com/pingcap/tiflow/engine/pkg/client"
 	resModel "github.com/pingcap/tiflow/engine/pkg/externalresource/resourcemeta/model"
+	"github.com/pingcap/tiflow/engine/pkg/externalresource/resourcetypes"
 	"github.com/pingcap/tiflow/engine/pkg/externalresource/storagecfg"
 	"github.com/pingcap/tiflow/engine/pkg/rpcerror"
 	"github.com/pingcap/tiflow/engine/pkg/tenant"
@@ -42,6 +44,11 @@ type DefaultBroker struct {
 	client     ResourceManagerClient
 
 	fileManager FileManager
+
+	// s3Temporaries are the s3 resources not persisted yet, they are
+	// removed when the creator worker is closed.
+	s3Mu          sync.Mutex
+	s3Temporaries map[resModel.WorkerID]map[*S3ResourceHandle]struct{}
 }
 
 // NewBroker creates a new Impl instance
@@ -50,9 +57,11 @@ func NewBroker(
 	executorID resModel.ExecutorID,
 	client ResourceManagerClient,
 ) *DefaultBroker {
+	// The s3 config may contain credentials, so it's not logged.
 	log.Info("Create new resource broker",
 		zap.String("executor-id", string(executorID)),
-		zap.Any("config", config))
+		zap.String("local-base-dir", config.Local.BaseDir),
+		zap.String("s3-uri", config.S3.URI))
 
 	fm := NewLocalFileManager(config.Local)
 	return &DefaultBroker{
@@ -60,6 +69,8 @@ func NewBroker(
 		executorID:  executorID,
 		client:      client,
 		fileManager: fm,
+
+		s3Temporaries: make(map[resModel.WorkerID]map[*S3ResourceHandle]struct{}),
 	}
 }
 
@@ -80,7 +91,7 @@ func (b *DefaultBroker) OpenStorage(
 	case resModel.ResourceTypeLocalFile:
 		return b.newHandleForLocalFile(ctx, projectInfo, jobID, workerID, resourcePath)
 	case resModel.ResourceTypeS3:
-		log.Panic("resource type s3 is not supported for now")
+		return b.newHandleForS3(ctx, projectInfo, jobID, workerID, resourcePath)
 	default:
 		log.Panic("unsupported resource type", zap.String("resource-path", resourcePath))
 	}
@@ -100,6 +111,20 @@ func (b *DefaultBroker) OnWorkerClosed(ctx context.Context, workerID resModel.Wo
 			zap.String("job-id", jobID),
 			zap.Error(err))
 	}
+
+	b.s3Mu.Lock()
+	temporaries := b.s3Temporaries[workerID]
+	delete(b.s3Temporaries, workerID)
+	b.s3Mu.Unlock()
+	for h := range temporaries {
+		if err := h.Discard(ctx); err != nil {
+			log.Warn("Failed to remove temporary s3 resource for worker",
+				zap.String("worker-id", workerID),
+				zap.String("job-id", jobID),
+				zap.String("resource-id", h.ID()),
+				zap.Error(err))
+		}
+	}
 }
 
 // RemoveResource implements pb.BrokerServiceServer.
@@ -182,6 +207,64 @@ func (b *DefaultBroker) newHandleForLocalFile(
 	return newLocalResourceHandle(projectInfo, resourceID, jobID, b.executorID, b.fileManager, desc, b.client)
 }
 
+func (b *DefaultBroker) newHandleForS3(
+	ctx context.Context,
+	projectInfo tenant.ProjectInfo,
+	jobID resModel.JobID,
+	workerID resModel.WorkerID,
+	resourceID resModel.ResourceID,
+) (Handle, error) {
+	_, resName, err := resModel.ParseResourcePath(resourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	record, exists, err := b.checkForExistingResource(ctx, resModel.ResourceKey{JobID: jobID, ID: resourceID})
+	if err != nil {
+		return nil, err
+	}
+
+	storage, err := resourcetypes.NewS3Storage(ctx, b.config.S3, jobID, resName)
+	if err != nil {
+		return nil, err
+	}
+	log.Info("Using s3 storage with uri", zap.String("uri", storage.URI()))
+
+	hdl := &S3ResourceHandle{
+		projectInfo: projectInfo,
+		id:          resourceID,
+		jobID:       jobID,
+		workerID:    workerID,
+		executorID:  b.executorID,
+		inner:       storage,
+		client:      b.client,
+		onDone:      b.removeS3Temporary,
+	}
+	if exists {
+		// The resource may be created by a worker on another executor.
+		hdl.workerID = record.Worker
+		hdl.isPersisted.Store(true)
+		return hdl, nil
+	}
+
+	b.s3Mu.Lock()
+	defer b.s3Mu.Unlock()
+	if _, ok := b.s3Temporaries[workerID]; !ok {
+		b.s3Temporaries[workerID] = make(map[*S3ResourceHandle]struct{})
+	}
+	b.s3Temporaries[workerID][hdl] = struct{}{}
+	return hdl, nil
+}
+
+func (b *DefaultBroker) removeS3Temporary(h *S3ResourceHandle) {
+	b.s3Mu.Lock()
+	defer b.s3Mu.Unlock()
+	delete(b.s3Temporaries[h.workerID], h)
+	if len(b.s3Temporaries[h.workerID]) == 0 {
+		delete(b.s3Temporaries, h.workerID)
+	}
+}
+
 func (b *DefaultBroker) checkForExistingResource(
 	ctx context.Context,
 	resourceKey resModel.ResourceKey,
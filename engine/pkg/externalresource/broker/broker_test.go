@@ -21,8 +21,10 @@ import (
 
 	pb "github.com/pingcap/tiflow/engine/enginepb"
 	"github.com/pingcap/tiflow/engine/pkg/externalresource/manager"
+	resModel "github.com/pingcap/tiflow/engine/pkg/externalresource/resourcemeta/model"
 	"github.com/pingcap/tiflow/engine/pkg/externalresource/storagecfg"
 	"github.com/pingcap/tiflow/engine/pkg/tenant"
+	derrors "github.com/pingcap/tiflow/pkg/errors"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
@@ -195,3 +197,93 @@ func TestBrokerRemoveResource(t *testing.T) {
 	code = status.Convert(err).Code()
 	require.Equal(t, codes.InvalidArgument, code)
 }
+
+func newBrokerWithS3(t *testing.T, executorID string, s3Dir string) (*DefaultBroker, *manager.MockClient) {
+	cli := manager.NewMockClient()
+	// A local directory stands in for s3 in the tests.
+	broker := NewBroker(&storagecfg.Config{
+		Local: storagecfg.LocalFileConfig{BaseDir: t.TempDir()},
+		S3:    storagecfg.S3Config{URI: s3Dir},
+	}, resModel.ExecutorID(executorID), cli)
+	return broker, cli
+}
+
+func TestBrokerOpenS3Storage(t *testing.T) {
+	fakeProjectInfo := tenant.NewProjectInfo("fakeTenant", "fakeProject")
+	s3Dir := t.TempDir()
+	brk, cli := newBrokerWithS3(t, "executor-1", s3Dir)
+
+	cli.On("QueryResource", mock.Anything,
+		&pb.QueryResourceRequest{ResourceKey: &pb.ResourceKey{JobId: "job-1", ResourceId: "/s3/test-1"}}, mock.Anything).
+		Return((*pb.QueryResourceResponse)(nil), status.Error(codes.NotFound, "resource manager error")).Once()
+	hdl, err := brk.OpenStorage(context.Background(), fakeProjectInfo, "worker-1", "job-1", "/s3/test-1")
+	require.NoError(t, err)
+	require.Equal(t, "/s3/test-1", hdl.ID())
+
+	err = hdl.BrExternalStorage().WriteFile(context.Background(), "1.txt", []byte("content"))
+	require.NoError(t, err)
+	require.FileExists(t, filepath.Join(s3Dir, "job-1", resourceNameToFilePathName("test-1"), "1.txt"))
+
+	cli.On("CreateResource", mock.Anything, &pb.CreateResourceRequest{
+		ProjectInfo:     &pb.ProjectInfo{TenantId: fakeProjectInfo.TenantID(), ProjectId: fakeProjectInfo.ProjectID()},
+		ResourceId:      "/s3/test-1",
+		CreatorExecutor: "executor-1",
+		JobId:           "job-1",
+		CreatorWorkerId: "worker-1",
+	}, mock.Anything).Return(nil).Once()
+	require.NoError(t, hdl.Persist(context.Background()))
+	cli.AssertExpectations(t)
+
+	// The persisted resource is kept after the worker is closed, and it can
+	// be opened on another executor.
+	brk.OnWorkerClosed(context.Background(), "worker-1", "job-1")
+	brk2, cli2 := newBrokerWithS3(t, "executor-2", s3Dir)
+	cli2.On("QueryResource", mock.Anything,
+		&pb.QueryResourceRequest{ResourceKey: &pb.ResourceKey{JobId: "job-1", ResourceId: "/s3/test-1"}}, mock.Anything).
+		Return(&pb.QueryResourceResponse{
+			CreatorExecutor: "executor-1",
+			JobId:           "job-1",
+			CreatorWorkerId: "worker-1",
+		}, nil).Once()
+	hdl, err = brk2.OpenStorage(context.Background(), fakeProjectInfo, "worker-2", "job-1", "/s3/test-1")
+	require.NoError(t, err)
+	content, err := hdl.BrExternalStorage().ReadFile(context.Background(), "1.txt")
+	require.NoError(t, err)
+	require.Equal(t, []byte("content"), content)
+
+	cli2.On("RemoveResource", mock.Anything, &pb.RemoveResourceRequest{
+		ResourceKey: &pb.ResourceKey{JobId: "job-1", ResourceId: "/s3/test-1"},
+	}, mock.Anything).Return(nil).Once()
+	require.NoError(t, hdl.Discard(context.Background()))
+	cli2.AssertExpectations(t)
+	require.NoFileExists(t, filepath.Join(s3Dir, "job-1", resourceNameToFilePathName("test-1"), "1.txt"))
+}
+
+func TestBrokerRemoveTemporaryS3Storage(t *testing.T) {
+	fakeProjectInfo := tenant.NewProjectInfo("fakeTenant", "fakeProject")
+	s3Dir := t.TempDir()
+	brk, cli := newBrokerWithS3(t, "executor-1", s3Dir)
+
+	cli.On("QueryResource", mock.Anything,
+		&pb.QueryResourceRequest{ResourceKey: &pb.ResourceKey{JobId: "job-1", ResourceId: "/s3/test-2"}}, mock.Anything).
+		Return((*pb.QueryResourceResponse)(nil), status.Error(codes.NotFound, "resource manager error"))
+	hdl, err := brk.OpenStorage(context.Background(), fakeProjectInfo, "worker-1", "job-1", "/s3/test-2")
+	require.NoError(t, err)
+	err = hdl.BrExternalStorage().WriteFile(context.Background(), "1.txt", []byte("content"))
+	require.NoError(t, err)
+
+	brk.OnWorkerClosed(context.Background(), "worker-1", "job-1")
+	require.NoFileExists(t, filepath.Join(s3Dir, "job-1", resourceNameToFilePathName("test-2"), "1.txt"))
+	require.Empty(t, brk.s3Temporaries)
+}
+
+func TestBrokerS3StorageNotConfigured(t *testing.T) {
+	fakeProjectInfo := tenant.NewProjectInfo("fakeTenant", "fakeProject")
+	brk, cli, _ := newBroker(t)
+
+	cli.On("QueryResource", mock.Anything,
+		&pb.QueryResourceRequest{ResourceKey: &pb.ResourceKey{JobId: "job-1", ResourceId: "/s3/test-3"}}, mock.Anything).
+		Return((*pb.QueryResourceResponse)(nil), status.Error(codes.NotFound, "resource manager error"))
+	_, err := brk.OpenStorage(context.Background(), fakeProjectInfo, "worker-1", "job-1", "/s3/test-3")
+	require.True(t, derrors.ErrS3StorageNotConfigured.Equal(err))
+}
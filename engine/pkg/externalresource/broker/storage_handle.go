@@ -21,6 +21,7 @@ import (
 	pb "github.com/pingcap/tiflow/engine/enginepb"
 	"github.com/pingcap/tiflow/engine/pkg/client"
 	resModel "github.com/pingcap/tiflow/engine/pkg/externalresource/resourcemeta/model"
+	"github.com/pingcap/tiflow/engine/pkg/externalresource/resourcetypes"
 	"github.com/pingcap/tiflow/engine/pkg/tenant"
 	derrors "github.com/pingcap/tiflow/pkg/errors"
 	"go.uber.org/atomic"
@@ -150,3 +151,84 @@ func (h *LocalResourceHandle) Discard(ctx context.Context) error {
 	h.isInvalid.Store(true)
 	return nil
 }
+
+// S3ResourceHandle is the Handle of an s3 based resource, the files of the
+// resource are shared by all executors.
+type S3ResourceHandle struct {
+	projectInfo tenant.ProjectInfo
+	id          resModel.ResourceID
+	jobID       resModel.JobID
+	workerID    resModel.WorkerID
+	executorID  resModel.ExecutorID
+
+	inner  brStorage.ExternalStorage
+	client client.ResourceManagerClient
+
+	// onDone is called after the resource is persisted or discarded.
+	onDone func(h *S3ResourceHandle)
+
+	isPersisted atomic.Bool
+	isInvalid   atomic.Bool
+}
+
+// ID implements Handle.ID
+func (h *S3ResourceHandle) ID() resModel.ResourceID {
+	return h.id
+}
+
+// BrExternalStorage implements Handle.BrExternalStorage
+func (h *S3ResourceHandle) BrExternalStorage() brStorage.ExternalStorage {
+	return h.inner
+}
+
+// Persist implements Handle.Persist
+func (h *S3ResourceHandle) Persist(ctx context.Context) error {
+	if h.isInvalid.Load() {
+		return derrors.ErrInvalidResourceHandle.FastGenByArgs()
+	}
+	if h.isPersisted.Load() {
+		return nil
+	}
+
+	err := h.client.CreateResource(ctx, &pb.CreateResourceRequest{
+		ProjectInfo:     &pb.ProjectInfo{TenantId: h.projectInfo.TenantID(), ProjectId: h.projectInfo.ProjectID()},
+		ResourceId:      h.id,
+		CreatorExecutor: string(h.executorID),
+		JobId:           h.jobID,
+		CreatorWorkerId: h.workerID,
+	})
+	if err != nil {
+		return errors.Trace(err)
+	}
+	h.isPersisted.Store(true)
+	h.onDone(h)
+	return nil
+}
+
+// Discard implements Handle.Discard
+func (h *S3ResourceHandle) Discard(ctx context.Context) error {
+	if h.isInvalid.Load() {
+		return derrors.ErrInvalidResourceHandle.FastGenByArgs()
+	}
+
+	if err := resourcetypes.RemoveAllFiles(ctx, h.inner); err != nil {
+		return err
+	}
+
+	if h.isPersisted.Load() {
+		err := h.client.RemoveResource(ctx, &pb.RemoveResourceRequest{
+			ResourceKey: &pb.ResourceKey{
+				JobId:      h.jobID,
+				ResourceId: h.id,
+			},
+		})
+		if err != nil {
+			return errors.Trace(err)
+		}
+		h.isPersisted.Store(false)
+	}
+
+	h.isInvalid.Store(true)
+	h.onDone(h)
+	return nil
+}
@@ -179,8 +179,7 @@ func (c *DefaultGCCoordinator) gcByStatusSnapshots(
 
 		// toRemove is used to remove meta records when
 		// the associated executors are offline.
-		// TODO adjust the mechanism when we implement S3 support.
-		toRemove []model.ExecutorID
+		toRemove []*resModel.ResourceMeta
 	)
 	for _, resMeta := range resources {
 		if _, exists := jobSnapshot[resMeta.Job]; !exists {
@@ -189,9 +188,9 @@ func (c *DefaultGCCoordinator) gcByStatusSnapshots(
 			continue
 		}
 
-		if _, exists := executorSet[resMeta.Executor]; !exists {
+		if _, exists := executorSet[resMeta.Executor]; !exists && isBoundToExecutor(resMeta) {
 			// The resource belongs to an offlined executor.
-			toRemove = append(toRemove, resMeta.Executor)
+			toRemove = append(toRemove, resMeta)
 			continue
 		}
 	}
@@ -207,9 +206,9 @@ func (c *DefaultGCCoordinator) gcByStatusSnapshots(
 
 	if len(toRemove) > 0 {
 		log.Info("Removing stale resources for offlined executors",
-			zap.Any("resource-ids", toRemove))
+			zap.Any("resources", toRemove))
 		// Note: soft delete has not been implemented for resources yet.
-		if _, err := c.metaClient.DeleteResourcesByExecutorIDs(ctx, toRemove); err != nil {
+		if err := c.removeResourceMetas(ctx, toRemove); err != nil {
 			return err
 		}
 	}
@@ -232,11 +231,38 @@ func (c *DefaultGCCoordinator) gcByOfflineExecutorID(ctx context.Context, execut
 	log.Info("Cleaning up resources meta for offlined executor",
 		zap.String("executor-id", string(executorID)))
 
-	// Currently, we only support local files, so the resources are bound to
-	// the executors. Hence, executors going offline means that the resource is
-	// already gone.
-	// TODO Trigger GC for all resources and let the GCRunner decide whether to
-	// perform any action, or just remove the meta record.
-	_, err := c.metaClient.DeleteResourcesByExecutorID(ctx, executorID)
-	return err
+	// The local files are bound to the executors, so executors going offline
+	// means that the resource is already gone. The s3 resources are stored
+	// outside the executors, they are kept until their jobs are removed.
+	resources, err := c.metaClient.QueryResourcesByExecutorID(ctx, string(executorID))
+	if err != nil {
+		return err
+	}
+	toRemove := make([]*resModel.ResourceMeta, 0, len(resources))
+	for _, resMeta := range resources {
+		if isBoundToExecutor(resMeta) {
+			toRemove = append(toRemove, resMeta)
+		}
+	}
+	return c.removeResourceMetas(ctx, toRemove)
+}
+
+func (c *DefaultGCCoordinator) removeResourceMetas(
+	ctx context.Context, resources []*resModel.ResourceMeta,
+) error {
+	for _, resMeta := range resources {
+		_, err := c.metaClient.DeleteResource(ctx,
+			pkgOrm.ResourceKey{JobID: resMeta.Job, ID: resMeta.ID})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isBoundToExecutor returns whether the resource is gone with its creator
+// executor.
+func isBoundToExecutor(resMeta *resModel.ResourceMeta) bool {
+	tp, _, err := resModel.ParseResourcePath(resMeta.ID)
+	return err != nil || tp != resModel.ResourceTypeS3
 }
@@ -215,3 +215,31 @@ func TestGCCoordinatorRemoveJobAndExecutor(t *testing.T) {
 
 	helper.Close()
 }
+
+func TestGCCoordinatorKeepS3ResourcesOfOfflineExecutor(t *testing.T) {
+	helper := newGCTestHelper()
+	helper.LoadDefaultMockData(t)
+	err := helper.Meta.CreateResource(context.Background(), &resModel.ResourceMeta{
+		ID:       "/s3/resource-4",
+		Job:      "job-1",
+		Worker:   "worker-1",
+		Executor: "executor-1",
+	})
+	require.NoError(t, err)
+	helper.Start()
+
+	helper.ExecInfo.RemoveExecutor("executor-1")
+	require.Eventually(t, func() bool {
+		return helper.IsRemoved(t, pkgOrm.ResourceKey{JobID: "job-1", ID: "resource-1"})
+	}, 1*time.Second, 10*time.Millisecond)
+	// The s3 resource is stored outside the executor.
+	require.False(t, helper.IsRemoved(t, pkgOrm.ResourceKey{JobID: "job-1", ID: "/s3/resource-4"}))
+
+	helper.JobInfo.RemoveJob("job-1")
+	helper.Notifier.WaitNotify(t, 1*time.Second)
+	require.Eventually(t, func() bool {
+		return helper.IsGCPending(t, pkgOrm.ResourceKey{JobID: "job-1", ID: "/s3/resource-4"})
+	}, 1*time.Second, 10*time.Millisecond)
+
+	helper.Close()
+}
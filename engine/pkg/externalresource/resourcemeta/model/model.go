@@ -27,8 +27,8 @@ import (
 type (
 	// WorkerID alias worker id string
 	WorkerID = string
-	// ResourceID should be in the form of `/<type>/<unique-name>`, currently
-	// only local type is available.
+	// ResourceID should be in the form of `/<type>/<unique-name>`, the type
+	// can be `local` or `s3`.
 	ResourceID = string
 	// JobID alias job id string
 	JobID = model.JobID
@@ -0,0 +1,127 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resourcetypes
+
+import (
+	"context"
+	"encoding/hex"
+	"path"
+
+	perrors "github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	brStorage "github.com/pingcap/tidb/br/pkg/storage"
+	resModel "github.com/pingcap/tiflow/engine/pkg/externalresource/resourcemeta/model"
+	"github.com/pingcap/tiflow/engine/pkg/externalresource/storagecfg"
+	derrors "github.com/pingcap/tiflow/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// S3ResourceController manages the s3 based resources, which are stored
+// outside the executors.
+type S3ResourceController struct {
+	config storagecfg.S3Config
+}
+
+// NewS3ResourceType creates a new S3ResourceController.
+func NewS3ResourceType(config storagecfg.S3Config) *S3ResourceController {
+	return &S3ResourceController{config: config}
+}
+
+// GCHandler returns a closure to the invoked by the GC runner.
+func (r *S3ResourceController) GCHandler() func(context.Context, *resModel.ResourceMeta) error {
+	return r.removeFiles
+}
+
+func (r *S3ResourceController) removeFiles(ctx context.Context, resource *resModel.ResourceMeta) error {
+	_, resName, err := resModel.ParseResourcePath(resource.ID)
+	if err != nil {
+		return err
+	}
+	storage, err := NewS3Storage(ctx, r.config, resource.Job, resName)
+	if err != nil {
+		return perrors.Annotate(err, "removeFiles")
+	}
+	return RemoveAllFiles(ctx, storage)
+}
+
+// StorageSize returns the total size in bytes of the files of a resource.
+func (r *S3ResourceController) StorageSize(ctx context.Context, resource *resModel.ResourceMeta) (int64, error) {
+	_, resName, err := resModel.ParseResourcePath(resource.ID)
+	if err != nil {
+		return 0, err
+	}
+	storage, err := NewS3Storage(ctx, r.config, resource.Job, resName)
+	if err != nil {
+		return 0, perrors.Annotate(err, "StorageSize")
+	}
+	var size int64
+	err = storage.WalkDir(ctx, &brStorage.WalkOption{}, func(_ string, fileSize int64) error {
+		size += fileSize
+		return nil
+	})
+	if err != nil {
+		return 0, perrors.Trace(err)
+	}
+	return size, nil
+}
+
+// NewS3Storage creates the external storage of an s3 resource. The files
+// of the resource are stored in `<uri>/<job-id>/<encoded-resource-name>`,
+// so that any executor can access them.
+func NewS3Storage(
+	ctx context.Context,
+	config storagecfg.S3Config,
+	jobID resModel.JobID,
+	resName resModel.ResourceName,
+) (brStorage.ExternalStorage, error) {
+	if !config.IsEnabled() {
+		return nil, derrors.ErrS3StorageNotConfigured.GenWithStackByArgs()
+	}
+	u, err := brStorage.ParseRawURL(config.URI)
+	if err != nil {
+		return nil, derrors.ErrFailToCreateExternalStorage.Wrap(err)
+	}
+	u.Path = path.Join(u.Path, jobID, hex.EncodeToString([]byte(resName)))
+	backend, err := brStorage.ParseBackend(u.String(), &brStorage.BackendOptions{
+		S3: config.S3BackendOptions,
+	})
+	if err != nil {
+		return nil, derrors.ErrFailToCreateExternalStorage.Wrap(err)
+	}
+	storage, err := brStorage.New(ctx, backend, nil)
+	if err != nil {
+		return nil, derrors.ErrFailToCreateExternalStorage.Wrap(err)
+	}
+	return storage, nil
+}
+
+// RemoveAllFiles removes all files in the external storage.
+func RemoveAllFiles(ctx context.Context, storage brStorage.ExternalStorage) error {
+	var files []string
+	err := storage.WalkDir(ctx, &brStorage.WalkOption{}, func(path string, _ int64) error {
+		files = append(files, path)
+		return nil
+	})
+	if err != nil {
+		return perrors.Trace(err)
+	}
+	for _, file := range files {
+		if err := storage.DeleteFile(ctx, file); err != nil {
+			return perrors.Trace(err)
+		}
+	}
+	log.Info("removed files of external storage",
+		zap.String("uri", storage.URI()), zap.Int("count", len(files)))
+	return nil
+}
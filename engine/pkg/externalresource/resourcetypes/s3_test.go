@@ -0,0 +1,86 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resourcetypes
+
+import (
+	"context"
+	"testing"
+
+	resModel "github.com/pingcap/tiflow/engine/pkg/externalresource/resourcemeta/model"
+	"github.com/pingcap/tiflow/engine/pkg/externalresource/storagecfg"
+	derrors "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRemoveS3Files(t *testing.T) {
+	// A local directory stands in for s3.
+	config := storagecfg.S3Config{URI: t.TempDir()}
+	ctx := context.Background()
+
+	storage, err := NewS3Storage(ctx, config, "job-1", "resource-1")
+	require.NoError(t, err)
+	require.NoError(t, storage.WriteFile(ctx, "1.txt", []byte("1")))
+	require.NoError(t, storage.WriteFile(ctx, "2.txt", []byte("2")))
+	other, err := NewS3Storage(ctx, config, "job-1", "resource-2")
+	require.NoError(t, err)
+	require.NoError(t, other.WriteFile(ctx, "1.txt", []byte("1")))
+
+	gcHandler := NewS3ResourceType(config).GCHandler()
+	err = gcHandler(ctx, &resModel.ResourceMeta{
+		ID:       "/s3/resource-1",
+		Job:      "job-1",
+		Worker:   "worker-1",
+		Executor: "executor-1",
+	})
+	require.NoError(t, err)
+
+	exists, err := storage.FileExists(ctx, "1.txt")
+	require.NoError(t, err)
+	require.False(t, exists)
+	exists, err = storage.FileExists(ctx, "2.txt")
+	require.NoError(t, err)
+	require.False(t, exists)
+	// The files of other resources are kept.
+	exists, err = other.FileExists(ctx, "1.txt")
+	require.NoError(t, err)
+	require.True(t, exists)
+
+	_, err = NewS3Storage(ctx, storagecfg.S3Config{}, "job-1", "resource-1")
+	require.True(t, derrors.ErrS3StorageNotConfigured.Equal(err))
+}
+
+func TestS3StorageSize(t *testing.T) {
+	config := storagecfg.S3Config{URI: t.TempDir()}
+	ctx := context.Background()
+
+	storage, err := NewS3Storage(ctx, config, "job-1", "resource-1")
+	require.NoError(t, err)
+	require.NoError(t, storage.WriteFile(ctx, "1.txt", []byte("1")))
+	require.NoError(t, storage.WriteFile(ctx, "2.txt", []byte("22")))
+
+	controller := NewS3ResourceType(config)
+	size, err := controller.StorageSize(ctx, &resModel.ResourceMeta{
+		ID:  "/s3/resource-1",
+		Job: "job-1",
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(3), size)
+
+	size, err = controller.StorageSize(ctx, &resModel.ResourceMeta{
+		ID:  "/s3/resource-2",
+		Job: "job-1",
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(0), size)
+}
@@ -13,12 +13,40 @@
 
 package storagecfg
 
+import (
+	brStorage "github.com/pingcap/tidb/br/pkg/storage"
+)
+
 // Config defines configurations for an external storage resource
 type Config struct {
 	Local LocalFileConfig `json:"local" toml:"local"`
+	S3    S3Config        `json:"s3" toml:"s3"`
 }
 
 // LocalFileConfig defines configurations for a local file based resource
 type LocalFileConfig struct {
 	BaseDir string `json:"base-dir" toml:"base-dir"`
 }
+
+// S3Config defines configurations for s3 based resources, which are shared
+// by all executors.
+type S3Config struct {
+	// URI is the root of the s3 resources, it can be any URI supported
+	// by br's external storage, e.g. `s3://bucket/prefix`.
+	URI string `json:"uri" toml:"uri"`
+
+	brStorage.S3BackendOptions
+}
+
+// IsEnabled returns whether s3 based resources are configured.
+func (c *S3Config) IsEnabled() bool {
+	return c.URI != ""
+}
+
+// Redacted returns a copy of the config with the s3 credentials blanked,
+// it is used when the config is printed.
+func (c Config) Redacted() Config {
+	c.S3.AccessKey = ""
+	c.S3.SecretAccessKey = ""
+	return c
+}
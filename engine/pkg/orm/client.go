@@ -17,6 +17,9 @@ import (
 	"context"
 	"database/sql"
 	gerrors "errors"
+	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	"gorm.io/gorm"
@@ -39,6 +42,8 @@ var globalModels = []interface{}{
 	&resModel.ResourceMeta{},
 	&model.LogicEpoch{},
 	&execModel.Executor{},
+	&model.QuotaLimit{},
+	&model.JobEvent{},
 }
 
 // TODO: retry and idempotent??
@@ -72,6 +77,10 @@ type Client interface {
 	WorkerClient
 	// resource meta
 	ResourceClient
+	// quota limit
+	QuotaClient
+	// job event
+	JobEventClient
 }
 
 // ProjectClient defines interface that manages project in metastore
@@ -95,6 +104,8 @@ type ProjectOperationClient interface {
 type JobClient interface {
 	UpsertJob(ctx context.Context, job *frameModel.MasterMetaKVData) error
 	UpdateJob(ctx context.Context, job *frameModel.MasterMetaKVData) error
+	UpdateJobExt(ctx context.Context, jobID string, fields map[string]interface{}) error
+	UpdateJobConfig(ctx context.Context, jobID string, config []byte) error
 	DeleteJob(ctx context.Context, jobID string) (Result, error)
 
 	GetJobByID(ctx context.Context, jobID string) (*frameModel.MasterMetaKVData, error)
@@ -111,6 +122,7 @@ type WorkerClient interface {
 	GetWorkerByID(ctx context.Context, masterID string, workerID string) (*frameModel.WorkerStatus, error)
 	QueryWorkersByMasterID(ctx context.Context, masterID string) ([]*frameModel.WorkerStatus, error)
 	QueryWorkersByStatus(ctx context.Context, masterID string, status int) ([]*frameModel.WorkerStatus, error)
+	QueryWorkersByProjectID(ctx context.Context, projectID string) ([]*frameModel.WorkerStatus, error)
 }
 
 // ResourceClient defines interface that manages resource in metastore
@@ -132,6 +144,26 @@ type ResourceClient interface {
 	DeleteResourcesByExecutorIDs(ctx context.Context, executorID []engineModel.ExecutorID) (Result, error)
 }
 
+// QuotaClient defines interface that manages quota limits in metastore
+type QuotaClient interface {
+	UpsertQuotaLimit(ctx context.Context, limit *model.QuotaLimit) error
+	DeleteQuotaLimit(ctx context.Context, scope string, scopeID string) (Result, error)
+	GetQuotaLimit(ctx context.Context, scope string, scopeID string) (*model.QuotaLimit, error)
+	QueryQuotaLimits(ctx context.Context) ([]*model.QuotaLimit, error)
+}
+
+// JobEventClient defines interface that manages job events in metastore
+type JobEventClient interface {
+	CreateJobEvent(ctx context.Context, event *model.JobEvent) error
+	// QueryJobEvents returns at most limit events of the job whose SeqID is
+	// greater than afterSeqID, in the order of SeqID. A non-positive limit
+	// means no limit.
+	QueryJobEvents(ctx context.Context, jobID string, afterSeqID uint, limit int) ([]*model.JobEvent, error)
+	DeleteJobEvents(ctx context.Context, jobID string) (Result, error)
+	// TrimJobEvents deletes the events of the job except the latest keep ones.
+	TrimJobEvents(ctx context.Context, jobID string, keep int) (Result, error)
+}
+
 // NewClient return the client to operate framework metastore
 func NewClient(cc metaModel.ClientConn) (Client, error) {
 	if cc == nil {
@@ -313,6 +345,53 @@ func (c *metaOpsClient) UpdateJob(ctx context.Context, job *frameModel.MasterMet
 	return nil
 }
 
+// UpdateJobExt sets the given fields of the ext of the job, the fields are
+// keyed by their json names. The other columns and the other fields of the
+// ext are not changed, so concurrent updates of different fields don't
+// overwrite each other.
+func (c *metaOpsClient) UpdateJobExt(ctx context.Context, jobID string, fields map[string]interface{}) error {
+	if len(fields) == 0 {
+		return nil
+	}
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	// expected SQL: UPDATE xxx SET ext=JSON_SET(ext,'$."key"',xxx), updated_at='2013-11-17 21:34:10' WHERE id=xxx;
+	var expr strings.Builder
+	args := make([]interface{}, 0, 2*len(fields))
+	expr.WriteString("JSON_SET(ext")
+	for _, name := range names {
+		expr.WriteString(",?,?")
+		args = append(args, fmt.Sprintf("$.%q", name), fields[name])
+	}
+	expr.WriteString(")")
+	if err := c.db.WithContext(ctx).
+		Model(&frameModel.MasterMetaKVData{}).
+		Where("id = ?", jobID).
+		Update("ext", gorm.Expr(expr.String(), args...)).Error; err != nil {
+		return errors.ErrMetaOpFail.Wrap(err)
+	}
+
+	return nil
+}
+
+// UpdateJobConfig sets the config of the job, the other columns are not
+// changed.
+func (c *metaOpsClient) UpdateJobConfig(ctx context.Context, jobID string, config []byte) error {
+	// expected SQL: UPDATE xxx SET config=xxx, updated_at='2013-11-17 21:34:10' WHERE id=xxx;
+	if err := c.db.WithContext(ctx).
+		Model(&frameModel.MasterMetaKVData{}).
+		Where("id = ?", jobID).
+		Update("config", config).Error; err != nil {
+		return errors.ErrMetaOpFail.Wrap(err)
+	}
+
+	return nil
+}
+
 // DeleteJob delete the specified jobInfo
 func (c *metaOpsClient) DeleteJob(ctx context.Context, jobID string) (Result, error) {
 	result := c.db.WithContext(ctx).
@@ -463,6 +542,18 @@ func (c *metaOpsClient) QueryWorkersByStatus(ctx context.Context, masterID strin
 	return workers, nil
 }
 
+// QueryWorkersByProjectID query all workers of projectID
+func (c *metaOpsClient) QueryWorkersByProjectID(ctx context.Context, projectID string) ([]*frameModel.WorkerStatus, error) {
+	var workers []*frameModel.WorkerStatus
+	if err := c.db.WithContext(ctx).
+		Where("project_id = ?", projectID).
+		Find(&workers).Error; err != nil {
+		return nil, errors.ErrMetaOpFail.Wrap(err)
+	}
+
+	return workers, nil
+}
+
 // ///////////////////////////// Resource Operation
 // UpsertResource upsert the ResourceMeta
 func (c *metaOpsClient) UpsertResource(ctx context.Context, resource *resModel.ResourceMeta) error {
@@ -656,3 +747,132 @@ type ormResult struct {
 func (r ormResult) RowsAffected() int64 {
 	return r.rowsAffected
 }
+
+// ///////////////////////////// Quota Operation
+// UpsertQuotaLimit upsert the QuotaLimit
+func (c *metaOpsClient) UpsertQuotaLimit(ctx context.Context, limit *model.QuotaLimit) error {
+	if limit == nil {
+		return errors.ErrMetaParamsInvalid.GenWithStackByArgs("input quota limit is nil")
+	}
+
+	if err := c.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "scope"}, {Name: "scope_id"}},
+			DoUpdates: clause.AssignmentColumns(model.QuotaLimitUpdateColumns),
+		}).Create(limit).Error; err != nil {
+		return errors.ErrMetaOpFail.Wrap(err)
+	}
+
+	return nil
+}
+
+// DeleteQuotaLimit delete the quota limit of the specified scope
+func (c *metaOpsClient) DeleteQuotaLimit(ctx context.Context, scope string, scopeID string) (Result, error) {
+	result := c.db.WithContext(ctx).
+		Where("scope = ? AND scope_id = ?", scope, scopeID).
+		Delete(&model.QuotaLimit{})
+	if result.Error != nil {
+		return nil, errors.ErrMetaOpFail.Wrap(result.Error)
+	}
+
+	return &ormResult{rowsAffected: result.RowsAffected}, nil
+}
+
+// GetQuotaLimit query the quota limit of the specified scope
+func (c *metaOpsClient) GetQuotaLimit(ctx context.Context, scope string, scopeID string) (*model.QuotaLimit, error) {
+	var limit model.QuotaLimit
+	if err := c.db.WithContext(ctx).
+		Where("scope = ? AND scope_id = ?", scope, scopeID).
+		First(&limit).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.ErrMetaEntryNotFound.Wrap(err)
+		}
+
+		return nil, errors.ErrMetaOpFail.Wrap(err)
+	}
+
+	return &limit, nil
+}
+
+// QueryQuotaLimits query all quota limits
+func (c *metaOpsClient) QueryQuotaLimits(ctx context.Context) ([]*model.QuotaLimit, error) {
+	var limits []*model.QuotaLimit
+	if err := c.db.WithContext(ctx).
+		Find(&limits).Error; err != nil {
+		return nil, errors.ErrMetaOpFail.Wrap(err)
+	}
+
+	return limits, nil
+}
+
+// ///////////////////////////// Job Event Operation
+// CreateJobEvent insert the job event
+func (c *metaOpsClient) CreateJobEvent(ctx context.Context, event *model.JobEvent) error {
+	if event == nil {
+		return errors.ErrMetaParamsInvalid.GenWithStackByArgs("input job event is nil")
+	}
+
+	if err := c.db.WithContext(ctx).
+		Create(event).Error; err != nil {
+		return errors.ErrMetaOpFail.Wrap(err)
+	}
+
+	return nil
+}
+
+// QueryJobEvents query the events of the job after the specified SeqID
+func (c *metaOpsClient) QueryJobEvents(ctx context.Context,
+	jobID string, afterSeqID uint, limit int,
+) ([]*model.JobEvent, error) {
+	var events []*model.JobEvent
+	db := c.db.WithContext(ctx).
+		Where("job_id = ? AND seq_id > ?", jobID, afterSeqID).
+		Order("seq_id")
+	if limit > 0 {
+		db = db.Limit(limit)
+	}
+	if err := db.Find(&events).Error; err != nil {
+		return nil, errors.ErrMetaOpFail.Wrap(err)
+	}
+
+	return events, nil
+}
+
+// DeleteJobEvents delete all events of the job
+func (c *metaOpsClient) DeleteJobEvents(ctx context.Context, jobID string) (Result, error) {
+	result := c.db.WithContext(ctx).
+		Where("job_id = ?", jobID).
+		Delete(&model.JobEvent{})
+	if result.Error != nil {
+		return nil, errors.ErrMetaOpFail.Wrap(result.Error)
+	}
+
+	return &ormResult{rowsAffected: result.RowsAffected}, nil
+}
+
+// TrimJobEvents delete the events of the job except the latest keep ones
+func (c *metaOpsClient) TrimJobEvents(ctx context.Context, jobID string, keep int) (Result, error) {
+	// expected SQL: SELECT seq_id FROM xxx WHERE job_id=xxx ORDER BY seq_id DESC LIMIT 1 OFFSET keep;
+	var seqIDs []uint
+	if err := c.db.WithContext(ctx).
+		Model(&model.JobEvent{}).
+		Where("job_id = ?", jobID).
+		Order("seq_id desc").
+		Offset(keep).
+		Limit(1).
+		Pluck("seq_id", &seqIDs).Error; err != nil {
+		return nil, errors.ErrMetaOpFail.Wrap(err)
+	}
+	if len(seqIDs) == 0 {
+		return &ormResult{rowsAffected: 0}, nil
+	}
+
+	result := c.db.WithContext(ctx).
+		Where("job_id = ? AND seq_id <= ?", jobID, seqIDs[0]).
+		Delete(&model.JobEvent{})
+	if result.Error != nil {
+		return nil, errors.ErrMetaOpFail.Wrap(result.Error)
+	}
+
+	return &ormResult{rowsAffected: result.RowsAffected}, nil
+}
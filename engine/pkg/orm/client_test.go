@@ -436,6 +436,35 @@ func TestJob(t *testing.T) {
 				mock.ExpectExec("UPDATE `master_meta_kv_data` SET").WillReturnResult(sqlmock.NewResult(0, 1))
 			},
 		},
+		{
+			// UPDATE `master_meta_kv_data` SET `ext`=JSON_SET(ext,'$."last-error"','','$."restart-count"',1),`updated_at`=? WHERE id = 'j111'
+			fn: "UpdateJobExt",
+			inputs: []interface{}{
+				"j111",
+				map[string]interface{}{
+					frameModel.MasterMetaExtRestartCount: int64(1),
+					frameModel.MasterMetaExtLastError:    "",
+				},
+			},
+			mockExpectResFn: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta("UPDATE `master_meta_kv_data` SET `ext`=JSON_SET(ext,?,?,?,?)")).
+					WithArgs(`$."last-error"`, "", `$."restart-count"`, int64(1), anyTime{}, "j111").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+		{
+			// UPDATE `master_meta_kv_data` SET `config`='\x11\x22',`updated_at`=? WHERE id = 'j111'
+			fn: "UpdateJobConfig",
+			inputs: []interface{}{
+				"j111",
+				[]byte{0x11, 0x22},
+			},
+			mockExpectResFn: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta("UPDATE `master_meta_kv_data` SET `config`=?")).
+					WithArgs([]byte{0x11, 0x22}, anyTime{}, "j111").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
 		{
 			// SELECT * FROM `master_meta_kv_data` WHERE project_id = '111-222-333' AND job_id = '111' ORDER BY `master_meta_kv_data`.`id` LIMIT 1
 			fn: "GetJobByID",
@@ -15,6 +15,7 @@ package orm
 
 import (
 	"context"
+	"fmt"
 	"reflect"
 	"testing"
 	"time"
@@ -404,6 +405,45 @@ func TestJobMock(t *testing.T) {
 	}
 }
 
+func TestUpdateJobExtMock(t *testing.T) {
+	cli, err := NewMockClient()
+	require.Nil(t, err)
+	require.NotNil(t, cli)
+	defer cli.Close()
+
+	ctx := context.Background()
+	require.NoError(t, cli.UpsertJob(ctx, &frameModel.MasterMetaKVData{
+		ProjectID:  "p111",
+		ID:         "j111",
+		Tp:         1,
+		StatusCode: frameModel.MasterStatusInit,
+		Config:     []byte{0x11, 0x22},
+		Ext:        frameModel.MasterMetaExt{TenantID: "t111", RestartCount: 1},
+	}))
+
+	// The fields updated separately don't overwrite each other.
+	require.NoError(t, cli.UpdateJobExt(ctx, "j111", map[string]interface{}{
+		frameModel.MasterMetaExtTargetStatus: frameModel.MasterStatusPaused,
+	}))
+	require.NoError(t, cli.UpdateJobExt(ctx, "j111", map[string]interface{}{
+		frameModel.MasterMetaExtRestartCount: int64(2),
+		frameModel.MasterMetaExtLastError:    "worker failed",
+	}))
+	require.NoError(t, cli.UpdateJobExt(ctx, "j111", nil))
+	require.NoError(t, cli.UpdateJobConfig(ctx, "j111", []byte{0x33}))
+
+	job, err := cli.GetJobByID(ctx, "j111")
+	require.NoError(t, err)
+	require.Equal(t, frameModel.MasterStatusInit, job.StatusCode)
+	require.Equal(t, []byte{0x33}, job.Config)
+	require.Equal(t, frameModel.MasterMetaExt{
+		TenantID:     "t111",
+		TargetStatus: frameModel.MasterStatusPaused,
+		RestartCount: 2,
+		LastError:    "worker failed",
+	}, job.Ext)
+}
+
 func TestWorkerMock(t *testing.T) {
 	cli, err := NewMockClient()
 	require.Nil(t, err)
@@ -568,6 +608,28 @@ func TestWorkerMock(t *testing.T) {
 			},
 			output: []*frameModel.WorkerStatus{},
 		},
+		{
+			fn: "QueryWorkersByProjectID",
+			inputs: []interface{}{
+				"p111",
+			},
+			output: []*frameModel.WorkerStatus{
+				{
+					Model: model.Model{
+						SeqID:     1,
+						CreatedAt: createdAt,
+						UpdatedAt: updatedAt,
+					},
+					ProjectID: "p111",
+					JobID:     "j111",
+					ID:        "w222",
+					Type:      1,
+					Code:      1,
+					ErrorMsg:  "error",
+					ExtBytes:  []byte{0x11, 0x22},
+				},
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -739,6 +801,107 @@ func TestResourceMock(t *testing.T) {
 	}
 }
 
+func TestQuotaMock(t *testing.T) {
+	cli, err := NewMockClient()
+	require.Nil(t, err)
+	require.NotNil(t, cli)
+	defer cli.Close()
+
+	ctx := context.Background()
+	_, err = cli.GetQuotaLimit(ctx, "project", "p111")
+	require.True(t, IsNotFoundError(err))
+
+	err = cli.UpsertQuotaLimit(ctx, &model.QuotaLimit{
+		Scope:   "project",
+		ScopeID: "p111",
+		MaxJobs: 1,
+	})
+	require.NoError(t, err)
+	err = cli.UpsertQuotaLimit(ctx, &model.QuotaLimit{
+		Scope:      "project",
+		ScopeID:    "p111",
+		MaxWorkers: 10,
+	})
+	require.NoError(t, err)
+	err = cli.UpsertQuotaLimit(ctx, &model.QuotaLimit{
+		Scope:   "tenant",
+		ScopeID: "p111",
+		MaxJobs: 5,
+	})
+	require.NoError(t, err)
+
+	limit, err := cli.GetQuotaLimit(ctx, "project", "p111")
+	require.NoError(t, err)
+	require.Equal(t, int64(0), limit.MaxJobs)
+	require.Equal(t, int64(10), limit.MaxWorkers)
+
+	limits, err := cli.QueryQuotaLimits(ctx)
+	require.NoError(t, err)
+	require.Len(t, limits, 2)
+
+	res, err := cli.DeleteQuotaLimit(ctx, "project", "p111")
+	require.NoError(t, err)
+	require.Equal(t, int64(1), res.RowsAffected())
+	_, err = cli.GetQuotaLimit(ctx, "project", "p111")
+	require.True(t, IsNotFoundError(err))
+}
+
+func TestJobEventMock(t *testing.T) {
+	cli, err := NewMockClient()
+	require.Nil(t, err)
+	require.NotNil(t, cli)
+	defer cli.Close()
+
+	ctx := context.Background()
+	for i := 0; i < 3; i++ {
+		for _, jobID := range []string{"j111", "j222"} {
+			err = cli.CreateJobEvent(ctx, &model.JobEvent{
+				JobID:   jobID,
+				Type:    model.JobEventStatusChanged,
+				Message: fmt.Sprintf("event-%d", i),
+			})
+			require.NoError(t, err)
+		}
+	}
+
+	events, err := cli.QueryJobEvents(ctx, "j111", 0, 0)
+	require.NoError(t, err)
+	require.Len(t, events, 3)
+	for i, event := range events {
+		require.Equal(t, "j111", event.JobID)
+		require.Equal(t, fmt.Sprintf("event-%d", i), event.Message)
+		require.False(t, event.CreatedAt.IsZero())
+	}
+
+	page, err := cli.QueryJobEvents(ctx, "j111", events[0].SeqID, 1)
+	require.NoError(t, err)
+	require.Len(t, page, 1)
+	require.Equal(t, events[1].SeqID, page[0].SeqID)
+
+	// Only the latest events of the job are kept.
+	res, err := cli.TrimJobEvents(ctx, "j222", 2)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), res.RowsAffected())
+	res, err = cli.TrimJobEvents(ctx, "j222", 2)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), res.RowsAffected())
+	events, err = cli.QueryJobEvents(ctx, "j222", 0, 0)
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	require.Equal(t, "event-1", events[0].Message)
+	require.Equal(t, "event-2", events[1].Message)
+
+	res, err = cli.DeleteJobEvents(ctx, "j111")
+	require.NoError(t, err)
+	require.Equal(t, int64(3), res.RowsAffected())
+	events, err = cli.QueryJobEvents(ctx, "j111", 0, 0)
+	require.NoError(t, err)
+	require.Empty(t, events)
+	events, err = cli.QueryJobEvents(ctx, "j222", 0, 0)
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+}
+
 func testInnerMock(t *testing.T, cli Client, c mCase) {
 	var args []reflect.Value
 	args = append(args, reflect.ValueOf(context.Background()))
@@ -0,0 +1,41 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import "time"
+
+// JobEventType is the type of a job event.
+type JobEventType string
+
+// Job event types
+const (
+	JobEventCreated        JobEventType = "created"
+	JobEventDispatched     JobEventType = "dispatched"
+	JobEventDispatchFailed JobEventType = "dispatch-failed"
+	JobEventOnline         JobEventType = "online"
+	JobEventFailover       JobEventType = "failover"
+	JobEventStatusChanged  JobEventType = "status-changed"
+	JobEventWorkerFailed   JobEventType = "worker-failed"
+	JobEventError          JobEventType = "error"
+)
+
+// JobEvent records an event in the lifecycle of a job, such as a dispatch,
+// a failover, a status transition or an error.
+type JobEvent struct {
+	SeqID     uint         `json:"seq-id" gorm:"primaryKey;autoIncrement;index:idx_jevt,priority:2"`
+	JobID     string       `json:"job-id" gorm:"column:job_id;type:varchar(128) not null;index:idx_jevt,priority:1"`
+	Type      JobEventType `json:"type" gorm:"column:type;type:varchar(32) not null"`
+	Message   string       `json:"message" gorm:"column:message;type:text"`
+	CreatedAt time.Time    `json:"created-at" gorm:"autoCreateTime"`
+}
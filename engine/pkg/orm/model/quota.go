@@ -0,0 +1,35 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+// QuotaLimit records the quota limits of a tenant or a project.
+// A zero limit means no limit.
+type QuotaLimit struct {
+	Model
+	Scope            string `json:"scope" gorm:"column:scope;type:varchar(16) not null;uniqueIndex:uidx_qsid,priority:1"`
+	ScopeID          string `json:"scope-id" gorm:"column:scope_id;type:varchar(128) not null;uniqueIndex:uidx_qsid,priority:2"`
+	MaxJobs          int64  `json:"max-jobs" gorm:"column:max_jobs;type:bigint not null;default:0"`
+	MaxWorkers       int64  `json:"max-workers" gorm:"column:max_workers;type:bigint not null;default:0"`
+	MaxResourceUnits int64  `json:"max-resource-units" gorm:"column:max_resource_units;type:bigint not null;default:0"`
+	MaxStorageBytes  int64  `json:"max-storage-bytes" gorm:"column:max_storage_bytes;type:bigint not null;default:0"`
+}
+
+// QuotaLimitUpdateColumns is used in gorm update
+var QuotaLimitUpdateColumns = []string{
+	"updated_at",
+	"max_jobs",
+	"max_workers",
+	"max_resource_units",
+	"max_storage_bytes",
+}
@@ -0,0 +1,118 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package quota
+
+import (
+	"github.com/pingcap/tiflow/engine/pkg/rpcerror"
+)
+
+// Scope is the scope that a quota applies to.
+type Scope string
+
+// Defines all quota scopes.
+const (
+	ScopeTenant  = Scope("tenant")
+	ScopeProject = Scope("project")
+)
+
+// Resource names used in ErrQuotaExceeded.
+const (
+	ResourceJobs         = "jobs"
+	ResourceWorkers      = "workers"
+	ResourceUnits        = "resource-units"
+	ResourceStorageBytes = "storage-bytes"
+)
+
+// Limits defines the upper bounds of resources that a tenant or a project
+// can consume. A zero value means no limit.
+type Limits struct {
+	MaxJobs          int64 `json:"max-jobs"`
+	MaxWorkers       int64 `json:"max-workers"`
+	MaxResourceUnits int64 `json:"max-resource-units"`
+	MaxStorageBytes  int64 `json:"max-storage-bytes"`
+}
+
+// Usage is the resources consumed by a tenant or a project.
+type Usage struct {
+	Jobs          int64 `json:"jobs"`
+	Workers       int64 `json:"workers"`
+	ResourceUnits int64 `json:"resource-units"`
+	StorageBytes  int64 `json:"storage-bytes"`
+}
+
+// Add returns the sum of two usages.
+func (u Usage) Add(other Usage) Usage {
+	return Usage{
+		Jobs:          u.Jobs + other.Jobs,
+		Workers:       u.Workers + other.Workers,
+		ResourceUnits: u.ResourceUnits + other.ResourceUnits,
+		StorageBytes:  u.StorageBytes + other.StorageBytes,
+	}
+}
+
+// Check returns ErrQuotaExceeded if the usage plus the requested resources
+// exceeds the limits. Only the requested resources are checked, except the
+// storage, which is written by workers directly and can't be requested in
+// advance, so any request is rejected once the storage limit is reached.
+func (l Limits) Check(scope Scope, scopeID string, usage, requested Usage) error {
+	checks := []struct {
+		resource  string
+		limit     int64
+		used      int64
+		requested int64
+	}{
+		{ResourceJobs, l.MaxJobs, usage.Jobs, requested.Jobs},
+		{ResourceWorkers, l.MaxWorkers, usage.Workers, requested.Workers},
+		{ResourceUnits, l.MaxResourceUnits, usage.ResourceUnits, requested.ResourceUnits},
+	}
+	for _, c := range checks {
+		if c.limit <= 0 || c.requested <= 0 || c.used+c.requested <= c.limit {
+			continue
+		}
+		return ErrQuotaExceeded.GenWithStack(&QuotaExceededError{
+			Scope:     scope,
+			ScopeID:   scopeID,
+			Resource:  c.resource,
+			Limit:     c.limit,
+			Used:      c.used,
+			Requested: c.requested,
+		})
+	}
+	if l.MaxStorageBytes > 0 && usage.StorageBytes >= l.MaxStorageBytes {
+		return ErrQuotaExceeded.GenWithStack(&QuotaExceededError{
+			Scope:    scope,
+			ScopeID:  scopeID,
+			Resource: ResourceStorageBytes,
+			Limit:    l.MaxStorageBytes,
+			Used:     usage.StorageBytes,
+		})
+	}
+	return nil
+}
+
+// ErrQuotaExceeded indicates that a request exceeds the quota of its
+// tenant or project.
+var ErrQuotaExceeded = rpcerror.Normalize[QuotaExceededError]()
+
+// QuotaExceededError provides details of an ErrQuotaExceeded.
+type QuotaExceededError struct {
+	rpcerror.Error[rpcerror.NotRetryable, rpcerror.ResourceExhausted]
+
+	Scope     Scope
+	ScopeID   string
+	Resource  string
+	Limit     int64
+	Used      int64
+	Requested int64
+}
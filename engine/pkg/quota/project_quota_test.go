@@ -0,0 +1,63 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package quota
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLimitsCheck(t *testing.T) {
+	t.Parallel()
+
+	limits := Limits{
+		MaxJobs:          2,
+		MaxResourceUnits: 10,
+		MaxStorageBytes:  100,
+	}
+	usage := Usage{Jobs: 1, Workers: 100, ResourceUnits: 8, StorageBytes: 10}
+
+	require.NoError(t, limits.Check(ScopeProject, "p1", usage, Usage{Jobs: 1}))
+	// MaxWorkers is not limited.
+	require.NoError(t, limits.Check(ScopeProject, "p1", usage, Usage{Workers: 1, ResourceUnits: 2}))
+
+	err := limits.Check(ScopeProject, "p1", usage, Usage{Workers: 1, ResourceUnits: 3})
+	require.True(t, ErrQuotaExceeded.Is(err))
+	detail, ok := ErrQuotaExceeded.Convert(err)
+	require.True(t, ok)
+	require.Equal(t, ScopeProject, detail.Scope)
+	require.Equal(t, "p1", detail.ScopeID)
+	require.Equal(t, ResourceUnits, detail.Resource)
+	require.Equal(t, int64(10), detail.Limit)
+	require.Equal(t, int64(8), detail.Used)
+	require.Equal(t, int64(3), detail.Requested)
+
+	usage = usage.Add(Usage{Jobs: 1})
+	err = limits.Check(ScopeTenant, "t1", usage, Usage{Jobs: 1})
+	detail, ok = ErrQuotaExceeded.Convert(err)
+	require.True(t, ok)
+	require.Equal(t, ScopeTenant, detail.Scope)
+	require.Equal(t, ResourceJobs, detail.Resource)
+
+	// Any request is rejected once the storage limit is reached.
+	usage = Usage{StorageBytes: 100}
+	err = limits.Check(ScopeProject, "p1", usage, Usage{Workers: 1})
+	detail, ok = ErrQuotaExceeded.Convert(err)
+	require.True(t, ok)
+	require.Equal(t, ResourceStorageBytes, detail.Resource)
+
+	// Zero limits mean no limit.
+	require.NoError(t, Limits{}.Check(ScopeProject, "p1", usage, Usage{Jobs: 100}))
+}
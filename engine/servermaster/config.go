@@ -24,6 +24,7 @@ import (
 	"github.com/pingcap/log"
 	"go.uber.org/zap"
 
+	"github.com/pingcap/tiflow/engine/pkg/externalresource/storagecfg"
 	metaModel "github.com/pingcap/tiflow/engine/pkg/meta/model"
 	"github.com/pingcap/tiflow/pkg/errors"
 	"github.com/pingcap/tiflow/pkg/logutil"
@@ -78,12 +79,18 @@ type Config struct {
 	RPCTimeout        time.Duration `toml:"-" json:"-"`
 
 	Security *security.Credential `toml:"security" json:"security"`
+
+	// Storage is used to clean up the s3 resources, only the
+	// s3 section is used by the server master.
+	Storage storagecfg.Config `toml:"storage" json:"storage"`
 }
 
 func (c *Config) String() string {
-	cfg, err := json.Marshal(c)
+	redacted := *c
+	redacted.Storage = c.Storage.Redacted()
+	cfg, err := json.Marshal(&redacted)
 	if err != nil {
-		log.Error("marshal to json", zap.Reflect("master config", c), logutil.ShortError(err))
+		log.Error("marshal to json", zap.Reflect("master config", &redacted), logutil.ShortError(err))
 	}
 	return string(cfg)
 }
@@ -56,6 +56,22 @@ store-type = "etcd"
 	fmt.Printf("config: %+v\n", config)
 }
 
+func TestConfigStringRedactsS3Credentials(t *testing.T) {
+	t.Parallel()
+
+	cfg := GetDefaultMasterConfig()
+	cfg.Storage.S3.URI = "s3://my-bucket/prefix"
+	cfg.Storage.S3.AccessKey = "my-access-key"
+	cfg.Storage.S3.SecretAccessKey = "my-secret-access-key"
+
+	s := cfg.String()
+	require.Contains(t, s, "s3://my-bucket/prefix")
+	require.NotContains(t, s, "my-access-key")
+	require.NotContains(t, s, "my-secret-access-key")
+	// The config itself is not changed.
+	require.Equal(t, "my-secret-access-key", cfg.Storage.S3.SecretAccessKey)
+}
+
 func mustWriteToTempFile(t *testing.T, content string) (filePath string) {
 	dir := t.TempDir()
 	fd, err := os.CreateTemp(dir, "*")
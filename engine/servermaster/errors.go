@@ -55,3 +55,25 @@ type JobNotRunningError struct {
 
 	JobID string
 }
+
+// ErrJobNotPaused indicates that a given job is not paused.
+// It's usually caused when caller tries to resume a job that is not paused.
+var ErrJobNotPaused = rpcerror.Normalize[JobNotPausedError]()
+
+// JobNotPausedError provides details of an ErrJobNotPaused.
+type JobNotPausedError struct {
+	rpcerror.Error[rpcerror.NotRetryable, rpcerror.FailedPrecondition]
+
+	JobID string
+}
+
+// ErrJobConfigUpdateNotSupported indicates that the job master of a given
+// job doesn't support updating its config.
+var ErrJobConfigUpdateNotSupported = rpcerror.Normalize[JobConfigUpdateNotSupportedError]()
+
+// JobConfigUpdateNotSupportedError provides details of an ErrJobConfigUpdateNotSupported.
+type JobConfigUpdateNotSupportedError struct {
+	rpcerror.Error[rpcerror.NotRetryable, rpcerror.FailedPrecondition]
+
+	JobID string
+}
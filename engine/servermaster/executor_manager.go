@@ -62,6 +62,13 @@ type ExecutorManager interface {
 	// GetExecutorInfos implements the interface scheduler.executorInfoProvider.
 	// It is called by the scheduler as the source of truth for executors.
 	GetExecutorInfos() map[model.ExecutorID]schedModel.ExecutorInfo
+
+	// DrainExecutor marks the executor as draining, so that no new task
+	// will be scheduled to it. The mark is persisted and is idempotent.
+	DrainExecutor(ctx context.Context, executorID model.ExecutorID) error
+
+	// GetDrainStatus returns the drain progress of the executor.
+	GetDrainStatus(executorID model.ExecutorID) (*pb.ExecutorDrainStatus, error)
 }
 
 // ExecutorManagerImpl holds all the executors' info, including liveness, status, resource usage.
@@ -162,7 +169,7 @@ func (e *ExecutorManagerImpl) HandleHeartbeat(req *pb.HeartbeatRequest) (*pb.Hea
 	e.mu.Unlock()
 
 	status := model.ExecutorStatus(req.Status)
-	if err := exec.heartbeat(req.Ttl, status); err != nil {
+	if err := exec.heartbeat(req.Ttl, status, req.WorkerCount); err != nil {
 		return &pb.HeartbeatResponse{Err: errors.ToPBError(err)}, nil
 	}
 	usage := model.RescUnit(req.GetResourceUsage())
@@ -261,7 +268,9 @@ type Executor struct {
 	// Last heartbeat
 	lastUpdateTime time.Time
 	heartbeatTTL   time.Duration
-	logRL          *rate.Limiter
+	// workerCount is the number of workers reported by the last heartbeat.
+	workerCount int64
+	logRL       *rate.Limiter
 }
 
 func (e *Executor) checkAlive() bool {
@@ -281,7 +290,7 @@ func (e *Executor) checkAlive() bool {
 	return true
 }
 
-func (e *Executor) heartbeat(ttl uint64, status model.ExecutorStatus) error {
+func (e *Executor) heartbeat(ttl uint64, status model.ExecutorStatus, workerCount int64) error {
 	e.mu.Lock()
 	defer e.mu.Unlock()
 	if e.status == model.Tombstone {
@@ -290,9 +299,16 @@ func (e *Executor) heartbeat(ttl uint64, status model.ExecutorStatus) error {
 	e.lastUpdateTime = time.Now()
 	e.heartbeatTTL = time.Duration(ttl) * time.Millisecond
 	e.status = status
+	e.workerCount = workerCount
 	return nil
 }
 
+func (e *Executor) getWorkerCount() int64 {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.workerCount
+}
+
 func (e *Executor) statusEqual(status model.ExecutorStatus) bool {
 	e.mu.RLock()
 	defer e.mu.RUnlock()
@@ -438,8 +454,61 @@ func (e *ExecutorManagerImpl) GetExecutorInfos() map[model.ExecutorID]schedModel
 			ID:             id,
 			ResourceStatus: *resStatus,
 			Labels:         label.Set(exec.Labels),
+			Draining:       exec.Draining,
 		}
 		ret[id] = schedInfo
 	}
 	return ret
 }
+
+// DrainExecutor implements ExecutorManager.DrainExecutor.
+func (e *ExecutorManagerImpl) DrainExecutor(ctx context.Context, executorID model.ExecutorID) error {
+	e.mu.Lock()
+	exec, ok := e.executors[executorID]
+	if !ok {
+		e.mu.Unlock()
+		return errors.ErrUnknownExecutorID.GenWithStackByArgs(executorID)
+	}
+	if exec.Draining {
+		e.mu.Unlock()
+		return nil
+	}
+	executorMeta := exec.Executor
+	e.mu.Unlock()
+
+	// Persist the mark before it takes effect, so that the executor stays
+	// unschedulable after the server master fails over.
+	executorMeta.Draining = true
+	if err := e.metaClient.UpdateExecutor(ctx, &executorMeta); err != nil {
+		return perrors.Trace(err)
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	exec, ok = e.executors[executorID]
+	if !ok {
+		// The executor has been removed in the meantime.
+		return errors.ErrUnknownExecutorID.GenWithStackByArgs(executorID)
+	}
+	exec.Draining = true
+	log.Info("executor is draining", zap.String("id", string(executorID)))
+	return nil
+}
+
+// GetDrainStatus implements ExecutorManager.GetDrainStatus.
+func (e *ExecutorManagerImpl) GetDrainStatus(executorID model.ExecutorID) (*pb.ExecutorDrainStatus, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	exec, ok := e.executors[executorID]
+	if !ok {
+		return nil, errors.ErrUnknownExecutorID.GenWithStackByArgs(executorID)
+	}
+	workerCount := exec.getWorkerCount()
+	return &pb.ExecutorDrainStatus{
+		ExecutorId:  string(executorID),
+		Draining:    exec.Draining,
+		WorkerCount: workerCount,
+		Drained:     exec.Draining && workerCount == 0,
+	}, nil
+}
@@ -257,3 +257,73 @@ func TestExecutorManagerWatch(t *testing.T) {
 	cancel()
 	mgr.Stop()
 }
+
+func TestExecutorManagerDrain(t *testing.T) {
+	t.Parallel()
+
+	metaClient := executormeta.NewMockClient(gomock.NewController(t))
+	mgr := NewExecutorManagerImpl(metaClient, time.Second, time.Second, nil)
+
+	ctx := context.Background()
+	metaClient.EXPECT().CreateExecutor(gomock.Any(), gomock.Any()).Times(2).Return(nil)
+	executor, err := mgr.AllocateNewExec(ctx, &pb.RegisterExecutorRequest{
+		Executor: &pb.Executor{Address: "127.0.0.1:10001", Capability: 2},
+	})
+	require.NoError(t, err)
+	otherExecutor, err := mgr.AllocateNewExec(ctx, &pb.RegisterExecutorRequest{
+		Executor: &pb.Executor{Address: "127.0.0.1:10002", Capability: 2},
+	})
+	require.NoError(t, err)
+
+	status, err := mgr.GetDrainStatus(executor.ID)
+	require.NoError(t, err)
+	require.False(t, status.Draining)
+	require.False(t, status.Drained)
+
+	resp, err := mgr.HandleHeartbeat(&pb.HeartbeatRequest{
+		ExecutorId:  string(executor.ID),
+		Status:      int32(model.Running),
+		Ttl:         uint64(time.Second.Milliseconds()),
+		WorkerCount: 2,
+	})
+	require.NoError(t, err)
+	require.Nil(t, resp.Err)
+
+	metaClient.EXPECT().UpdateExecutor(gomock.Any(), gomock.Any()).Times(1).
+		DoAndReturn(func(ctx context.Context, meta *execModel.Executor) error {
+			require.Equal(t, executor.ID, meta.ID)
+			require.True(t, meta.Draining)
+			return nil
+		})
+	require.NoError(t, mgr.DrainExecutor(ctx, executor.ID))
+	// Draining an executor is idempotent.
+	require.NoError(t, mgr.DrainExecutor(ctx, executor.ID))
+
+	err = mgr.DrainExecutor(ctx, "unknown-executor")
+	require.Error(t, err)
+	_, err = mgr.GetDrainStatus("unknown-executor")
+	require.Error(t, err)
+
+	infos := mgr.GetExecutorInfos()
+	require.True(t, infos[executor.ID].Draining)
+	require.False(t, infos[otherExecutor.ID].Draining)
+
+	status, err = mgr.GetDrainStatus(executor.ID)
+	require.NoError(t, err)
+	require.Equal(t, &pb.ExecutorDrainStatus{
+		ExecutorId:  string(executor.ID),
+		Draining:    true,
+		WorkerCount: 2,
+	}, status)
+
+	resp, err = mgr.HandleHeartbeat(&pb.HeartbeatRequest{
+		ExecutorId: string(executor.ID),
+		Status:     int32(model.Running),
+		Ttl:        uint64(time.Second.Milliseconds()),
+	})
+	require.NoError(t, err)
+	require.Nil(t, resp.Err)
+	status, err = mgr.GetDrainStatus(executor.ID)
+	require.NoError(t, err)
+	require.True(t, status.Drained)
+}
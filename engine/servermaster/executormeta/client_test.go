@@ -58,14 +58,14 @@ func TestExecutorClient(t *testing.T) {
 		},
 	}
 
-	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `executors` (`created_at`,`updated_at`,`id`,`name`,`address`,`capability`,`labels`) VALUES (?,?,?,?,?,?,?)")).
-		WithArgs(createdAt, updatedAt, executor.ID, executor.Name, executor.Address, executor.Capability, "{\"key1\":\"val1\",\"key2\":\"val2\"}").
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `executors` (`created_at`,`updated_at`,`id`,`name`,`address`,`capability`,`labels`,`draining`) VALUES (?,?,?,?,?,?,?,?)")).
+		WithArgs(createdAt, updatedAt, executor.ID, executor.Name, executor.Address, executor.Capability, "{\"key1\":\"val1\",\"key2\":\"val2\"}", false).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 	err = client.CreateExecutor(context.Background(), executor)
 	require.NoError(t, err)
 
-	mock.ExpectExec(regexp.QuoteMeta("UPDATE `executors` SET `address`=?,`capability`=?,`id`=?,`labels`=?,`name`=?,`updated_at`=? WHERE id = ?")).
-		WithArgs(executor.Address, executor.Capability, executor.ID, "{\"key1\":\"val1\",\"key2\":\"val2\"}", executor.Name, sqlmock.AnyArg(), executor.ID).
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `executors` SET `address`=?,`capability`=?,`draining`=?,`id`=?,`labels`=?,`name`=?,`updated_at`=? WHERE id = ?")).
+		WithArgs(executor.Address, executor.Capability, executor.Draining, executor.ID, "{\"key1\":\"val1\",\"key2\":\"val2\"}", executor.Name, sqlmock.AnyArg(), executor.ID).
 		WillReturnResult(sqlmock.NewResult(0, 1))
 	err = client.UpdateExecutor(context.Background(), executor)
 	require.NoError(t, err)
@@ -77,9 +77,9 @@ func TestExecutorClient(t *testing.T) {
 
 	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `executors`")).
 		WillReturnRows(sqlmock.NewRows([]string{
-			"seq_id", "created_at", "updated_at", "id", "name", "address", "capability", "labels",
+			"seq_id", "created_at", "updated_at", "id", "name", "address", "capability", "labels", "draining",
 		}).AddRow(1, createdAt, updatedAt, executor.ID, executor.Name,
-			executor.Address, executor.Capability, "{\"key1\":\"val1\",\"key2\":\"val2\"}"))
+			executor.Address, executor.Capability, "{\"key1\":\"val1\",\"key2\":\"val2\"}", executor.Draining))
 	executors, err := client.QueryExecutors(context.Background())
 	require.NoError(t, err)
 	require.Equal(t, executor, executors[0])
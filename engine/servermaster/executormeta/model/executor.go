@@ -88,6 +88,10 @@ type Executor struct {
 
 	// Labels store the label set for each executor.
 	Labels LabelSet `json:"labels" gorm:"column:labels;type:json"`
+
+	// Draining indicates that the executor is being taken out of service,
+	// so no new task should be scheduled to it.
+	Draining bool `json:"draining" gorm:"column:draining;type:BOOLEAN"`
 }
 
 // Map is used in gorm update.
@@ -98,5 +102,6 @@ func (e *Executor) Map() map[string]interface{} {
 		"address":    e.Address,
 		"capability": e.Capability,
 		"labels":     e.Labels,
+		"draining":   e.Draining,
 	}
 }
@@ -40,7 +40,8 @@ func registerRoutes(router *http.ServeMux, grpcMux *runtime.ServeMux, forwardJob
 	// 2. The job API implemented by the job master.
 	// Both of them are registered in the same "/api/v1/jobs/" path.
 	// The job API implemented by the job master is registered in the "/api/v1/jobs/{job_id}/".
-	// But framework has a special APIs cancel will register in the "/api/v1/jobs/{job_id}/" path too.
+	// But framework has some special APIs such as cancel, pause, resume and updating config,
+	// which are registered in the "/api/v1/jobs/{job_id}/" path too.
 	// So we first check whether the request should be forwarded to the job master.
 	// If yes, forward the request to the job master. Otherwise, delegate the request to the framework.
 	router.HandleFunc("/api/v1/", func(w http.ResponseWriter, r *http.Request) {
@@ -79,10 +80,15 @@ func shouldForwardJobAPI(r *http.Request) bool {
 	if len(fields) != 2 {
 		return false
 	}
-	// cancel is implemented by framework,
+	// cancel, pause, resume and events are implemented by framework,
 	// don't forward them to the job master.
-	if fields[1] == "cancel" {
+	switch fields[1] {
+	case "cancel", "pause", "resume", "events":
 		return false
+	case "config":
+		// Updating config is implemented by framework, which persists the
+		// new config after the job master accepts it.
+		return r.Method != http.MethodPut
 	}
 	return true
 }
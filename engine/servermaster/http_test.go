@@ -87,9 +87,19 @@ func TestRegisterRoutes(t *testing.T) {
 			expectedCode: http.StatusNotImplemented,
 		},
 		{
-			method:       http.MethodGet,
+			method:       http.MethodPost,
 			path:         "/api/v1/jobs/job1/pause",
-			expectedCode: http.StatusNotFound,
+			expectedCode: http.StatusNotImplemented,
+		},
+		{
+			method:       http.MethodPost,
+			path:         "/api/v1/jobs/job1/resume",
+			expectedCode: http.StatusNotImplemented,
+		},
+		{
+			method:       http.MethodPut,
+			path:         "/api/v1/jobs/job1/config",
+			expectedCode: http.StatusNotImplemented,
 		},
 		{
 			method:       http.MethodGet,
@@ -147,12 +157,17 @@ func TestShouldForwardJobAPI(t *testing.T) {
 		{
 			method:        http.MethodGet,
 			path:          "/api/v1/jobs/job1/pause",
-			shouldForward: true,
+			shouldForward: false,
 		},
 		{
 			method:        http.MethodPost,
 			path:          "/api/v1/jobs/job1/pause",
-			shouldForward: true,
+			shouldForward: false,
+		},
+		{
+			method:        http.MethodPost,
+			path:          "/api/v1/jobs/job1/resume",
+			shouldForward: false,
 		},
 		{
 			method:        http.MethodGet,
@@ -164,6 +179,11 @@ func TestShouldForwardJobAPI(t *testing.T) {
 			path:          "/api/v1/jobs/job1/cancel",
 			shouldForward: false,
 		},
+		{
+			method:        http.MethodGet,
+			path:          "/api/v1/jobs/job1/events",
+			shouldForward: false,
+		},
 		{
 			method:        http.MethodGet,
 			path:          "/api/v1/jobs/job1/status",
@@ -174,6 +194,16 @@ func TestShouldForwardJobAPI(t *testing.T) {
 			path:          "/api/v1/jobs/job1/config",
 			shouldForward: true,
 		},
+		{
+			method:        http.MethodGet,
+			path:          "/api/v1/jobs/job1/config",
+			shouldForward: true,
+		},
+		{
+			method:        http.MethodPut,
+			path:          "/api/v1/jobs/job1/config",
+			shouldForward: false,
+		},
 	}
 
 	for i, tc := range testCases {
@@ -0,0 +1,153 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package servermaster
+
+import (
+	"context"
+	"strconv"
+	"sync"
+
+	"github.com/pingcap/log"
+	pb "github.com/pingcap/tiflow/engine/enginepb"
+	pkgOrm "github.com/pingcap/tiflow/engine/pkg/orm"
+	ormModel "github.com/pingcap/tiflow/engine/pkg/orm/model"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// maxEventsPerJob is the number of the latest events kept for each job,
+	// the older ones are trimmed so that a flapping job master doesn't grow
+	// the event log without bound.
+	maxEventsPerJob = 1000
+	// maxPendingJobEvents bounds the events waiting to be persisted, the
+	// oldest ones are dropped if the metastore is unavailable for long.
+	maxPendingJobEvents = 1024
+)
+
+// jobEventBuffer holds the job events waiting to be persisted.
+type jobEventBuffer struct {
+	mu     sync.Mutex
+	events []*ormModel.JobEvent
+}
+
+// recordJobEvent appends an event to the event log of the job. It's called
+// by the callbacks of the job manager, so the event is buffered and persisted
+// by flushJobEvents in the next Tick rather than blocking the caller.
+func (jm *JobManagerImpl) recordJobEvent(jobID string, tp ormModel.JobEventType, message string) {
+	jm.jobEvents.mu.Lock()
+	defer jm.jobEvents.mu.Unlock()
+	if len(jm.jobEvents.events) >= maxPendingJobEvents {
+		dropped := jm.jobEvents.events[0]
+		log.Warn("too many job events are pending, drop the oldest one",
+			zap.String("job-id", dropped.JobID), zap.String("type", string(dropped.Type)),
+			zap.String("message", dropped.Message))
+		jm.jobEvents.events = jm.jobEvents.events[1:]
+	}
+	jm.jobEvents.events = append(jm.jobEvents.events, &ormModel.JobEvent{
+		JobID:   jobID,
+		Type:    tp,
+		Message: message,
+	})
+}
+
+// dropJobEvents drops the pending events of the job.
+func (jm *JobManagerImpl) dropJobEvents(jobID string) {
+	jm.jobEvents.mu.Lock()
+	defer jm.jobEvents.mu.Unlock()
+	events := jm.jobEvents.events[:0]
+	for _, event := range jm.jobEvents.events {
+		if event.JobID != jobID {
+			events = append(events, event)
+		}
+	}
+	jm.jobEvents.events = events
+}
+
+// flushJobEvents persists the pending job events and trims the event logs
+// of their jobs. The event log is only used for diagnosis, so failures are
+// logged and ignored.
+func (jm *JobManagerImpl) flushJobEvents(ctx context.Context) {
+	jm.jobEvents.mu.Lock()
+	events := jm.jobEvents.events
+	jm.jobEvents.events = nil
+	jm.jobEvents.mu.Unlock()
+	if len(events) == 0 {
+		return
+	}
+
+	jobIDs := make(map[string]struct{})
+	for _, event := range events {
+		if err := jm.frameMetaClient.CreateJobEvent(ctx, event); err != nil {
+			log.Warn("failed to record job event", zap.String("job-id", event.JobID),
+				zap.String("type", string(event.Type)), zap.String("message", event.Message),
+				zap.Error(err))
+			continue
+		}
+		jobIDs[event.JobID] = struct{}{}
+	}
+	for jobID := range jobIDs {
+		if _, err := jm.frameMetaClient.TrimJobEvents(ctx, jobID, maxEventsPerJob); err != nil {
+			log.Warn("failed to trim job events", zap.String("job-id", jobID), zap.Error(err))
+		}
+	}
+}
+
+// ListJobEvents implements JobManagerServer.ListJobEvents.
+// The page token is the sequence ID of the last returned event.
+func (jm *JobManagerImpl) ListJobEvents(
+	ctx context.Context, req *pb.ListJobEventsRequest,
+) (*pb.ListJobEventsResponse, error) {
+	var afterSeqID uint64
+	if req.PageToken != "" {
+		var err error
+		afterSeqID, err = strconv.ParseUint(req.PageToken, 10, 64)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page token: %s", req.PageToken)
+		}
+	}
+	if _, err := jm.frameMetaClient.GetJobByID(ctx, req.Id); err != nil {
+		if pkgOrm.IsNotFoundError(err) {
+			return nil, ErrJobNotFound.GenWithStack(&JobNotFoundError{JobID: req.Id})
+		}
+		return nil, err
+	}
+
+	limit := 0
+	if req.PageSize > 0 {
+		// Query one more event to know whether there is a next page.
+		limit = int(req.PageSize) + 1
+	}
+	events, err := jm.frameMetaClient.QueryJobEvents(ctx, req.Id, uint(afterSeqID), limit)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.ListJobEventsResponse{}
+	if req.PageSize > 0 && len(events) > int(req.PageSize) {
+		events = events[:req.PageSize]
+		resp.NextPageToken = strconv.FormatUint(uint64(events[len(events)-1].SeqID), 10)
+	}
+	for _, event := range events {
+		resp.Events = append(resp.Events, &pb.JobEvent{
+			Id:           int64(event.SeqID),
+			JobId:        event.JobID,
+			Type:         string(event.Type),
+			Message:      event.Message,
+			CreateTimeMs: event.CreatedAt.UnixMilli(),
+		})
+	}
+	return resp, nil
+}
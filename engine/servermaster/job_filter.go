@@ -0,0 +1,93 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package servermaster
+
+import (
+	"github.com/pingcap/errors"
+	pb "github.com/pingcap/tiflow/engine/enginepb"
+	frameModel "github.com/pingcap/tiflow/engine/framework/model"
+	"github.com/pingcap/tiflow/pkg/label"
+)
+
+// jobFilter filters the jobs returned by ListJobs.
+type jobFilter struct {
+	jobType         pb.Job_Type
+	statuses        map[pb.Job_Status]struct{}
+	selectors       []*label.Selector
+	createdAfterMs  int64
+	createdBeforeMs int64
+}
+
+func newJobFilter(req *pb.ListJobsRequest) (*jobFilter, error) {
+	if req.CreatedAfterMs < 0 || req.CreatedBeforeMs < 0 {
+		return nil, errors.New("creation time must not be negative")
+	}
+	filter := &jobFilter{
+		jobType:         req.Type,
+		createdAfterMs:  req.CreatedAfterMs,
+		createdBeforeMs: req.CreatedBeforeMs,
+	}
+	if len(req.Statuses) > 0 {
+		filter.statuses = make(map[pb.Job_Status]struct{}, len(req.Statuses))
+		for _, st := range req.Statuses {
+			filter.statuses[st] = struct{}{}
+		}
+	}
+	for _, str := range req.LabelSelectors {
+		selector, err := label.ParseSelector(str)
+		if err != nil {
+			return nil, err
+		}
+		filter.selectors = append(filter.selectors, selector)
+	}
+	return filter, nil
+}
+
+// matchMeta returns whether the job matches the filters on the metadata,
+// which are checked before converting the metadata to a job.
+func (f *jobFilter) matchMeta(masterMeta *frameModel.MasterMetaKVData) bool {
+	createdAt := masterMeta.CreatedAt.UnixMilli()
+	if f.createdAfterMs > 0 && createdAt < f.createdAfterMs {
+		return false
+	}
+	if f.createdBeforeMs > 0 && createdAt >= f.createdBeforeMs {
+		return false
+	}
+	if len(f.selectors) > 0 {
+		labels := make(label.Set, len(masterMeta.Ext.Labels))
+		for k, v := range masterMeta.Ext.Labels {
+			labels[label.Key(k)] = label.Value(v)
+		}
+		for _, selector := range f.selectors {
+			if !selector.Matches(labels) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// matchJob returns whether the job matches the filters on the type and
+// the status.
+func (f *jobFilter) matchJob(job *pb.Job) bool {
+	if f.jobType != pb.Job_TypeUnknown && job.Type != f.jobType {
+		return false
+	}
+	if f.statuses != nil {
+		if _, ok := f.statuses[job.Status]; !ok {
+			return false
+		}
+	}
+	return true
+}
@@ -149,6 +149,14 @@ func (fsm *JobFsm) JobDispatched(job *frameModel.MasterMetaKVData, addFromFailov
 	}
 }
 
+// JobResumed is called when a paused job is resumed, the job is added to the
+// pending jobs and will be dispatched again.
+func (fsm *JobFsm) JobResumed(job *frameModel.MasterMetaKVData) {
+	fsm.jobsMu.Lock()
+	defer fsm.jobsMu.Unlock()
+	fsm.pendingJobs[job.ID] = job
+}
+
 // IterPendingJobs iterates all pending jobs and dispatch(via create worker) them again.
 func (fsm *JobFsm) IterPendingJobs(dispatchJobFn func(job *frameModel.MasterMetaKVData) (string, error)) error {
 	fsm.jobsMu.Lock()
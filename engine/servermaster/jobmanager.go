@@ -14,10 +14,15 @@
 package servermaster
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
 	"regexp"
 	"sort"
+	"sync"
 	"time"
 
 	"github.com/pingcap/errors"
@@ -27,6 +32,7 @@ import (
 	"github.com/pingcap/tiflow/engine/framework"
 	"github.com/pingcap/tiflow/engine/framework/metadata"
 	frameModel "github.com/pingcap/tiflow/engine/framework/model"
+	cdc "github.com/pingcap/tiflow/engine/jobmaster/cdc"
 	engineModel "github.com/pingcap/tiflow/engine/model"
 	"github.com/pingcap/tiflow/engine/pkg/clock"
 	dcontext "github.com/pingcap/tiflow/engine/pkg/context"
@@ -34,11 +40,15 @@ import (
 	resManager "github.com/pingcap/tiflow/engine/pkg/externalresource/manager"
 	"github.com/pingcap/tiflow/engine/pkg/notifier"
 	pkgOrm "github.com/pingcap/tiflow/engine/pkg/orm"
+	ormModel "github.com/pingcap/tiflow/engine/pkg/orm/model"
 	"github.com/pingcap/tiflow/engine/pkg/p2p"
 	"github.com/pingcap/tiflow/engine/pkg/tenant"
 	derrors "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/httputil"
+	"github.com/pingcap/tiflow/pkg/label"
 	"github.com/pingcap/tiflow/pkg/uuid"
 	"go.uber.org/zap"
+	"golang.org/x/exp/maps"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -55,6 +65,7 @@ type JobManager interface {
 	WatchJobStatuses(
 		ctx context.Context,
 	) (resManager.JobStatusesSnapshot, *notifier.Receiver[resManager.JobStatusChangeEvent], error)
+	DrainExecutor(ctx context.Context, executorID engineModel.ExecutorID, pinnedWorkers []frameModel.WorkerID) error
 }
 
 const defaultJobMasterCost = 1
@@ -84,6 +95,22 @@ type JobManagerImpl struct {
 	// TODO We might add a pending operation queue in the future.
 	jobStatusChangeMu *ctxmu.CtxMutex
 	notifier          *notifier.Notifier[resManager.JobStatusChangeEvent]
+
+	// httpCli is used to call the OpenAPI of job masters.
+	httpCli *httputil.Client
+
+	// quotaManager enforces the quotas of tenants and projects.
+	// It's not initialized in some tests, which means no quota.
+	quotaManager *quotaManager
+
+	// drainingExecutors records the executors being drained and the workers
+	// pinned to them, so that the drain requests can be resent to the job
+	// masters that come online later.
+	drainMu           sync.Mutex
+	drainingExecutors map[engineModel.ExecutorID][]frameModel.WorkerID
+
+	// jobEvents buffers the job events, they are persisted in Tick.
+	jobEvents jobEventBuffer
 }
 
 // CancelJob implements JobManagerServer.CancelJob.
@@ -97,28 +124,249 @@ func (jm *JobManagerImpl) CancelJob(ctx context.Context, req *pb.CancelJobReques
 		}
 		return nil, ErrJobNotRunning.GenWithStack(&JobNotRunningError{JobID: req.Id})
 	}
-	topic := frameModel.WorkerStatusChangeRequestTopic(jm.BaseMaster.MasterID(), job.WorkerHandle().ID())
+	if err := jm.sendStopRequest(ctx, job.WorkerHandle()); err != nil {
+		return nil, err
+	}
+	jm.recordJobEvent(req.Id, ormModel.JobEventStatusChanged, "job is being canceled")
+	pbJob, err := buildPBJob(job.MasterMeta())
+	if err != nil {
+		return nil, err
+	}
+	// TODO: we should persist the job status to the database.
+	pbJob.Status = pb.Job_Canceling
+	return pbJob, nil
+}
+
+// sendStopRequest asks the job master to stop all its workers and exit.
+func (jm *JobManagerImpl) sendStopRequest(ctx context.Context, worker framework.WorkerHandle) error {
+	topic := frameModel.WorkerStatusChangeRequestTopic(jm.BaseMaster.MasterID(), worker.ID())
 	msg := &frameModel.StatusChangeRequest{
 		SendTime:     jm.clocker.Mono(),
 		FromMasterID: jm.BaseMaster.MasterID(),
 		Epoch:        jm.BaseMaster.MasterMeta().Epoch,
 		ExpectState:  frameModel.WorkerStatusStopped,
 	}
-	if handle := job.WorkerHandle().Unwrap(); handle != nil {
-		err := handle.SendMessage(ctx, topic, msg, true /*nonblocking*/)
-		if err != nil {
-			return nil, err
+	handle := worker.Unwrap()
+	if handle == nil {
+		// The job is a tombstone, which means that the job has already exited.
+		return ErrJobNotRunning.GenWithStack(&JobNotRunningError{JobID: worker.ID()})
+	}
+	return handle.SendMessage(ctx, topic, msg, true /*nonblocking*/)
+}
+
+// DrainExecutor asks all job masters to migrate their workers away from the
+// draining executor, and migrates the job masters running on the executor.
+// The workers in pinnedWorkers own local file resources on the executor, so
+// they are not migrated. Calling it repeatedly resends the requests.
+func (jm *JobManagerImpl) DrainExecutor(
+	ctx context.Context, executorID engineModel.ExecutorID, pinnedWorkers []frameModel.WorkerID,
+) error {
+	jm.drainMu.Lock()
+	if jm.drainingExecutors == nil {
+		jm.drainingExecutors = make(map[engineModel.ExecutorID][]frameModel.WorkerID)
+	}
+	jm.drainingExecutors[executorID] = pinnedWorkers
+	jm.drainMu.Unlock()
+
+	for _, worker := range jm.BaseMaster.GetWorkers() {
+		if worker.Unwrap() == nil {
+			continue
 		}
-		pbJob, err := buildPBJob(job.MasterMeta())
-		if err != nil {
-			return nil, err
+		if err := jm.sendDrainRequest(ctx, worker, executorID, pinnedWorkers); err != nil {
+			return err
+		}
+	}
+	// A job master that exits before handling the drain request gets the
+	// request again after it is recreated, see OnWorkerOnline.
+	_, err := jm.BaseMaster.MigrateWorkers(ctx, executorID, pinnedWorkers)
+	return err
+}
+
+// sendDrainRequest asks the job master to migrate its workers away from the executor.
+func (jm *JobManagerImpl) sendDrainRequest(
+	ctx context.Context,
+	worker framework.WorkerHandle,
+	executorID engineModel.ExecutorID,
+	pinnedWorkers []frameModel.WorkerID,
+) error {
+	topic := frameModel.DrainExecutorRequestTopic(jm.BaseMaster.MasterID(), worker.ID())
+	msg := &frameModel.DrainExecutorRequest{
+		SendTime:      jm.clocker.Mono(),
+		FromMasterID:  jm.BaseMaster.MasterID(),
+		Epoch:         jm.BaseMaster.MasterMeta().Epoch,
+		ExecutorID:    executorID,
+		PinnedWorkers: pinnedWorkers,
+	}
+	handle := worker.Unwrap()
+	if handle == nil {
+		return ErrJobNotRunning.GenWithStack(&JobNotRunningError{JobID: worker.ID()})
+	}
+	return handle.SendMessage(ctx, topic, msg, true /*nonblocking*/)
+}
+
+// PauseJob implements JobManagerServer.PauseJob.
+// The target status is persisted before the job master is asked to stop,
+// so that the job master exits as paused rather than canceled, even if the
+// job manager fails over in between.
+func (jm *JobManagerImpl) PauseJob(ctx context.Context, req *pb.PauseJobRequest) (*pb.Job, error) {
+	masterMeta, err := jm.frameMetaClient.GetJobByID(ctx, req.Id)
+	if err != nil {
+		if pkgOrm.IsNotFoundError(err) {
+			return nil, ErrJobNotFound.GenWithStack(&JobNotFoundError{JobID: req.Id})
+		}
+		return nil, err
+	}
+	if masterMeta.StatusCode == frameModel.MasterStatusPaused {
+		return buildPBJob(masterMeta)
+	}
+	job := jm.JobFsm.QueryOnlineJob(req.Id)
+	if job == nil || job.WorkerHandle().Unwrap() == nil {
+		return nil, ErrJobNotRunning.GenWithStack(&JobNotRunningError{JobID: req.Id})
+	}
+
+	if err := jm.setTargetStatus(ctx, req.Id, frameModel.MasterStatusPaused); err != nil {
+		return nil, err
+	}
+	if err := jm.sendStopRequest(ctx, job.WorkerHandle()); err != nil {
+		return nil, err
+	}
+	jm.recordJobEvent(req.Id, ormModel.JobEventStatusChanged, "job is being paused")
+	masterMeta.Ext.TargetStatus = frameModel.MasterStatusPaused
+	return buildPBJob(masterMeta)
+}
+
+// setTargetStatus persists the target status of the job. Only the target
+// status is updated, the job master may update its meta concurrently.
+func (jm *JobManagerImpl) setTargetStatus(
+	ctx context.Context, jobID string, targetStatus frameModel.MasterStatusCode,
+) error {
+	if ok := jm.jobStatusChangeMu.Lock(ctx); !ok {
+		return errors.Trace(ctx.Err())
+	}
+	defer jm.jobStatusChangeMu.Unlock()
+
+	return jm.frameMetaClient.UpdateJobExt(ctx, jobID, map[string]interface{}{
+		frameModel.MasterMetaExtTargetStatus: targetStatus,
+	})
+}
+
+// ResumeJob implements JobManagerServer.ResumeJob.
+func (jm *JobManagerImpl) ResumeJob(ctx context.Context, req *pb.ResumeJobRequest) (*pb.Job, error) {
+	if ok := jm.jobStatusChangeMu.Lock(ctx); !ok {
+		return nil, errors.Trace(ctx.Err())
+	}
+	defer jm.jobStatusChangeMu.Unlock()
+
+	masterMeta, err := jm.frameMetaClient.GetJobByID(ctx, req.Id)
+	if err != nil {
+		if pkgOrm.IsNotFoundError(err) {
+			return nil, ErrJobNotFound.GenWithStack(&JobNotFoundError{JobID: req.Id})
 		}
-		// TODO: we should persist the job status to the database.
-		pbJob.Status = pb.Job_Canceling
-		return pbJob, nil
+		return nil, err
+	}
+	if masterMeta.StatusCode != frameModel.MasterStatusPaused || jm.JobFsm.QueryJob(req.Id) != nil {
+		return nil, ErrJobNotPaused.GenWithStack(&JobNotPausedError{JobID: req.Id})
+	}
+
+	// The job master has been initialized before it is paused, so mark it as
+	// initialized to make it recover from its own metadata.
+	masterMeta.StatusCode = frameModel.MasterStatusInit
+	masterMeta.Ext.TargetStatus = frameModel.MasterStatusUninit
+	masterMeta.ErrorMsg = ""
+	masterMeta.ExtMsg = ""
+	if err := jm.frameMetaClient.UpdateJob(ctx, masterMeta); err != nil {
+		return nil, err
+	}
+
+	// TODO: Refine me. split the BaseMaster
+	defaultMaster, ok := jm.BaseMaster.(interface {
+		SetProjectInfo(frameModel.MasterID, tenant.ProjectInfo)
+	})
+	if ok {
+		defaultMaster.SetProjectInfo(masterMeta.ID, tenant.NewProjectInfo(req.TenantId, req.ProjectId))
+	} else {
+		log.Error("jobmanager don't have the 'SetProjectInfo' interface",
+			zap.String("masterID", masterMeta.ID),
+			zap.Any("projectInfo", tenant.NewProjectInfo(req.TenantId, req.ProjectId)))
+	}
+
+	// The job master will be created in the next Tick.
+	jm.JobFsm.JobResumed(masterMeta)
+	log.Info("resume job", zap.String("job-id", req.Id))
+	jm.recordJobEvent(req.Id, ormModel.JobEventStatusChanged, "job is resumed")
+
+	pbJob, err := buildPBJob(masterMeta)
+	if err != nil {
+		return nil, err
+	}
+	pbJob.Status = pb.Job_Created
+	return pbJob, nil
+}
+
+// jobConfigUpdateRequest is the request body sent to the job master when
+// updating config, it's compatible with the API of the DM job master.
+type jobConfigUpdateRequest struct {
+	Config string `json:"config"`
+}
+
+// UpdateJobConfig implements JobManagerServer.UpdateJobConfig.
+// The new config is sent to the job master by "PUT /api/v1/jobs/{id}/config",
+// and is persisted only if the job master accepts it. Job masters that don't
+// serve the API don't support updating config.
+func (jm *JobManagerImpl) UpdateJobConfig(ctx context.Context, req *pb.UpdateJobConfigRequest) (*pb.Job, error) {
+	addr, err := jm.GetJobMasterForwardAddress(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := json.Marshal(&jobConfigUpdateRequest{Config: string(req.Config)})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	// TODO: Support TLS.
+	url := fmt.Sprintf("http://%s%s%s/config", addr, jobAPIPrefix, req.Id)
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPut, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	resp, err := jm.httpCli.Do(httpReq)
+	if err != nil {
+		return nil, errors.Trace(err)
 	}
-	// The job is a tombstone, which means that the job has already exited.
-	return nil, ErrJobNotRunning.GenWithStack(&JobNotRunningError{JobID: req.Id})
+	defer resp.Body.Close()
+	content, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound, http.StatusMethodNotAllowed:
+		return nil, ErrJobConfigUpdateNotSupported.GenWithStack(
+			&JobConfigUpdateNotSupportedError{JobID: req.Id})
+	default:
+		return nil, status.Errorf(codes.InvalidArgument,
+			"job master rejected the config: [%d] %s", resp.StatusCode, content)
+	}
+
+	if ok := jm.jobStatusChangeMu.Lock(ctx); !ok {
+		return nil, errors.Trace(ctx.Err())
+	}
+	defer jm.jobStatusChangeMu.Unlock()
+
+	// Only the config is updated, the job master may update its meta
+	// concurrently.
+	if err := jm.frameMetaClient.UpdateJobConfig(ctx, req.Id, req.Config); err != nil {
+		return nil, err
+	}
+	masterMeta, err := jm.frameMetaClient.GetJobByID(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	log.Info("update job config", zap.String("job-id", req.Id),
+		zap.String("config", string(req.Config)))
+	jm.recordJobEvent(req.Id, ormModel.JobEventStatusChanged, "job config is updated")
+	return buildPBJob(masterMeta)
 }
 
 // DeleteJob implements JobManagerServer.DeleteJob.
@@ -131,8 +379,11 @@ func (jm *JobManagerImpl) DeleteJob(ctx context.Context, req *pb.DeleteJobReques
 		return nil, err
 	}
 
-	// Only stopped (canceled) jobs can be deleted.
-	if masterMeta.StatusCode != frameModel.MasterStatusStopped && masterMeta.StatusCode != frameModel.MasterStatusFinished {
+	// Only stopped (canceled), finished, failed or paused jobs can be deleted.
+	switch masterMeta.StatusCode {
+	case frameModel.MasterStatusStopped, frameModel.MasterStatusFinished,
+		frameModel.MasterStatusFailed, frameModel.MasterStatusPaused:
+	default:
 		return nil, ErrJobNotStopped.GenWithStack(&JobNotStoppedError{JobID: req.Id})
 	}
 	if err := jm.deleteJobMeta(ctx, req.Id); err != nil {
@@ -159,6 +410,10 @@ func (jm *JobManagerImpl) deleteJobMeta(ctx context.Context, jobID string) error
 		log.Warn("Job not found in meta (or already deleted)",
 			zap.Any("job-id", jobID))
 	}
+	jm.dropJobEvents(jobID)
+	if _, err := jm.frameMetaClient.DeleteJobEvents(ctx, jobID); err != nil {
+		log.Warn("failed to delete job events", zap.String("job-id", jobID), zap.Error(err))
+	}
 
 	jm.notifier.Notify(resManager.JobStatusChangeEvent{
 		EventType: resManager.JobRemovedEvent,
@@ -194,6 +449,10 @@ func (jm *JobManagerImpl) CreateJob(ctx context.Context, req *pb.CreateJobReques
 	if job.Id == "" {
 		job.Id = jm.uuidGen.NewString()
 	}
+	retryPolicy, err := retryPolicyFromPB(job.RetryPolicy)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid retry policy: %v", err)
+	}
 
 	meta := &frameModel.MasterMetaKVData{
 		ProjectID: tenant.NewProjectInfo(
@@ -203,6 +462,11 @@ func (jm *JobManagerImpl) CreateJob(ctx context.Context, req *pb.CreateJobReques
 		ID:         job.Id,
 		Config:     job.Config,
 		StatusCode: frameModel.MasterStatusUninit,
+		Ext: frameModel.MasterMetaExt{
+			TenantID:    req.TenantId,
+			RetryPolicy: retryPolicy,
+			Labels:      job.Labels,
+		},
 	}
 	switch job.Type {
 	case pb.Job_CVSDemo:
@@ -214,6 +478,11 @@ func (jm *JobManagerImpl) CreateJob(ctx context.Context, req *pb.CreateJobReques
 		meta.Tp = framework.CvsJobMaster
 	case pb.Job_DM:
 		meta.Tp = framework.DMJobMaster
+	case pb.Job_CDC:
+		if err := (&cdc.JobConfig{}).Decode(job.Config); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid config: %v", err)
+		}
+		meta.Tp = framework.CdcJobMaster
 	case pb.Job_FakeJob:
 		meta.Tp = framework.FakeJobMaster
 	default:
@@ -221,12 +490,7 @@ func (jm *JobManagerImpl) CreateJob(ctx context.Context, req *pb.CreateJobReques
 	}
 
 	// Store job master metadata before creating it.
-	// FIXME: note the following two operations are not atomic. We should use
-	//  a transaction or an insert statement to avoid inconsistent result.
-	if _, err := jm.frameMetaClient.GetJobByID(ctx, job.Id); err == nil {
-		return nil, ErrJobAlreadyExists.GenWithStack(&JobAlreadyExistsError{JobID: job.Id})
-	}
-	if err := metadata.StoreMasterMeta(ctx, jm.frameMetaClient, meta); err != nil {
+	if err := jm.storeNewJobMeta(ctx, req, meta); err != nil {
 		return nil, err
 	}
 
@@ -261,6 +525,7 @@ func (jm *JobManagerImpl) CreateJob(ctx context.Context, req *pb.CreateJobReques
 		log.Panic("job id is not equal to worker id of job master", zap.String("job-id", job.Id), zap.String("worker-id", workerID))
 	}
 	jm.JobFsm.JobDispatched(meta, false /*addFromFailover*/)
+	jm.recordJobEvent(meta.ID, ormModel.JobEventCreated, "job is created")
 
 	return buildPBJob(meta)
 }
@@ -275,12 +540,57 @@ func validateCreateJobRequest(req *pb.CreateJobRequest) error {
 	if req.Job.Type == pb.Job_TypeUnknown {
 		return status.Error(codes.InvalidArgument, "job type must be specified")
 	}
+	for k, v := range req.Job.Labels {
+		if _, err := label.NewKey(k); err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid label key %q: %v", k, err)
+		}
+		if _, err := label.NewValue(v); err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid label value %q: %v", v, err)
+		}
+	}
 	return nil
 }
 
+// storeNewJobMeta stores the metadata of a new job if the job doesn't exist
+// and doesn't exceed the quota.
+func (jm *JobManagerImpl) storeNewJobMeta(
+	ctx context.Context, req *pb.CreateJobRequest, meta *frameModel.MasterMetaKVData,
+) error {
+	// The mutex makes sure the quota check and the creation are atomic.
+	if ok := jm.jobStatusChangeMu.Lock(ctx); !ok {
+		return errors.Trace(ctx.Err())
+	}
+	defer jm.jobStatusChangeMu.Unlock()
+
+	// FIXME: note the following two operations are not atomic. We should use
+	//  a transaction or an insert statement to avoid inconsistent result.
+	if _, err := jm.frameMetaClient.GetJobByID(ctx, meta.ID); err == nil {
+		return ErrJobAlreadyExists.GenWithStack(&JobAlreadyExistsError{JobID: meta.ID})
+	}
+	if jm.quotaManager != nil {
+		if err := jm.quotaManager.CheckCreateJob(ctx, req.TenantId, req.ProjectId); err != nil {
+			return err
+		}
+	}
+	return metadata.StoreMasterMeta(ctx, jm.frameMetaClient, meta)
+}
+
 // ListJobs implements JobManagerServer.ListJobs.
+// The jobs are sorted by ID, and the page token is the ID of the last
+// returned job.
 func (jm *JobManagerImpl) ListJobs(ctx context.Context, req *pb.ListJobsRequest) (*pb.ListJobsResponse, error) {
-	masterMetas, err := jm.frameMetaClient.QueryJobs(ctx)
+	filter, err := newJobFilter(req)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
+	}
+
+	var masterMetas []*frameModel.MasterMetaKVData
+	if req.ProjectId != "" {
+		projectID := tenant.NewProjectInfo(req.TenantId, req.ProjectId).UniqueID()
+		masterMetas, err = jm.frameMetaClient.QueryJobsByProjectID(ctx, projectID)
+	} else {
+		masterMetas, err = jm.frameMetaClient.QueryJobs(ctx)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -289,30 +599,64 @@ func (jm *JobManagerImpl) ListJobs(ctx context.Context, req *pb.ListJobsRequest)
 		return masterMetas[i].ID < masterMetas[j].ID
 	})
 
-	resp := &pb.ListJobsResponse{
-		NextPageToken: req.PageToken,
-	}
+	resp := &pb.ListJobsResponse{}
 	firstIdx := sort.Search(len(masterMetas), func(i int) bool {
 		return masterMetas[i].ID > req.PageToken
 	})
+	var (
+		quotaKeys   []quotaKey
+		quotaKeySet = make(map[quotaKey]struct{})
+	)
 	for i := firstIdx; i < len(masterMetas); i++ {
-		if masterMetas[i].Tp == framework.JobManager {
+		if masterMetas[i].Tp == framework.JobManager || !filter.matchMeta(masterMetas[i]) {
 			continue
 		}
 		job, err := buildPBJob(masterMetas[i])
 		if err != nil {
 			return nil, err
 		}
-		resp.NextPageToken = job.Id
-		resp.Jobs = append(resp.Jobs, job)
+		if !filter.matchJob(job) {
+			continue
+		}
 		if req.PageSize > 0 && int32(len(resp.Jobs)) >= req.PageSize {
+			// There are more jobs than a page.
+			resp.NextPageToken = resp.Jobs[len(resp.Jobs)-1].Id
 			break
 		}
+		resp.Jobs = append(resp.Jobs, job)
+		for _, key := range quotaKeysOf(masterMetas[i].Ext.TenantID, masterMetas[i].ProjectID) {
+			if _, ok := quotaKeySet[key]; !ok {
+				quotaKeySet[key] = struct{}{}
+				quotaKeys = append(quotaKeys, key)
+			}
+		}
 	}
 
+	if jm.quotaManager != nil && len(quotaKeys) > 0 {
+		resp.Quotas, err = jm.quotaManager.getQuotas(ctx, quotaKeys...)
+		if err != nil {
+			return nil, err
+		}
+	}
 	return resp, nil
 }
 
+// GetQuota implements JobManagerServer.GetQuota.
+func (jm *JobManagerImpl) GetQuota(ctx context.Context, req *pb.GetQuotaRequest) (*pb.Quota, error) {
+	if jm.quotaManager == nil {
+		return nil, status.Error(codes.Unimplemented, "quota is not enabled")
+	}
+	return jm.quotaManager.GetQuota(ctx, req.Scope, req.Id)
+}
+
+// SetQuota implements JobManagerServer.SetQuota.
+func (jm *JobManagerImpl) SetQuota(ctx context.Context, req *pb.SetQuotaRequest) (*pb.Quota, error) {
+	if jm.quotaManager == nil {
+		return nil, status.Error(codes.Unimplemented, "quota is not enabled")
+	}
+	return jm.quotaManager.SetQuota(ctx, req.Scope, req.Id, req.Limits)
+}
+
 func buildPBJob(masterMeta *frameModel.MasterMetaKVData) (*pb.Job, error) {
 	var jobType pb.Job_Type
 	switch tp := framework.MustConvertWorkerType2JobType(masterMeta.Tp); tp {
@@ -334,23 +678,84 @@ func buildPBJob(masterMeta *frameModel.MasterMetaKVData) (*pb.Job, error) {
 		jobStatus = pb.Job_Created
 	case frameModel.MasterStatusInit:
 		jobStatus = pb.Job_Running
+		if masterMeta.Ext.TargetStatus == frameModel.MasterStatusPaused {
+			jobStatus = pb.Job_Pausing
+		}
 	case frameModel.MasterStatusFinished:
 		jobStatus = pb.Job_Finished
 	case frameModel.MasterStatusStopped:
 		jobStatus = pb.Job_Canceled
+	case frameModel.MasterStatusPaused:
+		jobStatus = pb.Job_Paused
+	case frameModel.MasterStatusFailed:
+		jobStatus = pb.Job_Failed
 	default:
 		return nil, errors.Errorf("job %s has unknown type %v", masterMeta.ID, masterMeta.StatusCode)
 	}
 
+	var jobErr *pb.Error
+	if masterMeta.ErrorMsg != "" {
+		jobErr = &pb.Error{Message: masterMeta.ErrorMsg}
+	}
+	var createTimeMs int64
+	if !masterMeta.CreatedAt.IsZero() {
+		createTimeMs = masterMeta.CreatedAt.UnixMilli()
+	}
+
 	return &pb.Job{
-		Id:     masterMeta.ID,
-		Type:   jobType,
-		Status: jobStatus,
-		Config: masterMeta.Config,
-		Error:  nil, // TODO: Fill error field.
+		Id:           masterMeta.ID,
+		Type:         jobType,
+		Status:       jobStatus,
+		Config:       masterMeta.Config,
+		Error:        jobErr,
+		RetryPolicy:  retryPolicyToPB(masterMeta.Ext.RetryPolicy),
+		RestartCount: masterMeta.Ext.RestartCount,
+		LastError:    masterMeta.Ext.LastError,
+		Labels:       masterMeta.Ext.Labels,
+		CreateTimeMs: createTimeMs,
+	}, nil
+}
+
+func retryPolicyFromPB(policy *pb.RetryPolicy) (*frameModel.RetryPolicy, error) {
+	if policy == nil {
+		return nil, nil
+	}
+	if policy.MaxRestarts < 0 || policy.InitialBackoffMs < 0 ||
+		policy.MaxBackoffMs < 0 || policy.FailureWindowMs < 0 {
+		return nil, errors.New("max restarts, backoffs and failure window must not be negative")
+	}
+	if policy.BackoffMultiplier != 0 && policy.BackoffMultiplier < 1 {
+		return nil, errors.Errorf("backoff multiplier %v must not be less than 1", policy.BackoffMultiplier)
+	}
+	for _, code := range policy.TerminalErrorCodes {
+		if code == "" {
+			return nil, errors.New("terminal error code must not be empty")
+		}
+	}
+	return &frameModel.RetryPolicy{
+		MaxRestarts:        int(policy.MaxRestarts),
+		InitialBackoff:     time.Duration(policy.InitialBackoffMs) * time.Millisecond,
+		MaxBackoff:         time.Duration(policy.MaxBackoffMs) * time.Millisecond,
+		BackoffMultiplier:  policy.BackoffMultiplier,
+		FailureWindow:      time.Duration(policy.FailureWindowMs) * time.Millisecond,
+		TerminalErrorCodes: policy.TerminalErrorCodes,
 	}, nil
 }
 
+func retryPolicyToPB(policy *frameModel.RetryPolicy) *pb.RetryPolicy {
+	if policy == nil {
+		return nil
+	}
+	return &pb.RetryPolicy{
+		MaxRestarts:        int32(policy.MaxRestarts),
+		InitialBackoffMs:   policy.InitialBackoff.Milliseconds(),
+		MaxBackoffMs:       policy.MaxBackoff.Milliseconds(),
+		BackoffMultiplier:  policy.BackoffMultiplier,
+		FailureWindowMs:    policy.FailureWindow.Milliseconds(),
+		TerminalErrorCodes: policy.TerminalErrorCodes,
+	}
+}
+
 // GetJobMasterForwardAddress implements JobManager.GetJobMasterForwardAddress.
 func (jm *JobManagerImpl) GetJobMasterForwardAddress(ctx context.Context, jobID string) (string, error) {
 	// Always query from database. Master meta in JobFsm may be out of date.
@@ -388,6 +793,7 @@ func (jm *JobManagerImpl) GetJobStatuses(
 func NewJobManagerImpl(
 	dctx *dcontext.Context,
 	id frameModel.MasterID,
+	quotaManager *quotaManager,
 ) (*JobManagerImpl, error) {
 	metaCli, err := dctx.Deps().Construct(func(cli pkgOrm.Client) (pkgOrm.Client, error) {
 		return cli, nil
@@ -398,6 +804,10 @@ func NewJobManagerImpl(
 
 	metaClient := metaCli.(pkgOrm.Client)
 	cli := metadata.NewMasterMetadataClient(id, metaClient)
+	httpCli, err := httputil.NewClient(nil)
+	if err != nil {
+		return nil, err
+	}
 	impl := &JobManagerImpl{
 		JobFsm:            NewJobFsm(),
 		uuidGen:           uuid.NewGenerator(),
@@ -406,6 +816,8 @@ func NewJobManagerImpl(
 		frameMetaClient:   metaClient,
 		jobStatusChangeMu: ctxmu.New(),
 		notifier:          notifier.NewNotifier[resManager.JobStatusChangeEvent](),
+		httpCli:           httpCli,
+		quotaManager:      quotaManager,
 	}
 	impl.BaseMaster = framework.NewBaseMaster(
 		dctx,
@@ -450,6 +862,8 @@ func (jm *JobManagerImpl) Tick(ctx context.Context) error {
 		return false, err
 	}
 
+	jm.flushJobEvents(ctx)
+
 	err := jm.JobFsm.IterPendingJobs(
 		func(job *frameModel.MasterMetaKVData) (string, error) {
 			return jm.BaseMaster.CreateWorker(
@@ -518,8 +932,11 @@ func (jm *JobManagerImpl) OnMasterRecovered(ctx context.Context) error {
 			continue
 		}
 		// TODO: filter the job in backend
-		if job.StatusCode == frameModel.MasterStatusFinished || job.StatusCode == frameModel.MasterStatusStopped {
-			log.Info("skip finished or stopped job", zap.Any("job", job))
+		if job.StatusCode == frameModel.MasterStatusFinished ||
+			job.StatusCode == frameModel.MasterStatusStopped ||
+			job.StatusCode == frameModel.MasterStatusFailed ||
+			job.StatusCode == frameModel.MasterStatusPaused {
+			log.Info("skip finished, stopped, failed or paused job", zap.Any("job", job))
 			continue
 		}
 		jm.JobFsm.JobDispatched(job, true /*addFromFailover*/)
@@ -532,15 +949,58 @@ func (jm *JobManagerImpl) OnMasterRecovered(ctx context.Context) error {
 func (jm *JobManagerImpl) OnWorkerDispatched(worker framework.WorkerHandle, result error) error {
 	if result != nil {
 		log.Warn("dispatch worker met error", zap.Error(result))
+		jm.recordJobEvent(worker.ID(), ormModel.JobEventDispatchFailed,
+			fmt.Sprintf("failed to dispatch job master: %v", result))
 		return jm.JobFsm.JobDispatchFailed(worker)
 	}
+	jm.recordJobEvent(worker.ID(), ormModel.JobEventDispatched, "job master is dispatched")
 	return nil
 }
 
 // OnWorkerOnline implements frame.MasterImpl.OnWorkerOnline
 func (jm *JobManagerImpl) OnWorkerOnline(worker framework.WorkerHandle) error {
 	log.Info("on worker online", zap.Any("id", worker.ID()))
-	return jm.JobFsm.JobOnline(worker)
+	if err := jm.JobFsm.JobOnline(worker); err != nil {
+		return err
+	}
+	jm.recordJobEvent(worker.ID(), ormModel.JobEventOnline, "job master is online")
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	// The job master could be recreated before it has migrated its workers
+	// away from the draining executors, so we resend the drain requests.
+	jm.drainMu.Lock()
+	drainingExecutors := maps.Clone(jm.drainingExecutors)
+	jm.drainMu.Unlock()
+	for executorID, pinnedWorkers := range drainingExecutors {
+		if err := jm.sendDrainRequest(ctx, worker, executorID, pinnedWorkers); err != nil {
+			log.Warn("failed to send drain request", zap.String("id", worker.ID()),
+				zap.String("executor-id", string(executorID)), zap.Error(err))
+		}
+		// The job master itself may be recovered on the draining executor
+		// after the server master fails over.
+		if _, err := jm.BaseMaster.MigrateWorkers(ctx, executorID, pinnedWorkers); err != nil {
+			log.Warn("failed to migrate job masters", zap.String("executor-id", string(executorID)),
+				zap.Error(err))
+		}
+	}
+
+	// The job could be paused before the job master exits, for example the
+	// job master fails over after receiving the stop request, so we resend
+	// the request to make the job master exit as paused.
+	masterMeta, err := jm.frameMetaClient.GetJobByID(ctx, worker.ID())
+	if err != nil {
+		log.Warn("failed to load job meta", zap.String("id", worker.ID()), zap.Error(err))
+		return nil
+	}
+	if masterMeta.Ext.TargetStatus == frameModel.MasterStatusPaused {
+		log.Info("job is being paused, stop it", zap.String("id", worker.ID()))
+		if err := jm.sendStopRequest(ctx, worker); err != nil {
+			log.Warn("failed to send stop request", zap.String("id", worker.ID()), zap.Error(err))
+		}
+	}
+	return nil
 }
 
 // OnWorkerOffline implements frame.MasterImpl.OnWorkerOffline
@@ -549,11 +1009,24 @@ func (jm *JobManagerImpl) OnWorkerOffline(worker framework.WorkerHandle, reason
 	if derrors.ErrWorkerFinish.Equal(reason) {
 		log.Info("job master finished", zap.String("id", worker.ID()))
 		needFailover = false
+		jm.recordJobEvent(worker.ID(), ormModel.JobEventStatusChanged, "job is finished")
 	} else if derrors.ErrWorkerStop.Equal(reason) {
 		log.Info("job master stopped", zap.String("id", worker.ID()))
 		needFailover = false
+		jm.recordJobEvent(worker.ID(), ormModel.JobEventStatusChanged, "job is stopped")
+	} else if workerStatus := worker.Status(); workerStatus != nil &&
+		workerStatus.Code == frameModel.WorkerStatusError {
+		// The job master has exited as failed, e.g. the retry policy of
+		// the job is exhausted, so it must not be restarted.
+		log.Info("job master failed", zap.String("id", worker.ID()),
+			zap.String("error", workerStatus.ErrorMsg))
+		needFailover = false
+		jm.recordJobEvent(worker.ID(), ormModel.JobEventStatusChanged,
+			fmt.Sprintf("job is failed: %s", workerStatus.ErrorMsg))
 	} else {
 		log.Info("on worker offline", zap.Any("id", worker.ID()), zap.Any("reason", reason))
+		jm.recordJobEvent(worker.ID(), ormModel.JobEventFailover,
+			fmt.Sprintf("job master is offline and will fail over: %v", reason))
 	}
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
 	defer cancel()
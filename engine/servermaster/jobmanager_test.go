@@ -15,12 +15,19 @@ package servermaster
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	pb "github.com/pingcap/tiflow/engine/enginepb"
 	"github.com/pingcap/tiflow/engine/framework"
@@ -33,7 +40,11 @@ import (
 	resourcemeta "github.com/pingcap/tiflow/engine/pkg/externalresource/resourcemeta/model"
 	"github.com/pingcap/tiflow/engine/pkg/notifier"
 	pkgOrm "github.com/pingcap/tiflow/engine/pkg/orm"
+	ormModel "github.com/pingcap/tiflow/engine/pkg/orm/model"
+	"github.com/pingcap/tiflow/engine/pkg/quota"
+	"github.com/pingcap/tiflow/engine/pkg/tenant"
 	"github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/httputil"
 	"github.com/pingcap/tiflow/pkg/uuid"
 )
 
@@ -88,6 +99,109 @@ func TestJobManagerCreateJob(t *testing.T) {
 	}
 	_, err = mgr.CreateJob(ctx, req)
 	require.True(t, ErrJobAlreadyExists.Is(err))
+
+	// Create a job with an invalid retry policy.
+	req = &pb.CreateJobRequest{
+		Job: &pb.Job{
+			Type:        pb.Job_FakeJob,
+			RetryPolicy: &pb.RetryPolicy{BackoffMultiplier: 0.5},
+		},
+	}
+	_, err = mgr.CreateJob(ctx, req)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Create a job with an invalid label.
+	req = &pb.CreateJobRequest{
+		Job: &pb.Job{
+			Type:   pb.Job_FakeJob,
+			Labels: map[string]string{"-invalid": "value"},
+		},
+	}
+	_, err = mgr.CreateJob(ctx, req)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// The creation of the job is recorded in its event log.
+	mgr.flushJobEvents(ctx)
+	eventsResp, err := mgr.ListJobEvents(ctx, &pb.ListJobEventsRequest{Id: job.Id})
+	require.NoError(t, err)
+	require.NotEmpty(t, eventsResp.Events)
+	require.Equal(t, string(ormModel.JobEventCreated), eventsResp.Events[0].Type)
+}
+
+func TestJobManagerCreateJobExceedQuota(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	mockMaster := framework.NewMockMasterImpl(t, "", "create-job-quota-test")
+	framework.MockMasterPrepareMeta(ctx, t, mockMaster)
+	mockMaster.On("InitImpl", mock.Anything).Return(nil)
+	mockMaster.MasterClient().EXPECT().ScheduleTask(
+		gomock.Any(),
+		gomock.Any()).Return(&pb.ScheduleTaskResponse{}, errors.ErrClusterResourceNotEnough.FastGenByArgs()).Times(1)
+	mgr := &JobManagerImpl{
+		BaseMaster:        mockMaster.DefaultBaseMaster,
+		JobFsm:            NewJobFsm(),
+		clocker:           clock.New(),
+		uuidGen:           uuid.NewGenerator(),
+		frameMetaClient:   mockMaster.GetFrameMetaClient(),
+		masterMetaClient:  metadata.NewMasterMetadataClient(metadata.JobManagerUUID, mockMaster.GetFrameMetaClient()),
+		jobStatusChangeMu: ctxmu.New(),
+		notifier:          notifier.NewNotifier[resManager.JobStatusChangeEvent](),
+		quotaManager:      newQuotaManager(mockMaster.GetFrameMetaClient(), nil),
+	}
+	mockMaster.Impl = mgr
+	err := mockMaster.Init(ctx)
+	require.Nil(t, err)
+
+	_, err = mgr.SetQuota(ctx, &pb.SetQuotaRequest{
+		Scope:  "project",
+		Id:     "p1",
+		Limits: &pb.QuotaLimits{MaxJobs: 1},
+	})
+	require.NoError(t, err)
+	newReq := func(jobID string) *pb.CreateJobRequest {
+		return &pb.CreateJobRequest{
+			Job: &pb.Job{
+				Id:     jobID,
+				Type:   pb.Job_CVSDemo,
+				Config: []byte("{\"srcHost\":\"0.0.0.0:1234\", \"dstHost\":\"0.0.0.0:1234\", \"srcDir\":\"data\", \"dstDir\":\"data1\"}"),
+			},
+			TenantId:  "t1",
+			ProjectId: "p1",
+		}
+	}
+	_, err = mgr.CreateJob(ctx, newReq("job-1"))
+	require.NoError(t, err)
+	require.NoError(t, mockMaster.Poll(ctx))
+	require.Eventually(t, func() bool {
+		return mgr.JobFsm.QueryJob("job-1") != nil
+	}, time.Second*2, time.Millisecond*20)
+	meta, err := mgr.frameMetaClient.GetJobByID(ctx, "job-1")
+	require.NoError(t, err)
+	require.Equal(t, "t1", meta.Ext.TenantID)
+
+	_, err = mgr.CreateJob(ctx, newReq("job-2"))
+	detail, ok := quota.ErrQuotaExceeded.Convert(err)
+	require.True(t, ok)
+	require.Equal(t, quota.ScopeProject, detail.Scope)
+	require.Equal(t, quota.ResourceJobs, detail.Resource)
+	_, err = mgr.frameMetaClient.GetJobByID(ctx, "job-2")
+	require.True(t, pkgOrm.IsNotFoundError(err))
+
+	// The quotas of the tenants and projects of the listed jobs are reported.
+	resp, err := mgr.ListJobs(ctx, &pb.ListJobsRequest{})
+	require.NoError(t, err)
+	quotas := make(map[string]*pb.Quota)
+	for _, q := range resp.Quotas {
+		quotas[q.Scope+"/"+q.Id] = q
+	}
+	require.Contains(t, quotas, "tenant/t1")
+	require.Equal(t, int64(1), quotas["tenant/t1"].Usage.Jobs)
+	require.Contains(t, quotas, "project/p1")
+	require.Equal(t, int64(1), quotas["project/p1"].Limits.MaxJobs)
+	require.Equal(t, int64(1), quotas["project/p1"].Usage.Jobs)
 }
 
 type mockBaseMasterCreateWorkerFailed struct {
@@ -115,10 +229,11 @@ func TestCreateWorkerReturnError(t *testing.T) {
 		MockMasterImpl: masterImpl,
 	}
 	mgr := &JobManagerImpl{
-		BaseMaster:      mockMaster,
-		JobFsm:          NewJobFsm(),
-		uuidGen:         uuid.NewGenerator(),
-		frameMetaClient: mockMaster.GetFrameMetaClient(),
+		BaseMaster:        mockMaster,
+		JobFsm:            NewJobFsm(),
+		uuidGen:           uuid.NewGenerator(),
+		frameMetaClient:   mockMaster.GetFrameMetaClient(),
+		jobStatusChangeMu: ctxmu.New(),
 	}
 	mockMaster.Impl = mgr
 	err := mockMaster.Init(ctx)
@@ -178,6 +293,194 @@ func TestJobManagerCancelJob(t *testing.T) {
 	require.True(t, ErrJobNotFound.Is(err))
 }
 
+func TestJobManagerPauseResumeJob(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	mockMaster := framework.NewMockMasterImpl(t, "", "pause-job-test")
+	framework.MockMasterPrepareMeta(ctx, t, mockMaster)
+	mockMaster.On("InitImpl", mock.Anything).Return(nil)
+	mgr := &JobManagerImpl{
+		BaseMaster:        mockMaster.DefaultBaseMaster,
+		JobFsm:            NewJobFsm(),
+		clocker:           clock.New(),
+		frameMetaClient:   mockMaster.GetFrameMetaClient(),
+		jobStatusChangeMu: ctxmu.New(),
+	}
+
+	jobID := "pause-job-id"
+	meta := &frameModel.MasterMetaKVData{
+		ID:         jobID,
+		Tp:         framework.FakeJobMaster,
+		StatusCode: frameModel.MasterStatusInit,
+	}
+	require.NoError(t, mgr.frameMetaClient.UpsertJob(ctx, meta))
+
+	// the job is not online
+	_, err := mgr.PauseJob(ctx, &pb.PauseJobRequest{Id: jobID})
+	require.True(t, ErrJobNotRunning.Is(err))
+	_, err = mgr.PauseJob(ctx, &pb.PauseJobRequest{Id: jobID + "-unknown"})
+	require.True(t, ErrJobNotFound.Is(err))
+
+	mgr.JobFsm.JobDispatched(meta, false)
+	mockWorkerHandle := &framework.MockHandle{WorkerID: jobID, ExecutorID: "executor-1"}
+	require.NoError(t, mgr.JobFsm.JobOnline(mockWorkerHandle))
+
+	job, err := mgr.PauseJob(ctx, &pb.PauseJobRequest{Id: jobID})
+	require.NoError(t, err)
+	require.Equal(t, pb.Job_Pausing, job.Status)
+	require.Equal(t, 1, mockWorkerHandle.SendMessageCount())
+	job, err = mgr.GetJob(ctx, &pb.GetJobRequest{Id: jobID})
+	require.NoError(t, err)
+	require.Equal(t, pb.Job_Pausing, job.Status)
+
+	// the job can't be resumed before it is paused
+	_, err = mgr.ResumeJob(ctx, &pb.ResumeJobRequest{Id: jobID})
+	require.True(t, ErrJobNotPaused.Is(err))
+
+	// mock the job master exits as paused
+	meta, err = mgr.frameMetaClient.GetJobByID(ctx, jobID)
+	require.NoError(t, err)
+	meta.StatusCode = frameModel.MasterStatusPaused
+	require.NoError(t, mgr.frameMetaClient.UpdateJob(ctx, meta))
+	mgr.JobFsm.JobOffline(mockWorkerHandle, false /* needFailover */)
+
+	// pause is idempotent
+	job, err = mgr.PauseJob(ctx, &pb.PauseJobRequest{Id: jobID})
+	require.NoError(t, err)
+	require.Equal(t, pb.Job_Paused, job.Status)
+
+	job, err = mgr.ResumeJob(ctx, &pb.ResumeJobRequest{Id: jobID})
+	require.NoError(t, err)
+	require.Equal(t, pb.Job_Created, job.Status)
+	require.Equal(t, 1, mgr.JobFsm.JobCount(pb.Job_Created))
+	meta, err = mgr.frameMetaClient.GetJobByID(ctx, jobID)
+	require.NoError(t, err)
+	require.Equal(t, frameModel.MasterStatusInit, meta.StatusCode)
+	require.Equal(t, frameModel.MasterStatusUninit, meta.Ext.TargetStatus)
+
+	// the job is resumed already
+	_, err = mgr.ResumeJob(ctx, &pb.ResumeJobRequest{Id: jobID})
+	require.True(t, ErrJobNotPaused.Is(err))
+}
+
+// extUpdatingClient persists the retry stats of a job right after the job is
+// loaded, like a job master does concurrently.
+type extUpdatingClient struct {
+	pkgOrm.Client
+	t *testing.T
+}
+
+func (c *extUpdatingClient) GetJobByID(ctx context.Context, jobID string) (*frameModel.MasterMetaKVData, error) {
+	meta, err := c.Client.GetJobByID(ctx, jobID)
+	if err != nil {
+		return nil, err
+	}
+	require.NoError(c.t, c.Client.UpdateJobExt(ctx, jobID, map[string]interface{}{
+		frameModel.MasterMetaExtRestartCount: int64(1),
+		frameModel.MasterMetaExtLastError:    "failed",
+	}))
+	return meta, nil
+}
+
+func TestJobManagerUpdateJobConfig(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var received jobConfigUpdateRequest
+	jobMasterServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/api/v1/jobs/update-job/config" && r.Method == http.MethodPut:
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+			if received.Config == "invalid" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer jobMasterServer.Close()
+	addr := strings.TrimPrefix(jobMasterServer.URL, "http://")
+
+	mockMaster := framework.NewMockMasterImpl(t, "", "update-job-config-test")
+	framework.MockMasterPrepareMeta(ctx, t, mockMaster)
+	httpCli, err := httputil.NewClient(nil)
+	require.NoError(t, err)
+	mgr := &JobManagerImpl{
+		BaseMaster:        mockMaster.DefaultBaseMaster,
+		JobFsm:            NewJobFsm(),
+		clocker:           clock.New(),
+		frameMetaClient:   mockMaster.GetFrameMetaClient(),
+		jobStatusChangeMu: ctxmu.New(),
+		httpCli:           httpCli,
+	}
+
+	for _, jobID := range []string{"update-job", "not-supported-job"} {
+		meta := &frameModel.MasterMetaKVData{
+			ID:         jobID,
+			Tp:         framework.FakeJobMaster,
+			StatusCode: frameModel.MasterStatusInit,
+			Addr:       addr,
+			Config:     []byte("old"),
+		}
+		require.NoError(t, mgr.frameMetaClient.UpsertJob(ctx, meta))
+		mgr.JobFsm.JobDispatched(meta, false)
+		require.NoError(t, mgr.JobFsm.JobOnline(&framework.MockHandle{WorkerID: jobID}))
+	}
+
+	job, err := mgr.UpdateJobConfig(ctx, &pb.UpdateJobConfigRequest{
+		Id:     "update-job",
+		Config: []byte("new"),
+	})
+	require.NoError(t, err)
+	require.Equal(t, []byte("new"), job.Config)
+	require.Equal(t, "new", received.Config)
+	meta, err := mgr.frameMetaClient.GetJobByID(ctx, "update-job")
+	require.NoError(t, err)
+	require.Equal(t, []byte("new"), meta.Config)
+
+	// the config is not persisted if the job master rejects it
+	_, err = mgr.UpdateJobConfig(ctx, &pb.UpdateJobConfigRequest{
+		Id:     "update-job",
+		Config: []byte("invalid"),
+	})
+	require.Error(t, err)
+	meta, err = mgr.frameMetaClient.GetJobByID(ctx, "update-job")
+	require.NoError(t, err)
+	require.Equal(t, []byte("new"), meta.Config)
+
+	_, err = mgr.UpdateJobConfig(ctx, &pb.UpdateJobConfigRequest{
+		Id:     "not-supported-job",
+		Config: []byte("new"),
+	})
+	require.True(t, ErrJobConfigUpdateNotSupported.Is(err))
+	_, err = mgr.UpdateJobConfig(ctx, &pb.UpdateJobConfigRequest{
+		Id:     "unknown-job",
+		Config: []byte("new"),
+	})
+	require.True(t, ErrJobNotFound.Is(err))
+
+	// The retry stats persisted concurrently are not overwritten.
+	metaClient := mgr.frameMetaClient
+	mgr.frameMetaClient = &extUpdatingClient{Client: metaClient, t: t}
+	_, err = mgr.UpdateJobConfig(ctx, &pb.UpdateJobConfigRequest{
+		Id:     "update-job",
+		Config: []byte("newer"),
+	})
+	require.NoError(t, err)
+	meta, err = metaClient.GetJobByID(ctx, "update-job")
+	require.NoError(t, err)
+	require.Equal(t, []byte("newer"), meta.Config)
+	require.Equal(t, int64(1), meta.Ext.RestartCount)
+	require.Equal(t, "failed", meta.Ext.LastError)
+}
+
 func TestJobManagerDeleteJob(t *testing.T) {
 	t.Parallel()
 
@@ -257,6 +560,40 @@ func TestJobManagerGetJob(t *testing.T) {
 			},
 			pb.Job_Canceled,
 		},
+		{
+			&frameModel.MasterMetaKVData{
+				ID:         "master-5",
+				Tp:         framework.FakeJobMaster,
+				StatusCode: frameModel.MasterStatusInit,
+				Ext:        frameModel.MasterMetaExt{TargetStatus: frameModel.MasterStatusPaused},
+			},
+			pb.Job_Pausing,
+		},
+		{
+			&frameModel.MasterMetaKVData{
+				ID:         "master-6",
+				Tp:         framework.FakeJobMaster,
+				StatusCode: frameModel.MasterStatusPaused,
+			},
+			pb.Job_Paused,
+		},
+		{
+			&frameModel.MasterMetaKVData{
+				ID:         "master-7",
+				Tp:         framework.FakeJobMaster,
+				StatusCode: frameModel.MasterStatusFailed,
+				ErrorMsg:   "restarts exhausted",
+				Ext: frameModel.MasterMetaExt{
+					RetryPolicy: &frameModel.RetryPolicy{
+						MaxRestarts:    3,
+						InitialBackoff: time.Second,
+					},
+					RestartCount: 3,
+					LastError:    "worker failed",
+				},
+			},
+			pb.Job_Failed,
+		},
 	}
 
 	mockMaster := framework.NewMockMasterImpl(t, "", "job-manager-get-job-test")
@@ -290,6 +627,13 @@ func TestJobManagerGetJob(t *testing.T) {
 		require.Contains(t, statuses, tc.meta.ID)
 		require.Equal(t, tc.meta.StatusCode, statuses[tc.meta.ID])
 	}
+
+	job, err := mgr.GetJob(ctx, &pb.GetJobRequest{Id: "master-7"})
+	require.NoError(t, err)
+	require.Equal(t, "restarts exhausted", job.GetError().GetMessage())
+	require.Equal(t, &pb.RetryPolicy{MaxRestarts: 3, InitialBackoffMs: 1000}, job.GetRetryPolicy())
+	require.Equal(t, int64(3), job.GetRestartCount())
+	require.Equal(t, "worker failed", job.GetLastError())
 }
 
 func TestJobManagerOnlineJob(t *testing.T) {
@@ -449,3 +793,213 @@ func TestJobManagerWatchJobStatuses(t *testing.T) {
 		JobID:     "job-to-be-deleted",
 	}, event)
 }
+
+func TestJobManagerDrainExecutor(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	mockMaster := framework.NewMockMasterImpl(t, "", "drain-executor-test")
+	framework.MockMasterPrepareMeta(ctx, t, mockMaster)
+	mockMaster.On("InitImpl", mock.Anything).Return(nil)
+	mgr := &JobManagerImpl{
+		BaseMaster:        mockMaster.DefaultBaseMaster,
+		JobFsm:            NewJobFsm(),
+		clocker:           clock.New(),
+		frameMetaClient:   mockMaster.GetFrameMetaClient(),
+		jobStatusChangeMu: ctxmu.New(),
+	}
+	mockMaster.Impl = mgr
+	require.NoError(t, mockMaster.Init(ctx))
+
+	pinned := []frameModel.WorkerID{"worker-1"}
+	require.NoError(t, mgr.DrainExecutor(ctx, "executor-1", pinned))
+	require.NoError(t, mgr.DrainExecutor(ctx, "executor-2", nil))
+	mgr.drainMu.Lock()
+	require.Len(t, mgr.drainingExecutors, 2)
+	require.Equal(t, pinned, mgr.drainingExecutors["executor-1"])
+	mgr.drainMu.Unlock()
+}
+
+func TestJobManagerListJobs(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	mockMaster := framework.NewMockMasterImpl(t, "", "list-jobs-test")
+	framework.MockMasterPrepareMeta(ctx, t, mockMaster)
+	mgr := &JobManagerImpl{
+		BaseMaster:        mockMaster.DefaultBaseMaster,
+		JobFsm:            NewJobFsm(),
+		clocker:           clock.New(),
+		frameMetaClient:   mockMaster.GetFrameMetaClient(),
+		jobStatusChangeMu: ctxmu.New(),
+		notifier:          notifier.NewNotifier[resManager.JobStatusChangeEvent](),
+	}
+
+	now := time.Now()
+	metas := []*frameModel.MasterMetaKVData{
+		{
+			ID:         "job-1",
+			Tp:         framework.FakeJobMaster,
+			StatusCode: frameModel.MasterStatusInit,
+			Ext:        frameModel.MasterMetaExt{Labels: map[string]string{"env": "prod"}},
+		},
+		{
+			ID:         "job-2",
+			Tp:         framework.CvsJobMaster,
+			StatusCode: frameModel.MasterStatusInit,
+			Ext:        frameModel.MasterMetaExt{Labels: map[string]string{"env": "test"}},
+		},
+		{
+			ID:         "job-3",
+			Tp:         framework.FakeJobMaster,
+			StatusCode: frameModel.MasterStatusFinished,
+		},
+		{
+			ID:         "job-4",
+			Tp:         framework.FakeJobMaster,
+			StatusCode: frameModel.MasterStatusInit,
+			Ext:        frameModel.MasterMetaExt{Labels: map[string]string{"env": "prod"}},
+		},
+	}
+	projectInfo := tenant.NewProjectInfo("tenant-1", "project-1")
+	for i, meta := range metas {
+		meta.ProjectID = projectInfo.UniqueID()
+		meta.CreatedAt = now.Add(time.Duration(i-len(metas)) * time.Hour)
+		require.NoError(t, mgr.frameMetaClient.UpsertJob(ctx, meta))
+	}
+
+	jobIDs := func(resp *pb.ListJobsResponse) []string {
+		var ids []string
+		for _, job := range resp.Jobs {
+			ids = append(ids, job.Id)
+		}
+		return ids
+	}
+
+	testCases := []struct {
+		req      *pb.ListJobsRequest
+		expected []string
+	}{
+		{&pb.ListJobsRequest{}, []string{"job-1", "job-2", "job-3", "job-4"}},
+		{&pb.ListJobsRequest{Type: pb.Job_FakeJob}, []string{"job-1", "job-3", "job-4"}},
+		{&pb.ListJobsRequest{Statuses: []pb.Job_Status{pb.Job_Finished}}, []string{"job-3"}},
+		{&pb.ListJobsRequest{LabelSelectors: []string{"env=prod"}}, []string{"job-1", "job-4"}},
+		{&pb.ListJobsRequest{LabelSelectors: []string{"env!=prod"}}, []string{"job-2", "job-3"}},
+		{
+			&pb.ListJobsRequest{
+				CreatedAfterMs:  now.Add(-3 * time.Hour).UnixMilli(),
+				CreatedBeforeMs: now.Add(-time.Hour).UnixMilli(),
+			},
+			[]string{"job-2", "job-3"},
+		},
+	}
+	for _, tc := range testCases {
+		tc.req.TenantId = projectInfo.TenantID()
+		tc.req.ProjectId = projectInfo.ProjectID()
+		resp, err := mgr.ListJobs(ctx, tc.req)
+		require.NoError(t, err)
+		require.Equal(t, tc.expected, jobIDs(resp))
+		require.Empty(t, resp.NextPageToken)
+	}
+
+	// Page through the running fake jobs.
+	req := &pb.ListJobsRequest{
+		TenantId:  projectInfo.TenantID(),
+		ProjectId: projectInfo.ProjectID(),
+		PageSize:  1,
+		Type:      pb.Job_FakeJob,
+		Statuses:  []pb.Job_Status{pb.Job_Running},
+	}
+	resp, err := mgr.ListJobs(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, []string{"job-1"}, jobIDs(resp))
+	require.Equal(t, "job-1", resp.NextPageToken)
+	req.PageToken = resp.NextPageToken
+	resp, err = mgr.ListJobs(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, []string{"job-4"}, jobIDs(resp))
+	require.Empty(t, resp.NextPageToken)
+
+	_, err = mgr.ListJobs(ctx, &pb.ListJobsRequest{LabelSelectors: []string{"=prod"}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestJobManagerListJobEvents(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	mockMaster := framework.NewMockMasterImpl(t, "", "list-job-events-test")
+	framework.MockMasterPrepareMeta(ctx, t, mockMaster)
+	mgr := &JobManagerImpl{
+		BaseMaster:        mockMaster.DefaultBaseMaster,
+		JobFsm:            NewJobFsm(),
+		clocker:           clock.New(),
+		frameMetaClient:   mockMaster.GetFrameMetaClient(),
+		masterMetaClient:  metadata.NewMasterMetadataClient(metadata.JobManagerUUID, mockMaster.GetFrameMetaClient()),
+		jobStatusChangeMu: ctxmu.New(),
+		notifier:          notifier.NewNotifier[resManager.JobStatusChangeEvent](),
+	}
+
+	err := mgr.frameMetaClient.UpsertJob(ctx, &frameModel.MasterMetaKVData{
+		ID:         "job-1",
+		Tp:         framework.FakeJobMaster,
+		StatusCode: frameModel.MasterStatusStopped,
+	})
+	require.NoError(t, err)
+	mgr.recordJobEvent("job-1", ormModel.JobEventCreated, "job is created")
+	mgr.recordJobEvent("job-1", ormModel.JobEventDispatched, "job master is dispatched")
+	mgr.recordJobEvent("job-1", ormModel.JobEventStatusChanged, "job is stopped")
+
+	// The events are persisted when they are flushed.
+	resp, err := mgr.ListJobEvents(ctx, &pb.ListJobEventsRequest{Id: "job-1"})
+	require.NoError(t, err)
+	require.Empty(t, resp.Events)
+	mgr.flushJobEvents(ctx)
+
+	resp, err = mgr.ListJobEvents(ctx, &pb.ListJobEventsRequest{Id: "job-1", PageSize: 2})
+	require.NoError(t, err)
+	require.Len(t, resp.Events, 2)
+	require.Equal(t, string(ormModel.JobEventCreated), resp.Events[0].Type)
+	require.Equal(t, string(ormModel.JobEventDispatched), resp.Events[1].Type)
+	require.NotEmpty(t, resp.NextPageToken)
+
+	resp, err = mgr.ListJobEvents(ctx, &pb.ListJobEventsRequest{
+		Id: "job-1", PageSize: 2, PageToken: resp.NextPageToken,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Events, 1)
+	require.Equal(t, "job is stopped", resp.Events[0].Message)
+	require.Empty(t, resp.NextPageToken)
+
+	_, err = mgr.ListJobEvents(ctx, &pb.ListJobEventsRequest{Id: "job-1", PageToken: "invalid"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = mgr.ListJobEvents(ctx, &pb.ListJobEventsRequest{Id: "job-2"})
+	require.True(t, ErrJobNotFound.Is(err))
+
+	// Only the latest events of a job are kept, and the pending events are
+	// bounded.
+	for i := 0; i < maxPendingJobEvents+10; i++ {
+		mgr.recordJobEvent("job-1", ormModel.JobEventStatusChanged, fmt.Sprintf("event-%d", i))
+	}
+	mgr.flushJobEvents(ctx)
+	events, err := mgr.frameMetaClient.QueryJobEvents(ctx, "job-1", 0, 0)
+	require.NoError(t, err)
+	require.Len(t, events, maxEventsPerJob)
+	require.Equal(t, fmt.Sprintf("event-%d", maxPendingJobEvents+9), events[len(events)-1].Message)
+
+	// The events are deleted with the job.
+	mgr.recordJobEvent("job-1", ormModel.JobEventStatusChanged, "job is pending")
+	require.NoError(t, mgr.OnMasterRecovered(ctx))
+	_, err = mgr.DeleteJob(ctx, &pb.DeleteJobRequest{Id: "job-1"})
+	require.NoError(t, err)
+	mgr.flushJobEvents(ctx)
+	events, err = mgr.frameMetaClient.QueryJobEvents(ctx, "job-1", 0, 0)
+	require.NoError(t, err)
+	require.Empty(t, events)
+}
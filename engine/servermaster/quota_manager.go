@@ -0,0 +1,469 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package servermaster
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	pb "github.com/pingcap/tiflow/engine/enginepb"
+	"github.com/pingcap/tiflow/engine/framework"
+	frameModel "github.com/pingcap/tiflow/engine/framework/model"
+	"github.com/pingcap/tiflow/engine/pkg/clock"
+	resModel "github.com/pingcap/tiflow/engine/pkg/externalresource/resourcemeta/model"
+	pkgOrm "github.com/pingcap/tiflow/engine/pkg/orm"
+	ormModel "github.com/pingcap/tiflow/engine/pkg/orm/model"
+	"github.com/pingcap/tiflow/engine/pkg/quota"
+	schedModel "github.com/pingcap/tiflow/engine/servermaster/scheduler/model"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultQuotaReconcileInterval    = 10 * time.Second
+	defaultQuotaStorageRefreshPeriod = time.Minute
+	// A scheduled task is counted even if its worker status is not found,
+	// until the grace period expires, because the worker status is persisted
+	// by the worker after it is dispatched.
+	defaultQuotaTaskGracePeriod = time.Minute
+)
+
+// storageSizeFunc returns the size in bytes of an external resource.
+type storageSizeFunc = func(ctx context.Context, resource *resModel.ResourceMeta) (int64, error)
+
+type quotaKey struct {
+	scope quota.Scope
+	id    string
+}
+
+type scheduledTask struct {
+	tenantID    string
+	projectID   string
+	cost        int64
+	scheduledAt time.Time
+}
+
+// quotaManager enforces the quotas of tenants and projects.
+//   - The limits are persisted in the metastore.
+//   - The jobs are counted from the job metas.
+//   - The workers and resource units are tracked in memory when tasks are
+//     scheduled, and reconciled with the worker statuses periodically. Job
+//     masters are counted as workers too. The cost of a worker is not
+//     persisted, so workers recovered after a failover cost nothing.
+//   - The storage bytes are the sizes of s3 resources, refreshed periodically.
+//     Local file resources are not counted.
+type quotaManager struct {
+	metaClient  pkgOrm.Client
+	storageSize storageSizeFunc
+	clocker     clock.Clock
+
+	mu           sync.Mutex
+	tasks        map[string]*scheduledTask
+	storageUsage map[quotaKey]int64
+}
+
+// newQuotaManager creates a quotaManager. storageSize can be nil if no
+// external storage is configured.
+func newQuotaManager(metaClient pkgOrm.Client, storageSize storageSizeFunc) *quotaManager {
+	return &quotaManager{
+		metaClient:   metaClient,
+		storageSize:  storageSize,
+		clocker:      clock.New(),
+		tasks:        make(map[string]*scheduledTask),
+		storageUsage: make(map[quotaKey]int64),
+	}
+}
+
+// Run reconciles the usage periodically until ctx is canceled.
+func (m *quotaManager) Run(ctx context.Context) error {
+	reconcileTicker := time.NewTicker(defaultQuotaReconcileInterval)
+	defer reconcileTicker.Stop()
+	storageTicker := time.NewTicker(defaultQuotaStorageRefreshPeriod)
+	defer storageTicker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return errors.Trace(ctx.Err())
+		case <-reconcileTicker.C:
+			if err := m.reconcile(ctx); err != nil {
+				log.Warn("failed to reconcile quota usage", zap.Error(err))
+			}
+		case <-storageTicker.C:
+			if err := m.refreshStorageUsage(ctx); err != nil {
+				log.Warn("failed to refresh storage usage", zap.Error(err))
+			}
+		}
+	}
+}
+
+// CheckCreateJob returns ErrQuotaExceeded if creating a job exceeds the
+// quota of its tenant or project. The caller must serialize job creations.
+func (m *quotaManager) CheckCreateJob(ctx context.Context, tenantID, projectID string) error {
+	keys := quotaKeysOf(tenantID, projectID)
+	limits, err := m.getLimits(ctx, keys...)
+	if err != nil {
+		return err
+	}
+	if len(limits) == 0 {
+		return nil
+	}
+	usages, err := m.getUsages(ctx, keys...)
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		err := limits[key].Check(key.scope, key.id, usages[key], quota.Usage{Jobs: 1})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ReserveTask implements scheduler.QuotaChecker.
+func (m *quotaManager) ReserveTask(ctx context.Context, request *schedModel.SchedulerRequest) error {
+	keys := quotaKeysOf(request.TenantID, request.ProjectID)
+	if len(keys) == 0 {
+		return nil
+	}
+	limits, err := m.getLimits(ctx, keys...)
+	if err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	// ScheduleTask may be retried, so the task itself is not counted.
+	delete(m.tasks, request.TaskID)
+	requested := quota.Usage{Workers: 1, ResourceUnits: int64(request.Cost)}
+	for _, key := range keys {
+		usage := m.taskUsageLocked(key)
+		usage.StorageBytes = m.storageUsage[key]
+		if err := limits[key].Check(key.scope, key.id, usage, requested); err != nil {
+			return err
+		}
+	}
+	m.tasks[request.TaskID] = &scheduledTask{
+		tenantID:    request.TenantID,
+		projectID:   request.ProjectID,
+		cost:        int64(request.Cost),
+		scheduledAt: m.clocker.Now(),
+	}
+	return nil
+}
+
+// ReleaseTask implements scheduler.QuotaChecker.
+func (m *quotaManager) ReleaseTask(taskID string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.tasks, taskID)
+}
+
+// GetQuota returns the limits and usage of a tenant or a project.
+func (m *quotaManager) GetQuota(ctx context.Context, scope, id string) (*pb.Quota, error) {
+	key, err := parseQuotaKey(scope, id)
+	if err != nil {
+		return nil, err
+	}
+	quotas, err := m.getQuotas(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	return quotas[0], nil
+}
+
+// SetQuota sets the limits of a tenant or a project.
+func (m *quotaManager) SetQuota(ctx context.Context, scope, id string, limits *pb.QuotaLimits) (*pb.Quota, error) {
+	key, err := parseQuotaKey(scope, id)
+	if err != nil {
+		return nil, err
+	}
+	if limits.GetMaxJobs() < 0 || limits.GetMaxWorkers() < 0 ||
+		limits.GetMaxResourceUnits() < 0 || limits.GetMaxStorageBytes() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "quota limits must not be negative")
+	}
+	if err := m.metaClient.UpsertQuotaLimit(ctx, &ormModel.QuotaLimit{
+		Scope:            string(key.scope),
+		ScopeID:          key.id,
+		MaxJobs:          limits.GetMaxJobs(),
+		MaxWorkers:       limits.GetMaxWorkers(),
+		MaxResourceUnits: limits.GetMaxResourceUnits(),
+		MaxStorageBytes:  limits.GetMaxStorageBytes(),
+	}); err != nil {
+		return nil, err
+	}
+	log.Info("quota limits updated", zap.String("scope", scope),
+		zap.String("id", id), zap.Any("limits", limits))
+
+	quotas, err := m.getQuotas(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	return quotas[0], nil
+}
+
+// getQuotas returns the limits and usage of the given tenants or projects,
+// in the same order as keys.
+func (m *quotaManager) getQuotas(ctx context.Context, keys ...quotaKey) ([]*pb.Quota, error) {
+	limits, err := m.getLimits(ctx, keys...)
+	if err != nil {
+		return nil, err
+	}
+	usages, err := m.getUsages(ctx, keys...)
+	if err != nil {
+		return nil, err
+	}
+
+	quotas := make([]*pb.Quota, 0, len(keys))
+	for _, key := range keys {
+		limit, usage := limits[key], usages[key]
+		quotas = append(quotas, &pb.Quota{
+			Scope: string(key.scope),
+			Id:    key.id,
+			Limits: &pb.QuotaLimits{
+				MaxJobs:          limit.MaxJobs,
+				MaxWorkers:       limit.MaxWorkers,
+				MaxResourceUnits: limit.MaxResourceUnits,
+				MaxStorageBytes:  limit.MaxStorageBytes,
+			},
+			Usage: &pb.QuotaUsage{
+				Jobs:          usage.Jobs,
+				Workers:       usage.Workers,
+				ResourceUnits: usage.ResourceUnits,
+				StorageBytes:  usage.StorageBytes,
+			},
+		})
+	}
+	return quotas, nil
+}
+
+// getLimits returns the limits of the given keys. Keys without limits
+// are not in the returned map.
+func (m *quotaManager) getLimits(ctx context.Context, keys ...quotaKey) (map[quotaKey]quota.Limits, error) {
+	limits := make(map[quotaKey]quota.Limits, len(keys))
+	for _, key := range keys {
+		limit, err := m.metaClient.GetQuotaLimit(ctx, string(key.scope), key.id)
+		if err != nil {
+			if pkgOrm.IsNotFoundError(err) {
+				continue
+			}
+			return nil, err
+		}
+		limits[key] = quota.Limits{
+			MaxJobs:          limit.MaxJobs,
+			MaxWorkers:       limit.MaxWorkers,
+			MaxResourceUnits: limit.MaxResourceUnits,
+			MaxStorageBytes:  limit.MaxStorageBytes,
+		}
+	}
+	return limits, nil
+}
+
+func (m *quotaManager) getUsages(ctx context.Context, keys ...quotaKey) (map[quotaKey]quota.Usage, error) {
+	jobs, err := m.metaClient.QueryJobs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	usages := make(map[quotaKey]quota.Usage, len(keys))
+	for _, key := range keys {
+		usages[key] = quota.Usage{}
+	}
+	for _, job := range jobs {
+		if !isJobCountedInQuota(job) {
+			continue
+		}
+		for _, key := range quotaKeysOf(job.Ext.TenantID, job.ProjectID) {
+			if usage, ok := usages[key]; ok {
+				usage.Jobs++
+				usages[key] = usage
+			}
+		}
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for key, usage := range usages {
+		usage = usage.Add(m.taskUsageLocked(key))
+		usage.StorageBytes = m.storageUsage[key]
+		usages[key] = usage
+	}
+	return usages, nil
+}
+
+func (m *quotaManager) taskUsageLocked(key quotaKey) quota.Usage {
+	var usage quota.Usage
+	for _, task := range m.tasks {
+		if (key.scope == quota.ScopeTenant && task.tenantID == key.id) ||
+			(key.scope == quota.ScopeProject && task.projectID == key.id) {
+			usage.Workers++
+			usage.ResourceUnits += task.cost
+		}
+	}
+	return usage
+}
+
+// reconcile removes the tasks whose workers have exited, and adds the
+// running workers that are not tracked, e.g. after a failover.
+func (m *quotaManager) reconcile(ctx context.Context) error {
+	jobs, err := m.metaClient.QueryJobs(ctx)
+	if err != nil {
+		return err
+	}
+
+	alive := make(map[string]*scheduledTask)
+	paused := make(map[string]struct{})
+	projectTenants := make(map[string]string)
+	for _, job := range jobs {
+		if !isJobCountedInQuota(job) {
+			continue
+		}
+		projectTenants[job.ProjectID] = job.Ext.TenantID
+		// The master of a paused job has exited, it takes the job quota
+		// but not the worker quota.
+		if job.StatusCode == frameModel.MasterStatusPaused {
+			paused[job.ID] = struct{}{}
+			continue
+		}
+		alive[job.ID] = &scheduledTask{tenantID: job.Ext.TenantID, projectID: job.ProjectID}
+	}
+	for projectID, tenantID := range projectTenants {
+		workers, err := m.metaClient.QueryWorkersByProjectID(ctx, projectID)
+		if err != nil {
+			return err
+		}
+		for _, worker := range workers {
+			if worker.InTerminateState() {
+				continue
+			}
+			alive[worker.ID] = &scheduledTask{tenantID: tenantID, projectID: projectID}
+		}
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := m.clocker.Now()
+	for id, task := range m.tasks {
+		if _, ok := alive[id]; ok {
+			continue
+		}
+		if _, ok := paused[id]; ok {
+			delete(m.tasks, id)
+			continue
+		}
+		if now.Sub(task.scheduledAt) < defaultQuotaTaskGracePeriod {
+			continue
+		}
+		delete(m.tasks, id)
+	}
+	for id, task := range alive {
+		if _, ok := m.tasks[id]; !ok {
+			task.scheduledAt = now
+			m.tasks[id] = task
+		}
+	}
+	return nil
+}
+
+func (m *quotaManager) refreshStorageUsage(ctx context.Context) error {
+	if m.storageSize == nil {
+		return nil
+	}
+	jobs, err := m.metaClient.QueryJobs(ctx)
+	if err != nil {
+		return err
+	}
+	jobTenants := make(map[string]string, len(jobs))
+	for _, job := range jobs {
+		jobTenants[job.ID] = job.Ext.TenantID
+	}
+	resources, err := m.metaClient.QueryResources(ctx)
+	if err != nil {
+		return err
+	}
+
+	usage := make(map[quotaKey]int64)
+	for _, resource := range resources {
+		if resource.Deleted {
+			continue
+		}
+		tp, _, err := resModel.ParseResourcePath(resource.ID)
+		if err != nil || tp != resModel.ResourceTypeS3 {
+			continue
+		}
+		size, err := m.storageSize(ctx, resource)
+		if err != nil {
+			log.Warn("failed to get the storage size of resource",
+				zap.String("job-id", resource.Job),
+				zap.String("resource-id", resource.ID),
+				zap.Error(err))
+			continue
+		}
+		for _, key := range quotaKeysOf(jobTenants[resource.Job], resource.ProjectID) {
+			usage[key] += size
+		}
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.storageUsage = usage
+	return nil
+}
+
+// isJobCountedInQuota returns whether a job takes the job quota. Paused
+// jobs are counted because they can be resumed at any time, but their
+// masters are not counted as workers.
+func isJobCountedInQuota(job *frameModel.MasterMetaKVData) bool {
+	if job.Tp == framework.JobManager {
+		return false
+	}
+	switch job.StatusCode {
+	case frameModel.MasterStatusFinished, frameModel.MasterStatusStopped, frameModel.MasterStatusFailed:
+		return false
+	default:
+		return true
+	}
+}
+
+func quotaKeysOf(tenantID, projectID string) []quotaKey {
+	var keys []quotaKey
+	if tenantID != "" {
+		keys = append(keys, quotaKey{scope: quota.ScopeTenant, id: tenantID})
+	}
+	if projectID != "" {
+		keys = append(keys, quotaKey{scope: quota.ScopeProject, id: projectID})
+	}
+	return keys
+}
+
+func parseQuotaKey(scope, id string) (quotaKey, error) {
+	switch quota.Scope(scope) {
+	case quota.ScopeTenant, quota.ScopeProject:
+	default:
+		return quotaKey{}, status.Errorf(codes.InvalidArgument,
+			"quota scope must be %q or %q, got %q", quota.ScopeTenant, quota.ScopeProject, scope)
+	}
+	if id == "" {
+		return quotaKey{}, status.Error(codes.InvalidArgument, "quota id must not be empty")
+	}
+	return quotaKey{scope: quota.Scope(scope), id: id}, nil
+}
@@ -0,0 +1,243 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package servermaster
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "github.com/pingcap/tiflow/engine/enginepb"
+	"github.com/pingcap/tiflow/engine/framework"
+	frameModel "github.com/pingcap/tiflow/engine/framework/model"
+	"github.com/pingcap/tiflow/engine/pkg/clock"
+	resModel "github.com/pingcap/tiflow/engine/pkg/externalresource/resourcemeta/model"
+	pkgOrm "github.com/pingcap/tiflow/engine/pkg/orm"
+	"github.com/pingcap/tiflow/engine/pkg/quota"
+	schedModel "github.com/pingcap/tiflow/engine/servermaster/scheduler/model"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func newQuotaManagerForTest(t *testing.T, storageSize storageSizeFunc) (*quotaManager, pkgOrm.Client) {
+	metaCli, err := pkgOrm.NewMockClient()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = metaCli.Close()
+	})
+	return newQuotaManager(metaCli, storageSize), metaCli
+}
+
+func upsertJobForQuotaTest(
+	t *testing.T, metaCli pkgOrm.Client, id, tenantID, projectID string, code frameModel.MasterStatusCode,
+) {
+	err := metaCli.UpsertJob(context.Background(), &frameModel.MasterMetaKVData{
+		ID:         id,
+		ProjectID:  projectID,
+		Tp:         framework.FakeJobMaster,
+		StatusCode: code,
+		Ext:        frameModel.MasterMetaExt{TenantID: tenantID},
+	})
+	require.NoError(t, err)
+}
+
+func TestQuotaManagerSetAndGetQuota(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	mgr, metaCli := newQuotaManagerForTest(t, nil)
+
+	_, err := mgr.GetQuota(ctx, "cluster", "c1")
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = mgr.SetQuota(ctx, "project", "", &pb.QuotaLimits{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = mgr.SetQuota(ctx, "project", "p1", &pb.QuotaLimits{MaxJobs: -1})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// No limits by default.
+	q, err := mgr.GetQuota(ctx, "project", "p1")
+	require.NoError(t, err)
+	require.Equal(t, &pb.QuotaLimits{}, q.Limits)
+
+	upsertJobForQuotaTest(t, metaCli, "job-1", "t1", "p1", frameModel.MasterStatusInit)
+	upsertJobForQuotaTest(t, metaCli, "job-2", "t1", "p2", frameModel.MasterStatusPaused)
+	upsertJobForQuotaTest(t, metaCli, "job-3", "t1", "p1", frameModel.MasterStatusFinished)
+	require.NoError(t, mgr.ReserveTask(ctx, &schedModel.SchedulerRequest{
+		TaskID: "task-1", TenantID: "t1", ProjectID: "p1", Cost: 5,
+	}))
+
+	q, err = mgr.SetQuota(ctx, "project", "p1", &pb.QuotaLimits{MaxJobs: 3, MaxWorkers: 2})
+	require.NoError(t, err)
+	require.Equal(t, "project", q.Scope)
+	require.Equal(t, "p1", q.Id)
+	require.Equal(t, &pb.QuotaLimits{MaxJobs: 3, MaxWorkers: 2}, q.Limits)
+	require.Equal(t, &pb.QuotaUsage{Jobs: 1, Workers: 1, ResourceUnits: 5}, q.Usage)
+
+	q, err = mgr.GetQuota(ctx, "tenant", "t1")
+	require.NoError(t, err)
+	require.Equal(t, &pb.QuotaUsage{Jobs: 2, Workers: 1, ResourceUnits: 5}, q.Usage)
+}
+
+func TestQuotaManagerCheckCreateJob(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	mgr, metaCli := newQuotaManagerForTest(t, nil)
+
+	upsertJobForQuotaTest(t, metaCli, "job-1", "t1", "p1", frameModel.MasterStatusInit)
+	upsertJobForQuotaTest(t, metaCli, "job-2", "t1", "p1", frameModel.MasterStatusStopped)
+	upsertJobForQuotaTest(t, metaCli, "job-3", "t1", "p2", frameModel.MasterStatusUninit)
+	require.NoError(t, mgr.CheckCreateJob(ctx, "t1", "p1"))
+
+	_, err := mgr.SetQuota(ctx, "project", "p1", &pb.QuotaLimits{MaxJobs: 1})
+	require.NoError(t, err)
+	err = mgr.CheckCreateJob(ctx, "t1", "p1")
+	detail, ok := quota.ErrQuotaExceeded.Convert(err)
+	require.True(t, ok)
+	require.Equal(t, quota.ScopeProject, detail.Scope)
+	require.Equal(t, "p1", detail.ScopeID)
+	require.Equal(t, quota.ResourceJobs, detail.Resource)
+	require.NoError(t, mgr.CheckCreateJob(ctx, "t1", "p2"))
+
+	_, err = mgr.SetQuota(ctx, "tenant", "t1", &pb.QuotaLimits{MaxJobs: 2})
+	require.NoError(t, err)
+	err = mgr.CheckCreateJob(ctx, "t1", "p2")
+	detail, ok = quota.ErrQuotaExceeded.Convert(err)
+	require.True(t, ok)
+	require.Equal(t, quota.ScopeTenant, detail.Scope)
+	require.Equal(t, int64(2), detail.Used)
+	require.NoError(t, mgr.CheckCreateJob(ctx, "t2", "p2"))
+}
+
+func TestQuotaManagerReserveTask(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	mgr, _ := newQuotaManagerForTest(t, nil)
+	_, err := mgr.SetQuota(ctx, "project", "p1", &pb.QuotaLimits{MaxWorkers: 2, MaxResourceUnits: 10})
+	require.NoError(t, err)
+
+	req := &schedModel.SchedulerRequest{TaskID: "task-1", TenantID: "t1", ProjectID: "p1", Cost: 6}
+	require.NoError(t, mgr.ReserveTask(ctx, req))
+	// Retrying the same task is not counted twice.
+	require.NoError(t, mgr.ReserveTask(ctx, req))
+
+	err = mgr.ReserveTask(ctx, &schedModel.SchedulerRequest{
+		TaskID: "task-2", TenantID: "t1", ProjectID: "p1", Cost: 6,
+	})
+	detail, ok := quota.ErrQuotaExceeded.Convert(err)
+	require.True(t, ok)
+	require.Equal(t, quota.ResourceUnits, detail.Resource)
+	require.NoError(t, mgr.ReserveTask(ctx, &schedModel.SchedulerRequest{
+		TaskID: "task-2", TenantID: "t1", ProjectID: "p1", Cost: 4,
+	}))
+	err = mgr.ReserveTask(ctx, &schedModel.SchedulerRequest{
+		TaskID: "task-3", TenantID: "t1", ProjectID: "p1", Cost: 0,
+	})
+	detail, ok = quota.ErrQuotaExceeded.Convert(err)
+	require.True(t, ok)
+	require.Equal(t, quota.ResourceWorkers, detail.Resource)
+
+	// Other projects are not affected.
+	require.NoError(t, mgr.ReserveTask(ctx, &schedModel.SchedulerRequest{
+		TaskID: "task-4", TenantID: "t1", ProjectID: "p2", Cost: 100,
+	}))
+
+	mgr.ReleaseTask("task-1")
+	require.NoError(t, mgr.ReserveTask(ctx, &schedModel.SchedulerRequest{
+		TaskID: "task-3", TenantID: "t1", ProjectID: "p1", Cost: 6,
+	}))
+}
+
+func TestQuotaManagerReconcile(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	mgr, metaCli := newQuotaManagerForTest(t, nil)
+	mockClock := clock.NewMock()
+	mockClock.Set(time.Now())
+	mgr.clocker = mockClock
+
+	upsertJobForQuotaTest(t, metaCli, "job-1", "t1", "p1", frameModel.MasterStatusInit)
+	for _, worker := range []*frameModel.WorkerStatus{
+		{ProjectID: "p1", JobID: "job-1", ID: "worker-1", Code: frameModel.WorkerStatusNormal},
+		{ProjectID: "p1", JobID: "job-1", ID: "worker-2", Code: frameModel.WorkerStatusFinished},
+	} {
+		require.NoError(t, metaCli.UpsertWorker(ctx, worker))
+	}
+
+	require.NoError(t, mgr.ReserveTask(ctx, &schedModel.SchedulerRequest{
+		TaskID: "worker-1", TenantID: "t1", ProjectID: "p1", Cost: 3,
+	}))
+	require.NoError(t, mgr.ReserveTask(ctx, &schedModel.SchedulerRequest{
+		TaskID: "worker-2", TenantID: "t1", ProjectID: "p1", Cost: 3,
+	}))
+	require.NoError(t, mgr.ReserveTask(ctx, &schedModel.SchedulerRequest{
+		TaskID: "worker-3", TenantID: "t1", ProjectID: "p1", Cost: 3,
+	}))
+
+	// The tasks are kept within the grace period.
+	require.NoError(t, mgr.reconcile(ctx))
+	require.Len(t, mgr.tasks, 4)
+
+	// The job master and the running worker are kept.
+	mockClock.Add(defaultQuotaTaskGracePeriod)
+	require.NoError(t, mgr.reconcile(ctx))
+	require.Len(t, mgr.tasks, 2)
+	require.Equal(t, int64(3), mgr.tasks["worker-1"].cost)
+	require.Equal(t, int64(0), mgr.tasks["job-1"].cost)
+	require.Equal(t, "t1", mgr.tasks["job-1"].tenantID)
+
+	// The master of a paused job is released at once, but the job is still
+	// counted.
+	upsertJobForQuotaTest(t, metaCli, "job-1", "t1", "p1", frameModel.MasterStatusPaused)
+	require.NoError(t, mgr.reconcile(ctx))
+	require.Len(t, mgr.tasks, 1)
+	require.Contains(t, mgr.tasks, "worker-1")
+	q, err := mgr.GetQuota(ctx, "project", "p1")
+	require.NoError(t, err)
+	require.Equal(t, &pb.QuotaUsage{Jobs: 1, Workers: 1, ResourceUnits: 3}, q.Usage)
+}
+
+func TestQuotaManagerStorageUsage(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	mgr, metaCli := newQuotaManagerForTest(t, func(_ context.Context, resource *resModel.ResourceMeta) (int64, error) {
+		return int64(len(resource.ID)), nil
+	})
+
+	upsertJobForQuotaTest(t, metaCli, "job-1", "t1", "p1", frameModel.MasterStatusInit)
+	for _, resource := range []*resModel.ResourceMeta{
+		{ProjectID: "p1", Job: "job-1", ID: "/s3/r1", Worker: "w1", Executor: "e1"},
+		{ProjectID: "p1", Job: "job-1", ID: "/s3/r2", Worker: "w1", Executor: "e1", Deleted: true},
+		{ProjectID: "p1", Job: "job-1", ID: "/local/r3", Worker: "w1", Executor: "e1"},
+	} {
+		require.NoError(t, metaCli.UpsertResource(ctx, resource))
+	}
+	require.NoError(t, mgr.refreshStorageUsage(ctx))
+
+	q, err := mgr.GetQuota(ctx, "project", "p1")
+	require.NoError(t, err)
+	require.Equal(t, int64(len("/s3/r1")), q.Usage.StorageBytes)
+	q, err = mgr.GetQuota(ctx, "tenant", "t1")
+	require.NoError(t, err)
+	require.Equal(t, int64(len("/s3/r1")), q.Usage.StorageBytes)
+
+	_, err = mgr.SetQuota(ctx, "project", "p1", &pb.QuotaLimits{MaxStorageBytes: 1})
+	require.NoError(t, err)
+	err = mgr.ReserveTask(ctx, &schedModel.SchedulerRequest{TaskID: "task-1", ProjectID: "p1"})
+	require.True(t, quota.ErrQuotaExceeded.Is(err))
+}
@@ -0,0 +1,194 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scheduler
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/engine/model"
+	"github.com/pingcap/tiflow/engine/pkg/clock"
+	schedModel "github.com/pingcap/tiflow/engine/servermaster/scheduler/model"
+	"go.uber.org/zap"
+)
+
+const (
+	// softAntiAffinityWeight is deducted from the score of an executor for
+	// every task of the same anti-affinity group on it. It equals the max
+	// weight of a preference, so spreading tasks outweighs any single
+	// preference.
+	softAntiAffinityWeight = schedModel.MaxPreferenceWeight
+
+	// recentPlacementTTL is how long a placement made by the scheduler is
+	// remembered. The placement is reported by the job master in the
+	// anti-affinity executors after the worker is online, the scheduler only
+	// needs to remember it for the workers being created concurrently.
+	recentPlacementTTL = time.Minute
+)
+
+type recentPlacement struct {
+	executorID  model.ExecutorID
+	scheduledAt time.Time
+}
+
+// placementTracker remembers the recent placements of the tasks in
+// anti-affinity groups.
+type placementTracker struct {
+	mu      sync.Mutex
+	clocker clock.Clock
+	// groups maps a group to the placements of its tasks, keyed by task ID.
+	groups map[string]map[string]recentPlacement
+}
+
+func newPlacementTracker() *placementTracker {
+	return &placementTracker{
+		clocker: clock.New(),
+		groups:  make(map[string]map[string]recentPlacement),
+	}
+}
+
+// Record remembers that the task has been scheduled to the executor.
+func (t *placementTracker) Record(request *schedModel.SchedulerRequest, executorID model.ExecutorID) {
+	if request.AntiAffinity == nil {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.gcLocked()
+	group := request.AntiAffinity.Group
+	if t.groups[group] == nil {
+		t.groups[group] = make(map[string]recentPlacement)
+	}
+	t.groups[group][request.TaskID] = recentPlacement{
+		executorID:  executorID,
+		scheduledAt: t.clocker.Now(),
+	}
+}
+
+// TaskCounts returns the number of tasks of the anti-affinity group of the
+// request on each executor, including the ones reported in the request and
+// the ones scheduled recently. Each task is counted once, the executor
+// reported in the request takes precedence. A task being rescheduled is not
+// counted.
+func (t *placementTracker) TaskCounts(request *schedModel.SchedulerRequest) map[model.ExecutorID]int {
+	if request.AntiAffinity == nil {
+		return nil
+	}
+
+	counts := make(map[model.ExecutorID]int)
+	for taskID, executorID := range request.AntiAffinity.TaskExecutors {
+		if taskID == request.TaskID {
+			continue
+		}
+		counts[executorID]++
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.gcLocked()
+	for taskID, placement := range t.groups[request.AntiAffinity.Group] {
+		if taskID == request.TaskID {
+			continue
+		}
+		if _, ok := request.AntiAffinity.TaskExecutors[taskID]; ok {
+			continue
+		}
+		counts[placement.executorID]++
+	}
+	return counts
+}
+
+func (t *placementTracker) gcLocked() {
+	now := t.clocker.Now()
+	for group, placements := range t.groups {
+		for taskID, placement := range placements {
+			if now.Sub(placement.scheduledAt) >= recentPlacementTTL {
+				delete(placements, taskID)
+			}
+		}
+		if len(placements) == 0 {
+			delete(t.groups, group)
+		}
+	}
+}
+
+// antiAffinityFilter filters out the executors running tasks of the same
+// group if the anti-affinity of the request is required.
+type antiAffinityFilter struct {
+	tracker *placementTracker
+}
+
+func newAntiAffinityFilter(tracker *placementTracker) *antiAffinityFilter {
+	return &antiAffinityFilter{tracker: tracker}
+}
+
+func (f *antiAffinityFilter) GetEligibleExecutors(
+	ctx context.Context, request *schedModel.SchedulerRequest, candidates []model.ExecutorID,
+) ([]model.ExecutorID, error) {
+	if request.AntiAffinity == nil || !request.AntiAffinity.Required {
+		return candidates, nil
+	}
+
+	counts := f.tracker.TaskCounts(request)
+	ret := make([]model.ExecutorID, 0, len(candidates))
+	for _, id := range candidates {
+		if counts[id] > 0 {
+			continue
+		}
+		ret = append(ret, id)
+	}
+
+	if len(ret) == 0 {
+		log.Info("all candidate executors are running tasks of the anti-affinity group",
+			zap.Any("candidates", candidates),
+			zap.Any("request", request))
+		return nil, ErrFilterNoResult.GenWithStack(&FilterNoResultError{
+			FilterName:      "anti-affinity",
+			InputCandidates: candidates,
+		})
+	}
+	return ret, nil
+}
+
+// scoreCandidates scores the candidates by the soft affinity and soft
+// anti-affinity of the request. It returns nil if the request has neither.
+func scoreCandidates(
+	request *schedModel.SchedulerRequest,
+	candidates []model.ExecutorID,
+	infos map[model.ExecutorID]schedModel.ExecutorInfo,
+	tracker *placementTracker,
+) map[model.ExecutorID]int64 {
+	if len(request.Preferences) == 0 && request.AntiAffinity == nil {
+		return nil
+	}
+
+	counts := tracker.TaskCounts(request)
+	scores := make(map[model.ExecutorID]int64, len(candidates))
+	for _, id := range candidates {
+		var score int64
+		for _, pref := range request.Preferences {
+			if pref.Selector.Matches(infos[id].Labels) {
+				score += pref.Weight
+			}
+		}
+		score -= int64(counts[id]) * softAntiAffinityWeight
+		scores[id] = score
+	}
+	return scores
+}
@@ -0,0 +1,223 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scheduler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pingcap/tiflow/engine/model"
+	"github.com/pingcap/tiflow/engine/pkg/clock"
+	schedModel "github.com/pingcap/tiflow/engine/servermaster/scheduler/model"
+	"github.com/pingcap/tiflow/pkg/label"
+	"github.com/stretchr/testify/require"
+)
+
+func newPlacementTrackerForTest() (*placementTracker, *clock.Mock) {
+	tracker := newPlacementTracker()
+	clk := clock.NewMock()
+	tracker.clocker = clk
+	return tracker, clk
+}
+
+func TestPlacementTracker(t *testing.T) {
+	t.Parallel()
+
+	tracker, clk := newPlacementTrackerForTest()
+	antiAffinity := &schedModel.AntiAffinity{
+		Group:         "job-1",
+		TaskExecutors: map[string]model.ExecutorID{"task-0": "executor-1"},
+	}
+
+	// requests without anti-affinity are not tracked.
+	tracker.Record(&schedModel.SchedulerRequest{TaskID: "task-0"}, "executor-2")
+	require.Empty(t, tracker.groups)
+	require.Nil(t, tracker.TaskCounts(&schedModel.SchedulerRequest{TaskID: "task-0"}))
+
+	tracker.Record(&schedModel.SchedulerRequest{TaskID: "task-1", AntiAffinity: antiAffinity}, "executor-2")
+	tracker.Record(&schedModel.SchedulerRequest{TaskID: "task-2", AntiAffinity: antiAffinity}, "executor-2")
+	tracker.Record(&schedModel.SchedulerRequest{
+		TaskID:       "task-3",
+		AntiAffinity: &schedModel.AntiAffinity{Group: "job-2"},
+	}, "executor-3")
+
+	counts := tracker.TaskCounts(&schedModel.SchedulerRequest{TaskID: "task-4", AntiAffinity: antiAffinity})
+	require.Equal(t, map[model.ExecutorID]int{"executor-1": 1, "executor-2": 2}, counts)
+	// a task being rescheduled doesn't count itself.
+	counts = tracker.TaskCounts(&schedModel.SchedulerRequest{TaskID: "task-1", AntiAffinity: antiAffinity})
+	require.Equal(t, map[model.ExecutorID]int{"executor-1": 1, "executor-2": 1}, counts)
+
+	// a task reported in the request is counted once.
+	reported := &schedModel.AntiAffinity{
+		Group:         "job-1",
+		TaskExecutors: map[string]model.ExecutorID{"task-0": "executor-1", "task-2": "executor-2"},
+	}
+	counts = tracker.TaskCounts(&schedModel.SchedulerRequest{TaskID: "task-4", AntiAffinity: reported})
+	require.Equal(t, map[model.ExecutorID]int{"executor-1": 1, "executor-2": 2}, counts)
+	// the reported executor takes precedence over the recent placement.
+	reported.TaskExecutors["task-1"] = "executor-3"
+	counts = tracker.TaskCounts(&schedModel.SchedulerRequest{TaskID: "task-4", AntiAffinity: reported})
+	require.Equal(t, map[model.ExecutorID]int{"executor-1": 1, "executor-2": 1, "executor-3": 1}, counts)
+	// a task being rescheduled doesn't count its reported executor either.
+	counts = tracker.TaskCounts(&schedModel.SchedulerRequest{TaskID: "task-0", AntiAffinity: reported})
+	require.Equal(t, map[model.ExecutorID]int{"executor-2": 1, "executor-3": 1}, counts)
+
+	// recent placements expire.
+	clk.Add(recentPlacementTTL)
+	counts = tracker.TaskCounts(&schedModel.SchedulerRequest{TaskID: "task-4", AntiAffinity: antiAffinity})
+	require.Equal(t, map[model.ExecutorID]int{"executor-1": 1}, counts)
+	require.Empty(t, tracker.groups)
+}
+
+func TestAntiAffinityFilter(t *testing.T) {
+	t.Parallel()
+
+	tracker, _ := newPlacementTrackerForTest()
+	filter := newAntiAffinityFilter(tracker)
+	candidates := []model.ExecutorID{"executor-1", "executor-2", "executor-3"}
+
+	// soft anti-affinity doesn't filter executors.
+	ret, err := filter.GetEligibleExecutors(context.Background(), &schedModel.SchedulerRequest{
+		AntiAffinity: &schedModel.AntiAffinity{
+			Group: "job-1",
+			TaskExecutors: map[string]model.ExecutorID{
+				"task-3": "executor-1", "task-4": "executor-2", "task-5": "executor-3",
+			},
+		},
+	}, candidates)
+	require.NoError(t, err)
+	require.Equal(t, candidates, ret)
+
+	tracker.Record(&schedModel.SchedulerRequest{
+		TaskID:       "task-1",
+		AntiAffinity: &schedModel.AntiAffinity{Group: "job-1"},
+	}, "executor-3")
+	ret, err = filter.GetEligibleExecutors(context.Background(), &schedModel.SchedulerRequest{
+		TaskID: "task-2",
+		AntiAffinity: &schedModel.AntiAffinity{
+			Group:         "job-1",
+			TaskExecutors: map[string]model.ExecutorID{"task-3": "executor-1"},
+			Required:      true,
+		},
+	}, candidates)
+	require.NoError(t, err)
+	require.Equal(t, []model.ExecutorID{"executor-2"}, ret)
+
+	_, err = filter.GetEligibleExecutors(context.Background(), &schedModel.SchedulerRequest{
+		TaskID: "task-2",
+		AntiAffinity: &schedModel.AntiAffinity{
+			Group:         "job-1",
+			TaskExecutors: map[string]model.ExecutorID{"task-3": "executor-1", "task-4": "executor-2"},
+			Required:      true,
+		},
+	}, candidates)
+	require.Error(t, err)
+	require.True(t, ErrFilterNoResult.Is(err))
+}
+
+func TestScoreCandidates(t *testing.T) {
+	t.Parallel()
+
+	tracker, _ := newPlacementTrackerForTest()
+	infos := map[model.ExecutorID]schedModel.ExecutorInfo{
+		"executor-1": {ID: "executor-1", Labels: label.Set{"zone": "z1", "disk": "ssd"}},
+		"executor-2": {ID: "executor-2", Labels: label.Set{"zone": "z2", "disk": "ssd"}},
+		"executor-3": {ID: "executor-3", Labels: label.Set{"zone": "z3"}},
+	}
+	candidates := []model.ExecutorID{"executor-1", "executor-2", "executor-3"}
+
+	require.Nil(t, scoreCandidates(&schedModel.SchedulerRequest{}, candidates, infos, tracker))
+
+	request := &schedModel.SchedulerRequest{
+		Preferences: []schedModel.SchedulePreference{
+			{Selector: label.Selector{Key: "disk", Target: "ssd", Op: label.OpEq}, Weight: 10},
+			{Selector: label.Selector{Key: "zone", Target: "z1", Op: label.OpEq}, Weight: 5},
+		},
+	}
+	scores := scoreCandidates(request, candidates, infos, tracker)
+	require.Equal(t, map[model.ExecutorID]int64{
+		"executor-1": 15,
+		"executor-2": 10,
+		"executor-3": 0,
+	}, scores)
+
+	request.AntiAffinity = &schedModel.AntiAffinity{
+		Group:         "job-1",
+		TaskExecutors: map[string]model.ExecutorID{"task-1": "executor-1"},
+	}
+	scores = scoreCandidates(request, candidates, infos, tracker)
+	require.Equal(t, map[model.ExecutorID]int64{
+		"executor-1": 15 - softAntiAffinityWeight,
+		"executor-2": 10,
+		"executor-3": 0,
+	}, scores)
+}
+
+func TestSchedulerAffinity(t *testing.T) {
+	t.Parallel()
+
+	infoProvider := getMockDataForScheduler()
+	for id, info := range infoProvider.infos {
+		info.Labels = label.Set{"name": label.Value(id)}
+		infoProvider.infos[id] = info
+	}
+	sched := NewScheduler(
+		infoProvider,
+		getMockResourceConstraintForScheduler(),
+		nil)
+
+	// the preferred executor is picked.
+	for i := 0; i < 10; i++ {
+		resp, err := sched.ScheduleTask(context.Background(), &schedModel.SchedulerRequest{
+			Cost: 10,
+			Preferences: []schedModel.SchedulePreference{{
+				Selector: label.Selector{Key: "name", Target: "executor-2", Op: label.OpEq},
+				Weight:   1,
+			}},
+		})
+		require.NoError(t, err)
+		require.Equal(t, model.ExecutorID("executor-2"), resp.ExecutorID)
+	}
+
+	// the workers of the same job are spread across executors, even if they
+	// are scheduled before any of them is online.
+	scheduled := make(map[model.ExecutorID]struct{})
+	for _, taskID := range []string{"task-1", "task-2", "task-3"} {
+		resp, err := sched.ScheduleTask(context.Background(), &schedModel.SchedulerRequest{
+			TaskID:       taskID,
+			Cost:         10,
+			AntiAffinity: &schedModel.AntiAffinity{Group: "job-1"},
+		})
+		require.NoError(t, err)
+		scheduled[resp.ExecutorID] = struct{}{}
+	}
+	require.Len(t, scheduled, 3)
+
+	// soft anti-affinity allows sharing an executor.
+	_, err := sched.ScheduleTask(context.Background(), &schedModel.SchedulerRequest{
+		TaskID:       "task-4",
+		Cost:         10,
+		AntiAffinity: &schedModel.AntiAffinity{Group: "job-1"},
+	})
+	require.NoError(t, err)
+
+	// required anti-affinity doesn't.
+	_, err = sched.ScheduleTask(context.Background(), &schedModel.SchedulerRequest{
+		TaskID:       "task-5",
+		Cost:         10,
+		AntiAffinity: &schedModel.AntiAffinity{Group: "job-1", Required: true},
+	})
+	require.Error(t, err)
+	require.True(t, ErrFilterNoResult.Is(err))
+}
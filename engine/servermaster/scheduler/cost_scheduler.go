@@ -15,6 +15,7 @@ package scheduler
 
 import (
 	"math/rand"
+	"sort"
 	"time"
 
 	"github.com/pingcap/tiflow/engine/model"
@@ -45,11 +46,27 @@ func NewDeterministicCostScheduler(infoProvider executorInfoProvider, seed int64
 
 // ScheduleByCost is a native random based scheduling strategy
 func (s *costScheduler) ScheduleByCost(cost schedModel.ResourceUnit, candidates []model.ExecutorID) (model.ExecutorID, bool) {
+	return s.ScheduleByCostAndScore(cost, candidates, nil)
+}
+
+// ScheduleByCostAndScore picks the executor with the highest score among
+// those that have enough capacity. Executors with the same score are picked
+// randomly. A nil scores map is treated as all zeros.
+func (s *costScheduler) ScheduleByCostAndScore(
+	cost schedModel.ResourceUnit,
+	candidates []model.ExecutorID,
+	scores map[model.ExecutorID]int64,
+) (model.ExecutorID, bool) {
 	infos := s.infoProvider.GetExecutorInfos()
 	// TODO optimize for performance if the need arises.
 	s.random.Shuffle(len(candidates), func(i, j int) {
 		candidates[i], candidates[j] = candidates[j], candidates[i]
 	})
+	if len(scores) > 0 {
+		sort.SliceStable(candidates, func(i, j int) bool {
+			return scores[candidates[i]] > scores[candidates[j]]
+		})
+	}
 
 	for _, executorID := range candidates {
 		if infos[executorID].ResourceStatus.Remaining() > cost {
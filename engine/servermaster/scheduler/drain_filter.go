@@ -0,0 +1,59 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scheduler
+
+import (
+	"context"
+
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/engine/model"
+	schedModel "github.com/pingcap/tiflow/engine/servermaster/scheduler/model"
+	"go.uber.org/zap"
+)
+
+// drainFilter filters out the executors that are being drained.
+// It runs after the resourceFilter, so a task that requires local files
+// on a draining executor fails to be scheduled, instead of being placed
+// on an executor that doesn't have the files.
+type drainFilter struct {
+	infoProvider executorInfoProvider
+}
+
+func newDrainFilter(infoProvider executorInfoProvider) *drainFilter {
+	return &drainFilter{infoProvider: infoProvider}
+}
+
+func (f *drainFilter) GetEligibleExecutors(
+	ctx context.Context, request *schedModel.SchedulerRequest, candidates []model.ExecutorID,
+) ([]model.ExecutorID, error) {
+	allExecutors := f.infoProvider.GetExecutorInfos()
+	ret := make([]model.ExecutorID, 0, len(candidates))
+	for _, id := range candidates {
+		if info, ok := allExecutors[id]; ok && info.Draining {
+			continue
+		}
+		ret = append(ret, id)
+	}
+
+	if len(ret) == 0 {
+		log.Info("all candidate executors are draining",
+			zap.Any("candidates", candidates),
+			zap.Any("request", request))
+		return nil, ErrFilterNoResult.GenWithStack(&FilterNoResultError{
+			FilterName:      "drain",
+			InputCandidates: candidates,
+		})
+	}
+	return ret, nil
+}
@@ -29,4 +29,8 @@ type ExecutorInfo struct {
 
 	// Labels stores the labels configured for the given executor.
 	Labels label.Set
+
+	// Draining indicates that the executor is being drained,
+	// and no new task should be scheduled to it.
+	Draining bool
 }
@@ -0,0 +1,109 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tiflow/engine/enginepb"
+	"github.com/pingcap/tiflow/engine/model"
+	resModel "github.com/pingcap/tiflow/engine/pkg/externalresource/resourcemeta/model"
+	"github.com/pingcap/tiflow/pkg/label"
+)
+
+var selectorOpToPB = map[label.Op]enginepb.Selector_Op{
+	label.OpEq:    enginepb.Selector_Eq,
+	label.OpNeq:   enginepb.Selector_Neq,
+	label.OpRegex: enginepb.Selector_Regex,
+}
+
+var selectorOpFromPB = map[enginepb.Selector_Op]label.Op{
+	enginepb.Selector_Eq:    label.OpEq,
+	enginepb.Selector_Neq:   label.OpNeq,
+	enginepb.Selector_Regex: label.OpRegex,
+}
+
+// SelectorToPB converts a label selector to its protobuf representation.
+func SelectorToPB(selector *label.Selector) *enginepb.Selector {
+	return &enginepb.Selector{
+		Label:  string(selector.Key),
+		Target: selector.Target,
+		Op:     selectorOpToPB[selector.Op],
+	}
+}
+
+// SelectorFromPB converts a protobuf selector to a validated label selector.
+func SelectorFromPB(selector *enginepb.Selector) (*label.Selector, error) {
+	op, ok := selectorOpFromPB[selector.GetOp()]
+	if !ok {
+		return nil, errors.Errorf("invalid selector op: %s", selector.GetOp())
+	}
+	ret := &label.Selector{
+		Key:    label.Key(selector.GetLabel()),
+		Target: selector.GetTarget(),
+		Op:     op,
+	}
+	if err := ret.Validate(); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// NewSchedulerRequestFromPB creates a SchedulerRequest from a ScheduleTaskRequest.
+// An error is returned if the request contains invalid selectors.
+func NewSchedulerRequestFromPB(req *enginepb.ScheduleTaskRequest) (*SchedulerRequest, error) {
+	ret := &SchedulerRequest{
+		TaskID:            req.GetTaskId(),
+		TenantID:          req.GetTenantId(),
+		ProjectID:         req.GetProjectId(),
+		Cost:              ResourceUnit(req.GetCost()),
+		ExternalResources: resModel.ToResourceKeys(req.GetResourceRequirements()),
+	}
+	for _, pbSelector := range req.GetSelectors() {
+		selector, err := SelectorFromPB(pbSelector)
+		if err != nil {
+			return nil, err
+		}
+		ret.Selectors = append(ret.Selectors, *selector)
+	}
+	for _, pref := range req.GetPreferences() {
+		if pref.GetSelector() == nil {
+			return nil, errors.New("preference without selector")
+		}
+		if pref.GetWeight() < MinPreferenceWeight || pref.GetWeight() > MaxPreferenceWeight {
+			return nil, errors.Errorf("preference weight %d is not in [%d, %d]",
+				pref.GetWeight(), MinPreferenceWeight, MaxPreferenceWeight)
+		}
+		selector, err := SelectorFromPB(pref.GetSelector())
+		if err != nil {
+			return nil, err
+		}
+		ret.Preferences = append(ret.Preferences, SchedulePreference{
+			Selector: *selector,
+			Weight:   pref.GetWeight(),
+		})
+	}
+	if antiAffinity := req.GetAntiAffinity(); antiAffinity != nil && antiAffinity.GetGroup() != "" {
+		ret.AntiAffinity = &AntiAffinity{
+			Group:    antiAffinity.GetGroup(),
+			Required: antiAffinity.GetRequired(),
+		}
+		for taskID, executorID := range antiAffinity.GetTaskExecutors() {
+			if ret.AntiAffinity.TaskExecutors == nil {
+				ret.AntiAffinity.TaskExecutors = make(map[string]model.ExecutorID)
+			}
+			ret.AntiAffinity.TaskExecutors[taskID] = model.ExecutorID(executorID)
+		}
+	}
+	return ret, nil
+}
@@ -0,0 +1,94 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/pingcap/tiflow/engine/enginepb"
+	"github.com/pingcap/tiflow/engine/model"
+	"github.com/pingcap/tiflow/pkg/label"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSelectorPBRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	for _, op := range []label.Op{label.OpEq, label.OpNeq, label.OpRegex} {
+		selector := &label.Selector{Key: "zone", Target: "z.*", Op: op}
+		ret, err := SelectorFromPB(SelectorToPB(selector))
+		require.NoError(t, err)
+		require.Equal(t, selector.Key, ret.Key)
+		require.Equal(t, selector.Target, ret.Target)
+		require.Equal(t, selector.Op, ret.Op)
+	}
+
+	_, err := SelectorFromPB(&enginepb.Selector{Label: "zone", Target: "z1"})
+	require.ErrorContains(t, err, "invalid selector op")
+	_, err = SelectorFromPB(&enginepb.Selector{Label: "zone", Target: "(", Op: enginepb.Selector_Regex})
+	require.Error(t, err)
+}
+
+func TestNewSchedulerRequestFromPB(t *testing.T) {
+	t.Parallel()
+
+	req, err := NewSchedulerRequestFromPB(&enginepb.ScheduleTaskRequest{
+		TaskId:    "task-1",
+		Cost:      10,
+		TenantId:  "tenant-1",
+		ProjectId: "project-1",
+		Selectors: []*enginepb.Selector{
+			{Label: "zone", Target: "z1", Op: enginepb.Selector_Eq},
+		},
+		Preferences: []*enginepb.SchedulePreference{
+			{Selector: &enginepb.Selector{Label: "disk", Target: "ssd", Op: enginepb.Selector_Eq}, Weight: 10},
+		},
+		AntiAffinity: &enginepb.AntiAffinity{
+			Group:         "job-1",
+			TaskExecutors: map[string]string{"task-0": "executor-1"},
+			Required:      true,
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "task-1", req.TaskID)
+	require.Equal(t, ResourceUnit(10), req.Cost)
+	require.Len(t, req.Selectors, 1)
+	require.Equal(t, label.Key("zone"), req.Selectors[0].Key)
+	require.Len(t, req.Preferences, 1)
+	require.Equal(t, label.Key("disk"), req.Preferences[0].Selector.Key)
+	require.Equal(t, int64(10), req.Preferences[0].Weight)
+	require.Equal(t, &AntiAffinity{
+		Group:         "job-1",
+		TaskExecutors: map[string]model.ExecutorID{"task-0": "executor-1"},
+		Required:      true,
+	}, req.AntiAffinity)
+
+	// anti-affinity without a group is ignored.
+	req, err = NewSchedulerRequestFromPB(&enginepb.ScheduleTaskRequest{
+		AntiAffinity: &enginepb.AntiAffinity{Required: true},
+	})
+	require.NoError(t, err)
+	require.Nil(t, req.AntiAffinity)
+
+	_, err = NewSchedulerRequestFromPB(&enginepb.ScheduleTaskRequest{
+		Preferences: []*enginepb.SchedulePreference{
+			{Selector: &enginepb.Selector{Label: "disk", Target: "ssd", Op: enginepb.Selector_Eq}, Weight: 0},
+		},
+	})
+	require.ErrorContains(t, err, "preference weight")
+	_, err = NewSchedulerRequestFromPB(&enginepb.ScheduleTaskRequest{
+		Preferences: []*enginepb.SchedulePreference{{Weight: 1}},
+	})
+	require.ErrorContains(t, err, "preference without selector")
+}
@@ -21,11 +21,41 @@ import (
 
 // SchedulerRequest represents a request for an executor to run a given task.
 type SchedulerRequest struct {
-	TenantID string // reserved for future use.
+	TaskID string
+	// TenantID and ProjectID are used to enforce quotas.
+	TenantID  string
+	ProjectID string
 
 	Cost              ResourceUnit
 	ExternalResources []resourcemeta.ResourceKey
 	Selectors         []label.Selector
+	Preferences       []SchedulePreference
+	AntiAffinity      *AntiAffinity
+}
+
+// MinPreferenceWeight and MaxPreferenceWeight are the bounds of the weight
+// of a SchedulePreference.
+const (
+	MinPreferenceWeight = 1
+	MaxPreferenceWeight = 100
+)
+
+// SchedulePreference is a weighted label selector. Executors matching
+// preferences with a higher total weight are preferred.
+type SchedulePreference struct {
+	Selector label.Selector
+	Weight   int64
+}
+
+// AntiAffinity spreads the tasks in the same group across executors.
+type AntiAffinity struct {
+	// Group identifies the tasks to spread, e.g. the workers of a job.
+	Group string
+	// TaskExecutors maps the tasks of the group that are running or being
+	// created to their executors.
+	TaskExecutors map[string]model.ExecutorID
+	// Required makes the anti-affinity a hard constraint.
+	Required bool
 }
 
 // SchedulerResponse represents a response to a task scheduling request.
@@ -26,21 +26,40 @@ type Scheduler struct {
 	infoProvider         executorInfoProvider
 	costScheduler        *costScheduler
 	placementConstrainer PlacementConstrainer
+	quotaChecker         QuotaChecker
+	placementTracker     *placementTracker
 	filters              []filter
 }
 
-// NewScheduler creates a new Scheduler instance
+// QuotaChecker describes an object that enforces the quotas of tenants
+// and projects on scheduled tasks.
+type QuotaChecker interface {
+	// ReserveTask returns an error if the task exceeds the quota of its
+	// tenant or project, otherwise the task is counted in the usage.
+	ReserveTask(ctx context.Context, request *schedModel.SchedulerRequest) error
+	// ReleaseTask removes a task counted by ReserveTask from the usage.
+	ReleaseTask(taskID string)
+}
+
+// NewScheduler creates a new Scheduler instance.
+// quotaChecker can be nil, in which case no quota is enforced.
 func NewScheduler(
 	infoProvider executorInfoProvider,
 	placementConstrainer PlacementConstrainer,
+	quotaChecker QuotaChecker,
 ) *Scheduler {
+	tracker := newPlacementTracker()
 	return &Scheduler{
 		infoProvider:         infoProvider,
 		costScheduler:        NewRandomizedCostScheduler(infoProvider),
 		placementConstrainer: placementConstrainer,
+		quotaChecker:         quotaChecker,
+		placementTracker:     tracker,
 		filters: []filter{
 			newResourceFilter(placementConstrainer),
+			newDrainFilter(infoProvider),
 			newSelectorFilter(infoProvider),
+			newAntiAffinityFilter(tracker),
 		},
 	}
 }
@@ -49,18 +68,31 @@ func NewScheduler(
 func (s *Scheduler) ScheduleTask(
 	ctx context.Context,
 	request *schedModel.SchedulerRequest,
-) (*schedModel.SchedulerResponse, error) {
+) (_ *schedModel.SchedulerResponse, retErr error) {
+	if s.quotaChecker != nil {
+		if err := s.quotaChecker.ReserveTask(ctx, request); err != nil {
+			return nil, err
+		}
+		defer func() {
+			if retErr != nil {
+				s.quotaChecker.ReleaseTask(request.TaskID)
+			}
+		}()
+	}
+
 	candidates, err := s.chainFilter(ctx, request)
 	if err != nil {
 		return nil, err
 	}
-	executorID, ok := s.costScheduler.ScheduleByCost(request.Cost, candidates)
+	scores := scoreCandidates(request, candidates, s.infoProvider.GetExecutorInfos(), s.placementTracker)
+	executorID, ok := s.costScheduler.ScheduleByCostAndScore(request.Cost, candidates, scores)
 	if !ok {
 		return nil, ErrCapacityNotEnough.GenWithStack(
 			&CapacityNotEnoughError{
 				FinalCandidates: candidates,
 			})
 	}
+	s.placementTracker.Record(request, executorID)
 	return &schedModel.SchedulerResponse{ExecutorID: executorID}, nil
 }
 
@@ -66,7 +66,8 @@ func getMockResourceConstraintForScheduler() PlacementConstrainer {
 func TestSchedulerByCost(t *testing.T) {
 	sched := NewScheduler(
 		getMockDataForScheduler(),
-		getMockResourceConstraintForScheduler())
+		getMockResourceConstraintForScheduler(),
+		nil)
 
 	resp, err := sched.ScheduleTask(context.Background(), &schedModel.SchedulerRequest{
 		Cost: 35,
@@ -78,7 +79,8 @@ func TestSchedulerByCost(t *testing.T) {
 func TestSchedulerByConstraint(t *testing.T) {
 	sched := NewScheduler(
 		getMockDataForScheduler(),
-		getMockResourceConstraintForScheduler())
+		getMockResourceConstraintForScheduler(),
+		nil)
 
 	resp, err := sched.ScheduleTask(context.Background(), &schedModel.SchedulerRequest{
 		Cost:              20,
@@ -91,7 +93,8 @@ func TestSchedulerByConstraint(t *testing.T) {
 func TestSchedulerNoConstraint(t *testing.T) {
 	sched := NewScheduler(
 		getMockDataForScheduler(),
-		getMockResourceConstraintForScheduler())
+		getMockResourceConstraintForScheduler(),
+		nil)
 
 	resp, err := sched.ScheduleTask(context.Background(), &schedModel.SchedulerRequest{
 		Cost: 35,
@@ -105,7 +108,8 @@ func TestSchedulerNoConstraint(t *testing.T) {
 func TestSchedulerResourceOwnerNoCapacity(t *testing.T) {
 	sched := NewScheduler(
 		getMockDataForScheduler(),
-		getMockResourceConstraintForScheduler())
+		getMockResourceConstraintForScheduler(),
+		nil)
 
 	_, err := sched.ScheduleTask(context.Background(), &schedModel.SchedulerRequest{
 		Cost: 50,
@@ -116,10 +120,44 @@ func TestSchedulerResourceOwnerNoCapacity(t *testing.T) {
 	require.Regexp(t, "CapacityNotEnoughError", err)
 }
 
+func TestSchedulerDrainingExecutor(t *testing.T) {
+	infoProvider := getMockDataForScheduler()
+	info := infoProvider.infos["executor-1"]
+	info.Draining = true
+	infoProvider.infos["executor-1"] = info
+	sched := NewScheduler(
+		infoProvider,
+		getMockResourceConstraintForScheduler(),
+		nil)
+
+	// executor-1 has the most available capacity, but it is draining.
+	resp, err := sched.ScheduleTask(context.Background(), &schedModel.SchedulerRequest{
+		Cost: 20,
+	})
+	require.NoError(t, err)
+	require.NotEqual(t, model.ExecutorID("executor-1"), resp.ExecutorID)
+
+	// Only executor-1 has enough capacity, but it is draining.
+	_, err = sched.ScheduleTask(context.Background(), &schedModel.SchedulerRequest{
+		Cost: 35,
+	})
+	require.Error(t, err)
+	require.Regexp(t, "CapacityNotEnoughError", err)
+
+	// resource-1 requires executor-1, which is draining.
+	_, err = sched.ScheduleTask(context.Background(), &schedModel.SchedulerRequest{
+		Cost:              10,
+		ExternalResources: []resModel.ResourceKey{{JobID: "fakeJob", ID: "resource-1"}},
+	})
+	require.Error(t, err)
+	require.True(t, ErrFilterNoResult.Is(err))
+}
+
 func TestSchedulerResourceNotFound(t *testing.T) {
 	sched := NewScheduler(
 		getMockDataForScheduler(),
-		getMockResourceConstraintForScheduler())
+		getMockResourceConstraintForScheduler(),
+		nil)
 
 	_, err := sched.ScheduleTask(context.Background(), &schedModel.SchedulerRequest{
 		Cost: 50,
@@ -135,7 +173,8 @@ func TestSchedulerResourceNotFound(t *testing.T) {
 func TestSchedulerByCostNoCapacity(t *testing.T) {
 	sched := NewScheduler(
 		getMockDataForScheduler(),
-		getMockResourceConstraintForScheduler())
+		getMockResourceConstraintForScheduler(),
+		nil)
 
 	_, err := sched.ScheduleTask(context.Background(), &schedModel.SchedulerRequest{
 		// No executor has the capacity to run this
@@ -148,7 +187,8 @@ func TestSchedulerByCostNoCapacity(t *testing.T) {
 func TestSchedulerConstraintConflict(t *testing.T) {
 	sched := NewScheduler(
 		getMockDataForScheduler(),
-		getMockResourceConstraintForScheduler())
+		getMockResourceConstraintForScheduler(),
+		nil)
 
 	_, err := sched.ScheduleTask(context.Background(), &schedModel.SchedulerRequest{
 		Cost: 10,
@@ -166,3 +206,59 @@ func TestSchedulerConstraintConflict(t *testing.T) {
 	require.Error(t, err)
 	require.Regexp(t, "ResourceConflictError", err)
 }
+
+type mockQuotaChecker struct {
+	limit    schedModel.ResourceUnit
+	reserved map[string]schedModel.ResourceUnit
+}
+
+func (c *mockQuotaChecker) ReserveTask(_ context.Context, request *schedModel.SchedulerRequest) error {
+	var used schedModel.ResourceUnit
+	for _, cost := range c.reserved {
+		used += cost
+	}
+	if used+request.Cost > c.limit {
+		return ErrCapacityNotEnough.GenWithStack(&CapacityNotEnoughError{})
+	}
+	c.reserved[request.TaskID] = request.Cost
+	return nil
+}
+
+func (c *mockQuotaChecker) ReleaseTask(taskID string) {
+	delete(c.reserved, taskID)
+}
+
+func TestSchedulerQuota(t *testing.T) {
+	checker := &mockQuotaChecker{
+		limit:    30,
+		reserved: make(map[string]schedModel.ResourceUnit),
+	}
+	sched := NewScheduler(
+		getMockDataForScheduler(),
+		getMockResourceConstraintForScheduler(),
+		checker)
+
+	_, err := sched.ScheduleTask(context.Background(), &schedModel.SchedulerRequest{
+		TaskID: "task-1",
+		Cost:   20,
+	})
+	require.NoError(t, err)
+	require.Len(t, checker.reserved, 1)
+
+	// Rejected by the quota checker.
+	_, err = sched.ScheduleTask(context.Background(), &schedModel.SchedulerRequest{
+		TaskID: "task-2",
+		Cost:   20,
+	})
+	require.Error(t, err)
+	require.Len(t, checker.reserved, 1)
+
+	// The reservation is released if the task fails to be scheduled.
+	_, err = sched.ScheduleTask(context.Background(), &schedModel.SchedulerRequest{
+		TaskID:            "task-3",
+		Cost:              10,
+		ExternalResources: []resModel.ResourceKey{{JobID: "fakeJob", ID: "resource-blah"}},
+	})
+	require.Error(t, err)
+	require.Len(t, checker.reserved, 1)
+}
@@ -63,6 +63,7 @@ import (
 	clientv3 "go.etcd.io/etcd/client/v3"
 	"go.uber.org/atomic"
 	"go.uber.org/zap"
+	"golang.org/x/exp/slices"
 	"golang.org/x/sync/errgroup"
 	"golang.org/x/time/rate"
 	"google.golang.org/grpc"
@@ -77,6 +78,11 @@ import (
 var masterRPCLimiterAllowList = []string{
 	"CreateJob",
 	"CancelJob",
+	"PauseJob",
+	"ResumeJob",
+	"UpdateJobConfig",
+	"SetQuota",
+	"DrainExecutor",
 	"ScheduleTask",
 	"CreateResource",
 	"RemoveResource",
@@ -105,6 +111,7 @@ type Server struct {
 	jobManager             JobManager
 	resourceManagerService *externRescManager.Service
 	scheduler              *scheduler.Scheduler
+	quotaManager           *quotaManager
 
 	// file resource GC
 	gcRunner      externRescManager.GCRunner
@@ -273,6 +280,16 @@ func (s *Server) ListJobs(ctx context.Context, req *pb.ListJobsRequest) (*pb.Lis
 	return s.jobManager.ListJobs(ctx, req)
 }
 
+// ListJobEvents delegates request to leader's JobManager.ListJobEvents.
+func (s *Server) ListJobEvents(ctx context.Context, req *pb.ListJobEventsRequest) (*pb.ListJobEventsResponse, error) {
+	resp := &pb.ListJobEventsResponse{}
+	shouldRet, err := s.masterRPCHook.PreRPC(ctx, req, &resp)
+	if shouldRet {
+		return resp, err
+	}
+	return s.jobManager.ListJobEvents(ctx, req)
+}
+
 // CancelJob delegates request to leader's JobManager.CancelJob.
 func (s *Server) CancelJob(ctx context.Context, req *pb.CancelJobRequest) (*pb.Job, error) {
 	job := &pb.Job{}
@@ -283,6 +300,56 @@ func (s *Server) CancelJob(ctx context.Context, req *pb.CancelJobRequest) (*pb.J
 	return s.jobManager.CancelJob(ctx, req)
 }
 
+// PauseJob delegates request to leader's JobManager.PauseJob.
+func (s *Server) PauseJob(ctx context.Context, req *pb.PauseJobRequest) (*pb.Job, error) {
+	job := &pb.Job{}
+	shouldRet, err := s.masterRPCHook.PreRPC(ctx, req, &job)
+	if shouldRet {
+		return job, err
+	}
+	return s.jobManager.PauseJob(ctx, req)
+}
+
+// ResumeJob delegates request to leader's JobManager.ResumeJob.
+func (s *Server) ResumeJob(ctx context.Context, req *pb.ResumeJobRequest) (*pb.Job, error) {
+	job := &pb.Job{}
+	shouldRet, err := s.masterRPCHook.PreRPC(ctx, req, &job)
+	if shouldRet {
+		return job, err
+	}
+	return s.jobManager.ResumeJob(ctx, req)
+}
+
+// UpdateJobConfig delegates request to leader's JobManager.UpdateJobConfig.
+func (s *Server) UpdateJobConfig(ctx context.Context, req *pb.UpdateJobConfigRequest) (*pb.Job, error) {
+	job := &pb.Job{}
+	shouldRet, err := s.masterRPCHook.PreRPC(ctx, req, &job)
+	if shouldRet {
+		return job, err
+	}
+	return s.jobManager.UpdateJobConfig(ctx, req)
+}
+
+// GetQuota delegates request to leader's JobManager.GetQuota.
+func (s *Server) GetQuota(ctx context.Context, req *pb.GetQuotaRequest) (*pb.Quota, error) {
+	quota := &pb.Quota{}
+	shouldRet, err := s.masterRPCHook.PreRPC(ctx, req, &quota)
+	if shouldRet {
+		return quota, err
+	}
+	return s.jobManager.GetQuota(ctx, req)
+}
+
+// SetQuota delegates request to leader's JobManager.SetQuota.
+func (s *Server) SetQuota(ctx context.Context, req *pb.SetQuotaRequest) (*pb.Quota, error) {
+	quota := &pb.Quota{}
+	shouldRet, err := s.masterRPCHook.PreRPC(ctx, req, &quota)
+	if shouldRet {
+		return quota, err
+	}
+	return s.jobManager.SetQuota(ctx, req)
+}
+
 // DeleteJob delegates request to leader's JobManager.DeleteJob.
 func (s *Server) DeleteJob(ctx context.Context, req *pb.DeleteJobRequest) (*emptypb.Empty, error) {
 	empty := &emptypb.Empty{}
@@ -328,6 +395,7 @@ func (s *Server) ListExecutors(ctx context.Context, req *pb.ListExecutorsRequest
 			Name:       executor.Name,
 			Address:    executor.Address,
 			Capability: int64(executor.Capability),
+			Draining:   executor.Draining,
 		})
 	}
 	sort.Slice(resp.Executors, func(i, j int) bool {
@@ -336,6 +404,102 @@ func (s *Server) ListExecutors(ctx context.Context, req *pb.ListExecutorsRequest
 	return resp, nil
 }
 
+// DrainExecutor implements DiscoveryServer.DrainExecutor.
+func (s *Server) DrainExecutor(ctx context.Context, req *pb.DrainExecutorRequest) (*pb.ExecutorDrainStatus, error) {
+	resp := &pb.ExecutorDrainStatus{}
+	shouldRet, err := s.masterRPCHook.PreRPC(ctx, req, &resp)
+	if shouldRet {
+		return resp, err
+	}
+
+	executorID := model.ExecutorID(req.ExecutorId)
+	if err := s.executorManager.DrainExecutor(ctx, executorID); err != nil {
+		return nil, err
+	}
+	pinnedWorkers, err := s.getPinnedWorkers(ctx, executorID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.jobManager.DrainExecutor(ctx, executorID, pinnedWorkers); err != nil {
+		return nil, err
+	}
+	return s.getExecutorDrainStatus(executorID, pinnedWorkers)
+}
+
+// GetExecutorDrainStatus implements DiscoveryServer.GetExecutorDrainStatus.
+func (s *Server) GetExecutorDrainStatus(
+	ctx context.Context, req *pb.GetExecutorDrainStatusRequest,
+) (*pb.ExecutorDrainStatus, error) {
+	resp := &pb.ExecutorDrainStatus{}
+	shouldRet, err := s.masterRPCHook.PreRPC(ctx, req, &resp)
+	if shouldRet {
+		return resp, err
+	}
+
+	executorID := model.ExecutorID(req.ExecutorId)
+	pinnedWorkers, err := s.getPinnedWorkers(ctx, executorID)
+	if err != nil {
+		return nil, err
+	}
+	return s.getExecutorDrainStatus(executorID, pinnedWorkers)
+}
+
+func (s *Server) getExecutorDrainStatus(
+	executorID model.ExecutorID, pinnedWorkers []frameModel.WorkerID,
+) (*pb.ExecutorDrainStatus, error) {
+	drainStatus, err := s.executorManager.GetDrainStatus(executorID)
+	if err != nil {
+		return nil, err
+	}
+	drainStatus.PinnedWorkerIds = pinnedWorkers
+	return drainStatus, nil
+}
+
+// getPinnedWorkers returns the workers that own local file resources on the
+// executor. They can't be migrated, because the files are not accessible
+// from other executors.
+func (s *Server) getPinnedWorkers(ctx context.Context, executorID model.ExecutorID) ([]frameModel.WorkerID, error) {
+	resources, err := s.frameMetaClient.QueryResourcesByExecutorID(ctx, string(executorID))
+	if err != nil {
+		return nil, err
+	}
+	var pinnedWorkers []frameModel.WorkerID
+	for _, resource := range resources {
+		if resource.Deleted || resource.GCPending {
+			continue
+		}
+		tp, _, err := resModel.ParseResourcePath(resource.ID)
+		if err != nil {
+			log.Warn("invalid resource id", zap.String("resource-id", resource.ID), zap.Error(err))
+			continue
+		}
+		if tp != resModel.ResourceTypeLocalFile {
+			continue
+		}
+		pinnedWorkers = append(pinnedWorkers, resource.Worker)
+	}
+	sort.Strings(pinnedWorkers)
+	return slices.Compact(pinnedWorkers), nil
+}
+
+// resumeDrainingExecutors resends the drain requests for the executors that
+// were being drained before the server master failed over.
+func (s *Server) resumeDrainingExecutors(ctx context.Context) error {
+	for _, executor := range s.executorManager.ListExecutors() {
+		if !executor.Draining {
+			continue
+		}
+		pinnedWorkers, err := s.getPinnedWorkers(ctx, executor.ID)
+		if err != nil {
+			return err
+		}
+		if err := s.jobManager.DrainExecutor(ctx, executor.ID, pinnedWorkers); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // ListMasters implements DiscoveryServer.ListMasters.
 func (s *Server) ListMasters(ctx context.Context, req *pb.ListMastersRequest) (*pb.ListMastersResponse, error) {
 	resp := &pb.ListMastersResponse{}
@@ -366,9 +530,9 @@ func (s *Server) ScheduleTask(ctx context.Context, req *pb.ScheduleTaskRequest)
 		return resp2, err
 	}
 
-	schedulerReq := &schedModel.SchedulerRequest{
-		Cost:              schedModel.ResourceUnit(req.GetCost()),
-		ExternalResources: resModel.ToResourceKeys(req.GetResourceRequirements()),
+	schedulerReq, err := schedModel.NewSchedulerRequestFromPB(req)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid schedule task request: %v", err)
 	}
 	schedulerResp, err := s.scheduler.ScheduleTask(ctx, schedulerReq)
 	if err != nil {
@@ -548,9 +712,15 @@ func (s *Server) Run(ctx context.Context) error {
 	// ResourceManagerService should be initialized after registerMetaStore.
 	// FIXME: We should do these work inside NewServer.
 	s.initResourceManagerService()
+	var storageSize storageSizeFunc
+	if s.cfg.Storage.S3.IsEnabled() {
+		storageSize = resourcetypes.NewS3ResourceType(s.cfg.Storage.S3).StorageSize
+	}
+	s.quotaManager = newQuotaManager(s.frameMetaClient, storageSize)
 	s.scheduler = scheduler.NewScheduler(
 		s.executorManager,
-		s.resourceManagerService)
+		s.resourceManagerService,
+		s.quotaManager)
 
 	wg, ctx := errgroup.WithContext(ctx)
 
@@ -875,8 +1045,13 @@ func (s *Server) runLeaderService(ctx context.Context) (err error) {
 		s.leader.Store(&rpcutil.Member{})
 	}()
 
+	// The usage of workers is tracked in memory, rebuild it before serving.
+	if err = s.quotaManager.reconcile(ctx); err != nil {
+		return
+	}
+
 	dctx = dctx.WithDeps(dp)
-	s.jobManager, err = NewJobManagerImpl(dctx, metadata.JobManagerUUID)
+	s.jobManager, err = NewJobManagerImpl(dctx, metadata.JobManagerUUID, s.quotaManager)
 	if err != nil {
 		return
 	}
@@ -887,10 +1062,17 @@ func (s *Server) runLeaderService(ctx context.Context) (err error) {
 		}
 		log.Info("job manager exited")
 	}()
+	if err = s.resumeDrainingExecutors(ctx); err != nil {
+		return
+	}
 
-	s.gcRunner = externRescManager.NewGCRunner(s.frameMetaClient, map[resModel.ResourceType]externRescManager.GCHandlerFunc{
+	gcHandlers := map[resModel.ResourceType]externRescManager.GCHandlerFunc{
 		"local": resourcetypes.NewLocalFileResourceType(executorClients).GCHandler(),
-	})
+	}
+	if s.cfg.Storage.S3.IsEnabled() {
+		gcHandlers[resModel.ResourceTypeS3] = resourcetypes.NewS3ResourceType(s.cfg.Storage.S3).GCHandler()
+	}
+	s.gcRunner = externRescManager.NewGCRunner(s.frameMetaClient, gcHandlers)
 	s.gcCoordinator = externRescManager.NewGCCoordinator(s.executorManager, s.jobManager, s.frameMetaClient, s.gcRunner)
 
 	// TODO refactor this method to make it more readable and maintainable.
@@ -902,6 +1084,9 @@ func (s *Server) runLeaderService(ctx context.Context) (err error) {
 	errg.Go(func() error {
 		return s.gcCoordinator.Run(errgCtx)
 	})
+	errg.Go(func() error {
+		return s.quotaManager.Run(errgCtx)
+	})
 
 	errg.Go(func() error {
 		defer func() {
@@ -162,9 +162,11 @@ func testRunLeaderService(t *testing.T) {
 	_ = s.registerMetaStore(ctx)
 
 	s.initResourceManagerService()
+	s.quotaManager = newQuotaManager(s.frameMetaClient, nil)
 	s.scheduler = scheduler.NewScheduler(
 		s.executorManager,
-		s.resourceManagerService)
+		s.resourceManagerService,
+		s.quotaManager)
 
 	var wg sync.WaitGroup
 	wg.Add(1)
@@ -225,6 +227,12 @@ func (m *mockJobManager) GetJobStatuses(ctx context.Context) (map[frameModel.Mas
 	panic("not implemented")
 }
 
+func (m *mockJobManager) DrainExecutor(
+	ctx context.Context, executorID model.ExecutorID, pinnedWorkers []frameModel.WorkerID,
+) error {
+	panic("not implemented")
+}
+
 func (m *mockJobManager) WatchJobStatuses(
 	ctx context.Context,
 ) (manager.JobStatusesSnapshot, *notifier.Receiver[manager.JobStatusChangeEvent], error) {
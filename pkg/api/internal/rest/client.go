@@ -66,6 +66,9 @@ type CDCRESTClient struct {
 
 	// Client is a wrapped http client.
 	Client *httputil.Client
+
+	// authorization is the value of the Authorization header of requests.
+	authorization string
 }
 
 // NewCDCRESTClient creates a new CDCRESTClient.
@@ -19,6 +19,7 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	cerrors "github.com/pingcap/tiflow/pkg/errors"
 	"github.com/stretchr/testify/require"
 )
 
@@ -81,6 +82,17 @@ func TestRestRawRequestFailed(t *testing.T) {
 	require.NotNil(t, err)
 }
 
+func TestRestRequestAPINotFound(t *testing.T) {
+	testServer := httptest.NewServer(http.NotFoundHandler())
+	defer testServer.Close()
+
+	c, err := restClient(testServer)
+	require.Nil(t, err)
+	result := c.Get().WithURI("not-found").WithMaxRetries(1).Do(context.Background())
+	require.Equal(t, http.StatusNotFound, result.StatusCode())
+	require.True(t, cerrors.ErrCDCAPINotFound.Equal(result.Error()))
+}
+
 func TestHTTPMethods(t *testing.T) {
 	testServer := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
 		rw.WriteHeader(http.StatusOK)
@@ -31,6 +31,8 @@ type Config struct {
 	APIPath string
 	// Credential holds the security Credential used for generating tls config
 	Credential *security.Credential
+	// Auth holds the credentials to authenticate the requests
+	Auth *security.HTTPAuth
 	// API verion
 	Version string
 }
@@ -83,6 +85,7 @@ func CDCRESTClientFromConfig(config *Config) (*CDCRESTClient, error) {
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
+	restClient.authorization = config.Auth.AuthorizationHeader()
 
 	return restClient, nil
 }
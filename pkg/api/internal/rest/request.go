@@ -88,6 +88,9 @@ func NewRequest(c *CDCRESTClient) *Request {
 	}
 	r.WithHeader("Accept", "application/json")
 	r.WithHeader(middleware.ClientVersionHeader, version.ReleaseVersion)
+	if c.authorization != "" {
+		r.WithHeader("Authorization", c.authorization)
+	}
 	return r
 }
 
@@ -360,6 +363,10 @@ func (r *Request) checkResponse(resp *http.Response) *Result {
 		err := json.Unmarshal(body, &jsonErr)
 		if err == nil {
 			err = errors.New(jsonErr.Error)
+		} else if resp.StatusCode == http.StatusNotFound {
+			// The CDC server never responds 404 by itself, the api is not
+			// found in a server older than the client.
+			err = cerrors.ErrCDCAPINotFound.GenWithStackByArgs(r.URL().String())
 		} else {
 			err = fmt.Errorf(
 				"call cdc api failed, url=%s, "+
@@ -401,6 +408,11 @@ func (r Result) Error() error {
 	return r.err
 }
 
+// StatusCode returns the status code of the http response.
+func (r Result) StatusCode() int {
+	return r.statusCode
+}
+
 // Into stores the http response body into obj.
 func (r Result) Into(obj interface{}) error {
 	if r.err != nil {
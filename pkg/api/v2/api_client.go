@@ -67,12 +67,15 @@ func (c *APIV2Client) Changefeeds() ChangefeedInterface {
 }
 
 // NewAPIClient creates a new APIV1Client.
-func NewAPIClient(serverAddr string, credential *security.Credential) (*APIV2Client, error) {
+func NewAPIClient(
+	serverAddr string, credential *security.Credential, auth *security.HTTPAuth,
+) (*APIV2Client, error) {
 	c := &rest.Config{}
 	c.APIPath = "/api"
 	c.Version = "v2"
 	c.Host = serverAddr
 	c.Credential = credential
+	c.Auth = auth
 	client, err := rest.CDCRESTClientFromConfig(c)
 	if err != nil {
 		return nil, errors.Trace(err)
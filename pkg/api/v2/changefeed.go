@@ -33,6 +33,8 @@ type ChangefeedInterface interface {
 	Create(ctx context.Context, cfg *v2.ChangefeedConfig) (*v2.ChangeFeedInfo, error)
 	// GetInfo gets a changefeed's info
 	GetInfo(ctx context.Context, name string) (*v2.ChangeFeedInfo, error)
+	// GetStatus gets a changefeed's runtime status, including the lag SLO status
+	GetStatus(ctx context.Context, name string) (*v2.ChangefeedStatus, error)
 	// VerifyTable verifies table for a changefeed
 	VerifyTable(ctx context.Context, cfg *v2.VerifyTableConfig) (*v2.Tables, error)
 	// Update updates a changefeed
@@ -89,6 +91,18 @@ func (c *changefeeds) GetInfo(ctx context.Context,
 	return result, err
 }
 
+func (c *changefeeds) GetStatus(ctx context.Context,
+	name string,
+) (*v2.ChangefeedStatus, error) {
+	result := &v2.ChangefeedStatus{}
+	u := fmt.Sprintf("changefeeds/%s/status", name)
+	err := c.client.Get().
+		WithURI(u).
+		Do(ctx).
+		Into(result)
+	return result, err
+}
+
 func (c *changefeeds) Update(ctx context.Context,
 	cfg *v2.ChangefeedConfig, name string,
 ) (*v2.ChangeFeedInfo, error) {
@@ -0,0 +1,103 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bdr
+
+import (
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/regionspan"
+)
+
+// LoopFilter drops the transactions written by the replicas in the
+// filter-replica-ids of a changefeed.
+type LoopFilter struct {
+	filterIDs  []uint64
+	replicaIDs map[uint64]struct{}
+}
+
+// NewLoopFilter creates a LoopFilter, it returns nil if the bidirectional
+// replication is not enabled.
+func NewLoopFilter(cfg *config.BidirectionalConfig) *LoopFilter {
+	if !cfg.IsEnabled() {
+		return nil
+	}
+	f := &LoopFilter{
+		filterIDs:  cfg.FilterReplicaIDs,
+		replicaIDs: make(map[uint64]struct{}, len(cfg.FilterReplicaIDs)),
+	}
+	for _, id := range cfg.FilterReplicaIDs {
+		f.replicaIDs[id] = struct{}{}
+	}
+	return f
+}
+
+// FilterTxns removes the transactions containing mark rows of the filtered
+// replicas from the events, the events must have the same commit ts. The
+// mark rows themselves are always removed.
+func (f *LoopFilter) FilterTxns(events []*model.PolymorphicEvent) []*model.PolymorphicEvent {
+	var marked map[uint64]struct{}
+	hasMarkRows := false
+	for _, e := range events {
+		if e.Row == nil || e.Row.Table == nil {
+			continue
+		}
+		replicaID, ok := ParseMarkTable(e.Row.Table.Schema, e.Row.Table.Table)
+		if !ok {
+			continue
+		}
+		hasMarkRows = true
+		if _, ok := f.replicaIDs[replicaID]; ok {
+			if marked == nil {
+				marked = make(map[uint64]struct{})
+			}
+			marked[e.StartTs] = struct{}{}
+		}
+	}
+	if !hasMarkRows {
+		return events
+	}
+
+	res := events[:0]
+	for _, e := range events {
+		if _, ok := marked[e.StartTs]; ok {
+			continue
+		}
+		if e.Row != nil && e.Row.Table != nil &&
+			IsMarkTable(e.Row.Table.Schema, e.Row.Table.Table) {
+			continue
+		}
+		res = append(res, e)
+	}
+	return res
+}
+
+// MarkSpans returns the spans of the mark rows of the table in the mark
+// tables of the filtered replicas, tableIDByName looks up the table id of a
+// mark table.
+func (f *LoopFilter) MarkSpans(
+	table *model.TableName,
+	tableIDByName func(schema, table string) (model.TableID, bool),
+) ([]regionspan.Span, error) {
+	spans := make([]regionspan.Span, 0, len(f.filterIDs))
+	for _, replicaID := range f.filterIDs {
+		markTable := MarkTableName(replicaID)
+		markTableID, ok := tableIDByName(markTable.Schema, markTable.Table)
+		if !ok {
+			return nil, cerror.ErrBidirectionalMarkTableNotFound.GenWithStackByArgs(markTable)
+		}
+		spans = append(spans, MarkSpan(markTableID, table))
+	}
+	return spans, nil
+}
@@ -0,0 +1,77 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bdr
+
+import (
+	"testing"
+
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/regionspan"
+	"github.com/stretchr/testify/require"
+)
+
+func newRowEvent(startTs uint64, table *model.TableName) *model.PolymorphicEvent {
+	return &model.PolymorphicEvent{
+		StartTs: startTs,
+		CRTs:    100,
+		Row:     &model.RowChangedEvent{StartTs: startTs, CommitTs: 100, Table: table},
+	}
+}
+
+func TestLoopFilterFilterTxns(t *testing.T) {
+	t.Parallel()
+
+	require.Nil(t, NewLoopFilter(nil))
+	require.Nil(t, NewLoopFilter(&config.BidirectionalConfig{ReplicaID: 1}))
+
+	f := NewLoopFilter(&config.BidirectionalConfig{
+		Enable: true, ReplicaID: 1, FilterReplicaIDs: []uint64{2},
+	})
+	table := &model.TableName{Schema: "test", Table: "t"}
+	events := []*model.PolymorphicEvent{newRowEvent(1, table), newRowEvent(2, table)}
+	require.Equal(t, events, f.FilterTxns(events))
+
+	// The transaction 2 is written by replica 2, and the transaction 3 is
+	// written by replica 3 which is not filtered.
+	e1, e2, e3 := newRowEvent(1, table), newRowEvent(2, table), newRowEvent(3, table)
+	events = []*model.PolymorphicEvent{
+		e1, e2, newRowEvent(2, MarkTableName(2)),
+		newRowEvent(3, MarkTableName(3)), e3, {StartTs: 4, CRTs: 100},
+	}
+	res := f.FilterTxns(events)
+	require.Equal(t, []*model.PolymorphicEvent{e1, e3, {StartTs: 4, CRTs: 100}}, res)
+}
+
+func TestLoopFilterMarkSpans(t *testing.T) {
+	t.Parallel()
+
+	f := NewLoopFilter(&config.BidirectionalConfig{
+		Enable: true, ReplicaID: 1, FilterReplicaIDs: []uint64{2, 3},
+	})
+	table := &model.TableName{Schema: "test", Table: "t"}
+	tableIDs := map[string]model.TableID{"bdr_mark_2": 20}
+	tableIDByName := func(schema, table string) (model.TableID, bool) {
+		id, ok := tableIDs[table]
+		return id, ok
+	}
+	_, err := f.MarkSpans(table, tableIDByName)
+	require.True(t, cerror.ErrBidirectionalMarkTableNotFound.Equal(err))
+
+	tableIDs["bdr_mark_3"] = 30
+	spans, err := f.MarkSpans(table, tableIDByName)
+	require.NoError(t, err)
+	require.Equal(t, []regionspan.Span{MarkSpan(20, table), MarkSpan(30, table)}, spans)
+}
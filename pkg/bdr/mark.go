@@ -0,0 +1,115 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package bdr implements the loop prevention of bidirectional replication.
+//
+// Every changefeed of a bidirectional replication has a replica id, and it
+// owns a mark table named `tidb_cdc`.`bdr_mark_<replica id>` in its
+// downstream. The MySQL sink upserts a mark row in every transaction it
+// writes, the handle of the mark row is derived from the name of the
+// replicated table. The changefeed replicating in the opposite direction
+// subscribes the mark rows of each table together with the table itself, and
+// drops the transactions containing mark rows.
+package bdr
+
+import (
+	"fmt"
+	"hash/fnv"
+	"strconv"
+	"strings"
+
+	"github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/tablecodec"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/quotes"
+	"github.com/pingcap/tiflow/pkg/regionspan"
+)
+
+const (
+	// MarkSchema is the schema of the mark tables.
+	MarkSchema = "tidb_cdc"
+
+	markTablePrefix = "bdr_mark_"
+
+	// The lower bits of a mark handle is the bucket of the sink worker, so
+	// that the workers don't update the same mark row concurrently.
+	bucketBits = 8
+	bucketMask = 1<<bucketBits - 1
+	// The hash of the table name is truncated to keep the handles positive.
+	tableHashMask = 1<<(62-bucketBits) - 1
+)
+
+// MarkTableName returns the name of the mark table of the replica.
+func MarkTableName(replicaID uint64) *model.TableName {
+	return &model.TableName{
+		Schema: MarkSchema,
+		Table:  markTablePrefix + strconv.FormatUint(replicaID, 10),
+	}
+}
+
+// ParseMarkTable returns the replica id of a mark table, the second return
+// value is false if the table is not a mark table.
+func ParseMarkTable(schema, table string) (uint64, bool) {
+	if schema != MarkSchema || !strings.HasPrefix(table, markTablePrefix) {
+		return 0, false
+	}
+	replicaID, err := strconv.ParseUint(table[len(markTablePrefix):], 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return replicaID, true
+}
+
+// IsMarkTable returns whether the table is a mark table.
+func IsMarkTable(schema, table string) bool {
+	_, ok := ParseMarkTable(schema, table)
+	return ok
+}
+
+// markHandleBase returns the first mark handle of the table.
+func markHandleBase(table *model.TableName) int64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(table.Schema))
+	_, _ = h.Write([]byte{'.'})
+	_, _ = h.Write([]byte(table.Table))
+	return int64(h.Sum64()&tableHashMask) << bucketBits
+}
+
+// MarkSpan returns the span of the mark rows of the table in a mark table.
+func MarkSpan(markTableID model.TableID, table *model.TableName) regionspan.Span {
+	base := markHandleBase(table)
+	return regionspan.Span{
+		Start: tablecodec.EncodeRowKeyWithHandle(markTableID, kv.IntHandle(base)),
+		End:   tablecodec.EncodeRowKeyWithHandle(markTableID, kv.IntHandle(base+bucketMask+1)),
+	}
+}
+
+// GenCreateMarkTableDDL returns the DDL creating the mark table of the replica.
+func GenCreateMarkTableDDL(replicaID uint64) string {
+	return fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s ("+
+		"`id` BIGINT NOT NULL PRIMARY KEY /*T![clustered_index] CLUSTERED */,"+
+		"`val` BIGINT NOT NULL DEFAULT 0)", MarkTableName(replicaID).QuoteString())
+}
+
+// GenMarkDML returns the DML upserting the mark row of the table, it must be
+// executed in the same transaction as the rows of the table.
+func GenMarkDML(replicaID uint64, table *model.TableName, bucket int) (string, []interface{}) {
+	query := fmt.Sprintf("INSERT INTO %s (`id`,`val`) VALUES (?,1) "+
+		"ON DUPLICATE KEY UPDATE `val`=`val`+1", MarkTableName(replicaID).QuoteString())
+	return query, []interface{}{markHandleBase(table) | int64(bucket&bucketMask)}
+}
+
+// GenCreateMarkSchemaDDL returns the DDL creating the schema of the mark tables.
+func GenCreateMarkSchemaDDL() string {
+	return "CREATE DATABASE IF NOT EXISTS " + quotes.QuoteName(MarkSchema)
+}
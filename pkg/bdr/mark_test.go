@@ -0,0 +1,61 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bdr
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/tablecodec"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMarkTableName(t *testing.T) {
+	t.Parallel()
+
+	name := MarkTableName(10)
+	require.Equal(t, "`tidb_cdc`.`bdr_mark_10`", name.QuoteString())
+	replicaID, ok := ParseMarkTable(name.Schema, name.Table)
+	require.True(t, ok)
+	require.Equal(t, uint64(10), replicaID)
+
+	require.False(t, IsMarkTable("test", "bdr_mark_10"))
+	require.False(t, IsMarkTable(MarkSchema, "bdr_mark_"))
+	require.False(t, IsMarkTable(MarkSchema, "bdr_mark_a"))
+	require.False(t, IsMarkTable(MarkSchema, "syncpoint_v1"))
+}
+
+func TestMarkSpan(t *testing.T) {
+	t.Parallel()
+
+	table := &model.TableName{Schema: "test", Table: "t1"}
+	span := MarkSpan(100, table)
+	for _, bucket := range []int{0, 1, 255, 256} {
+		query, args := GenMarkDML(1, table, bucket)
+		require.Equal(t, "INSERT INTO `tidb_cdc`.`bdr_mark_1` (`id`,`val`) VALUES (?,1) "+
+			"ON DUPLICATE KEY UPDATE `val`=`val`+1", query)
+		handle := args[0].(int64)
+		require.Positive(t, handle)
+		key := tablecodec.EncodeRowKeyWithHandle(100, kv.IntHandle(handle))
+		require.True(t, bytes.Compare(span.Start, key) <= 0)
+		require.True(t, bytes.Compare(key, span.End) < 0)
+	}
+
+	// The mark rows of other tables are not in the span.
+	_, args := GenMarkDML(1, &model.TableName{Schema: "test", Table: "t2"}, 0)
+	key := tablecodec.EncodeRowKeyWithHandle(100, kv.IntHandle(args[0].(int64)))
+	require.False(t, bytes.Compare(span.Start, key) <= 0 && bytes.Compare(key, span.End) < 0)
+}
@@ -30,23 +30,26 @@ import (
 
 // cfMeta holds changefeed info and changefeed status.
 type cfMeta struct {
-	UpstreamID     uint64                    `json:"upstream_id"`
-	Namespace      string                    `json:"namespace"`
-	ID             string                    `json:"id"`
-	SinkURI        string                    `json:"sink_uri"`
-	Config         *v2.ReplicaConfig         `json:"config"`
-	CreateTime     model.JSONTime            `json:"create_time"`
-	StartTs        uint64                    `json:"start_ts"`
-	ResolvedTs     uint64                    `json:"resolved_ts"`
-	TargetTs       uint64                    `json:"target_ts"`
-	CheckpointTSO  uint64                    `json:"checkpoint_tso"`
-	CheckpointTime model.JSONTime            `json:"checkpoint_time"`
-	Engine         model.SortEngine          `json:"sort_engine,omitempty"`
-	FeedState      model.FeedState           `json:"state"`
-	RunningError   *model.RunningError       `json:"error"`
-	ErrorHis       []int64                   `json:"error_history"`
-	CreatorVersion string                    `json:"creator_version"`
-	TaskStatus     []model.CaptureTaskStatus `json:"task_status,omitempty"`
+	UpstreamID     uint64                     `json:"upstream_id"`
+	Namespace      string                     `json:"namespace"`
+	ID             string                     `json:"id"`
+	SinkURI        string                     `json:"sink_uri"`
+	Config         *v2.ReplicaConfig          `json:"config"`
+	CreateTime     model.JSONTime             `json:"create_time"`
+	StartTs        uint64                     `json:"start_ts"`
+	ResolvedTs     uint64                     `json:"resolved_ts"`
+	TargetTs       uint64                     `json:"target_ts"`
+	CheckpointTSO  uint64                     `json:"checkpoint_tso"`
+	CheckpointTime model.JSONTime             `json:"checkpoint_time"`
+	Engine         model.SortEngine           `json:"sort_engine,omitempty"`
+	FeedState      model.FeedState            `json:"state"`
+	RunningError   *model.RunningError        `json:"error"`
+	ErrorHis       []int64                    `json:"error_history"`
+	CreatorVersion string                     `json:"creator_version"`
+	TaskStatus     []model.CaptureTaskStatus  `json:"task_status,omitempty"`
+	SLO            *model.ChangefeedSLOStatus `json:"slo,omitempty"`
+	// PendingAsyncDDLs are the DDLs executing asynchronously downstream.
+	PendingAsyncDDLs []*model.AsyncDDL `json:"pending_async_ddls,omitempty"`
 }
 
 // queryChangefeedOptions defines flags for the `cli changefeed query` command.
@@ -110,6 +113,14 @@ func (o *queryChangefeedOptions) run(cmd *cobra.Command) error {
 	if err != nil && cerror.ErrChangeFeedNotExists.NotEqual(err) {
 		return err
 	}
+	status, err := o.apiClientV2.Changefeeds().GetStatus(ctx, o.changefeedID)
+	if err != nil {
+		// The status is unavailable in the servers older than the cli.
+		if cerror.ErrCDCAPINotFound.NotEqual(err) && cerror.ErrChangeFeedNotExists.NotEqual(err) {
+			return err
+		}
+		status = &v2.ChangefeedStatus{}
+	}
 	meta := &cfMeta{
 		UpstreamID:     detail.UpstreamID,
 		Namespace:      detail.Namespace,
@@ -128,6 +139,9 @@ func (o *queryChangefeedOptions) run(cmd *cobra.Command) error {
 		ErrorHis:       detail.ErrorHis,
 		CreatorVersion: detail.CreatorVersion,
 		TaskStatus:     detail.TaskStatus,
+		SLO:            status.SLO,
+
+		PendingAsyncDDLs: status.PendingAsyncDDLs,
 	}
 	return util.JSONPrint(cmd, meta)
 }
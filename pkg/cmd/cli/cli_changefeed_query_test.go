@@ -25,6 +25,7 @@ import (
 	"github.com/pingcap/tiflow/cdc/model"
 	mock_v1 "github.com/pingcap/tiflow/pkg/api/v1/mock"
 	mock_v2 "github.com/pingcap/tiflow/pkg/api/v2/mock"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
 	"github.com/stretchr/testify/require"
 )
 
@@ -77,6 +78,11 @@ func TestChangefeedQueryCli(t *testing.T) {
 	cfV2.EXPECT().GetInfo(gomock.Any(), gomock.Any()).Return(&v2.ChangeFeedInfo{
 		Config: v2.GetDefaultReplicaConfig(),
 	}, nil)
+	cfV2.EXPECT().GetStatus(gomock.Any(), "bcd").Return(&v2.ChangefeedStatus{
+		SLO: &model.ChangefeedSLOStatus{
+			CheckpointLag: &model.LagSLOStatus{MaxLagInSec: 60, Breached: true},
+		},
+	}, nil)
 
 	o.simplified = false
 	o.changefeedID = "bcd"
@@ -87,6 +93,23 @@ func TestChangefeedQueryCli(t *testing.T) {
 	require.Nil(t, err)
 	// make sure config is printed
 	require.Contains(t, string(out), "config")
+	// make sure slo status is printed
+	require.Contains(t, string(out), "checkpoint_lag")
+
+	// the status api is not found in old servers
+	cfV1.EXPECT().Get(gomock.Any(), "bcd").Return(&model.ChangefeedDetail{}, nil)
+	cfV2.EXPECT().GetInfo(gomock.Any(), gomock.Any()).Return(&v2.ChangeFeedInfo{
+		Config: v2.GetDefaultReplicaConfig(),
+	}, nil)
+	cfV2.EXPECT().GetStatus(gomock.Any(), "bcd").
+		Return(nil, cerror.ErrCDCAPINotFound.GenWithStackByArgs("status"))
+	b = bytes.NewBufferString("")
+	cmd.SetOut(b)
+	require.Nil(t, o.run(cmd))
+	out, err = ioutil.ReadAll(b)
+	require.Nil(t, err)
+	require.Contains(t, string(out), "config")
+	require.NotContains(t, string(out), "slo")
 
 	// query failed
 	cfV1.EXPECT().Get(gomock.Any(), "bcd").Return(nil, errors.New("test"))
@@ -0,0 +1,95 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tiflow/pkg/cmd/context"
+	"github.com/pingcap/tiflow/pkg/cmd/factory"
+	"github.com/pingcap/tiflow/pkg/cmd/util"
+	"github.com/pingcap/tiflow/pkg/etcd"
+	"github.com/pingcap/tiflow/pkg/migrate"
+	"github.com/spf13/cobra"
+)
+
+// backupMetadataOptions defines flags for the `cli metadata backup` command.
+type backupMetadataOptions struct {
+	clusterID  string
+	file       string
+	etcdClient *etcd.CDCEtcdClientImpl
+}
+
+// newBackupMetadataOptions creates new options for the `cli metadata backup` command.
+func newBackupMetadataOptions() *backupMetadataOptions {
+	return &backupMetadataOptions{}
+}
+
+func (o *backupMetadataOptions) addFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().StringVar(&o.clusterID, "cluster-id", "default", "cdc cluster id")
+	cmd.PersistentFlags().StringVarP(&o.file, "file", "f", "", "Path of the backup file")
+	_ = cmd.MarkPersistentFlagRequired("file")
+}
+
+// complete adapts from the command line args to the data and client required.
+func (o *backupMetadataOptions) complete(f factory.Factory) error {
+	etcdClient, err := f.EtcdClient()
+	if err != nil {
+		return err
+	}
+	o.etcdClient = etcdClient
+	return nil
+}
+
+// run runs the `cli metadata backup` command.
+func (o *backupMetadataOptions) run(cmd *cobra.Command) error {
+	ctx := context.GetDefaultContext()
+	defer o.etcdClient.Close()
+
+	backup, err := migrate.Backup(ctx, o.etcdClient.Client, o.clusterID)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	data, err := json.MarshalIndent(backup, "", "  ")
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if err := os.WriteFile(o.file, data, 0o600); err != nil {
+		return errors.Trace(err)
+	}
+
+	cmd.Printf("Backup metadata of cluster %s to %s, %d changefeeds, %d upstreams\n",
+		o.clusterID, o.file, len(backup.Changefeeds), len(backup.Upstreams))
+	return nil
+}
+
+// newCmdBackupMetadata creates the `cli metadata backup` command.
+func newCmdBackupMetadata(f factory.Factory) *cobra.Command {
+	o := newBackupMetadataOptions()
+
+	command := &cobra.Command{
+		Use:   "backup",
+		Short: "Backup the changefeeds and upstreams of the TiCDC cluster to a file",
+		Args:  cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			util.CheckErr(o.complete(f))
+			util.CheckErr(o.run(cmd))
+		},
+	}
+	o.addFlags(command)
+
+	return command
+}
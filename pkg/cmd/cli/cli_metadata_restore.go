@@ -0,0 +1,136 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"encoding/json"
+	"os"
+	"strings"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tiflow/pkg/cmd/context"
+	"github.com/pingcap/tiflow/pkg/cmd/factory"
+	"github.com/pingcap/tiflow/pkg/cmd/util"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/etcd"
+	"github.com/pingcap/tiflow/pkg/migrate"
+	"github.com/spf13/cobra"
+)
+
+// restoreMetadataOptions defines flags for the `cli metadata restore` command.
+type restoreMetadataOptions struct {
+	clusterID       string
+	file            string
+	sinkURIRewrites []string
+	force           bool
+	etcdClient      *etcd.CDCEtcdClientImpl
+}
+
+// newRestoreMetadataOptions creates new options for the `cli metadata restore` command.
+func newRestoreMetadataOptions() *restoreMetadataOptions {
+	return &restoreMetadataOptions{}
+}
+
+func (o *restoreMetadataOptions) addFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().StringVar(&o.clusterID, "cluster-id", "",
+		"Restore to the cdc cluster id, use the cluster id of the backup if it's empty")
+	cmd.PersistentFlags().StringVarP(&o.file, "file", "f", "", "Path of the backup file")
+	cmd.PersistentFlags().StringArrayVar(&o.sinkURIRewrites, "sink-uri-rewrite", nil,
+		"Rewrite the prefix of sink URIs, in the form of old-prefix=new-prefix, can be specified multiple times")
+	cmd.PersistentFlags().BoolVar(&o.force, "force", false,
+		"Overwrite the existing changefeeds and upstreams in the cluster")
+	_ = cmd.MarkPersistentFlagRequired("file")
+}
+
+// complete adapts from the command line args to the data and client required.
+func (o *restoreMetadataOptions) complete(f factory.Factory) error {
+	etcdClient, err := f.EtcdClient()
+	if err != nil {
+		return err
+	}
+	o.etcdClient = etcdClient
+	return nil
+}
+
+// parseSinkURIRewrites parses the rewrite rules in the form of old-prefix=new-prefix.
+func parseSinkURIRewrites(rules []string) ([]migrate.SinkURIRewrite, error) {
+	rewrites := make([]migrate.SinkURIRewrite, 0, len(rules))
+	for _, rule := range rules {
+		from, to, ok := strings.Cut(rule, "=")
+		if !ok || from == "" {
+			return nil, cerror.ErrInvalidMetadataBackup.GenWithStackByArgs(
+				"invalid sink uri rewrite rule " + rule)
+		}
+		rewrites = append(rewrites, migrate.SinkURIRewrite{From: from, To: to})
+	}
+	return rewrites, nil
+}
+
+// run runs the `cli metadata restore` command.
+func (o *restoreMetadataOptions) run(cmd *cobra.Command) error {
+	ctx := context.GetDefaultContext()
+	defer o.etcdClient.Close()
+
+	rewrites, err := parseSinkURIRewrites(o.sinkURIRewrites)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	data, err := os.ReadFile(o.file)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	backup := &migrate.MetadataBackup{}
+	if err := json.Unmarshal(data, backup); err != nil {
+		return cerror.WrapError(cerror.ErrInvalidMetadataBackup, err)
+	}
+
+	keys, err := migrate.Restore(ctx, o.etcdClient.Client, backup, migrate.RestoreOptions{
+		ClusterID:       o.clusterID,
+		SinkURIRewrites: rewrites,
+		Force:           o.force,
+	})
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	clusterID := o.clusterID
+	if clusterID == "" {
+		clusterID = backup.ClusterID
+	}
+	cmd.Printf("Restore metadata to cluster %s from %s, %d changefeeds, %d upstreams\n",
+		clusterID, o.file, len(backup.Changefeeds), len(backup.Upstreams))
+	cmd.Printf("Written keys:\n")
+	for _, key := range keys {
+		cmd.Printf("  %s\n", key)
+	}
+	return nil
+}
+
+// newCmdRestoreMetadata creates the `cli metadata restore` command.
+func newCmdRestoreMetadata(f factory.Factory) *cobra.Command {
+	o := newRestoreMetadataOptions()
+
+	command := &cobra.Command{
+		Use:   "restore",
+		Short: "Restore the changefeeds and upstreams of the TiCDC cluster from a backup file",
+		Args:  cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			util.CheckErr(o.complete(f))
+			util.CheckErr(o.run(cmd))
+		},
+	}
+	o.addFlags(command)
+
+	return command
+}
@@ -0,0 +1,41 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"testing"
+
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/migrate"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSinkURIRewrites(t *testing.T) {
+	t.Parallel()
+
+	rewrites, err := parseSinkURIRewrites([]string{
+		"mysql://127.0.0.1:3306=mysql://127.0.0.1:4000",
+		"kafka://a:9092/topic=kafka://b:9092/topic?a=b",
+	})
+	require.NoError(t, err)
+	require.Equal(t, []migrate.SinkURIRewrite{
+		{From: "mysql://127.0.0.1:3306", To: "mysql://127.0.0.1:4000"},
+		{From: "kafka://a:9092/topic", To: "kafka://b:9092/topic?a=b"},
+	}, rewrites)
+
+	_, err = parseSinkURIRewrites([]string{"mysql://127.0.0.1:3306"})
+	require.ErrorIs(t, err, cerror.ErrInvalidMetadataBackup)
+	_, err = parseSinkURIRewrites([]string{"=mysql://127.0.0.1:3306"})
+	require.ErrorIs(t, err, cerror.ErrInvalidMetadataBackup)
+}
@@ -15,6 +15,7 @@ package factory
 
 import (
 	"crypto/tls"
+	"os"
 
 	"github.com/pingcap/errors"
 	apiv1client "github.com/pingcap/tiflow/pkg/api/v1"
@@ -43,8 +44,18 @@ type ClientGetter interface {
 	GetServerAddr() string
 	GetLogLevel() string
 	GetCredential() *security.Credential
+	GetHTTPAuth() *security.HTTPAuth
 }
 
+const (
+	// authTokenEnv is the environment variable of the bearer token of the HTTP API,
+	// it's used if --auth-token is not specified.
+	authTokenEnv = "TICDC_AUTH_TOKEN"
+	// passwordEnv is the environment variable of the password of the HTTP API,
+	// it's used if --password is not specified.
+	passwordEnv = "TICDC_PASSWORD"
+)
+
 // ClientFlags specifies the parameters needed to construct the client.
 type ClientFlags struct {
 	pdAddr     string
@@ -53,6 +64,9 @@ type ClientFlags struct {
 	caPath     string
 	certPath   string
 	keyPath    string
+	authToken  string
+	user       string
+	password   string
 }
 
 var _ ClientGetter = &ClientFlags{}
@@ -114,6 +128,14 @@ func (c *ClientFlags) AddFlags(cmd *cobra.Command) {
 		"Private key path for TLS connection to CDC server")
 	cmd.PersistentFlags().StringVar(&c.logLevel, "log-level", "warn",
 		"log level (etc: debug|info|warn|error)")
+	cmd.PersistentFlags().StringVar(&c.authToken, "auth-token", "",
+		"Bearer token to authenticate to the CDC server, "+
+			"read from the environment variable "+authTokenEnv+" if it's empty")
+	cmd.PersistentFlags().StringVar(&c.user, "user", "",
+		"User to authenticate to the CDC server by basic auth")
+	cmd.PersistentFlags().StringVar(&c.password, "password", "",
+		"Password of the user to authenticate to the CDC server, "+
+			"read from the environment variable "+passwordEnv+" if it's empty")
 }
 
 // GetCredential returns credential.
@@ -127,3 +149,19 @@ func (c *ClientFlags) GetCredential() *security.Credential {
 		CertAllowedCN: certAllowedCN,
 	}
 }
+
+// GetHTTPAuth returns the credentials to authenticate to the CDC server.
+func (c *ClientFlags) GetHTTPAuth() *security.HTTPAuth {
+	auth := &security.HTTPAuth{
+		Token:    c.authToken,
+		User:     c.user,
+		Password: c.password,
+	}
+	if auth.Token == "" {
+		auth.Token = os.Getenv(authTokenEnv)
+	}
+	if auth.User != "" && auth.Password == "" {
+		auth.Password = os.Getenv(passwordEnv)
+	}
+	return auth
+}
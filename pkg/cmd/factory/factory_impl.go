@@ -88,6 +88,11 @@ func (f *factoryImpl) GetCredential() *security.Credential {
 	return f.clientGetter.GetCredential()
 }
 
+// GetHTTPAuth returns the credentials of the HTTP API.
+func (f *factoryImpl) GetHTTPAuth() *security.HTTPAuth {
+	return f.clientGetter.GetHTTPAuth()
+}
+
 // EtcdClient creates new cdc etcd client.
 func (f *factoryImpl) EtcdClient() (*etcd.CDCEtcdClientImpl, error) {
 	ctx := cmdconetxt.GetDefaultContext()
@@ -208,7 +213,8 @@ func (f *factoryImpl) APIV1Client() (apiv1client.APIV1Interface, error) {
 		return nil, errors.Trace(err)
 	}
 	log.Info(serverAddr)
-	client, err := apiv1client.NewAPIClient(serverAddr, f.clientGetter.GetCredential())
+	client, err := apiv1client.NewAPIClient(serverAddr,
+		f.clientGetter.GetCredential(), f.clientGetter.GetHTTPAuth())
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
@@ -222,14 +228,16 @@ func (f *factoryImpl) APIV2Client() (apiv2client.APIV2Interface, error) {
 		return nil, errors.Trace(err)
 	}
 	log.Info(serverAddr)
-	client, err := apiv1client.NewAPIClient(serverAddr, f.clientGetter.GetCredential())
+	client, err := apiv1client.NewAPIClient(serverAddr,
+		f.clientGetter.GetCredential(), f.clientGetter.GetHTTPAuth())
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
 	if err := checkCDCVersion(client); err != nil {
 		return nil, errors.Trace(err)
 	}
-	return apiv2client.NewAPIClient(serverAddr, f.clientGetter.GetCredential())
+	return apiv2client.NewAPIClient(serverAddr,
+		f.clientGetter.GetCredential(), f.clientGetter.GetHTTPAuth())
 }
 
 // findServerAddr find the cdc server address by the following logic
@@ -15,16 +15,24 @@ package factory
 
 import (
 	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/gin-gonic/gin"
 	"github.com/golang/mock/gomock"
 	"github.com/pingcap/errors"
+	"github.com/pingcap/tiflow/cdc/api/middleware"
 	"github.com/pingcap/tiflow/cdc/model"
 	apiv1client "github.com/pingcap/tiflow/pkg/api/v1"
 	"github.com/pingcap/tiflow/pkg/api/v1/mock"
 	cmdcontext "github.com/pingcap/tiflow/pkg/cmd/context"
 	mock_factory "github.com/pingcap/tiflow/pkg/cmd/factory/mock"
+	"github.com/pingcap/tiflow/pkg/config"
 	"github.com/pingcap/tiflow/pkg/security"
+	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
 )
@@ -117,3 +125,63 @@ func TestCheckCDCVersion(t *testing.T) {
 	require.NotNil(t, err)
 	require.Contains(t, err.Error(), "the cluster version is too old")
 }
+
+func TestAPIClientWithHTTPAuth(t *testing.T) {
+	dir := t.TempDir()
+	tokenFile := filepath.Join(dir, "tokens")
+	require.NoError(t, os.WriteFile(tokenFile, []byte("secret-token alice\n"), 0o600))
+	htpasswdFile := filepath.Join(dir, "htpasswd")
+	// The SHA1 hash of "password".
+	require.NoError(t, os.WriteFile(htpasswdFile,
+		[]byte("bob:{SHA}W6ph5Mm5Pz8GgiULbPgzG37mj9g=\n"), 0o600))
+	authenticator, err := middleware.NewAuthenticator(&config.HTTPAuthConfig{
+		Enable:       true,
+		TokenFile:    tokenFile,
+		HtpasswdFile: htpasswdFile,
+		UserRoles: map[string]string{
+			"alice": config.HTTPRoleReadOnly,
+			"bob":   config.HTTPRoleReadOnly,
+		},
+	})
+	require.NoError(t, err)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(middleware.AuthMiddleware(authenticator))
+	router.GET("/api/v1/status", func(c *gin.Context) {
+		c.IndentedJSON(http.StatusOK, &model.ServerStatus{Version: "v6.3.0"})
+	})
+	server := httptest.NewServer(router)
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	cmdcontext.SetDefaultContext(ctx)
+	newFactory := func(args ...string) Factory {
+		flags := NewClientFlags()
+		cmd := &cobra.Command{}
+		flags.AddFlags(cmd)
+		require.NoError(t, cmd.ParseFlags(append([]string{"--server", server.URL}, args...)))
+		return NewFactory(flags)
+	}
+
+	// The request without credentials is rejected.
+	_, err = newFactory().APIV1Client()
+	require.ErrorContains(t, err, "ErrHTTPUnauthorized")
+	_, err = newFactory("--auth-token", "wrong-token").APIV1Client()
+	require.ErrorContains(t, err, "ErrHTTPUnauthorized")
+
+	_, err = newFactory("--auth-token", "secret-token").APIV1Client()
+	require.NoError(t, err)
+	_, err = newFactory("--user", "bob", "--password", "password").APIV1Client()
+	require.NoError(t, err)
+
+	// The credentials can be read from the environment variables.
+	t.Setenv(authTokenEnv, "secret-token")
+	_, err = newFactory().APIV1Client()
+	require.NoError(t, err)
+	t.Setenv(authTokenEnv, "")
+	t.Setenv(passwordEnv, "password")
+	_, err = newFactory("--user", "bob").APIV1Client()
+	require.NoError(t, err)
+}
@@ -99,6 +99,20 @@ func (mr *MockFactoryMockRecorder) GetCredential() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCredential", reflect.TypeOf((*MockFactory)(nil).GetCredential))
 }
 
+// GetHTTPAuth mocks base method.
+func (m *MockFactory) GetHTTPAuth() *security.HTTPAuth {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHTTPAuth")
+	ret0, _ := ret[0].(*security.HTTPAuth)
+	return ret0
+}
+
+// GetHTTPAuth indicates an expected call of GetHTTPAuth.
+func (mr *MockFactoryMockRecorder) GetHTTPAuth() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHTTPAuth", reflect.TypeOf((*MockFactory)(nil).GetHTTPAuth))
+}
+
 // GetLogLevel mocks base method.
 func (m *MockFactory) GetLogLevel() string {
 	m.ctrl.T.Helper()
@@ -223,6 +237,20 @@ func (mr *MockClientGetterMockRecorder) GetCredential() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCredential", reflect.TypeOf((*MockClientGetter)(nil).GetCredential))
 }
 
+// GetHTTPAuth mocks base method.
+func (m *MockClientGetter) GetHTTPAuth() *security.HTTPAuth {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHTTPAuth")
+	ret0, _ := ret[0].(*security.HTTPAuth)
+	return ret0
+}
+
+// GetHTTPAuth indicates an expected call of GetHTTPAuth.
+func (mr *MockClientGetterMockRecorder) GetHTTPAuth() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHTTPAuth", reflect.TypeOf((*MockClientGetter)(nil).GetHTTPAuth))
+}
+
 // GetLogLevel mocks base method.
 func (m *MockClientGetter) GetLogLevel() string {
 	m.ctrl.T.Helper()
@@ -168,6 +168,7 @@ func TestParseCfg(t *testing.T) {
 			KeyPath:       "cc",
 			CertAllowedCN: []string{"dd", "ee"},
 		},
+		HTTPAuth:            &config.HTTPAuthConfig{},
 		PerTableMemoryQuota: config.DefaultTableMemoryQuota,
 		KVClient: &config.KVClientConfig{
 			WorkerConcurrent:    8,
@@ -253,6 +254,12 @@ sort-dir = "/tmp/just_a_test"
 [kv-client]
 region-retry-duration = "3s"
 
+[http-auth]
+enable = true
+token-file = "/tmp/tokens"
+[http-auth.user-roles]
+reader = "read-only"
+
 [debug]
 enable-db-sorter = false
 enable-scheduler-v3 = true
@@ -324,7 +331,12 @@ check-balance-interval = "10s"
 			NumWorkerPoolGoroutine: 5,
 			SortDir:                config.DefaultSortDir,
 		},
-		Security:            &config.SecurityConfig{},
+		Security: &config.SecurityConfig{},
+		HTTPAuth: &config.HTTPAuthConfig{
+			Enable:    true,
+			TokenFile: "/tmp/tokens",
+			UserRoles: map[string]string{"reader": config.HTTPRoleReadOnly},
+		},
 		PerTableMemoryQuota: config.DefaultTableMemoryQuota,
 		KVClient: &config.KVClientConfig{
 			WorkerConcurrent:    8,
@@ -472,6 +484,7 @@ cert-allowed-cn = ["dd","ee"]
 			KeyPath:       "cc",
 			CertAllowedCN: []string{"dd", "ee"},
 		},
+		HTTPAuth:            &config.HTTPAuthConfig{},
 		PerTableMemoryQuota: config.DefaultTableMemoryQuota,
 		KVClient: &config.KVClientConfig{
 			WorkerConcurrent:    8,
@@ -0,0 +1,73 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"net/url"
+
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/sink"
+)
+
+// BidirectionalConfig represents the bidirectional replication config of a
+// changefeed. Two changefeeds replicate between two clusters in opposite
+// directions, each of them tags the transactions it writes with its replica
+// id, and drops the transactions tagged by the other one.
+type BidirectionalConfig struct {
+	Enable bool `toml:"enable" json:"enable"`
+	// ReplicaID tags the transactions written by this changefeed downstream.
+	ReplicaID uint64 `toml:"replica-id" json:"replica-id"`
+	// FilterReplicaIDs are the replica ids of the changefeeds writing to the
+	// upstream of this changefeed, their transactions are not replicated.
+	FilterReplicaIDs []uint64 `toml:"filter-replica-ids" json:"filter-replica-ids"`
+	// SyncDDL indicates whether this changefeed replicates DDLs, it must be
+	// true for exactly one of the two changefeeds.
+	SyncDDL bool `toml:"sync-ddl" json:"sync-ddl"`
+}
+
+// IsEnabled returns whether the bidirectional replication is enabled.
+func (c *BidirectionalConfig) IsEnabled() bool {
+	return c != nil && c.Enable
+}
+
+func (c *BidirectionalConfig) validateAndAdjust(sinkURI *url.URL, sinkCfg *SinkConfig) error {
+	if !c.IsEnabled() {
+		return nil
+	}
+	if c.ReplicaID == 0 {
+		return cerror.ErrInvalidBidirectionalConfig.GenWithStackByArgs(
+			"replica-id should be positive")
+	}
+	if len(c.FilterReplicaIDs) == 0 {
+		return cerror.ErrInvalidBidirectionalConfig.GenWithStackByArgs(
+			"filter-replica-ids should not be empty")
+	}
+	for _, id := range c.FilterReplicaIDs {
+		if id == c.ReplicaID {
+			return cerror.ErrInvalidBidirectionalConfig.GenWithStackByArgs(
+				"filter-replica-ids should not contain the replica-id of the changefeed")
+		}
+	}
+	if sinkURI != nil && !sink.IsMySQLCompatibleScheme(sinkURI.Scheme) {
+		return cerror.ErrInvalidBidirectionalConfig.GenWithStackByArgs(
+			"only MySQL compatible sinks are supported, but got " + sinkURI.Scheme)
+	}
+	// The rows of a transaction and its mark row must be written downstream
+	// in one transaction, otherwise the transaction can't be recognized.
+	if sinkCfg != nil && sinkCfg.TxnAtomicity.ShouldSplitTxn() {
+		return cerror.ErrInvalidBidirectionalConfig.GenWithStackByArgs(
+			"transaction-atomicity should not be none")
+	}
+	return nil
+}
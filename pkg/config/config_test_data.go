@@ -89,6 +89,13 @@ const (
     "key-path": "",
     "cert-allowed-cn": null
   },
+  "http-auth": {
+    "enable": false,
+    "token-file": "",
+    "htpasswd-file": "",
+    "enable-tls-identity": false,
+    "user-roles": null
+  },
   "per-table-memory-quota": 10485760,
   "kv-client": {
     "worker-concurrent": 8,
@@ -132,7 +139,9 @@ const (
       "check-balance-interval": 60000000000,
       "add-table-batch-size": 50
     },
-    "enable-new-sink": true
+    "enable-new-sink": true,
+    "enable-shared-schema-store": false,
+    "enable-shared-region-subscription": false
   },
   "cluster-id": "default"
 }`
@@ -167,7 +176,8 @@ const (
       }
     ],
     "schema-registry": "",
-    "transaction-atomicity": ""
+    "transaction-atomicity": "",
+    "async-index-ddl": false
   },
   "consistent": {
     "level": "none",
@@ -39,6 +39,16 @@ type DebugConfig struct {
 	// EnableNewSink enables the new sink.
 	// The default value is false.
 	EnableNewSink bool `toml:"enable-new-sink" json:"enable-new-sink"`
+
+	// EnableSharedSchemaStore makes the changefeeds of the same upstream
+	// share the multi-version schema storage and the DDL puller in a capture.
+	// The default value is false.
+	EnableSharedSchemaStore bool `toml:"enable-shared-schema-store" json:"enable-shared-schema-store"`
+
+	// EnableSharedRegionSubscription makes the changefeeds of the same upstream
+	// share the region subscriptions of the same table in a capture.
+	// The default value is false.
+	EnableSharedRegionSubscription bool `toml:"enable-shared-region-subscription" json:"enable-shared-region-subscription"`
 }
 
 // ValidateAndAdjust validates and adjusts the debug configuration
@@ -0,0 +1,66 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import "github.com/pingcap/tiflow/pkg/errors"
+
+const (
+	// HTTPRoleReadOnly can only access the read-only endpoints.
+	HTTPRoleReadOnly = "read-only"
+	// HTTPRoleOperator can access the read-only endpoints and manage changefeeds.
+	HTTPRoleOperator = "operator"
+	// HTTPRoleAdmin can access all endpoints, including the unsafe and debug ones.
+	HTTPRoleAdmin = "admin"
+)
+
+// HTTPAuthConfig represents config for the authentication and
+// authorization of the HTTP API.
+type HTTPAuthConfig struct {
+	// Enable enables the authentication and authorization of the HTTP API.
+	Enable bool `toml:"enable" json:"enable"`
+	// TokenFile is the path of a file which contains the static bearer tokens,
+	// each line is in the form of `<token> <user>`.
+	TokenFile string `toml:"token-file" json:"token-file"`
+	// HtpasswdFile is the path of a htpasswd file used by basic auth,
+	// only bcrypt and SHA1 passwords are supported.
+	HtpasswdFile string `toml:"htpasswd-file" json:"htpasswd-file"`
+	// EnableTLSIdentity uses the common name of the client certificate as the user.
+	// Note that requests forwarded to the owner are sent with the certificate of
+	// the capture, so the common name of captures should be granted the admin role.
+	EnableTLSIdentity bool `toml:"enable-tls-identity" json:"enable-tls-identity"`
+	// UserRoles maps users to roles, users without a role are denied.
+	UserRoles map[string]string `toml:"user-roles" json:"user-roles"`
+}
+
+// ValidateAndAdjust validates and adjusts the HTTP auth configuration
+func (c *HTTPAuthConfig) ValidateAndAdjust() error {
+	if !c.Enable {
+		return nil
+	}
+	if c.TokenFile == "" && c.HtpasswdFile == "" && !c.EnableTLSIdentity {
+		return errors.ErrInvalidServerOption.GenWithStack(
+			"at least one of token-file, htpasswd-file and enable-tls-identity " +
+				"should be specified when http-auth is enabled")
+	}
+	for user, role := range c.UserRoles {
+		switch role {
+		case HTTPRoleReadOnly, HTTPRoleOperator, HTTPRoleAdmin:
+		default:
+			return errors.ErrInvalidServerOption.GenWithStack(
+				"unknown role %s of user %s, should be one of %s, %s and %s",
+				role, user, HTTPRoleReadOnly, HTTPRoleOperator, HTTPRoleAdmin)
+		}
+	}
+	return nil
+}
@@ -53,14 +53,16 @@ func GetDefaultReplicaConfig() *ReplicaConfig {
 type ReplicaConfig replicaConfig
 
 type replicaConfig struct {
-	CaseSensitive    bool              `toml:"case-sensitive" json:"case-sensitive"`
-	EnableOldValue   bool              `toml:"enable-old-value" json:"enable-old-value"`
-	ForceReplicate   bool              `toml:"force-replicate" json:"force-replicate"`
-	CheckGCSafePoint bool              `toml:"check-gc-safe-point" json:"check-gc-safe-point"`
-	Filter           *FilterConfig     `toml:"filter" json:"filter"`
-	Mounter          *MounterConfig    `toml:"mounter" json:"mounter"`
-	Sink             *SinkConfig       `toml:"sink" json:"sink"`
-	Consistent       *ConsistentConfig `toml:"consistent" json:"consistent"`
+	CaseSensitive    bool                 `toml:"case-sensitive" json:"case-sensitive"`
+	EnableOldValue   bool                 `toml:"enable-old-value" json:"enable-old-value"`
+	ForceReplicate   bool                 `toml:"force-replicate" json:"force-replicate"`
+	CheckGCSafePoint bool                 `toml:"check-gc-safe-point" json:"check-gc-safe-point"`
+	Filter           *FilterConfig        `toml:"filter" json:"filter"`
+	Mounter          *MounterConfig       `toml:"mounter" json:"mounter"`
+	Sink             *SinkConfig          `toml:"sink" json:"sink"`
+	Consistent       *ConsistentConfig    `toml:"consistent" json:"consistent"`
+	SLO              *SLOConfig           `toml:"slo" json:"slo,omitempty"`
+	Bidirectional    *BidirectionalConfig `toml:"bidirectional" json:"bidirectional,omitempty"`
 }
 
 // Marshal returns the json marshal format of a ReplicationConfig
@@ -126,6 +128,16 @@ func (c *ReplicaConfig) ValidateAndAdjust(sinkURI *url.URL) error {
 			return err
 		}
 	}
+	if c.SLO != nil {
+		if err := c.SLO.validateAndAdjust(); err != nil {
+			return err
+		}
+	}
+	if c.Bidirectional != nil {
+		if err := c.Bidirectional.validateAndAdjust(sinkURI, c.Sink); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
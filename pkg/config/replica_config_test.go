@@ -16,6 +16,7 @@ package config
 import (
 	"bytes"
 	"encoding/json"
+	"net/url"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -117,4 +118,60 @@ func TestReplicaConfigValidate(t *testing.T) {
 	require.Equal(t, "d1", rules[0].PartitionRule)
 	require.Equal(t, "p1", rules[1].PartitionRule)
 	require.Equal(t, "", rules[2].PartitionRule)
+
+	// Lag SLO configuration.
+	conf = GetDefaultReplicaConfig()
+	conf.SLO = &SLOConfig{MaxCheckpointLagInSec: -1}
+	require.Regexp(t, ".*max-checkpoint-lag-in-sec should not be negative.*",
+		conf.ValidateAndAdjust(nil))
+	conf.SLO = &SLOConfig{MaxCheckpointLagInSec: 60, WebhookURL: "tcp://127.0.0.1"}
+	require.Regexp(t, ".*webhook-url should be a http or https url.*",
+		conf.ValidateAndAdjust(nil))
+	conf.SLO = &SLOConfig{MaxCheckpointLagInSec: 60, WebhookURL: "http://127.0.0.1/alert"}
+	require.Nil(t, conf.ValidateAndAdjust(nil))
+
+	// Conflict rules.
+	conf = GetDefaultReplicaConfig()
+	conf.Sink.ConflictRules = []*ConflictRule{
+		{Matcher: []string{"a.b"}, Policy: ConflictPolicyLastWriterWins},
+	}
+	require.Regexp(t, ".*timestamp-column is required.*", conf.ValidateAndAdjust(nil))
+	conf.Sink.ConflictRules[0] = &ConflictRule{
+		Matcher: []string{"a.b"}, Policy: ConflictPolicyRecord, ConflictTable: "conflicts",
+	}
+	require.Regexp(t, ".*conflict-table should be in the form of schema.table.*",
+		conf.ValidateAndAdjust(nil))
+	conf.Sink.ConflictRules[0] = &ConflictRule{Matcher: []string{"a.b"}, Policy: "unknown"}
+	require.Regexp(t, ".*unknown conflict policy.*", conf.ValidateAndAdjust(nil))
+	conf.Sink.ConflictRules[0] = &ConflictRule{
+		Matcher: []string{"a.b"}, Policy: ConflictPolicyRecord, ConflictTable: "cdc.conflicts",
+	}
+	require.Nil(t, conf.ValidateAndAdjust(nil))
+	sinkURI, err := url.Parse("kafka://127.0.0.1:9092/topic?protocol=open-protocol")
+	require.Nil(t, err)
+	require.Regexp(t, ".*conflict rules are not supported by kafka scheme.*",
+		conf.ValidateAndAdjust(sinkURI))
+	conf.EnableOldValue = false
+	require.Regexp(t, ".*conflict rules require old value to be enabled.*",
+		conf.ValidateAndAdjust(nil))
+
+	// Bidirectional replication.
+	conf = GetDefaultReplicaConfig()
+	conf.Bidirectional = &BidirectionalConfig{Enable: true}
+	require.Regexp(t, ".*replica-id should be positive.*", conf.ValidateAndAdjust(nil))
+	conf.Bidirectional.ReplicaID = 1
+	require.Regexp(t, ".*filter-replica-ids should not be empty.*", conf.ValidateAndAdjust(nil))
+	conf.Bidirectional.FilterReplicaIDs = []uint64{1}
+	require.Regexp(t, ".*should not contain the replica-id.*", conf.ValidateAndAdjust(nil))
+	conf.Bidirectional.FilterReplicaIDs = []uint64{2}
+	require.Regexp(t, ".*only MySQL compatible sinks are supported.*",
+		conf.ValidateAndAdjust(sinkURI))
+	conf.Sink.Protocol = ""
+	sinkURI, err = url.Parse("mysql://127.0.0.1:3306/?transaction-atomicity=none")
+	require.Nil(t, err)
+	require.Regexp(t, ".*transaction-atomicity should not be none.*",
+		conf.ValidateAndAdjust(sinkURI))
+	sinkURI, err = url.Parse("mysql://127.0.0.1:3306/")
+	require.Nil(t, err)
+	require.Nil(t, conf.ValidateAndAdjust(sinkURI))
 }
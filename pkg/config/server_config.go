@@ -110,6 +110,7 @@ var defaultServerConfig = &ServerConfig{
 		SortDir:                DefaultSortDir,
 	},
 	Security:            &SecurityConfig{},
+	HTTPAuth:            &HTTPAuthConfig{},
 	PerTableMemoryQuota: DefaultTableMemoryQuota,
 	KVClient: &KVClientConfig{
 		WorkerConcurrent: 8,
@@ -147,6 +148,9 @@ var defaultServerConfig = &ServerConfig{
 		EnableSchedulerV3: true,
 		Scheduler:         NewDefaultSchedulerConfig(),
 		EnableNewSink:     true,
+
+		EnableSharedSchemaStore:        false,
+		EnableSharedRegionSubscription: false,
 	},
 	ClusterID: "default",
 }
@@ -172,6 +176,7 @@ type ServerConfig struct {
 
 	Sorter              *SorterConfig   `toml:"sorter" json:"sorter"`
 	Security            *SecurityConfig `toml:"security" json:"security"`
+	HTTPAuth            *HTTPAuthConfig `toml:"http-auth" json:"http-auth"`
 	PerTableMemoryQuota uint64          `toml:"per-table-memory-quota" json:"per-table-memory-quota"`
 	KVClient            *KVClientConfig `toml:"kv-client" json:"kv-client"`
 	Debug               *DebugConfig    `toml:"debug" json:"debug"`
@@ -273,6 +278,18 @@ func (c *ServerConfig) ValidateAndAdjust() error {
 		return err
 	}
 
+	if c.HTTPAuth == nil {
+		c.HTTPAuth = defaultCfg.HTTPAuth
+	}
+	if err = c.HTTPAuth.ValidateAndAdjust(); err != nil {
+		return errors.Trace(err)
+	}
+	if c.HTTPAuth.Enable && c.HTTPAuth.EnableTLSIdentity &&
+		(c.Security == nil || !c.Security.IsTLSEnabled()) {
+		return cerror.ErrInvalidServerOption.GenWithStack(
+			"http-auth.enable-tls-identity requires TLS to be enabled")
+	}
+
 	if c.PerTableMemoryQuota == 0 {
 		c.PerTableMemoryQuota = defaultCfg.PerTableMemoryQuota
 	}
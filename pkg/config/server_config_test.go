@@ -108,6 +108,23 @@ func TestKVClientConfigValidateAndAdjust(t *testing.T) {
 	require.Error(t, conf.ValidateAndAdjust())
 }
 
+func TestHTTPAuthConfigValidateAndAdjust(t *testing.T) {
+	t.Parallel()
+	conf := GetDefaultServerConfig().Clone()
+	conf.Addr = "cdc:1234"
+
+	require.Nil(t, conf.ValidateAndAdjust())
+	conf.HTTPAuth.Enable = true
+	require.Regexp(t, ".*at least one of.*", conf.ValidateAndAdjust())
+	conf.HTTPAuth.TokenFile = "/path/to/tokens"
+	conf.HTTPAuth.UserRoles = map[string]string{"user": "unknown"}
+	require.Regexp(t, ".*unknown role.*", conf.ValidateAndAdjust())
+	conf.HTTPAuth.UserRoles = map[string]string{"user": HTTPRoleOperator}
+	require.Nil(t, conf.ValidateAndAdjust())
+	conf.HTTPAuth.EnableTLSIdentity = true
+	require.Regexp(t, ".*requires TLS.*", conf.ValidateAndAdjust())
+}
+
 func TestSchedulerConfigValidateAndAdjust(t *testing.T) {
 	t.Parallel()
 	conf := GetDefaultServerConfig().Clone().Debug.Scheduler
@@ -16,6 +16,7 @@ package config
 import (
 	"fmt"
 	"net/url"
+	"strings"
 
 	"github.com/pingcap/errors"
 	"github.com/pingcap/log"
@@ -68,6 +69,12 @@ type SinkConfig struct {
 	ColumnSelectors []*ColumnSelector `toml:"column-selectors" json:"column-selectors"`
 	SchemaRegistry  string            `toml:"schema-registry" json:"schema-registry"`
 	TxnAtomicity    AtomicityLevel    `toml:"transaction-atomicity" json:"transaction-atomicity"`
+	// ConflictRules are only used by the MySQL sink.
+	ConflictRules []*ConflictRule `toml:"conflict-rules" json:"conflict-rules,omitempty"`
+	// AsyncIndexDDL indicates whether to execute the DDLs adding or dropping
+	// non-unique indexes asynchronously downstream, it's only supported by
+	// the MySQL sink.
+	AsyncIndexDDL bool `toml:"async-index-ddl" json:"async-index-ddl"`
 }
 
 // DispatchRule represents partition rule for a table.
@@ -87,6 +94,60 @@ type ColumnSelector struct {
 	Columns []string `toml:"columns" json:"columns"`
 }
 
+const (
+	// ConflictPolicyUpstreamWins overwrites the downstream row.
+	ConflictPolicyUpstreamWins = "upstream-wins"
+	// ConflictPolicyDownstreamWins skips the row change if the downstream row
+	// is changed by others.
+	ConflictPolicyDownstreamWins = "downstream-wins"
+	// ConflictPolicyLastWriterWins applies the row change only if it's not older
+	// than the downstream row, which is decided by a timestamp column.
+	ConflictPolicyLastWriterWins = "last-writer-wins"
+	// ConflictPolicyRecord skips the conflicting row change like downstream-wins,
+	// and records it to a conflict table.
+	ConflictPolicyRecord = "record"
+)
+
+// ConflictRule represents the conflict policy of the tables matched by Matcher.
+// A row conflicts if the downstream row is changed by other writers, which
+// happens in bidirectional and multi-source replication.
+type ConflictRule struct {
+	Matcher []string `toml:"matcher" json:"matcher"`
+	Policy  string   `toml:"policy" json:"policy"`
+	// TimestampColumn is required by the last-writer-wins policy.
+	TimestampColumn string `toml:"timestamp-column" json:"timestamp-column"`
+	// ConflictTable is required by the record policy, in the form of `schema.table`.
+	ConflictTable string `toml:"conflict-table" json:"conflict-table"`
+}
+
+func (r *ConflictRule) validate() error {
+	if len(r.Matcher) == 0 {
+		return cerror.ErrSinkInvalidConfig.GenWithStack(
+			"matcher of conflict rule should not be empty")
+	}
+	switch r.Policy {
+	case ConflictPolicyUpstreamWins, ConflictPolicyDownstreamWins:
+	case ConflictPolicyLastWriterWins:
+		if r.TimestampColumn == "" {
+			return cerror.ErrSinkInvalidConfig.GenWithStack(
+				"timestamp-column is required by %s conflict policy", r.Policy)
+		}
+	case ConflictPolicyRecord:
+		schema, table, ok := strings.Cut(r.ConflictTable, ".")
+		if !ok || schema == "" || table == "" {
+			return cerror.ErrSinkInvalidConfig.GenWithStack(
+				"conflict-table should be in the form of schema.table "+
+					"for %s conflict policy, got %q", r.Policy, r.ConflictTable)
+		}
+	default:
+		return cerror.ErrSinkInvalidConfig.GenWithStack(
+			"unknown conflict policy %q, should be one of %s, %s, %s and %s", r.Policy,
+			ConflictPolicyUpstreamWins, ConflictPolicyDownstreamWins,
+			ConflictPolicyLastWriterWins, ConflictPolicyRecord)
+	}
+	return nil
+}
+
 func (s *SinkConfig) validateAndAdjust(sinkURI *url.URL, enableOldValue bool) error {
 	if err := s.applyParameter(sinkURI); err != nil {
 		return err
@@ -118,6 +179,28 @@ func (s *SinkConfig) validateAndAdjust(sinkURI *url.URL, enableOldValue bool) er
 		}
 	}
 
+	if len(s.ConflictRules) != 0 {
+		if sinkURI != nil && !sink.IsMySQLCompatibleScheme(sinkURI.Scheme) {
+			return cerror.ErrSinkInvalidConfig.GenWithStack(
+				"conflict rules are not supported by %s scheme", sinkURI.Scheme)
+		}
+		// The conflict policies need the old values to check the downstream rows.
+		if !enableOldValue {
+			return cerror.ErrSinkInvalidConfig.GenWithStack(
+				"conflict rules require old value to be enabled")
+		}
+		for _, rule := range s.ConflictRules {
+			if err := rule.validate(); err != nil {
+				return err
+			}
+		}
+	}
+
+	if s.AsyncIndexDDL && sinkURI != nil && !sink.IsMySQLCompatibleScheme(sinkURI.Scheme) {
+		return cerror.ErrSinkInvalidConfig.GenWithStack(
+			"async-index-ddl is not supported by %s scheme", sinkURI.Scheme)
+	}
+
 	return nil
 }
 
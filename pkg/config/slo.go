@@ -0,0 +1,60 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"net/url"
+
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+)
+
+// SLOConfig represents the replication lag SLO of a changefeed.
+type SLOConfig struct {
+	// MaxCheckpointLagInSec is the max lag of the checkpoint, 0 means no limit.
+	MaxCheckpointLagInSec int64 `toml:"max-checkpoint-lag-in-sec" json:"max-checkpoint-lag-in-sec"`
+	// MaxResolvedLagInSec is the max lag of the resolved ts, 0 means no limit.
+	MaxResolvedLagInSec int64 `toml:"max-resolved-lag-in-sec" json:"max-resolved-lag-in-sec"`
+	// WebhookURL is notified by POST requests when the SLO is breached or recovered.
+	// The breach state is persisted, so it lasts when the changefeed is stopped,
+	// and the recovered event is sent after the changefeed is resumed and
+	// catches up. No recovered event is sent if the changefeed is removed.
+	WebhookURL string `toml:"webhook-url" json:"webhook-url"`
+}
+
+// IsEnabled returns whether any lag limit is set.
+func (c *SLOConfig) IsEnabled() bool {
+	return c != nil && (c.MaxCheckpointLagInSec > 0 || c.MaxResolvedLagInSec > 0)
+}
+
+func (c *SLOConfig) validateAndAdjust() error {
+	if c.MaxCheckpointLagInSec < 0 {
+		return cerror.ErrInvalidChangefeedSLO.GenWithStackByArgs(
+			"max-checkpoint-lag-in-sec should not be negative")
+	}
+	if c.MaxResolvedLagInSec < 0 {
+		return cerror.ErrInvalidChangefeedSLO.GenWithStackByArgs(
+			"max-resolved-lag-in-sec should not be negative")
+	}
+	if c.WebhookURL != "" {
+		u, err := url.Parse(c.WebhookURL)
+		if err != nil {
+			return cerror.WrapError(cerror.ErrInvalidChangefeedSLO, err)
+		}
+		if u.Scheme != "http" && u.Scheme != "https" {
+			return cerror.ErrInvalidChangefeedSLO.GenWithStackByArgs(
+				"webhook-url should be a http or https url")
+		}
+	}
+	return nil
+}
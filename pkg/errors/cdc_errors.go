@@ -279,6 +279,14 @@ var (
 		"pulsar send message failed",
 		errors.RFCCodeText("CDC:ErrPulsarSendMessage"),
 	)
+	ErrPulsarProducerClosed = errors.Normalize(
+		"pulsar producer closed",
+		errors.RFCCodeText("CDC:ErrPulsarProducerClosed"),
+	)
+	ErrClaimCheckStorage = errors.Normalize(
+		"claim-check storage operation failed",
+		errors.RFCCodeText("CDC:ErrClaimCheckStorage"),
+	)
 	ErrRedoConfigInvalid = errors.Normalize(
 		"redo log config invalid",
 		errors.RFCCodeText("CDC:ErrRedoConfigInvalid"),
@@ -526,6 +534,22 @@ var (
 		"invalid key: %s",
 		errors.RFCCodeText("CDC:ErrInvalidEtcdKey"),
 	)
+	ErrInvalidMetadataBackup = errors.Normalize(
+		"invalid metadata backup: %s",
+		errors.RFCCodeText("CDC:ErrInvalidMetadataBackup"),
+	)
+	ErrCDCAPINotFound = errors.Normalize(
+		"cdc api %s is not found, the server may be older than the client",
+		errors.RFCCodeText("CDC:ErrCDCAPINotFound"),
+	)
+	ErrMetadataRestoreConflict = errors.Normalize(
+		"metadata of changefeed %s already exists in cluster %s",
+		errors.RFCCodeText("CDC:ErrMetadataRestoreConflict"),
+	)
+	ErrMetadataRestoreUpstreamConflict = errors.Normalize(
+		"upstream %d of namespace %s already exists in cluster %s with different info",
+		errors.RFCCodeText("CDC:ErrMetadataRestoreUpstreamConflict"),
+	)
 
 	// schema storage errors
 	ErrSchemaStorageUnresolved = errors.Normalize(
@@ -672,6 +696,30 @@ var (
 		"internal server error",
 		errors.RFCCodeText("CDC:ErrInternalServerError"),
 	)
+	ErrHTTPUnauthorized = errors.Normalize(
+		"unauthorized http request: %s",
+		errors.RFCCodeText("CDC:ErrHTTPUnauthorized"),
+	)
+	ErrHTTPPermissionDenied = errors.Normalize(
+		"user %s with role %s is not allowed to access %s %s",
+		errors.RFCCodeText("CDC:ErrHTTPPermissionDenied"),
+	)
+	ErrHTTPAuthConfig = errors.Normalize(
+		"invalid http auth config: %s",
+		errors.RFCCodeText("CDC:ErrHTTPAuthConfig"),
+	)
+	ErrInvalidChangefeedSLO = errors.Normalize(
+		"invalid changefeed slo config: %s",
+		errors.RFCCodeText("CDC:ErrInvalidChangefeedSLO"),
+	)
+	ErrInvalidBidirectionalConfig = errors.Normalize(
+		"invalid bidirectional replication config: %s",
+		errors.RFCCodeText("CDC:ErrInvalidBidirectionalConfig"),
+	)
+	ErrBidirectionalMarkTableNotFound = errors.Normalize(
+		"mark table %s of bidirectional replication is not found",
+		errors.RFCCodeText("CDC:ErrBidirectionalMarkTableNotFound"),
+	)
 	ErrOwnerSortDir = errors.Normalize(
 		"owner sort dir",
 		errors.RFCCodeText("CDC:ErrOwnerSortDir"),
@@ -237,6 +237,18 @@ var (
 		"the worker is in half-exited state",
 		errors.RFCCodeText("DFLOW:ErrWorkerHalfExit"),
 	)
+	ErrWorkerMigrated = errors.Normalize(
+		"worker exits to be migrated away from the draining executor %s",
+		errors.RFCCodeText("DFLOW:ErrWorkerMigrated"),
+	)
+	ErrWorkerRestartExhausted = errors.Normalize(
+		"worker %s has failed %d times, exceeding the retry policy of the job, last error: %s",
+		errors.RFCCodeText("DFLOW:ErrWorkerRestartExhausted"),
+	)
+	ErrWorkerTerminalError = errors.Normalize(
+		"worker %s failed with the terminal error code %s, error: %s",
+		errors.RFCCodeText("DFLOW:ErrWorkerTerminalError"),
+	)
 	ErrInvalidWorkerType = errors.Normalize(
 		"invalid worker type: %s",
 		errors.RFCCodeText("DFLOW:ErrInvalidWorkerType"),
@@ -467,6 +479,16 @@ var (
 		"local resource directory not writable",
 		errors.RFCCodeText("DFLOW:ErrLocalFileDirNotWritable"),
 	)
+	ErrS3StorageNotConfigured = errors.Normalize(
+		"s3 storage is not configured",
+		errors.RFCCodeText("DFLOW:ErrS3StorageNotConfigured"),
+	)
+
+	// cdc job related errors
+	ErrCDCJobConfigInvalid = errors.Normalize(
+		"cdc job config is invalid: %s",
+		errors.RFCCodeText("DFLOW:ErrCDCJobConfigInvalid"),
+	)
 
 	// cli related errors
 	ErrInvalidCliParameter = errors.Normalize(
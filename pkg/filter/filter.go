@@ -183,7 +183,7 @@ func (f *filter) ShouldDiscardDDL(ddlType timodel.ActionType) bool {
 // ShouldIgnoreTable returns true if the specified table should be ignored by this change feed.
 // NOTICE: Set `tbl` to an empty string to test against the whole database.
 func (f *filter) ShouldIgnoreTable(db, tbl string) bool {
-	if isSysSchema(db) {
+	if isSysSchema(db) || isBDRMarkTable(db, tbl) {
 		return true
 	}
 	return !f.tableFilter.MatchTable(db, tbl)
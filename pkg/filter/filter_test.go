@@ -35,6 +35,7 @@ func TestShouldUseDefaultRules(t *testing.T) {
 	require.False(t, filter.ShouldIgnoreTable("metric_schema", "query_duration"))
 	require.False(t, filter.ShouldIgnoreTable("sns", "user"))
 	require.False(t, filter.ShouldIgnoreTable("tidb_cdc", "repl_mark_a_a"))
+	require.True(t, filter.ShouldIgnoreTable("tidb_cdc", "bdr_mark_1"))
 }
 
 func TestShouldUseCustomRules(t *testing.T) {
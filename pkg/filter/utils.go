@@ -21,6 +21,7 @@ import (
 	timodel "github.com/pingcap/tidb/parser/model"
 	tifilter "github.com/pingcap/tidb/util/filter"
 	tfilter "github.com/pingcap/tidb/util/table-filter"
+	"github.com/pingcap/tiflow/pkg/bdr"
 	"github.com/pingcap/tiflow/pkg/config"
 	cerror "github.com/pingcap/tiflow/pkg/errors"
 )
@@ -30,6 +31,13 @@ func isSysSchema(db string) bool {
 	return tifilter.IsSystemSchema(db)
 }
 
+// isBDRMarkTable returns true if the given table is a mark table of the
+// bidirectional replication, the mark tables are never replicated as normal
+// tables.
+func isBDRMarkTable(db, tbl string) bool {
+	return bdr.IsMarkTable(db, tbl)
+}
+
 // VerifyTableRules checks the table filter rules in the configuration
 // and returns an invalid rule error if the verification fails,
 // otherwise it will return a table filter.
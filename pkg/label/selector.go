@@ -15,6 +15,7 @@ package label
 
 import (
 	"regexp"
+	"strings"
 
 	"github.com/pingcap/errors"
 	"github.com/pingcap/log"
@@ -51,6 +52,35 @@ type Selector struct {
 	regex atomic.Value // *regexp.Regexp
 }
 
+// ParseSelector parses a selector in the form of "key=target",
+// "key!=target" or "key=~target", where the target of "=~" is
+// a regular expression. The returned selector is validated.
+func ParseSelector(str string) (*Selector, error) {
+	var (
+		key, target string
+		op          Op
+	)
+	if idx := strings.Index(str, "!="); idx >= 0 {
+		key, target, op = str[:idx], str[idx+2:], OpNeq
+	} else if idx := strings.Index(str, "=~"); idx >= 0 {
+		key, target, op = str[:idx], str[idx+2:], OpRegex
+	} else if idx := strings.Index(str, "="); idx >= 0 {
+		key, target, op = str[:idx], str[idx+1:], OpEq
+	} else {
+		return nil, errors.Errorf("invalid selector: %s", str)
+	}
+
+	selector := &Selector{
+		Key:    Key(strings.TrimSpace(key)),
+		Target: strings.TrimSpace(target),
+		Op:     op,
+	}
+	if err := selector.Validate(); err != nil {
+		return nil, err
+	}
+	return selector, nil
+}
+
 // Validate returns whether the selector is valid.
 // Calling Matches on invalid selectors results in undefined behavior.
 func (s *Selector) Validate() error {
@@ -238,3 +238,57 @@ func TestSelectorValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestParseSelector(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		str      string
+		expected *Selector
+		errMsg   string
+	}{
+		{
+			str:      "tenant=1234",
+			expected: &Selector{Key: "tenant", Target: "1234", Op: OpEq},
+		},
+		{
+			str:      "tenant != 1234",
+			expected: &Selector{Key: "tenant", Target: "1234", Op: OpNeq},
+		},
+		{
+			str:      "tenant=~.*abc.*",
+			expected: &Selector{Key: "tenant", Target: ".*abc.*", Op: OpRegex},
+		},
+		{
+			str:      "tenant=",
+			expected: &Selector{Key: "tenant", Target: "", Op: OpEq},
+		},
+		{
+			str:    "tenant",
+			errMsg: "invalid selector: tenant",
+		},
+		{
+			str:    "#@$!@#=1234",
+			errMsg: "validate selector key: label string has wrong format: #@$!@#",
+		},
+		{
+			str:    "tenant=~)))",
+			errMsg: ")))",
+		},
+	}
+
+	for idx, tc := range cases {
+		tc := tc
+		t.Run(strconv.Itoa(idx), func(t *testing.T) {
+			selector, err := ParseSelector(tc.str)
+			if tc.errMsg != "" {
+				require.ErrorContains(t, err, tc.errMsg)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected.Key, selector.Key)
+			require.Equal(t, tc.expected.Target, selector.Target)
+			require.Equal(t, tc.expected.Op, selector.Op)
+		})
+	}
+}
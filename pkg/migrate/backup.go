@@ -0,0 +1,420 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package migrate
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/model"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/etcd"
+	clientV3 "go.etcd.io/etcd/client/v3"
+	"go.uber.org/zap"
+)
+
+// MetadataBackupVersion is the version of the metadata backup file format.
+const MetadataBackupVersion = 1
+
+// MetadataBackup is the metadata of a TiCDC cluster exported by
+// `cdc cli metadata backup`, it's saved as a JSON file.
+type MetadataBackup struct {
+	// Version is the version of the backup file format.
+	Version int `json:"version"`
+	// MetaVersion is the meta version of the cluster when it's backed up.
+	MetaVersion int       `json:"meta-version"`
+	ClusterID   string    `json:"cluster-id"`
+	BackupTime  time.Time `json:"backup-time"`
+
+	Changefeeds []*ChangefeedBackup `json:"changefeeds"`
+	Upstreams   []*UpstreamBackup   `json:"upstreams"`
+}
+
+// ChangefeedBackup is the backup of a changefeed.
+type ChangefeedBackup struct {
+	Namespace string                  `json:"namespace"`
+	ID        string                  `json:"id"`
+	Info      *model.ChangeFeedInfo   `json:"info"`
+	Status    *model.ChangeFeedStatus `json:"status,omitempty"`
+}
+
+// UpstreamBackup is the backup of an upstream.
+type UpstreamBackup struct {
+	Namespace string              `json:"namespace"`
+	Info      *model.UpstreamInfo `json:"info"`
+}
+
+// RestoreOptions is the options of restoring a metadata backup.
+type RestoreOptions struct {
+	// ClusterID is the cluster to restore the metadata to,
+	// the cluster ID of the backup is used if it's empty.
+	ClusterID string
+	// SinkURIRewrites rewrites the sink URIs of the changefeeds,
+	// the first rule whose From is a prefix of the sink URI is applied.
+	SinkURIRewrites []SinkURIRewrite
+	// Force overwrites the existing changefeeds and upstreams in the cluster.
+	Force bool
+}
+
+// SinkURIRewrite replaces the prefix From of a sink URI with To.
+type SinkURIRewrite struct {
+	From string
+	To   string
+}
+
+// GetMetaVersion returns the meta version of the cluster,
+// it returns -1 if the cluster has no meta version.
+func GetMetaVersion(ctx context.Context, cli *etcd.Client, clusterID string) (int, error) {
+	return getMetaVersion(ctx, cli, clusterID)
+}
+
+// ValidateMetaVersion checks whether the metadata of the meta version
+// can be used by the current TiCDC without migration.
+func ValidateMetaVersion(version int) error {
+	if version != cdcMetaVersion {
+		return cerror.ErrInvalidMetadataBackup.GenWithStackByArgs(
+			fmt.Sprintf("meta version %d mismatches the current meta version %d",
+				version, cdcMetaVersion))
+	}
+	return nil
+}
+
+// Validate checks whether the backup can be restored by the current TiCDC.
+func (b *MetadataBackup) Validate() error {
+	if b.Version != MetadataBackupVersion {
+		return cerror.ErrInvalidMetadataBackup.GenWithStackByArgs(
+			fmt.Sprintf("unsupported backup version %d", b.Version))
+	}
+	if err := ValidateMetaVersion(b.MetaVersion); err != nil {
+		return errors.Trace(err)
+	}
+	if b.ClusterID == "" {
+		return cerror.ErrInvalidMetadataBackup.GenWithStackByArgs("cluster id is empty")
+	}
+
+	upstreams := make(map[string]map[model.UpstreamID]struct{})
+	for _, up := range b.Upstreams {
+		if up.Info == nil {
+			return cerror.ErrInvalidMetadataBackup.GenWithStackByArgs(
+				fmt.Sprintf("upstream info of namespace %s is empty", up.Namespace))
+		}
+		if _, ok := upstreams[up.Namespace]; !ok {
+			upstreams[up.Namespace] = make(map[model.UpstreamID]struct{})
+		}
+		upstreams[up.Namespace][up.Info.ID] = struct{}{}
+	}
+
+	changefeeds := make(map[model.ChangeFeedID]struct{}, len(b.Changefeeds))
+	for _, cf := range b.Changefeeds {
+		id := model.ChangeFeedID{Namespace: cf.Namespace, ID: cf.ID}
+		if err := model.ValidateNamespace(cf.Namespace); err != nil {
+			return errors.Trace(err)
+		}
+		if err := model.ValidateChangefeedID(cf.ID); err != nil {
+			return errors.Trace(err)
+		}
+		if _, ok := changefeeds[id]; ok {
+			return cerror.ErrInvalidMetadataBackup.GenWithStackByArgs(
+				fmt.Sprintf("duplicated changefeed %s", id))
+		}
+		changefeeds[id] = struct{}{}
+		if cf.Info == nil {
+			return cerror.ErrInvalidMetadataBackup.GenWithStackByArgs(
+				fmt.Sprintf("info of changefeed %s is empty", id))
+		}
+		if _, ok := upstreams[cf.Namespace][cf.Info.UpstreamID]; !ok {
+			return cerror.ErrInvalidMetadataBackup.GenWithStackByArgs(
+				fmt.Sprintf("upstream %d of changefeed %s is not found",
+					cf.Info.UpstreamID, id))
+		}
+	}
+	return nil
+}
+
+// Backup exports the changefeed infos, statuses, upstream infos and
+// the meta version of the cluster.
+func Backup(ctx context.Context, cli *etcd.Client, clusterID string) (*MetadataBackup, error) {
+	metaVersion, err := getMetaVersion(ctx, cli, clusterID)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if metaVersion == noMetaVersion {
+		return nil, cerror.ErrInvalidMetadataBackup.GenWithStackByArgs(
+			fmt.Sprintf("cluster %s has no metadata", clusterID))
+	}
+	if err := ValidateMetaVersion(metaVersion); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	resp, err := cli.Get(ctx, etcd.BaseKey(clusterID)+"/", clientV3.WithPrefix())
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrPDEtcdAPIError, err)
+	}
+	backup := &MetadataBackup{
+		Version:     MetadataBackupVersion,
+		MetaVersion: metaVersion,
+		ClusterID:   clusterID,
+		BackupTime:  time.Now(),
+	}
+	changefeeds := make(map[model.ChangeFeedID]*ChangefeedBackup)
+	getChangefeed := func(id model.ChangeFeedID) *ChangefeedBackup {
+		cf, ok := changefeeds[id]
+		if !ok {
+			cf = &ChangefeedBackup{Namespace: id.Namespace, ID: id.ID}
+			changefeeds[id] = cf
+		}
+		return cf
+	}
+	for _, kv := range resp.Kvs {
+		key := &etcd.CDCKey{}
+		if err := key.Parse(clusterID, string(kv.Key)); err != nil {
+			log.Warn("skip unknown key when backup metadata",
+				zap.String("key", string(kv.Key)), zap.Error(err))
+			continue
+		}
+		switch key.Tp {
+		case etcd.CDCKeyTypeChangefeedInfo:
+			info := &model.ChangeFeedInfo{}
+			if err := info.Unmarshal(kv.Value); err != nil {
+				return nil, errors.Trace(err)
+			}
+			getChangefeed(key.ChangefeedID).Info = info
+		case etcd.CDCKeyTypeChangeFeedStatus:
+			status := &model.ChangeFeedStatus{}
+			if err := status.Unmarshal(kv.Value); err != nil {
+				return nil, errors.Trace(err)
+			}
+			getChangefeed(key.ChangefeedID).Status = status
+		case etcd.CDCKeyTypeUpStream:
+			info := &model.UpstreamInfo{}
+			if err := info.Unmarshal(kv.Value); err != nil {
+				return nil, errors.Trace(err)
+			}
+			backup.Upstreams = append(backup.Upstreams,
+				&UpstreamBackup{Namespace: key.Namespace, Info: info})
+		}
+	}
+
+	for id, cf := range changefeeds {
+		// The status may be left after the changefeed is removed.
+		if cf.Info == nil {
+			log.Warn("skip changefeed without info when backup metadata",
+				zap.String("namespace", id.Namespace),
+				zap.String("changefeed", id.ID))
+			continue
+		}
+		backup.Changefeeds = append(backup.Changefeeds, cf)
+	}
+	sort.Slice(backup.Changefeeds, func(i, j int) bool {
+		if backup.Changefeeds[i].Namespace != backup.Changefeeds[j].Namespace {
+			return backup.Changefeeds[i].Namespace < backup.Changefeeds[j].Namespace
+		}
+		return backup.Changefeeds[i].ID < backup.Changefeeds[j].ID
+	})
+	sort.Slice(backup.Upstreams, func(i, j int) bool {
+		if backup.Upstreams[i].Namespace != backup.Upstreams[j].Namespace {
+			return backup.Upstreams[i].Namespace < backup.Upstreams[j].Namespace
+		}
+		return backup.Upstreams[i].Info.ID < backup.Upstreams[j].Info.ID
+	})
+	return backup, nil
+}
+
+// Restore re-creates the changefeeds and upstreams of the backup, and returns
+// the keys written to etcd. All keys are written in a single etcd txn, so a
+// backup with more keys than the --max-txn-ops of etcd can't be restored.
+// It fails if any changefeed of the backup already exists in the cluster, or
+// any upstream exists with different info, unless opts.Force is set.
+func Restore(
+	ctx context.Context, cli *etcd.Client, backup *MetadataBackup, opts RestoreOptions,
+) ([]string, error) {
+	if err := backup.Validate(); err != nil {
+		return nil, errors.Trace(err)
+	}
+	clusterID := opts.ClusterID
+	if clusterID == "" {
+		clusterID = backup.ClusterID
+	}
+
+	metaVersion, err := getMetaVersion(ctx, cli, clusterID)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if metaVersion != noMetaVersion {
+		if err := ValidateMetaVersion(metaVersion); err != nil {
+			return nil, errors.Trace(err)
+		}
+	}
+
+	r := &restorer{cli: cli, clusterID: clusterID}
+	if metaVersion == noMetaVersion {
+		key := etcd.CDCKey{Tp: etcd.CDCKeyTypeMetaVersion, ClusterID: clusterID}
+		if _, err := r.check(ctx, key.String()); err != nil {
+			return nil, errors.Trace(err)
+		}
+		r.put(key.String(), strconv.Itoa(cdcMetaVersion))
+	}
+	for _, up := range backup.Upstreams {
+		key := etcd.CDCKey{
+			Tp:         etcd.CDCKeyTypeUpStream,
+			ClusterID:  clusterID,
+			UpstreamID: up.Info.ID,
+			Namespace:  up.Namespace,
+		}
+		value, err := up.Info.Marshal()
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		old, err := r.check(ctx, key.String())
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		// The same upstream may be shared by the existing changefeeds.
+		if old != nil && string(old) == string(value) {
+			continue
+		}
+		if old != nil && !opts.Force {
+			return nil, cerror.ErrMetadataRestoreUpstreamConflict.GenWithStackByArgs(
+				up.Info.ID, up.Namespace, clusterID)
+		}
+		r.put(key.String(), string(value))
+	}
+	for _, cf := range backup.Changefeeds {
+		if err := r.restoreChangefeed(ctx, cf, opts); err != nil {
+			return nil, errors.Trace(err)
+		}
+	}
+
+	resp, err := cli.Txn(ctx, r.cmps, r.ops, etcd.TxnEmptyOpsElse)
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrPDEtcdAPIError, err)
+	}
+	if !resp.Succeeded {
+		// The metadata is modified after it's checked.
+		return nil, cerror.ErrEtcdTryAgain.GenWithStackByArgs()
+	}
+	log.Info("metadata restored",
+		zap.String("clusterID", clusterID),
+		zap.String("backupClusterID", backup.ClusterID),
+		zap.Int("changefeeds", len(backup.Changefeeds)),
+		zap.Int("upstreams", len(backup.Upstreams)),
+		zap.Strings("keys", r.keys))
+	return r.keys, nil
+}
+
+// restorer collects the writes of restoring a backup into a single etcd txn.
+type restorer struct {
+	cli       *etcd.Client
+	clusterID string
+
+	cmps []clientV3.Cmp
+	ops  []clientV3.Op
+	// keys are the keys written by ops.
+	keys []string
+}
+
+// check returns the value of the key, or nil if it doesn't exist. The key
+// is compared in the txn to make sure it's not modified after checking.
+func (r *restorer) check(ctx context.Context, key string) ([]byte, error) {
+	resp, err := r.cli.Get(ctx, key)
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrPDEtcdAPIError, err)
+	}
+	if resp.Count == 0 {
+		r.cmps = append(r.cmps, clientV3.Compare(clientV3.ModRevision(key), "=", 0))
+		return nil, nil
+	}
+	kv := resp.Kvs[0]
+	r.cmps = append(r.cmps, clientV3.Compare(clientV3.ModRevision(key), "=", kv.ModRevision))
+	return kv.Value, nil
+}
+
+func (r *restorer) put(key, value string) {
+	r.ops = append(r.ops, clientV3.OpPut(key, value))
+	r.keys = append(r.keys, key)
+}
+
+func (r *restorer) delete(key string) {
+	r.ops = append(r.ops, clientV3.OpDelete(key))
+	r.keys = append(r.keys, key)
+}
+
+func (r *restorer) restoreChangefeed(
+	ctx context.Context, cf *ChangefeedBackup, opts RestoreOptions,
+) error {
+	id := model.ChangeFeedID{Namespace: cf.Namespace, ID: cf.ID}
+	info, err := cf.Info.Clone()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	info.SinkURI = rewriteSinkURI(info.SinkURI, opts.SinkURIRewrites)
+	value, err := info.Marshal()
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	keys := changefeedKeys(r.clusterID, id)
+	exists := false
+	for _, key := range keys {
+		old, err := r.check(ctx, key)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		exists = exists || old != nil
+	}
+	if exists {
+		if !opts.Force {
+			return cerror.ErrMetadataRestoreConflict.GenWithStackByArgs(id, r.clusterID)
+		}
+		log.Warn("overwrite the existing changefeed when restore metadata",
+			zap.String("clusterID", r.clusterID),
+			zap.String("namespace", id.Namespace),
+			zap.String("changefeed", id.ID))
+	}
+	r.put(keys[0], value)
+	if cf.Status != nil {
+		status, err := cf.Status.Marshal()
+		if err != nil {
+			return errors.Trace(err)
+		}
+		r.put(keys[1], status)
+	} else if exists {
+		// Don't leave the status of the overwritten changefeed.
+		r.delete(keys[1])
+	}
+	return nil
+}
+
+// changefeedKeys returns the info key and status key of the changefeed.
+func changefeedKeys(clusterID string, id model.ChangeFeedID) []string {
+	return []string{
+		etcd.GetEtcdKeyChangeFeedInfo(clusterID, id),
+		etcd.GetEtcdKeyJob(clusterID, id),
+	}
+}
+
+func rewriteSinkURI(sinkURI string, rewrites []SinkURIRewrite) string {
+	for _, rewrite := range rewrites {
+		if strings.HasPrefix(sinkURI, rewrite.From) {
+			return rewrite.To + sinkURI[len(rewrite.From):]
+		}
+	}
+	return sinkURI
+}
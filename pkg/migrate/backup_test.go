@@ -0,0 +1,211 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package migrate
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/pingcap/tiflow/cdc/model"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/etcd"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+func TestBackupAndRestore(t *testing.T) {
+	s := &etcd.Tester{}
+	s.SetUpTest(t)
+	defer s.TearDownTest(t)
+	cli, err := clientv3.New(clientv3.Config{
+		Endpoints:   []string{s.ClientURL.String()},
+		DialTimeout: 3 * time.Second,
+	})
+	require.NoError(t, err)
+	defer cli.Close()
+	etcdCli := etcd.Wrap(cli, make(map[string]prometheus.Counter))
+	ctx := context.Background()
+
+	// Metadata is required to backup.
+	_, err = Backup(ctx, etcdCli, "default")
+	require.ErrorIs(t, err, cerror.ErrInvalidMetadataBackup)
+
+	put := func(key etcd.CDCKey, value string) {
+		_, err := cli.Put(ctx, key.String(), value)
+		require.NoError(t, err)
+	}
+	put(etcd.CDCKey{Tp: etcd.CDCKeyTypeMetaVersion, ClusterID: "default"},
+		strconv.Itoa(cdcMetaVersion))
+	up := &model.UpstreamInfo{ID: 1, PDEndpoints: "http://127.0.0.1:2379"}
+	upValue, err := up.Marshal()
+	require.NoError(t, err)
+	put(etcd.CDCKey{
+		Tp: etcd.CDCKeyTypeUpStream, ClusterID: "default",
+		UpstreamID: 1, Namespace: model.DefaultNamespace,
+	}, string(upValue))
+	for _, id := range []string{"cf2", "cf1"} {
+		cfID := model.DefaultChangeFeedID(id)
+		info := &model.ChangeFeedInfo{
+			UpstreamID: 1, Namespace: cfID.Namespace, ID: cfID.ID,
+			SinkURI: "mysql://root@127.0.0.1:3306/", StartTs: 1, State: model.StateNormal,
+		}
+		infoValue, err := info.Marshal()
+		require.NoError(t, err)
+		put(etcd.CDCKey{
+			Tp: etcd.CDCKeyTypeChangefeedInfo, ClusterID: "default", ChangefeedID: cfID,
+		}, infoValue)
+		status := &model.ChangeFeedStatus{CheckpointTs: 10}
+		statusValue, err := status.Marshal()
+		require.NoError(t, err)
+		put(etcd.CDCKey{
+			Tp: etcd.CDCKeyTypeChangeFeedStatus, ClusterID: "default", ChangefeedID: cfID,
+		}, statusValue)
+	}
+
+	backup, err := Backup(ctx, etcdCli, "default")
+	require.NoError(t, err)
+	require.Equal(t, MetadataBackupVersion, backup.Version)
+	require.Equal(t, cdcMetaVersion, backup.MetaVersion)
+	require.Len(t, backup.Upstreams, 1)
+	require.Len(t, backup.Changefeeds, 2)
+	require.Equal(t, "cf1", backup.Changefeeds[0].ID)
+	require.Equal(t, "cf2", backup.Changefeeds[1].ID)
+	require.Equal(t, uint64(10), backup.Changefeeds[0].Status.CheckpointTs)
+
+	// The backup is saved as a JSON file.
+	data, err := json.Marshal(backup)
+	require.NoError(t, err)
+	backup = &MetadataBackup{}
+	require.NoError(t, json.Unmarshal(data, backup))
+
+	// Restore to the same cluster conflicts with the existing changefeeds.
+	_, err = Restore(ctx, etcdCli, backup, RestoreOptions{})
+	require.ErrorIs(t, err, cerror.ErrMetadataRestoreConflict)
+
+	keys, err := Restore(ctx, etcdCli, backup, RestoreOptions{
+		ClusterID: "new",
+		SinkURIRewrites: []SinkURIRewrite{{
+			From: "mysql://root@127.0.0.1:3306",
+			To:   "mysql://root@127.0.0.1:4000",
+		}},
+	})
+	require.NoError(t, err)
+	metaVersionKey := etcd.CDCKey{Tp: etcd.CDCKeyTypeMetaVersion, ClusterID: "new"}
+	upstreamKey := etcd.CDCKey{
+		Tp: etcd.CDCKeyTypeUpStream, ClusterID: "new",
+		UpstreamID: 1, Namespace: model.DefaultNamespace,
+	}
+	require.Equal(t, []string{
+		metaVersionKey.String(),
+		upstreamKey.String(),
+		etcd.GetEtcdKeyChangeFeedInfo("new", model.DefaultChangeFeedID("cf1")),
+		etcd.GetEtcdKeyJob("new", model.DefaultChangeFeedID("cf1")),
+		etcd.GetEtcdKeyChangeFeedInfo("new", model.DefaultChangeFeedID("cf2")),
+		etcd.GetEtcdKeyJob("new", model.DefaultChangeFeedID("cf2")),
+	}, keys)
+	version, err := GetMetaVersion(ctx, etcdCli, "new")
+	require.NoError(t, err)
+	require.Equal(t, cdcMetaVersion, version)
+	restored, err := Backup(ctx, etcdCli, "new")
+	require.NoError(t, err)
+	require.Equal(t, backup.Upstreams, restored.Upstreams)
+	require.Len(t, restored.Changefeeds, 2)
+	for i, cf := range restored.Changefeeds {
+		require.Equal(t, "mysql://root@127.0.0.1:4000/", cf.Info.SinkURI)
+		require.Equal(t, backup.Changefeeds[i].Status, cf.Status)
+	}
+
+	// Nothing is written if any changefeed conflicts.
+	cf3 := *backup.Changefeeds[0]
+	cf3.ID = "cf3"
+	info3, err := cf3.Info.Clone()
+	require.NoError(t, err)
+	info3.ID = "cf3"
+	cf3.Info = info3
+	backup.Changefeeds = append(backup.Changefeeds, &cf3)
+	_, err = Restore(ctx, etcdCli, backup, RestoreOptions{ClusterID: "new"})
+	require.ErrorIs(t, err, cerror.ErrMetadataRestoreConflict)
+	resp, err := cli.Get(ctx, etcd.GetEtcdKeyChangeFeedInfo("new", model.DefaultChangeFeedID("cf3")))
+	require.NoError(t, err)
+	require.Zero(t, resp.Count)
+
+	// The upstream with different info conflicts too.
+	upstreams := backup.Upstreams
+	backup.Upstreams = []*UpstreamBackup{{
+		Namespace: model.DefaultNamespace,
+		Info:      &model.UpstreamInfo{ID: 1, PDEndpoints: "http://127.0.0.1:2479"},
+	}}
+	backup.Changefeeds = backup.Changefeeds[2:]
+	_, err = Restore(ctx, etcdCli, backup, RestoreOptions{ClusterID: "new"})
+	require.ErrorIs(t, err, cerror.ErrMetadataRestoreUpstreamConflict)
+	// The same upstream is not written again.
+	backup.Upstreams = upstreams
+	keys, err = Restore(ctx, etcdCli, backup, RestoreOptions{ClusterID: "new"})
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		etcd.GetEtcdKeyChangeFeedInfo("new", model.DefaultChangeFeedID("cf3")),
+		etcd.GetEtcdKeyJob("new", model.DefaultChangeFeedID("cf3")),
+	}, keys)
+
+	// The existing changefeeds are overwritten with force.
+	backup.Changefeeds[0].Status = nil
+	keys, err = Restore(ctx, etcdCli, backup, RestoreOptions{ClusterID: "new", Force: true})
+	require.NoError(t, err)
+	require.Len(t, keys, 2)
+	resp, err = cli.Get(ctx, etcd.GetEtcdKeyJob("new", model.DefaultChangeFeedID("cf3")))
+	require.NoError(t, err)
+	require.Zero(t, resp.Count)
+}
+
+func TestValidateBackup(t *testing.T) {
+	t.Parallel()
+
+	newBackup := func() *MetadataBackup {
+		return &MetadataBackup{
+			Version:     MetadataBackupVersion,
+			MetaVersion: cdcMetaVersion,
+			ClusterID:   "default",
+			Changefeeds: []*ChangefeedBackup{{
+				Namespace: model.DefaultNamespace,
+				ID:        "cf1",
+				Info:      &model.ChangeFeedInfo{UpstreamID: 1},
+			}},
+			Upstreams: []*UpstreamBackup{{
+				Namespace: model.DefaultNamespace,
+				Info:      &model.UpstreamInfo{ID: 1},
+			}},
+		}
+	}
+	require.NoError(t, newBackup().Validate())
+
+	for _, mutate := range []func(b *MetadataBackup){
+		func(b *MetadataBackup) { b.Version = MetadataBackupVersion + 1 },
+		func(b *MetadataBackup) { b.MetaVersion = cdcMetaVersion - 1 },
+		func(b *MetadataBackup) { b.ClusterID = "" },
+		func(b *MetadataBackup) { b.Changefeeds[0].Info = nil },
+		func(b *MetadataBackup) { b.Changefeeds[0].Info.UpstreamID = 2 },
+		func(b *MetadataBackup) { b.Changefeeds = append(b.Changefeeds, b.Changefeeds[0]) },
+	} {
+		b := newBackup()
+		mutate(b)
+		require.ErrorIs(t, b.Validate(), cerror.ErrInvalidMetadataBackup)
+	}
+	b := newBackup()
+	b.Changefeeds[0].ID = "invalid id"
+	require.Error(t, b.Validate())
+}
@@ -0,0 +1,43 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package security
+
+import "encoding/base64"
+
+// HTTPAuth holds the credentials to authenticate the requests to the HTTP API
+// of a TiCDC server. The bearer token is used if both are specified.
+type HTTPAuth struct {
+	// Token is a static bearer token.
+	Token string
+	// User and Password are used by the basic authentication.
+	User     string
+	Password string
+}
+
+// IsEmpty checks whether no credential is specified.
+func (a *HTTPAuth) IsEmpty() bool {
+	return a == nil || (a.Token == "" && a.User == "")
+}
+
+// AuthorizationHeader returns the value of the Authorization header,
+// it's empty if no credential is specified.
+func (a *HTTPAuth) AuthorizationHeader() string {
+	if a.IsEmpty() {
+		return ""
+	}
+	if a.Token != "" {
+		return "Bearer " + a.Token
+	}
+	return "Basic " + base64.StdEncoding.EncodeToString([]byte(a.User+":"+a.Password))
+}
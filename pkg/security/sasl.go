@@ -14,6 +14,7 @@
 package security
 
 import (
+	"net/url"
 	"strings"
 
 	"github.com/Shopify/sarama"
@@ -35,6 +36,8 @@ const (
 	SCRAM512Mechanism SASLMechanism = sarama.SASLTypeSCRAMSHA512
 	// GSSAPIMechanism means the SASL mechanism is GSSAPI.
 	GSSAPIMechanism SASLMechanism = sarama.SASLTypeGSSAPI
+	// OAuthMechanism means the SASL mechanism is OAUTHBEARER.
+	OAuthMechanism SASLMechanism = sarama.SASLTypeOAuth
 )
 
 // SASLMechanismFromString converts the string to SASL mechanism.
@@ -48,6 +51,8 @@ func SASLMechanismFromString(s string) (SASLMechanism, error) {
 		return SCRAM512Mechanism, nil
 	case "gssapi":
 		return GSSAPIMechanism, nil
+	case "oauthbearer":
+		return OAuthMechanism, nil
 	default:
 		return UnknownMechanism, errors.Errorf("unknown %s SASL mechanism", s)
 	}
@@ -59,6 +64,7 @@ type SASL struct {
 	SASLPassword  string        `toml:"sasl-password" json:"sasl-password"`
 	SASLMechanism SASLMechanism `toml:"sasl-mechanism" json:"sasl-mechanism"`
 	GSSAPI        GSSAPI        `toml:"sasl-gssapi" json:"sasl-gssapi"`
+	OAuth2        OAuth2        `toml:"sasl-oauth" json:"sasl-oauth"`
 }
 
 // GSSAPIAuthType defines the type of GSSAPI authentication.
@@ -96,3 +102,34 @@ type GSSAPI struct {
 	Realm              string         `toml:"sasl-gssapi-realm" json:"sasl-gssapi-realm"`
 	DisablePAFXFAST    bool           `toml:"sasl-gssapi-disable-pafxfast" json:"sasl-gssapi-disable-pafxfast"`
 }
+
+// OAuth2 holds necessary path parameter to support sasl-oauthbearer
+// with the OAuth 2.0 client credentials grant.
+type OAuth2 struct {
+	// TokenURL is the endpoint to request access tokens.
+	TokenURL string `toml:"sasl-oauth-token-url" json:"sasl-oauth-token-url"`
+	// ClientID is the client identifier registered in the authorization server.
+	ClientID string `toml:"sasl-oauth-client-id" json:"sasl-oauth-client-id"`
+	// ClientSecretFile is the path of the file which contains the client secret,
+	// it's read every time a new token is requested, so the secret can be rotated.
+	ClientSecretFile string `toml:"sasl-oauth-client-secret-file" json:"sasl-oauth-client-secret-file"`
+	// Scopes is the scopes to request.
+	Scopes []string `toml:"sasl-oauth-scopes" json:"sasl-oauth-scopes"`
+}
+
+// Validate validates the parameters of OAuth2.
+func (o *OAuth2) Validate() error {
+	if o.TokenURL == "" {
+		return errors.New("OAuth2 token url is not specified")
+	}
+	if _, err := url.Parse(o.TokenURL); err != nil {
+		return errors.Annotate(err, "invalid OAuth2 token url")
+	}
+	if o.ClientID == "" {
+		return errors.New("OAuth2 client id is not specified")
+	}
+	if o.ClientSecretFile == "" {
+		return errors.New("OAuth2 client secret file is not specified")
+	}
+	return nil
+}
@@ -74,6 +74,16 @@ func TestSASLMechanismFromString(t *testing.T) {
 			s:                 "GSSAPI",
 			expectedMechanism: "GSSAPI",
 		},
+		{
+			name:              "lower case oauthbearer mechanism",
+			s:                 "oauthbearer",
+			expectedMechanism: "OAUTHBEARER",
+		},
+		{
+			name:              "upper case OAUTHBEARER mechanism",
+			s:                 "OAUTHBEARER",
+			expectedMechanism: "OAUTHBEARER",
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
@@ -142,3 +152,16 @@ func TestAuthTypeFromString(t *testing.T) {
 		})
 	}
 }
+
+func TestOAuth2Validate(t *testing.T) {
+	t.Parallel()
+
+	oauth2 := &OAuth2{}
+	require.Regexp(t, "token url is not specified", oauth2.Validate())
+	oauth2.TokenURL = "https://127.0.0.1/oauth2/token"
+	require.Regexp(t, "client id is not specified", oauth2.Validate())
+	oauth2.ClientID = "ticdc"
+	require.Regexp(t, "client secret file is not specified", oauth2.Validate())
+	oauth2.ClientSecretFile = "/path/to/secret"
+	require.NoError(t, oauth2.Validate())
+}
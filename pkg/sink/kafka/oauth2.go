@@ -0,0 +1,92 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kafka
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	"github.com/Shopify/sarama"
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/pkg/security"
+	"go.uber.org/zap"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+// tokenProvider is a sarama.AccessTokenProvider which gets access tokens by
+// the OAuth 2.0 client credentials grant. The token is cached and refreshed
+// automatically when it's about to expire.
+type tokenProvider struct {
+	tokenSource oauth2.TokenSource
+}
+
+// NewTokenProvider creates a new sarama.AccessTokenProvider for SASL/OAUTHBEARER.
+func NewTokenProvider(
+	ctx context.Context, o *security.OAuth2,
+) (sarama.AccessTokenProvider, error) {
+	if err := o.Validate(); err != nil {
+		return nil, errors.Trace(err)
+	}
+	src := &clientCredentialsTokenSource{ctx: ctx, config: *o}
+	return &tokenProvider{
+		tokenSource: oauth2.ReuseTokenSource(nil, src),
+	}, nil
+}
+
+// Token implements sarama.AccessTokenProvider.
+func (t *tokenProvider) Token() (*sarama.AccessToken, error) {
+	token, err := t.tokenSource.Token()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &sarama.AccessToken{Token: token.AccessToken}, nil
+}
+
+// clientCredentialsTokenSource requests a new token from the token endpoint
+// every time it's called, the client secret is read from the secret file
+// before each request to support secret rotation.
+type clientCredentialsTokenSource struct {
+	ctx    context.Context
+	config security.OAuth2
+}
+
+// Token implements oauth2.TokenSource.
+func (s *clientCredentialsTokenSource) Token() (*oauth2.Token, error) {
+	secret, err := os.ReadFile(s.config.ClientSecretFile)
+	if err != nil {
+		return nil, errors.Annotate(err, "read OAuth2 client secret file failed")
+	}
+	cfg := &clientcredentials.Config{
+		ClientID:     s.config.ClientID,
+		ClientSecret: strings.TrimSpace(string(secret)),
+		TokenURL:     s.config.TokenURL,
+		Scopes:       s.config.Scopes,
+	}
+	token, err := cfg.Token(s.ctx)
+	if err != nil {
+		log.Warn("request OAuth2 token failed",
+			zap.String("tokenURL", s.config.TokenURL),
+			zap.String("clientID", s.config.ClientID),
+			zap.Error(err))
+		return nil, errors.Trace(err)
+	}
+	log.Info("OAuth2 token refreshed",
+		zap.String("tokenURL", s.config.TokenURL),
+		zap.String("clientID", s.config.ClientID),
+		zap.Time("expiry", token.Expiry))
+	return token, nil
+}
@@ -0,0 +1,96 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pingcap/tiflow/pkg/security"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
+)
+
+func TestTokenProvider(t *testing.T) {
+	t.Parallel()
+
+	var (
+		requests   atomic.Int32
+		lastSecret atomic.String
+		// expiresIn is the lifetime of the issued tokens in seconds, tokens
+		// expire in less than 10s are considered expired by oauth2.
+		expiresIn atomic.Int32
+	)
+	expiresIn.Store(3600)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		require.Equal(t, "client_credentials", r.PostForm.Get("grant_type"))
+		require.Equal(t, "kafka produce", r.PostForm.Get("scope"))
+		_, secret, ok := r.BasicAuth()
+		require.True(t, ok)
+		lastSecret.Store(secret)
+		n := requests.Inc()
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"access_token":"token-%d","token_type":"bearer","expires_in":%d}`,
+			n, expiresIn.Load())
+	}))
+	defer server.Close()
+
+	secretFile := filepath.Join(t.TempDir(), "secret")
+	require.NoError(t, os.WriteFile(secretFile, []byte("secret-1\n"), 0o600))
+	o := &security.OAuth2{
+		TokenURL:         server.URL,
+		ClientID:         "ticdc",
+		ClientSecretFile: secretFile,
+		Scopes:           []string{"kafka", "produce"},
+	}
+
+	_, err := NewTokenProvider(context.Background(), &security.OAuth2{})
+	require.Regexp(t, "token url is not specified", err)
+
+	provider, err := NewTokenProvider(context.Background(), o)
+	require.NoError(t, err)
+	token, err := provider.Token()
+	require.NoError(t, err)
+	require.Equal(t, "token-1", token.Token)
+	require.Equal(t, "secret-1", lastSecret.Load())
+	// The token is cached until it's expired.
+	token, err = provider.Token()
+	require.NoError(t, err)
+	require.Equal(t, "token-1", token.Token)
+	require.Equal(t, int32(1), requests.Load())
+
+	// Issue tokens which are expired immediately, the rotated secret is used.
+	provider, err = NewTokenProvider(context.Background(), o)
+	require.NoError(t, err)
+	expiresIn.Store(1)
+	require.NoError(t, os.WriteFile(secretFile, []byte("secret-2"), 0o600))
+	token, err = provider.Token()
+	require.NoError(t, err)
+	require.Equal(t, "token-2", token.Token)
+	require.Equal(t, "secret-2", lastSecret.Load())
+	token, err = provider.Token()
+	require.NoError(t, err)
+	require.Equal(t, "token-3", token.Token)
+
+	// Fail to read the secret file.
+	require.NoError(t, os.Remove(secretFile))
+	_, err = provider.Token()
+	require.Regexp(t, "read OAuth2 client secret file failed", err)
+}
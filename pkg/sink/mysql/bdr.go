@@ -0,0 +1,75 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mysql
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/bdr"
+	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// BDRMarker generates the mark rows of the bidirectional replication, which
+// tag the transactions written by the changefeed.
+type BDRMarker struct {
+	replicaID uint64
+}
+
+// NewBDRMarker creates a BDRMarker, it returns nil if the bidirectional
+// replication is not enabled.
+func NewBDRMarker(cfg *config.ReplicaConfig) *BDRMarker {
+	if !cfg.Bidirectional.IsEnabled() {
+		return nil
+	}
+	return &BDRMarker{replicaID: cfg.Bidirectional.ReplicaID}
+}
+
+// CreateMarkTable creates the mark table of the changefeed downstream.
+func (m *BDRMarker) CreateMarkTable(ctx context.Context, db *sql.DB) error {
+	if _, err := db.ExecContext(ctx, bdr.GenCreateMarkSchemaDDL()); err != nil {
+		return cerror.WrapError(cerror.ErrMySQLTxnError, err)
+	}
+	if _, err := db.ExecContext(ctx, bdr.GenCreateMarkTableDDL(m.replicaID)); err != nil {
+		return cerror.WrapError(cerror.ErrMySQLTxnError, err)
+	}
+	log.Info("bidirectional replication mark table created",
+		zap.Stringer("table", bdr.MarkTableName(m.replicaID)))
+	return nil
+}
+
+// GenMarkDMLs returns the DMLs upserting a mark row for each of the tables,
+// they must be executed in the same transaction as the rows of the tables.
+// bucket is the index of the sink worker.
+func (m *BDRMarker) GenMarkDMLs(
+	tables []*model.TableName, bucket int,
+) ([]string, [][]interface{}) {
+	sqls := make([]string, 0, 1)
+	values := make([][]interface{}, 0, 1)
+	seen := make(map[model.TableName]struct{}, 1)
+	for _, table := range tables {
+		if _, ok := seen[*table]; ok {
+			continue
+		}
+		seen[*table] = struct{}{}
+		query, args := bdr.GenMarkDML(m.replicaID, table, bucket)
+		sqls = append(sqls, query)
+		values = append(values, args)
+	}
+	return sqls, values
+}
@@ -0,0 +1,53 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mysql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBDRMarker(t *testing.T) {
+	t.Parallel()
+
+	require.Nil(t, NewBDRMarker(config.GetDefaultReplicaConfig()))
+
+	cfg := config.GetDefaultReplicaConfig()
+	cfg.Bidirectional = &config.BidirectionalConfig{
+		Enable: true, ReplicaID: 1, FilterReplicaIDs: []uint64{2},
+	}
+	m := NewBDRMarker(cfg)
+	t1 := &model.TableName{Schema: "test", Table: "t1"}
+	t2 := &model.TableName{Schema: "test", Table: "t2"}
+	sqls, values := m.GenMarkDMLs([]*model.TableName{t1, t2, {Schema: "test", Table: "t1"}}, 3)
+	require.Len(t, sqls, 2)
+	require.Len(t, values, 2)
+	require.Contains(t, sqls[0], "INSERT INTO `tidb_cdc`.`bdr_mark_1`")
+	require.NotEqual(t, values[0], values[1])
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	mock.ExpectExec("CREATE DATABASE IF NOT EXISTS `tidb_cdc`").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("CREATE TABLE IF NOT EXISTS `tidb_cdc`.`bdr_mark_1`").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	require.NoError(t, m.CreateMarkTable(context.Background(), db))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,231 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/pingcap/log"
+	"github.com/pingcap/tidb/parser/charset"
+	timodel "github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/types"
+	filter "github.com/pingcap/tidb/util/table-filter"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/quotes"
+	"github.com/pingcap/tiflow/pkg/sqlmodel"
+	"go.uber.org/zap"
+)
+
+// ConflictResolver generates the DMLs of the tables matched by the conflict
+// rules of a changefeed, the DMLs of other tables are not affected.
+type ConflictResolver struct {
+	rules []struct {
+		resolution *sqlmodel.ConflictResolution
+		filter.Filter
+	}
+	conflictTables []*model.TableName
+}
+
+// NewConflictResolver creates a ConflictResolver, it returns nil if no
+// conflict rules are configured.
+func NewConflictResolver(cfg *config.ReplicaConfig) (*ConflictResolver, error) {
+	if cfg.Sink == nil || len(cfg.Sink.ConflictRules) == 0 {
+		return nil, nil
+	}
+	r := &ConflictResolver{}
+	seen := make(map[model.TableName]struct{})
+	for _, rule := range cfg.Sink.ConflictRules {
+		f, err := filter.Parse(rule.Matcher)
+		if err != nil {
+			return nil, cerror.WrapError(cerror.ErrFilterRuleInvalid, err, rule.Matcher)
+		}
+		if !cfg.CaseSensitive {
+			f = filter.CaseInsensitive(f)
+		}
+		res := &sqlmodel.ConflictResolution{}
+		switch rule.Policy {
+		case config.ConflictPolicyUpstreamWins:
+			res.Policy = sqlmodel.ConflictUpstreamWins
+		case config.ConflictPolicyDownstreamWins:
+			res.Policy = sqlmodel.ConflictDownstreamWins
+		case config.ConflictPolicyLastWriterWins:
+			res.Policy = sqlmodel.ConflictLastWriterWins
+			res.TimestampColumn = rule.TimestampColumn
+		case config.ConflictPolicyRecord:
+			res.Policy = sqlmodel.ConflictRecord
+			schema, table, _ := strings.Cut(rule.ConflictTable, ".")
+			res.ConflictTable = &model.TableName{Schema: schema, Table: table}
+			if _, ok := seen[*res.ConflictTable]; !ok {
+				seen[*res.ConflictTable] = struct{}{}
+				r.conflictTables = append(r.conflictTables, res.ConflictTable)
+			}
+		default:
+			return nil, cerror.ErrSinkInvalidConfig.GenWithStack(
+				"unknown conflict policy %q", rule.Policy)
+		}
+		r.rules = append(r.rules, struct {
+			resolution *sqlmodel.ConflictResolution
+			filter.Filter
+		}{resolution: res, Filter: f})
+	}
+	return r, nil
+}
+
+// CreateConflictTables creates the conflict tables used by the record policy.
+func (r *ConflictResolver) CreateConflictTables(ctx context.Context, db *sql.DB) error {
+	for _, table := range r.conflictTables {
+		_, err := db.ExecContext(ctx, "CREATE DATABASE IF NOT EXISTS "+quotes.QuoteName(table.Schema))
+		if err != nil {
+			return cerror.WrapError(cerror.ErrMySQLTxnError, err)
+		}
+		_, err = db.ExecContext(ctx, sqlmodel.GenConflictTableDDL(table))
+		if err != nil {
+			return cerror.WrapError(cerror.ErrMySQLTxnError, err)
+		}
+		log.Info("conflict table created", zap.Stringer("table", table))
+	}
+	return nil
+}
+
+// Match returns the conflict resolution of the table, it returns nil if the
+// table doesn't match any conflict rule.
+func (r *ConflictResolver) Match(table *model.TableName) *sqlmodel.ConflictResolution {
+	for _, rule := range r.rules {
+		if rule.MatchTable(table.Schema, table.Table) {
+			return rule.resolution
+		}
+	}
+	return nil
+}
+
+// PrepareDMLs generates the DMLs of the row by the conflict resolution.
+func (r *ConflictResolver) PrepareDMLs(
+	row *model.RowChangedEvent, res *sqlmodel.ConflictResolution,
+) ([]string, [][]interface{}, error) {
+	change, err := newRowChange(row, res)
+	if err != nil {
+		return nil, nil, err
+	}
+	sqls, values := change.GenSQLsWithConflictResolution(res, row.CommitTs)
+	return sqls, values, nil
+}
+
+// newRowChange converts a row changed event to a sqlmodel.RowChange. The table
+// info is built by the columns of the event, the handle key columns are
+// treated as the primary key.
+func newRowChange(
+	row *model.RowChangedEvent, res *sqlmodel.ConflictResolution,
+) (*sqlmodel.RowChange, error) {
+	n := len(row.Columns)
+	if len(row.PreColumns) > n {
+		n = len(row.PreColumns)
+	}
+	tableInfo := &timodel.TableInfo{
+		Name:  timodel.NewCIStr(row.Table.Table),
+		State: timodel.StatePublic,
+	}
+	var preValues, postValues []interface{}
+	if len(row.PreColumns) != 0 {
+		preValues = make([]interface{}, 0, n)
+	}
+	if len(row.Columns) != 0 {
+		postValues = make([]interface{}, 0, n)
+	}
+	var keyColumns []*timodel.IndexColumn
+	foundTsColumn := false
+	for i := 0; i < n; i++ {
+		var pre, post *model.Column
+		if i < len(row.PreColumns) {
+			pre = row.PreColumns[i]
+		}
+		if i < len(row.Columns) {
+			post = row.Columns[i]
+		}
+		col := post
+		if col == nil {
+			col = pre
+		}
+		if col == nil {
+			continue
+		}
+
+		colInfo := &timodel.ColumnInfo{
+			ID:        int64(len(tableInfo.Columns) + 1),
+			Name:      timodel.NewCIStr(col.Name),
+			Offset:    len(tableInfo.Columns),
+			FieldType: *types.NewFieldType(col.Type),
+			State:     timodel.StatePublic,
+		}
+		if col.Flag.IsGeneratedColumn() {
+			// The expression is not used, it only marks the column as generated.
+			colInfo.GeneratedExprString = col.Name
+		}
+		if col.Flag.IsHandleKey() {
+			colInfo.AddFlag(mysql.NotNullFlag)
+			keyColumns = append(keyColumns, &timodel.IndexColumn{
+				Name:   colInfo.Name,
+				Offset: colInfo.Offset,
+				Length: types.UnspecifiedLength,
+			})
+		}
+		if res.Policy == sqlmodel.ConflictLastWriterWins &&
+			strings.EqualFold(col.Name, res.TimestampColumn) {
+			foundTsColumn = true
+		}
+		tableInfo.Columns = append(tableInfo.Columns, colInfo)
+		if preValues != nil {
+			preValues = append(preValues, columnValue(pre))
+		}
+		if postValues != nil {
+			postValues = append(postValues, columnValue(post))
+		}
+	}
+	if res.Policy == sqlmodel.ConflictLastWriterWins && !foundTsColumn {
+		return nil, cerror.ErrSinkInvalidConfig.GenWithStack(
+			"timestamp column %s of conflict rule is not found in table %s",
+			res.TimestampColumn, row.Table)
+	}
+	if len(keyColumns) != 0 {
+		tableInfo.Indices = []*timodel.IndexInfo{{
+			Name:    timodel.NewCIStr("PRIMARY"),
+			Table:   tableInfo.Name,
+			Columns: keyColumns,
+			State:   timodel.StatePublic,
+			Unique:  true,
+			Primary: true,
+			Tp:      timodel.IndexTypeBtree,
+		}}
+	}
+	return sqlmodel.NewRowChange(row.Table, nil, preValues, postValues, tableInfo, nil, nil), nil
+}
+
+// columnValue returns the value as the query argument. If the column value type
+// is []byte and charset is not binary, we get its string representation,
+// otherwise the go-sql-driver will set `_binary` charset for the column.
+func columnValue(col *model.Column) interface{} {
+	if col == nil {
+		return nil
+	}
+	if col.Charset != "" && col.Charset != charset.CharsetBin {
+		if b, ok := col.Value.([]byte); ok {
+			return string(b)
+		}
+	}
+	return col.Value
+}
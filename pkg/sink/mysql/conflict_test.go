@@ -0,0 +1,133 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mysql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/sqlmodel"
+	"github.com/stretchr/testify/require"
+)
+
+func newConflictTestConfig() *config.ReplicaConfig {
+	cfg := config.GetDefaultReplicaConfig()
+	cfg.Sink.ConflictRules = []*config.ConflictRule{
+		{Matcher: []string{"test.lww"}, Policy: config.ConflictPolicyLastWriterWins, TimestampColumn: "ts"},
+		{Matcher: []string{"test.record*"}, Policy: config.ConflictPolicyRecord, ConflictTable: "cdc.conflicts"},
+		{Matcher: []string{"test.*"}, Policy: config.ConflictPolicyDownstreamWins},
+	}
+	return cfg
+}
+
+func TestConflictResolverMatch(t *testing.T) {
+	t.Parallel()
+
+	r, err := NewConflictResolver(config.GetDefaultReplicaConfig())
+	require.NoError(t, err)
+	require.Nil(t, r)
+
+	r, err = NewConflictResolver(newConflictTestConfig())
+	require.NoError(t, err)
+	require.Equal(t, sqlmodel.ConflictLastWriterWins,
+		r.Match(&model.TableName{Schema: "test", Table: "lww"}).Policy)
+	require.Equal(t, sqlmodel.ConflictRecord,
+		r.Match(&model.TableName{Schema: "test", Table: "record1"}).Policy)
+	require.Equal(t, sqlmodel.ConflictDownstreamWins,
+		r.Match(&model.TableName{Schema: "test", Table: "t"}).Policy)
+	require.Nil(t, r.Match(&model.TableName{Schema: "other", Table: "t"}))
+	require.Equal(t, []*model.TableName{{Schema: "cdc", Table: "conflicts"}}, r.conflictTables)
+}
+
+func TestConflictResolverPrepareDMLs(t *testing.T) {
+	t.Parallel()
+
+	r, err := NewConflictResolver(newConflictTestConfig())
+	require.NoError(t, err)
+
+	row := &model.RowChangedEvent{
+		CommitTs: 100,
+		Table:    &model.TableName{Schema: "test", Table: "lww"},
+		PreColumns: []*model.Column{
+			{Name: "id", Type: mysql.TypeLong, Flag: model.HandleKeyFlag | model.PrimaryKeyFlag, Value: 1},
+			{Name: "name", Type: mysql.TypeVarchar, Charset: "utf8mb4", Value: []byte("a")},
+			{Name: "ts", Type: mysql.TypeLonglong, Value: 10},
+			{Name: "gen", Type: mysql.TypeLong, Flag: model.GeneratedColumnFlag, Value: 2},
+		},
+		Columns: []*model.Column{
+			{Name: "id", Type: mysql.TypeLong, Flag: model.HandleKeyFlag | model.PrimaryKeyFlag, Value: 1},
+			{Name: "name", Type: mysql.TypeVarchar, Charset: "utf8mb4", Value: []byte("b")},
+			{Name: "ts", Type: mysql.TypeLonglong, Value: 20},
+			{Name: "gen", Type: mysql.TypeLong, Flag: model.GeneratedColumnFlag, Value: 2},
+		},
+	}
+	sqls, values, err := r.PrepareDMLs(row, r.Match(row.Table))
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"UPDATE `test`.`lww` SET `id` = ?, `name` = ?, `ts` = ? " +
+			"WHERE `id` = ? AND (`ts` IS NULL OR `ts` <= ?) LIMIT 1",
+	}, sqls)
+	require.Equal(t, [][]interface{}{{1, "b", 20, 1, 20}}, values)
+
+	// The timestamp column must exist.
+	row.PreColumns[2].Name = "other"
+	row.Columns[2].Name = "other"
+	_, _, err = r.PrepareDMLs(row, r.Match(row.Table))
+	require.ErrorIs(t, err, cerror.ErrSinkInvalidConfig)
+
+	// Delete events are recorded to the conflict table.
+	row = &model.RowChangedEvent{
+		CommitTs: 100,
+		Table:    &model.TableName{Schema: "test", Table: "record"},
+		PreColumns: []*model.Column{
+			{Name: "id", Type: mysql.TypeLong, Flag: model.HandleKeyFlag | model.PrimaryKeyFlag, Value: 1},
+			nil,
+			{Name: "name", Type: mysql.TypeVarchar, Value: nil},
+		},
+	}
+	sqls, values, err = r.PrepareDMLs(row, r.Match(row.Table))
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"INSERT INTO `cdc`.`conflicts` (`source_schema`,`source_table`,`change_type`," +
+			"`commit_ts`,`pre_values`,`post_values`) SELECT ?,?,?,?,?,? FROM DUAL " +
+			"WHERE NOT EXISTS (SELECT 1 FROM `test`.`record` WHERE `id` = ? AND `name` IS ?)",
+		"DELETE FROM `test`.`record` WHERE `id` = ? AND `name` IS ? LIMIT 1",
+	}, sqls)
+	require.Equal(t, [][]interface{}{
+		{"test", "record", "delete", uint64(100), `{"id":1,"name":null}`, nil, 1, nil},
+		{1, nil},
+	}, values)
+}
+
+func TestCreateConflictTables(t *testing.T) {
+	t.Parallel()
+
+	r, err := NewConflictResolver(newConflictTestConfig())
+	require.NoError(t, err)
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	mock.ExpectExec("CREATE DATABASE IF NOT EXISTS `cdc`").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("CREATE TABLE IF NOT EXISTS `cdc`.`conflicts`").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	require.NoError(t, r.CreateConflictTables(context.Background(), db))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
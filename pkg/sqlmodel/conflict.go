@@ -0,0 +1,395 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlmodel
+
+import (
+	"encoding/json"
+	"strings"
+
+	timodel "github.com/pingcap/tidb/parser/model"
+	"go.uber.org/zap"
+
+	cdcmodel "github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/pkg/quotes"
+)
+
+// ConflictPolicy decides how a row change is applied when the downstream row
+// has been changed by other writers, e.g. in bidirectional or multi-source
+// replication.
+type ConflictPolicy int
+
+// these constants represent conflict policies.
+const (
+	// ConflictUpstreamWins overwrites the downstream row.
+	ConflictUpstreamWins ConflictPolicy = iota
+	// ConflictDownstreamWins skips the row change if the downstream row is not
+	// the same as the old values of the row change.
+	ConflictDownstreamWins
+	// ConflictLastWriterWins applies the row change only if it's not older than
+	// the downstream row, which is decided by a timestamp column.
+	ConflictLastWriterWins
+	// ConflictRecord skips the conflicting row change like ConflictDownstreamWins,
+	// and records it to a conflict table.
+	ConflictRecord
+)
+
+// String implements fmt.Stringer interface.
+func (p ConflictPolicy) String() string {
+	switch p {
+	case ConflictUpstreamWins:
+		return "ConflictUpstreamWins"
+	case ConflictDownstreamWins:
+		return "ConflictDownstreamWins"
+	case ConflictLastWriterWins:
+		return "ConflictLastWriterWins"
+	case ConflictRecord:
+		return "ConflictRecord"
+	}
+
+	return ""
+}
+
+// ConflictResolution describes how to resolve the conflicts of a table.
+type ConflictResolution struct {
+	Policy ConflictPolicy
+	// TimestampColumn is required by ConflictLastWriterWins.
+	TimestampColumn string
+	// ConflictTable is required by ConflictRecord.
+	ConflictTable *cdcmodel.TableName
+}
+
+// columns of the conflict table.
+const (
+	conflictColSourceSchema = "source_schema"
+	conflictColSourceTable  = "source_table"
+	conflictColChangeType   = "change_type"
+	conflictColCommitTs     = "commit_ts"
+	conflictColPreValues    = "pre_values"
+	conflictColPostValues   = "post_values"
+)
+
+// GenConflictTableDDL generates the CREATE TABLE statement of a conflict table.
+func GenConflictTableDDL(table *cdcmodel.TableName) string {
+	return "CREATE TABLE IF NOT EXISTS " + table.QuoteString() + " (" +
+		"`id` BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY," +
+		quotes.QuoteName(conflictColSourceSchema) + " VARCHAR(255) NOT NULL," +
+		quotes.QuoteName(conflictColSourceTable) + " VARCHAR(255) NOT NULL," +
+		quotes.QuoteName(conflictColChangeType) + " VARCHAR(16) NOT NULL," +
+		quotes.QuoteName(conflictColCommitTs) + " BIGINT UNSIGNED NOT NULL," +
+		quotes.QuoteName(conflictColPreValues) + " LONGTEXT," +
+		quotes.QuoteName(conflictColPostValues) + " LONGTEXT," +
+		"`detect_time` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP)"
+}
+
+// GenSQLsWithConflictResolution generates the DMLs which apply the row change
+// with the conflict resolution, they should be executed in order in one
+// transaction. commitTs is only used to record the conflicts.
+// NOTE: the conflicts are detected by comparing the downstream row with all
+// old values of the row change, so the columns which can't be compared
+// precisely, such as FLOAT, may be reported as conflicts.
+func (r *RowChange) GenSQLsWithConflictResolution(
+	res *ConflictResolution, commitTs uint64,
+) ([]string, [][]interface{}) {
+	switch res.Policy {
+	case ConflictUpstreamWins:
+		return r.genUpstreamWinsSQLs()
+	case ConflictDownstreamWins:
+		sql, args := r.genDownstreamWinsSQL()
+		return []string{sql}, [][]interface{}{args}
+	case ConflictLastWriterWins:
+		sql, args := r.genLastWriterWinsSQL(res.TimestampColumn)
+		if sql == "" {
+			return nil, nil
+		}
+		return []string{sql}, [][]interface{}{args}
+	case ConflictRecord:
+		sqls := make([]string, 0, 2)
+		values := make([][]interface{}, 0, 2)
+		if sql, args := r.genRecordConflictSQL(res.ConflictTable, commitTs); sql != "" {
+			sqls = append(sqls, sql)
+			values = append(values, args)
+		}
+		sql, args := r.genDownstreamWinsSQL()
+		return append(sqls, sql), append(values, args)
+	}
+	log.L().DPanic("illegal conflict policy",
+		zap.String("sourceTable", r.sourceTable.String()),
+		zap.Stringer("policy", res.Policy))
+	return nil, nil
+}
+
+// genUpstreamWinsSQLs overwrites the downstream row like the safe mode,
+// an UPDATE is split into DELETE and REPLACE.
+func (r *RowChange) genUpstreamWinsSQLs() ([]string, [][]interface{}) {
+	switch r.tp {
+	case RowChangeInsert:
+		sql, args := r.GenSQL(DMLReplace)
+		return []string{sql}, [][]interface{}{args}
+	case RowChangeUpdate:
+		pre, post := r.SplitUpdate()
+		deleteSQL, deleteArgs := pre.GenSQL(DMLDelete)
+		replaceSQL, replaceArgs := post.GenSQL(DMLReplace)
+		return []string{deleteSQL, replaceSQL}, [][]interface{}{deleteArgs, replaceArgs}
+	default:
+		sql, args := r.GenSQL(DMLDelete)
+		return []string{sql}, [][]interface{}{args}
+	}
+}
+
+// genDownstreamWinsSQL generates a DML which doesn't change the downstream row
+// if it's not the same as the old values.
+func (r *RowChange) genDownstreamWinsSQL() (string, []interface{}) {
+	if r.tp == RowChangeInsert {
+		return r.GenSQL(DMLInsertIgnore)
+	}
+
+	var buf strings.Builder
+	buf.Grow(2048)
+	var args []interface{}
+	if r.tp == RowChangeUpdate {
+		buf.WriteString("UPDATE ")
+		buf.WriteString(r.targetTable.QuoteString())
+		buf.WriteString(" SET ")
+		args = r.genSet(&buf)
+	} else {
+		buf.WriteString("DELETE FROM ")
+		buf.WriteString(r.targetTable.QuoteString())
+	}
+	buf.WriteString(" WHERE ")
+	args = append(args, r.genFullWhere(&buf, r.preValues)...)
+	buf.WriteString(" LIMIT 1")
+	return buf.String(), args
+}
+
+// genLastWriterWinsSQL generates a DML which only changes the downstream row
+// if its timestamp is not newer than the row change.
+func (r *RowChange) genLastWriterWinsSQL(tsColumn string) (string, []interface{}) {
+	tsCol := timodel.FindColumnInfo(r.sourceTableInfo.Columns, strings.ToLower(tsColumn))
+	if tsCol == nil {
+		log.L().DPanic("timestamp column not found",
+			zap.String("sourceTable", r.sourceTable.String()),
+			zap.String("column", tsColumn))
+		return "", nil
+	}
+	quotedTs := quotes.QuoteName(tsCol.Name.O)
+
+	if r.tp == RowChangeInsert {
+		sql, args := r.GenSQL(DMLInsert)
+		cond := quotedTs + " IS NULL OR VALUES(" + quotedTs + ") >= " + quotedTs
+		var buf strings.Builder
+		buf.Grow(len(sql) * 2)
+		buf.WriteString(sql)
+		buf.WriteString(" ON DUPLICATE KEY UPDATE ")
+		// MySQL assigns the columns from left to right, so the timestamp column
+		// must be the last one to keep the condition unchanged for other columns.
+		for _, col := range r.sourceTableInfo.Columns {
+			if col.Offset == tsCol.Offset || isGenerated(r.targetTableInfo.Columns, col.Name) {
+				continue
+			}
+			colName := quotes.QuoteName(col.Name.O)
+			buf.WriteString(colName + "=IF(" + cond + ",VALUES(" + colName + ")," + colName + "),")
+		}
+		buf.WriteString(quotedTs + "=IF(" + cond + ",VALUES(" + quotedTs + ")," + quotedTs + ")")
+		return buf.String(), args
+	}
+
+	var buf strings.Builder
+	buf.Grow(2048)
+	var args []interface{}
+	var ts interface{}
+	if r.tp == RowChangeUpdate {
+		buf.WriteString("UPDATE ")
+		buf.WriteString(r.targetTable.QuoteString())
+		buf.WriteString(" SET ")
+		args = r.genSet(&buf)
+		ts = r.postValues[tsCol.Offset]
+	} else {
+		buf.WriteString("DELETE FROM ")
+		buf.WriteString(r.targetTable.QuoteString())
+		ts = r.preValues[tsCol.Offset]
+	}
+	buf.WriteString(" WHERE ")
+	args = append(args, r.genWhere(&buf)...)
+	buf.WriteString(" AND (" + quotedTs + " IS NULL OR " + quotedTs + " <= ?) LIMIT 1")
+	args = append(args, ts)
+	return buf.String(), args
+}
+
+// genRecordConflictSQL generates an INSERT ... SELECT which records the row
+// change to the conflict table if it conflicts with the downstream row.
+// It returns an empty SQL if the row change can't conflict.
+func (r *RowChange) genRecordConflictSQL(
+	conflictTable *cdcmodel.TableName, commitTs uint64,
+) (string, []interface{}) {
+	var buf strings.Builder
+	buf.Grow(2048)
+	buf.WriteString("INSERT INTO ")
+	buf.WriteString(conflictTable.QuoteString())
+	buf.WriteString(" (")
+	for i, col := range []string{
+		conflictColSourceSchema, conflictColSourceTable, conflictColChangeType,
+		conflictColCommitTs, conflictColPreValues, conflictColPostValues,
+	} {
+		if i != 0 {
+			buf.WriteByte(',')
+		}
+		buf.WriteString(quotes.QuoteName(col))
+	}
+	buf.WriteString(") SELECT ?,?,?,?,?,? FROM DUAL WHERE ")
+	args := []interface{}{
+		r.sourceTable.Schema, r.sourceTable.Table, r.changeTypeName(), commitTs,
+		r.valuesJSON(r.preValues), r.valuesJSON(r.postValues),
+	}
+
+	if r.tp == RowChangeInsert {
+		// An INSERT conflicts with the downstream rows which have the same
+		// unique keys.
+		buf.WriteString("EXISTS (SELECT 1 FROM ")
+		buf.WriteString(r.targetTable.QuoteString())
+		buf.WriteString(" WHERE ")
+		whereArgs, ok := r.genUniqueKeysWhere(&buf, r.postValues)
+		if !ok {
+			return "", nil
+		}
+		buf.WriteString(")")
+		return buf.String(), append(args, whereArgs...)
+	}
+
+	// UPDATE and DELETE conflict if the downstream row is not the same as the
+	// old values.
+	buf.WriteString("NOT EXISTS (SELECT 1 FROM ")
+	buf.WriteString(r.targetTable.QuoteString())
+	buf.WriteString(" WHERE ")
+	args = append(args, r.genFullWhere(&buf, r.preValues)...)
+	buf.WriteString(")")
+	return buf.String(), args
+}
+
+// genSet generates the SET clause of UPDATE by the post values.
+func (r *RowChange) genSet(buf *strings.Builder) []interface{} {
+	args := make([]interface{}, 0, len(r.postValues))
+	writtenFirstCol := false
+	for i, col := range r.sourceTableInfo.Columns {
+		if isGenerated(r.targetTableInfo.Columns, col.Name) {
+			continue
+		}
+
+		if writtenFirstCol {
+			buf.WriteString(", ")
+		}
+		writtenFirstCol = true
+		buf.WriteString(quotes.QuoteName(col.Name.O) + " = ?")
+		args = append(args, r.postValues[i])
+	}
+	return args
+}
+
+// genFullWhere generates a WHERE clause which matches all the non-generated
+// columns.
+func (r *RowChange) genFullWhere(buf *strings.Builder, values []interface{}) []interface{} {
+	args := make([]interface{}, 0, len(values))
+	for i, col := range r.sourceTableInfo.Columns {
+		if isGenerated(r.targetTableInfo.Columns, col.Name) {
+			continue
+		}
+
+		if len(args) != 0 {
+			buf.WriteString(" AND ")
+		}
+		buf.WriteString(quotes.QuoteName(col.Name.O))
+		if values[i] == nil {
+			buf.WriteString(" IS ?")
+		} else {
+			buf.WriteString(" = ?")
+		}
+		args = append(args, values[i])
+	}
+	return args
+}
+
+// genUniqueKeysWhere generates a WHERE clause which matches any row with the
+// same unique key values. It returns false if there are no such unique keys.
+func (r *RowChange) genUniqueKeysWhere(
+	buf *strings.Builder, values []interface{},
+) ([]interface{}, bool) {
+	r.lazyInitWhereHandle()
+
+	var args []interface{}
+	written := false
+	for _, idx := range r.whereHandle.UniqueIdxs {
+		// NULL values never conflict.
+		cols, idxValues := getColsAndValuesOfIdx(r.sourceTableInfo.Columns, idx, values)
+		hasNull := false
+		for _, v := range idxValues {
+			if v == nil {
+				hasNull = true
+				break
+			}
+		}
+		if hasNull {
+			continue
+		}
+
+		if written {
+			buf.WriteString(" OR ")
+		}
+		written = true
+		buf.WriteByte('(')
+		for i, col := range cols {
+			if i != 0 {
+				buf.WriteString(" AND ")
+			}
+			buf.WriteString(quotes.QuoteName(col.Name.O) + " = ?")
+		}
+		buf.WriteByte(')')
+		args = append(args, idxValues...)
+	}
+	return args, written
+}
+
+func (r *RowChange) changeTypeName() string {
+	switch r.tp {
+	case RowChangeInsert:
+		return "insert"
+	case RowChangeUpdate:
+		return "update"
+	case RowChangeDelete:
+		return "delete"
+	}
+	return ""
+}
+
+// valuesJSON encodes the values as a JSON object from column names to values,
+// it returns nil for nil values.
+func (r *RowChange) valuesJSON(values []interface{}) interface{} {
+	if values == nil {
+		return nil
+	}
+	m := make(map[string]interface{}, len(values))
+	for i, col := range r.sourceTableInfo.Columns {
+		if v, ok := values[i].([]byte); ok {
+			m[col.Name.O] = ColValAsStr(v)
+			continue
+		}
+		m[col.Name.O] = values[i]
+	}
+	data, err := json.Marshal(m)
+	if err != nil {
+		log.L().Warn("failed to encode values of conflict row",
+			zap.Stringer("sourceTable", r.sourceTable), zap.Error(err))
+		return nil
+	}
+	return string(data)
+}
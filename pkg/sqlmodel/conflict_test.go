@@ -0,0 +1,164 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlmodel
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	cdcmodel "github.com/pingcap/tiflow/cdc/model"
+)
+
+func TestGenSQLsWithConflictResolution(t *testing.T) {
+	t.Parallel()
+
+	source := &cdcmodel.TableName{Schema: "db", Table: "tb"}
+	conflictTable := &cdcmodel.TableName{Schema: "db", Table: "conflicts"}
+	ti := mockTableInfo(t, "CREATE TABLE tb (id INT PRIMARY KEY, name VARCHAR(20), ts BIGINT)")
+	insert := NewRowChange(source, nil, nil, []interface{}{1, "a", 10}, ti, nil, nil)
+	update := NewRowChange(source, nil, []interface{}{1, "a", 10}, []interface{}{1, nil, 20}, ti, nil, nil)
+	del := NewRowChange(source, nil, []interface{}{1, nil, 20}, nil, ti, nil, nil)
+
+	cases := []struct {
+		res          *ConflictResolution
+		change       *RowChange
+		expectedSQLs []string
+		expectedArgs [][]interface{}
+	}{
+		{
+			res:          &ConflictResolution{Policy: ConflictUpstreamWins},
+			change:       insert,
+			expectedSQLs: []string{"REPLACE INTO `db`.`tb` (`id`,`name`,`ts`) VALUES (?,?,?)"},
+			expectedArgs: [][]interface{}{{1, "a", 10}},
+		},
+		{
+			res:    &ConflictResolution{Policy: ConflictUpstreamWins},
+			change: update,
+			expectedSQLs: []string{
+				"DELETE FROM `db`.`tb` WHERE `id` = ? LIMIT 1",
+				"REPLACE INTO `db`.`tb` (`id`,`name`,`ts`) VALUES (?,?,?)",
+			},
+			expectedArgs: [][]interface{}{{1}, {1, nil, 20}},
+		},
+		{
+			res:          &ConflictResolution{Policy: ConflictDownstreamWins},
+			change:       insert,
+			expectedSQLs: []string{"INSERT IGNORE INTO `db`.`tb` (`id`,`name`,`ts`) VALUES (?,?,?)"},
+			expectedArgs: [][]interface{}{{1, "a", 10}},
+		},
+		{
+			res:    &ConflictResolution{Policy: ConflictDownstreamWins},
+			change: update,
+			expectedSQLs: []string{
+				"UPDATE `db`.`tb` SET `id` = ?, `name` = ?, `ts` = ? " +
+					"WHERE `id` = ? AND `name` = ? AND `ts` = ? LIMIT 1",
+			},
+			expectedArgs: [][]interface{}{{1, nil, 20, 1, "a", 10}},
+		},
+		{
+			res:    &ConflictResolution{Policy: ConflictDownstreamWins},
+			change: del,
+			expectedSQLs: []string{
+				"DELETE FROM `db`.`tb` WHERE `id` = ? AND `name` IS ? AND `ts` = ? LIMIT 1",
+			},
+			expectedArgs: [][]interface{}{{1, nil, 20}},
+		},
+		{
+			res:    &ConflictResolution{Policy: ConflictLastWriterWins, TimestampColumn: "TS"},
+			change: insert,
+			expectedSQLs: []string{
+				"INSERT INTO `db`.`tb` (`id`,`name`,`ts`) VALUES (?,?,?) ON DUPLICATE KEY UPDATE " +
+					"`id`=IF(`ts` IS NULL OR VALUES(`ts`) >= `ts`,VALUES(`id`),`id`)," +
+					"`name`=IF(`ts` IS NULL OR VALUES(`ts`) >= `ts`,VALUES(`name`),`name`)," +
+					"`ts`=IF(`ts` IS NULL OR VALUES(`ts`) >= `ts`,VALUES(`ts`),`ts`)",
+			},
+			expectedArgs: [][]interface{}{{1, "a", 10}},
+		},
+		{
+			res:    &ConflictResolution{Policy: ConflictLastWriterWins, TimestampColumn: "ts"},
+			change: update,
+			expectedSQLs: []string{
+				"UPDATE `db`.`tb` SET `id` = ?, `name` = ?, `ts` = ? " +
+					"WHERE `id` = ? AND (`ts` IS NULL OR `ts` <= ?) LIMIT 1",
+			},
+			expectedArgs: [][]interface{}{{1, nil, 20, 1, 20}},
+		},
+		{
+			res:    &ConflictResolution{Policy: ConflictLastWriterWins, TimestampColumn: "ts"},
+			change: del,
+			expectedSQLs: []string{
+				"DELETE FROM `db`.`tb` WHERE `id` = ? AND (`ts` IS NULL OR `ts` <= ?) LIMIT 1",
+			},
+			expectedArgs: [][]interface{}{{1, 20}},
+		},
+		{
+			res:    &ConflictResolution{Policy: ConflictRecord, ConflictTable: conflictTable},
+			change: insert,
+			expectedSQLs: []string{
+				"INSERT INTO `db`.`conflicts` (`source_schema`,`source_table`,`change_type`," +
+					"`commit_ts`,`pre_values`,`post_values`) SELECT ?,?,?,?,?,? FROM DUAL " +
+					"WHERE EXISTS (SELECT 1 FROM `db`.`tb` WHERE (`id` = ?))",
+				"INSERT IGNORE INTO `db`.`tb` (`id`,`name`,`ts`) VALUES (?,?,?)",
+			},
+			expectedArgs: [][]interface{}{
+				{"db", "tb", "insert", uint64(100), nil, `{"id":1,"name":"a","ts":10}`, 1},
+				{1, "a", 10},
+			},
+		},
+		{
+			res:    &ConflictResolution{Policy: ConflictRecord, ConflictTable: conflictTable},
+			change: del,
+			expectedSQLs: []string{
+				"INSERT INTO `db`.`conflicts` (`source_schema`,`source_table`,`change_type`," +
+					"`commit_ts`,`pre_values`,`post_values`) SELECT ?,?,?,?,?,? FROM DUAL " +
+					"WHERE NOT EXISTS (SELECT 1 FROM `db`.`tb` WHERE `id` = ? AND `name` IS ? AND `ts` = ?)",
+				"DELETE FROM `db`.`tb` WHERE `id` = ? AND `name` IS ? AND `ts` = ? LIMIT 1",
+			},
+			expectedArgs: [][]interface{}{
+				{"db", "tb", "delete", uint64(100), `{"id":1,"name":null,"ts":20}`, nil, 1, nil, 20},
+				{1, nil, 20},
+			},
+		},
+	}
+	for i, c := range cases {
+		sqls, args := c.change.GenSQLsWithConflictResolution(c.res, 100)
+		require.Equal(t, c.expectedSQLs, sqls, "case %d", i)
+		require.Equal(t, c.expectedArgs, args, "case %d", i)
+	}
+}
+
+func TestRecordConflictWithoutUniqueKey(t *testing.T) {
+	t.Parallel()
+
+	source := &cdcmodel.TableName{Schema: "db", Table: "tb"}
+	ti := mockTableInfo(t, "CREATE TABLE tb (id INT, name VARCHAR(20))")
+	insert := NewRowChange(source, nil, nil, []interface{}{1, "a"}, ti, nil, nil)
+	sqls, args := insert.GenSQLsWithConflictResolution(&ConflictResolution{
+		Policy:        ConflictRecord,
+		ConflictTable: &cdcmodel.TableName{Schema: "db", Table: "conflicts"},
+	}, 100)
+	// An INSERT never conflicts if there are no unique keys.
+	require.Equal(t, []string{"INSERT IGNORE INTO `db`.`tb` (`id`,`name`) VALUES (?,?)"}, sqls)
+	require.Equal(t, [][]interface{}{{1, "a"}}, args)
+}
+
+func TestGenConflictTableDDL(t *testing.T) {
+	t.Parallel()
+
+	ddl := GenConflictTableDDL(&cdcmodel.TableName{Schema: "db", Table: "conflicts"})
+	require.Contains(t, ddl, "CREATE TABLE IF NOT EXISTS `db`.`conflicts`")
+	// The DDL can be parsed.
+	mockTableInfo(t, ddl)
+}
@@ -133,9 +133,12 @@ func GenInsertSQL(tp DMLType, changes ...*RowChange) (string, []interface{}) {
 
 	var buf strings.Builder
 	buf.Grow(1024)
-	if tp == DMLReplace {
+	switch tp {
+	case DMLReplace:
 		buf.WriteString("REPLACE INTO ")
-	} else {
+	case DMLInsertIgnore:
+		buf.WriteString("INSERT IGNORE INTO ")
+	default:
 		buf.WriteString("INSERT INTO ")
 	}
 	buf.WriteString(first.targetTable.QuoteString())
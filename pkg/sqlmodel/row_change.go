@@ -324,6 +324,7 @@ const (
 	DMLInsertOnDuplicateUpdate
 	DMLUpdate
 	DMLDelete
+	DMLInsertIgnore
 )
 
 // String implements fmt.Stringer interface.
@@ -339,6 +340,8 @@ func (t DMLType) String() string {
 		return "DMLInsertOnDuplicateUpdate"
 	case DMLDelete:
 		return "DMLDelete"
+	case DMLInsertIgnore:
+		return "DMLInsertIgnore"
 	}
 
 	return ""
@@ -347,7 +350,7 @@ func (t DMLType) String() string {
 // GenSQL generated a DML SQL for this RowChange.
 func (r *RowChange) GenSQL(tp DMLType) (string, []interface{}) {
 	switch tp {
-	case DMLInsert, DMLReplace, DMLInsertOnDuplicateUpdate:
+	case DMLInsert, DMLReplace, DMLInsertOnDuplicateUpdate, DMLInsertIgnore:
 		return r.genInsertSQL(tp)
 	case DMLUpdate:
 		return r.genUpdateSQL()
@@ -0,0 +1,429 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package upstream
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	timeta "github.com/pingcap/tidb/meta"
+	timodel "github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tiflow/cdc/entry"
+	"github.com/pingcap/tiflow/cdc/entry/schema"
+	"github.com/pingcap/tiflow/cdc/kv"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+	"github.com/pingcap/tiflow/pkg/filter"
+	"go.uber.org/zap"
+)
+
+// DDLJobPuller pulls the DDL jobs of an upstream.
+// It's implemented by puller.DDLJobPuller.
+type DDLJobPuller interface {
+	// Run starts the DDLJobPuller.
+	Run(ctx context.Context) error
+	// Output the DDL job entry, it contains the DDL job and the error.
+	Output() <-chan *model.DDLJobEntry
+}
+
+// DDLJobPullerCreator creates a DDLJobPuller which pulls DDL jobs of
+// the upstream from startTs. id is used to identify the schema store
+// in logs and metrics.
+type DDLJobPullerCreator func(
+	ctx context.Context, up *Upstream, startTs uint64, id model.ChangeFeedID,
+) (DDLJobPuller, error)
+
+// schemaStoreManager manages the schema stores of an upstream.
+// The changefeeds with the same `force-replicate` share a schema store
+// as long as the schema store still holds the snapshot of their checkpoint ts.
+type schemaStoreManager struct {
+	up *Upstream
+
+	mu     sync.Mutex
+	stores []*schemaStore
+	nextID uint64
+}
+
+// schemaStore is a multi-version schema storage shared by changefeeds.
+// It's driven by a single DDL job puller, and its snapshots are garbage
+// collected by the minimal GC ts of all subscribers.
+type schemaStore struct {
+	id             model.ChangeFeedID
+	forceReplicate bool
+	storage        entry.SchemaStorage
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	mu          sync.Mutex
+	gcTs        uint64
+	subscribers map[model.ChangeFeedID]*SchemaSubscription
+	err         error
+	stopped     bool
+}
+
+// SchemaSubscription is a subscription of a changefeed to the shared schema
+// store of the upstream. It implements entry.SchemaStorage, the snapshots
+// are read from the shared schema store and the GC ts of the changefeed
+// is reported to it.
+type SchemaSubscription struct {
+	changefeedID model.ChangeFeedID
+	store        *schemaStore
+	manager      *schemaStoreManager
+	gcTs         uint64
+	onError      func(error)
+	closeOnce    sync.Once
+}
+
+var _ entry.SchemaStorage = (*SchemaSubscription)(nil)
+
+// SubscribeSchemaStorage subscribes the shared schema store of the upstream.
+// A new schema store is created if no schema store can serve the checkpointTs.
+// onError is called if the shared schema store fails, the subscription should
+// be closed and re-created after that.
+func (up *Upstream) SubscribeSchemaStorage(
+	changefeedID model.ChangeFeedID,
+	checkpointTs uint64,
+	forceReplicate bool,
+	creator DDLJobPullerCreator,
+	onError func(error),
+) (*SchemaSubscription, error) {
+	up.mu.Lock()
+	if up.schemaStores == nil {
+		up.schemaStores = &schemaStoreManager{up: up}
+	}
+	manager := up.schemaStores
+	up.mu.Unlock()
+	return manager.subscribe(changefeedID, checkpointTs, forceReplicate, creator, onError)
+}
+
+func (m *schemaStoreManager) subscribe(
+	changefeedID model.ChangeFeedID,
+	checkpointTs uint64,
+	forceReplicate bool,
+	creator DDLJobPullerCreator,
+	onError func(error),
+) (*SchemaSubscription, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	sub := &SchemaSubscription{
+		changefeedID: changefeedID,
+		manager:      m,
+		gcTs:         checkpointTs,
+		onError:      onError,
+	}
+	for _, store := range m.stores {
+		if store.forceReplicate != forceReplicate {
+			continue
+		}
+		if store.tryAddSubscriber(sub) {
+			sub.store = store
+			log.Info("changefeed subscribes the shared schema store",
+				zap.String("namespace", changefeedID.Namespace),
+				zap.String("changefeed", changefeedID.ID),
+				zap.String("schemaStore", store.id.ID),
+				zap.Uint64("checkpointTs", checkpointTs))
+			return sub, nil
+		}
+	}
+
+	id := model.DefaultChangeFeedID(fmt.Sprintf("schema-store-%d-%d", m.up.ID, m.nextID))
+	m.nextID++
+	store, err := newSchemaStore(m, id, checkpointTs, forceReplicate, creator)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if !store.tryAddSubscriber(sub) {
+		log.Panic("add subscriber to a new schema store failed",
+			zap.String("namespace", changefeedID.Namespace),
+			zap.String("changefeed", changefeedID.ID))
+	}
+	sub.store = store
+	m.stores = append(m.stores, store)
+	log.Info("changefeed subscribes a new shared schema store",
+		zap.String("namespace", changefeedID.Namespace),
+		zap.String("changefeed", changefeedID.ID),
+		zap.String("schemaStore", id.ID),
+		zap.Uint64("checkpointTs", checkpointTs),
+		zap.Bool("forceReplicate", forceReplicate))
+	return sub, nil
+}
+
+// removeStore removes the store from the manager, it's no-op
+// if the store is already removed.
+func (m *schemaStoreManager) removeStore(store *schemaStore) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for i, s := range m.stores {
+		if s == store {
+			m.stores = append(m.stores[:i], m.stores[i+1:]...)
+			return
+		}
+	}
+}
+
+// unsubscribe removes the subscription from its store, the store is
+// stopped if it has no subscriber.
+func (m *schemaStoreManager) unsubscribe(sub *SchemaSubscription) {
+	m.mu.Lock()
+	empty := sub.store.removeSubscriber(sub)
+	if empty {
+		for i, s := range m.stores {
+			if s == sub.store {
+				m.stores = append(m.stores[:i], m.stores[i+1:]...)
+				break
+			}
+		}
+	}
+	m.mu.Unlock()
+	if empty {
+		sub.store.stop()
+	}
+}
+
+// close stops all schema stores of the upstream.
+func (m *schemaStoreManager) close() {
+	m.mu.Lock()
+	stores := m.stores
+	m.stores = nil
+	m.mu.Unlock()
+	for _, store := range stores {
+		store.stop()
+	}
+}
+
+func newSchemaStore(
+	m *schemaStoreManager,
+	id model.ChangeFeedID,
+	startTs uint64,
+	forceReplicate bool,
+	creator DDLJobPullerCreator,
+) (*schemaStore, error) {
+	up := m.up
+	var meta *timeta.Meta
+	// KVStorage can be nil only in the test.
+	if up.KVStorage != nil {
+		var err error
+		meta, err = kv.GetSnapshotMeta(up.KVStorage, startTs)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+	}
+	// Whether a DDL should be discarded doesn't depend on the changefeed config,
+	// so the default replica config is used here.
+	f, err := filter.NewFilter(config.GetDefaultReplicaConfig(), "")
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	storage, err := entry.NewSchemaStorage(meta, startTs, f, forceReplicate, id)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	puller, err := creator(ctx, up, startTs, id)
+	if err != nil {
+		cancel()
+		return nil, errors.Trace(err)
+	}
+	s := &schemaStore{
+		id:             id,
+		forceReplicate: forceReplicate,
+		storage:        storage,
+		cancel:         cancel,
+		gcTs:           startTs,
+		subscribers:    make(map[model.ChangeFeedID]*SchemaSubscription),
+	}
+	s.wg.Add(2)
+	go func() {
+		defer s.wg.Done()
+		if err := puller.Run(ctx); err != nil && ctx.Err() == nil {
+			s.fail(m, errors.Trace(err))
+		}
+	}()
+	go func() {
+		defer s.wg.Done()
+		if err := s.handleDDLJobs(ctx, puller); err != nil {
+			s.fail(m, errors.Trace(err))
+		}
+	}()
+	return s, nil
+}
+
+func (s *schemaStore) handleDDLJobs(ctx context.Context, puller DDLJobPuller) error {
+	var jobEntry *model.DDLJobEntry
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case jobEntry = <-puller.Output():
+		}
+		if jobEntry.OpType == model.OpTypeResolved {
+			s.storage.AdvanceResolvedTs(jobEntry.CRTs)
+		}
+		job, err := jobEntry.Job, jobEntry.Err
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if job == nil {
+			continue
+		}
+		if err := s.storage.HandleDDLJob(job); err != nil {
+			return errors.Trace(err)
+		}
+	}
+}
+
+// fail marks the schema store as failed and notifies all subscribers.
+// The failed store is removed from the manager, so that the following
+// subscriptions create a new schema store.
+func (s *schemaStore) fail(m *schemaStoreManager, err error) {
+	s.mu.Lock()
+	if s.err != nil || s.stopped {
+		s.mu.Unlock()
+		return
+	}
+	s.err = err
+	subscribers := make([]*SchemaSubscription, 0, len(s.subscribers))
+	for _, sub := range s.subscribers {
+		subscribers = append(subscribers, sub)
+	}
+	s.mu.Unlock()
+
+	log.Warn("shared schema store failed",
+		zap.String("schemaStore", s.id.ID),
+		zap.Int("subscribers", len(subscribers)),
+		zap.Error(err))
+	m.removeStore(s)
+	for _, sub := range subscribers {
+		if sub.onError != nil {
+			sub.onError(err)
+		}
+	}
+}
+
+// tryAddSubscriber adds the subscriber if the store holds the snapshot
+// of the GC ts of the subscriber.
+func (s *schemaStore) tryAddSubscriber(sub *SchemaSubscription) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.err != nil || s.stopped || sub.gcTs < s.gcTs {
+		return false
+	}
+	s.subscribers[sub.changefeedID] = sub
+	return true
+}
+
+// removeSubscriber removes the subscriber and returns true if
+// the store has no subscriber.
+func (s *schemaStore) removeSubscriber(sub *SchemaSubscription) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.subscribers, sub.changefeedID)
+	return len(s.subscribers) == 0
+}
+
+// doGC updates the GC ts of the subscriber and removes the snapshots
+// which are no longer needed by all subscribers.
+func (s *schemaStore) doGC(sub *SchemaSubscription, ts uint64) uint64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if ts > sub.gcTs {
+		sub.gcTs = ts
+	}
+	minGcTs := uint64(math.MaxUint64)
+	for _, sub := range s.subscribers {
+		if sub.gcTs < minGcTs {
+			minGcTs = sub.gcTs
+		}
+	}
+	if minGcTs == math.MaxUint64 {
+		return s.gcTs
+	}
+	s.gcTs = s.storage.DoGC(minGcTs)
+	return s.gcTs
+}
+
+func (s *schemaStore) stop() {
+	s.mu.Lock()
+	if s.stopped {
+		s.mu.Unlock()
+		return
+	}
+	s.stopped = true
+	s.mu.Unlock()
+
+	s.cancel()
+	s.wg.Wait()
+	log.Info("shared schema store stopped", zap.String("schemaStore", s.id.ID))
+}
+
+// GetSnapshot implements entry.SchemaStorage.
+func (s *SchemaSubscription) GetSnapshot(ctx context.Context, ts uint64) (*schema.Snapshot, error) {
+	return s.store.storage.GetSnapshot(ctx, ts)
+}
+
+// GetLastSnapshot implements entry.SchemaStorage.
+func (s *SchemaSubscription) GetLastSnapshot() *schema.Snapshot {
+	return s.store.storage.GetLastSnapshot()
+}
+
+// HandleDDLJob implements entry.SchemaStorage.
+// The shared schema store is driven by its own DDL job puller,
+// so it must not be called by subscribers.
+func (s *SchemaSubscription) HandleDDLJob(job *timodel.Job) error {
+	log.Panic("HandleDDLJob must not be called on a shared schema store",
+		zap.String("namespace", s.changefeedID.Namespace),
+		zap.String("changefeed", s.changefeedID.ID),
+		zap.Int64("jobID", job.ID))
+	return nil
+}
+
+// AdvanceResolvedTs implements entry.SchemaStorage.
+// The shared schema store is driven by its own DDL job puller,
+// so it must not be called by subscribers.
+func (s *SchemaSubscription) AdvanceResolvedTs(ts uint64) {
+	log.Panic("AdvanceResolvedTs must not be called on a shared schema store",
+		zap.String("namespace", s.changefeedID.Namespace),
+		zap.String("changefeed", s.changefeedID.ID),
+		zap.Uint64("ts", ts))
+}
+
+// ResolvedTs implements entry.SchemaStorage.
+func (s *SchemaSubscription) ResolvedTs() uint64 {
+	return s.store.storage.ResolvedTs()
+}
+
+// DoGC implements entry.SchemaStorage. It reports the GC ts of the changefeed,
+// snapshots are removed only if they are no longer needed by all subscribers.
+func (s *SchemaSubscription) DoGC(ts uint64) (lastSchemaTs uint64) {
+	return s.store.doGC(s, ts)
+}
+
+// Close unsubscribes the shared schema store, the schema store
+// is stopped if it has no subscriber.
+func (s *SchemaSubscription) Close() {
+	s.closeOnce.Do(func() {
+		s.manager.unsubscribe(s)
+		log.Info("changefeed unsubscribes the shared schema store",
+			zap.String("namespace", s.changefeedID.Namespace),
+			zap.String("changefeed", s.changefeedID.ID),
+			zap.String("schemaStore", s.store.id.ID))
+	})
+}
@@ -0,0 +1,193 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package upstream
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pingcap/errors"
+	timodel "github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
+)
+
+type mockDDLJobPuller struct {
+	outputCh chan *model.DDLJobEntry
+	runErr   chan error
+}
+
+func (p *mockDDLJobPuller) Run(ctx context.Context) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case err := <-p.runErr:
+		return err
+	}
+}
+
+func (p *mockDDLJobPuller) Output() <-chan *model.DDLJobEntry {
+	return p.outputCh
+}
+
+type mockDDLJobPullerCreator struct {
+	mu      sync.Mutex
+	pullers []*mockDDLJobPuller
+}
+
+func (c *mockDDLJobPullerCreator) create(
+	_ context.Context, _ *Upstream, _ uint64, _ model.ChangeFeedID,
+) (DDLJobPuller, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	p := &mockDDLJobPuller{
+		outputCh: make(chan *model.DDLJobEntry, 16),
+		runErr:   make(chan error, 1),
+	}
+	c.pullers = append(c.pullers, p)
+	return p, nil
+}
+
+func (c *mockDDLJobPullerCreator) count() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.pullers)
+}
+
+func createSchemaJob(schemaID int64, finishedTs uint64) *timodel.Job {
+	return &timodel.Job{
+		ID:       schemaID,
+		State:    timodel.JobStateSynced,
+		SchemaID: schemaID,
+		Type:     timodel.ActionCreateSchema,
+		BinlogInfo: &timodel.HistoryInfo{
+			SchemaVersion: schemaID,
+			DBInfo: &timodel.DBInfo{
+				ID:    schemaID,
+				Name:  timodel.NewCIStr("test"),
+				State: timodel.StatePublic,
+			},
+			FinishedTS: finishedTs,
+		},
+		Query: "create database test",
+	}
+}
+
+func TestSharedSchemaStore(t *testing.T) {
+	t.Parallel()
+
+	up := NewUpstream4Test(nil)
+	defer up.Close()
+	creator := &mockDDLJobPullerCreator{}
+
+	sub1, err := up.SubscribeSchemaStorage(model.DefaultChangeFeedID("cf1"),
+		100, false, creator.create, nil)
+	require.Nil(t, err)
+	sub2, err := up.SubscribeSchemaStorage(model.DefaultChangeFeedID("cf2"),
+		110, false, creator.create, nil)
+	require.Nil(t, err)
+	// Changefeeds with the same force-replicate share the schema store.
+	require.Equal(t, 1, creator.count())
+
+	puller := creator.pullers[0]
+	puller.outputCh <- &model.DDLJobEntry{Job: createSchemaJob(100, 120), OpType: model.OpTypePut, CRTs: 120}
+	puller.outputCh <- &model.DDLJobEntry{OpType: model.OpTypeResolved, CRTs: 130}
+	require.Eventually(t, func() bool {
+		return sub1.ResolvedTs() == 130 && sub2.ResolvedTs() == 130
+	}, 5*time.Second, 10*time.Millisecond)
+
+	ctx := context.Background()
+	for _, sub := range []*SchemaSubscription{sub1, sub2} {
+		snap, err := sub.GetSnapshot(ctx, 125)
+		require.Nil(t, err)
+		_, ok := snap.SchemaByID(100)
+		require.True(t, ok)
+		snap, err = sub.GetSnapshot(ctx, 115)
+		require.Nil(t, err)
+		_, ok = snap.SchemaByID(100)
+		require.False(t, ok)
+	}
+
+	// The snapshots are kept until all subscribers don't need them.
+	require.Less(t, sub2.DoGC(125), uint64(120))
+	_, err = sub1.GetSnapshot(ctx, 115)
+	require.Nil(t, err)
+	require.Equal(t, uint64(120), sub1.DoGC(125))
+
+	// The schema store can't serve the checkpoint ts which is GCed,
+	// or with a different force-replicate.
+	sub3, err := up.SubscribeSchemaStorage(model.DefaultChangeFeedID("cf3"),
+		110, false, creator.create, nil)
+	require.Nil(t, err)
+	require.Equal(t, 2, creator.count())
+	sub4, err := up.SubscribeSchemaStorage(model.DefaultChangeFeedID("cf4"),
+		125, true, creator.create, nil)
+	require.Nil(t, err)
+	require.Equal(t, 3, creator.count())
+	sub5, err := up.SubscribeSchemaStorage(model.DefaultChangeFeedID("cf5"),
+		125, false, creator.create, nil)
+	require.Nil(t, err)
+	require.Equal(t, 3, creator.count())
+	require.Len(t, up.schemaStores.stores, 3)
+
+	// The schema store is stopped when all subscribers are closed.
+	sub1.Close()
+	sub2.Close()
+	require.Len(t, up.schemaStores.stores, 3)
+	sub5.Close()
+	require.Len(t, up.schemaStores.stores, 2)
+	// Close twice is ok.
+	sub5.Close()
+	sub3.Close()
+	sub4.Close()
+	require.Len(t, up.schemaStores.stores, 0)
+}
+
+func TestSharedSchemaStoreError(t *testing.T) {
+	t.Parallel()
+
+	up := NewUpstream4Test(nil)
+	defer up.Close()
+	creator := &mockDDLJobPullerCreator{}
+
+	errCount := atomic.NewInt32(0)
+	onError := func(err error) {
+		require.ErrorContains(t, err, "puller failed")
+		errCount.Inc()
+	}
+	sub1, err := up.SubscribeSchemaStorage(model.DefaultChangeFeedID("cf1"),
+		100, false, creator.create, onError)
+	require.Nil(t, err)
+	sub2, err := up.SubscribeSchemaStorage(model.DefaultChangeFeedID("cf2"),
+		100, false, creator.create, onError)
+	require.Nil(t, err)
+
+	creator.pullers[0].runErr <- errors.New("puller failed")
+	require.Eventually(t, func() bool {
+		return errCount.Load() == 2
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// The failed schema store is not shared anymore.
+	sub3, err := up.SubscribeSchemaStorage(model.DefaultChangeFeedID("cf3"),
+		100, false, creator.create, onError)
+	require.Nil(t, err)
+	require.Equal(t, 2, creator.count())
+
+	sub1.Close()
+	sub2.Close()
+	sub3.Close()
+}
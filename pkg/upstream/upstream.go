@@ -26,6 +26,8 @@ import (
 	"github.com/pingcap/log"
 	tidbkv "github.com/pingcap/tidb/kv"
 	"github.com/pingcap/tiflow/cdc/kv"
+	"github.com/pingcap/tiflow/cdc/kv/multiplexer"
+	"github.com/pingcap/tiflow/cdc/model"
 	"github.com/pingcap/tiflow/pkg/config"
 	"github.com/pingcap/tiflow/pkg/etcd"
 	"github.com/pingcap/tiflow/pkg/pdutil"
@@ -66,6 +68,9 @@ type Upstream struct {
 	RegionCache *tikv.RegionCache
 	PDClock     pdutil.Clock
 	GCManager   gc.Manager
+	// RegionMultiplexer shares the region subscriptions of the same span
+	// between changefeeds in a capture.
+	RegionMultiplexer *multiplexer.Multiplexer
 	// Only use in Close().
 	cancel func()
 	mu     sync.Mutex
@@ -78,6 +83,10 @@ type Upstream struct {
 
 	err               uatomic.Error
 	isDefaultUpstream bool
+
+	// schemaStores is the shared schema stores of the upstream,
+	// it's created on the first subscription.
+	schemaStores *schemaStoreManager
 }
 
 func newUpstream(pdEndpoints []string,
@@ -180,6 +189,12 @@ func initUpstream(ctx context.Context, up *Upstream, gcServiceID string) error {
 
 	up.GCManager = gc.NewManager(gcServiceID, up.PDClient, up.PDClock)
 
+	multiplexerID := model.DefaultChangeFeedID(fmt.Sprintf("shared-region-feed-%d", up.ID))
+	up.RegionMultiplexer = multiplexer.New(ctx, multiplexerID, func() kv.CDCKVClient {
+		return kv.NewCDCKVClient(ctx, up.PDClient, up.GrpcPool, up.RegionCache,
+			up.PDClock, multiplexerID, config.GetGlobalServerConfig().KVClient)
+	})
+
 	// Update meta-region label to ensure that meta region isolated from data regions.
 	pc, err := pdutil.NewPDAPIClient(up.PDClient, up.SecurityConfig)
 	if err != nil {
@@ -223,6 +238,17 @@ func (up *Upstream) Close() {
 	}
 	atomic.StoreInt32(&up.status, closing)
 
+	up.mu.Lock()
+	schemaStores := up.schemaStores
+	up.mu.Unlock()
+	if schemaStores != nil {
+		schemaStores.close()
+	}
+
+	if up.RegionMultiplexer != nil {
+		up.RegionMultiplexer.Close()
+	}
+
 	if up.PDClient != nil {
 		up.PDClient.Close()
 	}